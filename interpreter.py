@@ -5,23 +5,62 @@ from __future__ import annotations
 
 import argparse
 import json
+from collections.abc import Callable
+from pathlib import Path
 
 from interpreter import constants
 from interpreter.api import (
+    check_types,
+    dead_stores,
+    declaration_conflicts,
+    diagnostics,
+    diff_ast,
+    dominator_trees,
+    dump_call_graph_mermaid,
     dump_cfg,
+    dump_dominator_mermaid,
     dump_ir,
     dump_mermaid,
+    dump_sexp,
+    format_code,
+    function_metrics,
+    function_purity,
+    missing_returns,
+    parse_cst,
+    range_checks,
+    recursive_functions,
+    ssa_form,
+    syntax_diagnostics,
+    taint_flows,
+    unassigned_reads,
+    unused_variables,
 )
+from interpreter.cst_json import dumps_cst
+from interpreter.diagnostic import DiagnosticCode, DiagnosticConfig, Level, Severity
+from interpreter.frontends.nesting import NestingTooDeepError
 from interpreter.func_name import FuncName
 from interpreter.project.entry_point import EntryPoint
+from interpreter.project.language_detection import (
+    LanguageDetectionError,
+    detect_language,
+)
 from interpreter.run import run
+from interpreter.run_types import IntegerOverflowMode
+from interpreter.ssa_types import SSAMode
+from interpreter.taint_types import DEFAULT_POLICY
+from interpreter.value_range_types import Verdict
+from interpreter.vm.call_depth import CallDepthExceededError
+from interpreter.vm.integer_overflow import IntegerOverflowError
 
 
-def main():
+def _build_parser() -> argparse.ArgumentParser:
     parser = argparse.ArgumentParser(description="LLM Symbolic Interpreter")
     parser.add_argument("file", nargs="?", help="Source file to interpret")
     parser.add_argument(
-        "--language", "-l", default="python", help="Source language (default: python)"
+        "--language",
+        "-l",
+        default="",
+        help="Source language (default: detected from the file)",
     )
     parser.add_argument(
         "--entry", "-e", default="", help="Entry point label or function name"
@@ -52,11 +91,205 @@ def main():
     parser.add_argument(
         "--cfg-only", action="store_true", help="Only print the CFG (no LLM execution)"
     )
+    parser.add_argument(
+        "--ssa-only",
+        action="store_true",
+        help="Only print the program in SSA form (no LLM execution)",
+    )
+    parser.add_argument(
+        "--ssa-mode",
+        default=SSAMode.PRUNED.value,
+        choices=[mode.value for mode in SSAMode],
+        help="Which joins get a phi, with --ssa-only (default: pruned)",
+    )
+    parser.add_argument(
+        "--dominators",
+        action="store_true",
+        help="Only print each function's dominator tree and dominance frontiers "
+        "(no LLM execution; with --mermaid, as a diagram)",
+    )
+    parser.add_argument(
+        "--check-syntax",
+        action="store_true",
+        help="Report every syntax error in the file and exit",
+    )
+    parser.add_argument(
+        "--check-types",
+        action="store_true",
+        help="Report every type error in the file and exit",
+    )
+    parser.add_argument(
+        "--check-assigned",
+        action="store_true",
+        help="Report every read of a variable that may be unassigned and exit",
+    )
+    parser.add_argument(
+        "--check-dead-stores",
+        action="store_true",
+        help="Report every assignment whose value is never read and exit",
+    )
+    parser.add_argument(
+        "--check-ranges",
+        action="store_true",
+        help="Report every index that may be out of bounds and every divisor "
+        "that may be zero and exit",
+    )
+    parser.add_argument(
+        "--check-returns",
+        action="store_true",
+        help="Report every function that can end without returning and exit",
+    )
+    parser.add_argument(
+        "--check-recursion",
+        action="store_true",
+        help="Report every recursive function as an error and exit, "
+        "for dialects without recursion",
+    )
+    parser.add_argument(
+        "--purity",
+        action="store_true",
+        help="Report whether each function is pure, and why not, and exit",
+    )
+    parser.add_argument(
+        "--require-pure",
+        metavar="NAME",
+        action="append",
+        default=[],
+        help="Report function NAME as an error unless it is pure, and exit; "
+        "may be repeated",
+    )
+    parser.add_argument(
+        "--taint",
+        action="store_true",
+        help="Report every untrusted value that reaches a sink unsanitized "
+        "and exit",
+    )
+    parser.add_argument(
+        "--taint-source",
+        dest="taint_sources",
+        metavar="NAME",
+        action="append",
+        default=[],
+        help="With --taint, treat calls of NAME as returning untrusted values; "
+        "may be repeated",
+    )
+    parser.add_argument(
+        "--taint-sink",
+        dest="taint_sinks",
+        metavar="NAME",
+        action="append",
+        default=[],
+        help="With --taint, report untrusted values passed to NAME; "
+        "may be repeated",
+    )
+    parser.add_argument(
+        "--taint-sanitizer",
+        dest="taint_sanitizers",
+        metavar="NAME",
+        action="append",
+        default=[],
+        help="With --taint, treat calls of NAME as returning trusted values; "
+        "may be repeated",
+    )
+    parser.add_argument(
+        "--check-unused",
+        action="store_true",
+        help="Report every unused local and parameter in the file and exit",
+    )
+    parser.add_argument(
+        "--strict",
+        action="store_true",
+        help="With --check-unused, report them as errors and exit non-zero; "
+        "with --check, report every warning as an error",
+    )
+    parser.add_argument(
+        "--check-declarations",
+        action="store_true",
+        help="Report every redeclared name and shadowed variable and exit",
+    )
+    parser.add_argument(
+        "--no-shadowing",
+        action="store_true",
+        help="With --check-declarations or --check, do not warn about legal "
+        "shadowing",
+    )
+    parser.add_argument(
+        "--check",
+        action="store_true",
+        help="Run every check and report what they find, with stable codes, "
+        "and exit",
+    )
+    parser.add_argument(
+        "-W",
+        dest="levels",
+        metavar="CODE=LEVEL",
+        action="append",
+        default=[],
+        help="With --check, report CODE (e.g. RD2004 or unused-variable) "
+        "as off, warning or error; may be repeated",
+    )
+    parser.add_argument(
+        "--metrics",
+        action="store_true",
+        help="Print each function's cyclomatic complexity and nesting depth and exit",
+    )
+    parser.add_argument(
+        "--call-graph",
+        action="store_true",
+        help="Output the call graph as a Mermaid flowchart diagram and exit",
+    )
+    parser.add_argument(
+        "--fmt",
+        action="store_true",
+        help="Print the file in canonical layout and exit",
+    )
+    parser.add_argument(
+        "--cst-json",
+        action="store_true",
+        help="Print the file's concrete syntax tree as JSON and exit",
+    )
+    parser.add_argument(
+        "--sexp",
+        action="store_true",
+        help="Print the file's concrete syntax tree as an S-expression and exit",
+    )
+    parser.add_argument(
+        "--diff",
+        metavar="NEW_FILE",
+        default="",
+        help="Print the syntax-tree edits that turn the file into NEW_FILE and exit",
+    )
     parser.add_argument(
         "--mermaid",
         action="store_true",
         help="Output CFG as a Mermaid flowchart diagram",
     )
+    parser.add_argument(
+        "--show-output",
+        action="store_true",
+        help="Print the program's captured output after the run",
+    )
+    parser.add_argument(
+        "--integer-overflow",
+        default=IntegerOverflowMode.BIG.value,
+        choices=[mode.value for mode in IntegerOverflowMode],
+        help="Integer results past the int64 range: keep exact (big), wrap, "
+        "or stop with a runtime error (trap) (default: big)",
+    )
+    parser.add_argument(
+        "--max-nesting-depth",
+        type=int,
+        default=constants.MAX_NESTING_DEPTH,
+        help="Deepest syntax tree to lower before reporting an error "
+        f"(default: {constants.MAX_NESTING_DEPTH})",
+    )
+    parser.add_argument(
+        "--max-call-depth",
+        type=int,
+        default=constants.MAX_CALL_DEPTH,
+        help="Most nested calls before stopping with a runtime error "
+        f"(default: {constants.MAX_CALL_DEPTH})",
+    )
     parser.add_argument(
         "--function",
         default="",
@@ -74,8 +307,14 @@ def main():
         help="Frontend type: deterministic, llm, or chunked_llm (default: deterministic)",
     )
 
-    args = parser.parse_args()
+    return parser
+
+
+def _read_source(args: argparse.Namespace) -> str:
+    """The file's source, or the built-in demo when no file is given.
 
+    Detects the language from the file name when --language is not given.
+    """
     if not args.file:
         source = """\
 def factorial(n):
@@ -88,48 +327,281 @@ result = factorial(5)
 """
         print("No file provided. Using built-in demo:\n")
         print(source)
-    else:
-        with open(args.file) as f:
-            source = f.read()
+        args.language = args.language or constants.Language.PYTHON
+        return source
+    with open(args.file) as f:
+        source = f.read()
+    if not args.language:
+        try:
+            args.language = detect_language(Path(args.file))
+        except LanguageDetectionError as err:
+            raise SystemExit(f"{err}; pass --language") from err
+    return source
+
+
+def _report(args: argparse.Namespace, finding: object) -> None:
+    """Print *finding* prefixed with the file it was found in."""
+    print(f"{args.file or '<demo>'}:{finding}")
+
+
+def _check_syntax(args: argparse.Namespace, source: str) -> int:
+    syntax_errors = syntax_diagnostics(source, args.language)
+    for diagnostic in syntax_errors:
+        _report(args, diagnostic)
+    return 1 if syntax_errors else 0
+
+
+def _check_types(args: argparse.Namespace, source: str) -> int:
+    errors = check_types(source, args.language).errors
+    for error in errors:
+        _report(args, error)
+        for note in error.notes:
+            _report(args, note)
+    return 1 if errors else 0
+
+
+def _check_assigned(args: argparse.Namespace, source: str) -> int:
+    reads = unassigned_reads(source, args.language)
+    for read in reads:
+        _report(args, read)
+    return 1 if reads else 0
+
+
+def _check_dead_stores(args: argparse.Namespace, source: str) -> int:
+    stores = dead_stores(source, args.language)
+    for store in stores:
+        _report(args, store)
+    return 1 if stores else 0
+
+
+def _check_ranges(args: argparse.Namespace, source: str) -> int:
+    problems = [c for c in range_checks(source, args.language) if c.is_problem]
+    for check in problems:
+        _report(args, check)
+    return 1 if any(c.verdict == Verdict.UNSAFE for c in problems) else 0
+
+
+def _check_returns(args: argparse.Namespace, source: str) -> int:
+    missing = missing_returns(source, args.language)
+    for function in missing:
+        _report(args, function)
+    return 1 if missing else 0
+
+
+def _check_recursion(args: argparse.Namespace, source: str) -> int:
+    recursive = recursive_functions(source, args.language)
+    for recursion in recursive:
+        _report(args, recursion.as_error())
+    return 1 if recursive else 0
+
+
+def _purity(args: argparse.Namespace, source: str) -> int:
+    purities = function_purity(source, args.language)
+    for purity in purities:
+        _report(args, purity)
+    return 0 if all(p.is_pure for p in purities) else 1
+
+
+def _require_pure(args: argparse.Namespace, source: str) -> int:
+    impure = [
+        purity
+        for purity in function_purity(source, args.language)
+        if purity.function in args.require_pure and not purity.is_pure
+    ]
+    for purity in impure:
+        diagnostic = purity.as_diagnostic()
+        _report(args, diagnostic)
+        for span in diagnostic.secondary:
+            _report(args, span)
+    return 1 if impure else 0
+
+
+def _taint(args: argparse.Namespace, source: str) -> int:
+    policy = (
+        DEFAULT_POLICY.with_sources(*args.taint_sources)
+        .with_sinks(*args.taint_sinks)
+        .with_sanitizers(*args.taint_sanitizers)
+    )
+    for flow in taint_flows(source, args.language, policy):
+        _report(args, flow)
+        for span in flow.as_diagnostic().secondary:
+            _report(args, span)
+    return 0
+
 
-    if args.ir_only:
-        print("═══ IR ═══")
-        print(dump_ir(source, args.language, args.frontend, args.backend))
-        return
+def _check_unused(args: argparse.Namespace, source: str) -> int:
+    unused = unused_variables(source, args.language, strict=args.strict)
+    for variable in unused:
+        _report(args, variable)
+    return 1 if unused and args.strict else 0
 
+
+def _check_declarations(args: argparse.Namespace, source: str) -> int:
+    conflicts = declaration_conflicts(
+        source, args.language, shadowing=not args.no_shadowing
+    )
+    for conflict in conflicts:
+        _report(args, conflict)
+    errors = [c for c in conflicts if c.severity == Severity.ERROR]
+    return 1 if errors else 0
+
+
+def _check(args: argparse.Namespace, source: str) -> int:
+    try:
+        config = DiagnosticConfig.from_options(args.levels, strict=args.strict)
+    except ValueError as err:
+        raise SystemExit(f"error: {err}") from err
+    if args.no_shadowing:
+        config = config.with_level(DiagnosticCode.SHADOWED, Level.OFF)
+    found = diagnostics(source, args.language, config)
+    for diagnostic in found:
+        _report(args, diagnostic)
+        for span in diagnostic.secondary:
+            _report(args, span)
+        for note in diagnostic.notes:
+            print(f"  = note: {note}")
+    return 1 if any(d.is_error for d in found) else 0
+
+
+def _metrics(args: argparse.Namespace, source: str) -> int:
+    for metrics in function_metrics(source, args.language):
+        _report(args, metrics)
+    return 0
+
+
+def _call_graph(args: argparse.Namespace, source: str) -> int:
+    print(dump_call_graph_mermaid(source, args.language))
+    return 0
+
+
+def _fmt(args: argparse.Namespace, source: str) -> int:
+    print(format_code(source, args.language), end="")
+    return 0
+
+
+def _cst_json(args: argparse.Namespace, source: str) -> int:
+    print(dumps_cst(parse_cst(source, args.language), indent=2))
+    return 0
+
+
+def _sexp(args: argparse.Namespace, source: str) -> int:
+    print(dump_sexp(source, args.language))
+    return 0
+
+
+def _diff(args: argparse.Namespace, source: str) -> int:
+    with open(args.diff) as f:
+        new_source = f.read()
+    for edit in diff_ast(source, new_source, args.language):
+        print(edit)
+    return 0
+
+
+def _ir_only(args: argparse.Namespace, source: str) -> int:
+    print("═══ IR ═══")
+    print(dump_ir(source, args.language, args.frontend, args.backend))
+    return 0
+
+
+def _dominators(args: argparse.Namespace, source: str) -> int:
     if args.mermaid:
-        print(
-            dump_mermaid(
-                source, args.language, args.frontend, args.backend, args.function
-            )
-        )
-        return
+        print(dump_dominator_mermaid(source, args.language, args.function))
+        return 0
+    print("═══ Dominators ═══")
+    for function, tree in dominator_trees(source, args.language).items():
+        print(f"[{function or 'top level'}]")
+        print(tree)
+    return 0
+
+
+def _mermaid(args: argparse.Namespace, source: str) -> int:
+    print(
+        dump_mermaid(source, args.language, args.frontend, args.backend, args.function)
+    )
+    return 0
 
-    if args.cfg_only:
-        print("═══ CFG ═══")
-        print(
-            dump_cfg(source, args.language, args.frontend, args.backend, args.function)
-        )
-        return
 
-    # Full run
+def _cfg_only(args: argparse.Namespace, source: str) -> int:
+    print("═══ CFG ═══")
+    print(dump_cfg(source, args.language, args.frontend, args.backend, args.function))
+    return 0
+
+
+def _ssa_only(args: argparse.Namespace, source: str) -> int:
+    print("═══ SSA ═══")
+    print(ssa_form(source, args.language, SSAMode(args.ssa_mode)))
+    return 0
+
+
+def _run_program(args: argparse.Namespace, source: str) -> int:
     entry = (
         EntryPoint.function(lambda f, _name=FuncName(args.entry): f.name == _name)
         if args.entry
         else EntryPoint.top_level()
     )
-    vm = run(
-        source,
-        language=args.language,
-        entry_point=entry,
-        backend=args.backend,
-        max_steps=args.max_steps,
-        verbose=args.verbose,
-        frontend_type=args.frontend,
-    )
+    try:
+        vm = run(
+            source,
+            language=args.language,
+            entry_point=entry,
+            backend=args.backend,
+            max_steps=args.max_steps,
+            verbose=args.verbose,
+            frontend_type=args.frontend,
+            integer_overflow=IntegerOverflowMode(args.integer_overflow),
+            max_nesting_depth=args.max_nesting_depth,
+            max_call_depth=args.max_call_depth,
+        )
+    except (IntegerOverflowError, CallDepthExceededError, NestingTooDeepError) as err:
+        raise SystemExit(str(err)) from err
+
+    if args.show_output:
+        print("\n═══ Program Output ═══")
+        print(vm.stdout_text(), end="")
 
     print("\n═══ Final VM State ═══")
     print(json.dumps(vm.to_dict(), indent=2, default=str))
+    return 0
+
+
+# Each report-and-exit mode, by the option that selects it, in precedence
+# order; with none of them given, the program is run.
+_MODES: tuple[tuple[str, Callable[[argparse.Namespace, str], int]], ...] = (
+    ("check_syntax", _check_syntax),
+    ("check_types", _check_types),
+    ("check_assigned", _check_assigned),
+    ("check_dead_stores", _check_dead_stores),
+    ("check_ranges", _check_ranges),
+    ("check_returns", _check_returns),
+    ("check_recursion", _check_recursion),
+    ("purity", _purity),
+    ("require_pure", _require_pure),
+    ("taint", _taint),
+    ("check_unused", _check_unused),
+    ("check_declarations", _check_declarations),
+    ("check", _check),
+    ("metrics", _metrics),
+    ("call_graph", _call_graph),
+    ("fmt", _fmt),
+    ("cst_json", _cst_json),
+    ("sexp", _sexp),
+    ("diff", _diff),
+    ("ir_only", _ir_only),
+    ("dominators", _dominators),
+    ("mermaid", _mermaid),
+    ("cfg_only", _cfg_only),
+    ("ssa_only", _ssa_only),
+)
+
+
+def main():
+    args = _build_parser().parse_args()
+    source = _read_source(args)
+    mode = next(
+        (handler for option, handler in _MODES if getattr(args, option)),
+        _run_program,
+    )
+    raise SystemExit(mode(args, source))
 
 
 if __name__ == "__main__":
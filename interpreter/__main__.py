@@ -1,12 +1,18 @@
 """CLI: uv run python -m interpreter <path> [--entry PROGRAM] [options]
 
-Compiles and runs a COBOL program through the full pipeline:
-  CICS pre-pass → ProLeap parse → IR lowering → CFG → VM execution
+Compiles and runs a program through the full pipeline:
+  parse → IR lowering → CFG → VM execution
+
+The source language is detected from file extensions (and, for files
+without a recognized one, from a shebang line or the source itself); a
+directory holding several languages is compiled and run once per language.
+Pass --language to override detection.
 
 Examples:
     uv run python -m interpreter myprogram.cbl
     uv run python -m interpreter cobol/ --entry MAINPROG
     uv run python -m interpreter cobol/ --entry MAINPROG --max-steps 100000 --log-level INFO
+    uv run python -m interpreter exercises/leap/solutions/
 """
 
 from __future__ import annotations
@@ -19,6 +25,11 @@ from pathlib import Path
 from interpreter.constants import Language
 from interpreter.project.compiler import compile_directory
 from interpreter.project.entry_point import EntryPoint
+from interpreter.project.language_detection import (
+    LanguageDetectionError,
+    detect_directory_languages,
+    detect_language,
+)
 from interpreter.run import initial_vm_state, run, run_linked
 
 
@@ -43,9 +54,15 @@ def _entry_point(name: str | None) -> EntryPoint:
 def main() -> int:
     ap = argparse.ArgumentParser(
         prog="python -m interpreter",
-        description="Compile and run a COBOL program through the full pipeline.",
+        description="Compile and run a program through the full pipeline.",
+    )
+    ap.add_argument("path", help="Source file or directory of sources")
+    ap.add_argument(
+        "--language",
+        default="",
+        choices=[language.value for language in Language],
+        help="Source language (default: detected from the sources)",
     )
-    ap.add_argument("path", help="COBOL source file (.cbl) or directory of sources")
     ap.add_argument(
         "--entry",
         metavar="PROGRAM",
@@ -77,22 +94,40 @@ def main() -> int:
     ep = _entry_point(args.entry)
 
     if target.is_file():
+        try:
+            language = (
+                Language(args.language) if args.language else detect_language(target)
+            )
+        except LanguageDetectionError as err:
+            print(f"error: {err}; pass --language", file=sys.stderr)
+            return 1
         run(
             target.read_text(encoding="utf-8"),
-            language=Language.COBOL,
+            language=language,
             entry_point=ep,
             max_steps=args.max_steps,
             verbose=args.verbose,
         )
     elif target.is_dir():
-        linked = compile_directory(target, Language.COBOL)
-        run_linked(
-            linked,
-            ep,
-            max_steps=args.max_steps,
-            verbose=args.verbose,
-            initial_vm=initial_vm_state(),
+        languages = (
+            (Language(args.language),)
+            if args.language
+            else detect_directory_languages(target)
         )
+        if not languages:
+            print(f"error: no source files found in {target}", file=sys.stderr)
+            return 1
+        for language in languages:
+            if len(languages) > 1:
+                print(f"═══ {language.value} ═══", file=sys.stderr)
+            linked = compile_directory(target, language)
+            run_linked(
+                linked,
+                ep,
+                max_steps=args.max_steps,
+                verbose=args.verbose,
+                initial_vm=initial_vm_state(),
+            )
     else:
         print(f"error: {target} does not exist", file=sys.stderr)
         return 1
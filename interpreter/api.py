@@ -17,14 +17,42 @@ from interpreter import constants
 from interpreter.cfg import (
     CFG,
     build_cfg,
+    build_function_cfgs,
     cfg_to_mermaid,
     extract_function_instructions,
 )
+from interpreter.ast_diff import diff_trees
+from interpreter.ast_diff_types import TreeEdit
 from interpreter.constants import Language, LLMProvider
-from interpreter.frontend import get_frontend
+from interpreter.cst import build_cst
+from interpreter.cst_sexp import cst_to_sexp
+from interpreter.cst_types import CstNode
+from interpreter.dead_store_types import DeadStore
+from interpreter.dead_stores import find_dead_stores
+from interpreter.declaration_conflict_types import DeclarationConflict
+from interpreter.declaration_conflicts import find_declaration_conflicts
+from interpreter.definite_assignment import find_unassigned_reads
+from interpreter.definite_assignment_types import UnassignedRead
+from interpreter.diagnostic import Diagnostic, DiagnosticConfig
+from interpreter.dominator_types import DominatorTree
+from interpreter.dominators import build_dominator_tree, dominator_tree_to_mermaid
+from interpreter.formatter import format_source
+from interpreter.frontend import Frontend, get_frontend
+from interpreter.frontends.compile_error import CompileError
+from interpreter.frontends.scopes import Scope
+from interpreter.function_metrics import compute_function_metrics
+from interpreter.function_metrics_types import FunctionMetrics
+from interpreter.frontends.syntax_diagnostics import collect_syntax_diagnostics
 from interpreter.instructions import InstructionBase
+from interpreter.ir import SourcePosition
 from interpreter.ir_stats import count_opcodes
+from interpreter.missing_return_types import MissingReturn
+from interpreter.missing_returns import find_missing_returns
 from interpreter.parser import Parser, TreeSitterParserFactory
+from interpreter.purity import find_purity
+from interpreter.purity_types import Purity
+from interpreter.recursion import find_recursion
+from interpreter.recursion_types import Recursion
 from interpreter.registry import build_registry
 from interpreter.run import (
     build_execution_strategies,
@@ -32,16 +60,28 @@ from interpreter.run import (
     initial_vm_state,
 )
 from interpreter.run_types import VMConfig
+from interpreter.ssa import to_ssa
+from interpreter.ssa_types import SSAForm, SSAMode
+from interpreter.syntax_diagnostic import SyntaxDiagnostic
+from interpreter.taint import find_taint_flows
+from interpreter.taint_types import DEFAULT_POLICY, TaintFlow, TaintPolicy
 from interpreter.trace_types import ExecutionTrace
+from interpreter.types import type_check
 from interpreter.types.coercion.default_conversion_rules import (
     DefaultTypeConversionRules,
 )
 from interpreter.types.type_environment import TypeEnvironment
+from interpreter.types.type_expr import TypeExpr
 from interpreter.types.type_inference import infer_types
+from interpreter.types.type_info import TypeInfo, build_type_info
 from interpreter.types.type_resolver import TypeResolver
+from interpreter.unused_variable_types import UnusedVariable
+from interpreter.unused_variables import find_unused_variables
+from interpreter.value_range_types import RangeCheck
+from interpreter.value_ranges import find_range_checks
 
 if TYPE_CHECKING:
-    from interpreter.interprocedural.types import InterproceduralResult
+    from interpreter.interprocedural.types import CallGraph, InterproceduralResult
     from interpreter.vm.vm import VMState
 
 logger = logging.getLogger(__name__)
@@ -108,15 +148,61 @@ def lower_and_infer(
     logger.info("lower_and_infer: language=%s, frontend=%s", lang, frontend_type)
     frontend = get_frontend(lang, frontend_type=frontend_type, llm_provider=backend)
     instructions = frontend.lower(source.encode("utf-8"))
-    resolver = TypeResolver(DefaultTypeConversionRules())
-    env = infer_types(
+    return instructions, _infer(frontend, instructions)
+
+
+def _infer(frontend: Frontend, instructions: list[InstructionBase]) -> TypeEnvironment:
+    """Infer the types of *instructions*, from the seeds *frontend* lowered."""
+    return infer_types(
         instructions,
-        resolver,
+        TypeResolver(DefaultTypeConversionRules()),
         type_env_builder=frontend.type_env_builder,
         func_symbol_table=frontend.func_symbol_table,
         class_symbol_table=frontend.class_symbol_table,
     )
-    return instructions, env
+
+
+def check_types(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> type_check.TypeCheckResult:
+    """Lower source, infer types and type-check every expression.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "java", "kotlin").
+
+    Returns:
+        The type of every expression and one TypeDiagnostic per type
+        error; languages without static types never report errors.
+    """
+    lang = Language(language)
+    instructions, env = lower_and_infer(source, lang)
+    return type_check.check_types(instructions, env, lang)
+
+
+def type_info(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> TypeInfo:
+    """Lower and check source and record what checking found out.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "go", "java").
+
+    Returns:
+        A TypeInfo: the type of every expression, the declaration every
+        name introduces or refers to, and the value of every constant
+        expression, by source span.
+    """
+    lang = Language(language)
+    frontend = get_frontend(lang)
+    instructions = frontend.lower(source.encode("utf-8"))
+    checked = type_check.check_types(
+        instructions, _infer(frontend, instructions), lang
+    )
+    return build_type_info(instructions, checked, frontend.scopes)
 
 
 def dump_ir(
@@ -140,6 +226,476 @@ def dump_ir(
     return "\n".join(f"  {inst}" for inst in instructions)
 
 
+def resolve_scopes(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> Scope:
+    """Lower source and return the scopes its names were resolved against.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "go").
+
+    Returns:
+        The module scope; ``lookup_at(pos, name)`` on it gives the
+        declaration that *name* written at *pos* refers to.
+    """
+    frontend = get_frontend(Language(language))
+    frontend.lower(source.encode("utf-8"))
+    return frontend.scopes
+
+
+def type_at(
+    source: str,
+    position: SourcePosition,
+    name: str,
+    language: str | Language = Language.PYTHON,
+) -> TypeExpr:
+    """Lower source and return the type of *name* written at *position*.
+
+    This is the type a hover over that identifier shows: the type its
+    declaration was given, or inferred from its initializer (Go's
+    ``x := 1.5`` gives ``Float``).
+
+    Args:
+        source: The source code text.
+        position: Where the identifier is written.
+        name: The identifier.
+        language: Source language name (e.g. "go", "java").
+
+    Returns:
+        The type, or UNKNOWN if the name is undeclared or untyped there.
+    """
+    return resolve_scopes(source, language).lookup_at(position, name).type_expr
+
+
+def unused_variables(
+    source: str,
+    language: str | Language = Language.PYTHON,
+    strict: bool = False,
+) -> tuple[UnusedVariable, ...]:
+    """Lower source and report the locals and parameters it never reads.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "go", "python").
+        strict: Report them as errors, as Go does, rather than warnings.
+
+    Returns:
+        One UnusedVariable per variable, in source order; a name starting
+        with ``_`` is never reported.
+    """
+    frontend = get_frontend(Language(language))
+    instructions = frontend.lower(source.encode("utf-8"))
+    return find_unused_variables(frontend.scopes, instructions, strict)
+
+
+def unassigned_reads(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> tuple[UnassignedRead, ...]:
+    """Lower source and report the reads of variables that may be unassigned.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "java", "rust").
+
+    Returns:
+        One UnassignedRead per read that some path reaches before the
+        variable is assigned, in source order.
+    """
+    frontend = get_frontend(Language(language))
+    instructions = frontend.lower(source.encode("utf-8"))
+    return find_unassigned_reads(frontend.scopes, instructions)
+
+
+def dead_stores(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> tuple[DeadStore, ...]:
+    """Lower source and report the assignments whose value is never read.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "java", "python").
+
+    Returns:
+        One DeadStore per assignment that every path overwrites or drops
+        before reading, in source order.
+    """
+    frontend = get_frontend(Language(language))
+    instructions = frontend.lower(source.encode("utf-8"))
+    return find_dead_stores(frontend.scopes, instructions)
+
+
+def range_checks(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> tuple[RangeCheck, ...]:
+    """Lower source and decide, from value ranges, whether each index and
+    division can fail.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "go", "java").
+
+    Returns:
+        One RangeCheck per index and division, in source order: safe,
+        unsafe, possibly failing, or unknown when the ranges do not bound
+        its operands.  Indexing is checked only in languages where an
+        index past the end is an error.
+    """
+    lang = Language(language)
+    frontend = get_frontend(lang)
+    instructions = frontend.lower(source.encode("utf-8"))
+    return find_range_checks(frontend.scopes, instructions, lang)
+
+
+def missing_returns(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> tuple[MissingReturn, ...]:
+    """Lower source and report the functions that can end without returning.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "java", "go").
+
+    Returns:
+        One MissingReturn per function declared to return a value that has
+        a path to the end of its body, in source order.
+    """
+    lang = Language(language)
+    frontend = get_frontend(lang)
+    instructions = frontend.lower(source.encode("utf-8"))
+    return find_missing_returns(
+        instructions, frontend.type_env_builder.func_return_types, lang
+    )
+
+
+def declaration_conflicts(
+    source: str,
+    language: str | Language = Language.PYTHON,
+    shadowing: bool = True,
+) -> tuple[DeclarationConflict, ...]:
+    """Lower source and report its redeclared names and shadowed variables.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "java", "go").
+        shadowing: Also report the shadows the language allows, as warnings.
+
+    Returns:
+        One DeclarationConflict per redeclaration or shadowing declaration,
+        in source order.
+    """
+    lang = Language(language)
+    frontend = get_frontend(lang)
+    frontend.lower(source.encode("utf-8"))
+    return find_declaration_conflicts(frontend.scopes, lang, shadowing)
+
+
+def function_metrics(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> tuple[FunctionMetrics, ...]:
+    """Lower and parse source and measure how complex each function is.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "java").
+
+    Returns:
+        The cyclomatic complexity and nesting depth of the top level, then
+        of each function in source order.
+    """
+    lang = Language(language)
+    instructions = get_frontend(lang).lower(source.encode("utf-8"))
+    return compute_function_metrics(instructions, parse_cst(source, lang), lang)
+
+
+def _lower_with_call_graph(
+    source: str, language: Language
+) -> tuple[list[InstructionBase], CallGraph]:
+    frontend = get_frontend(language)
+    instructions = frontend.lower(source.encode("utf-8"))
+    return instructions, _call_graph_of(frontend, instructions)
+
+
+def _call_graph_of(
+    frontend: Frontend, instructions: list[InstructionBase]
+) -> CallGraph:
+    from interpreter.interprocedural.call_graph import build_call_graph
+
+    cfg = build_cfg(instructions)
+    registry = build_registry(
+        instructions,
+        cfg,
+        func_symbol_table=frontend.func_symbol_table,
+        class_symbol_table=frontend.class_symbol_table,
+    )
+    return build_call_graph(cfg, registry)
+
+
+def call_graph(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> CallGraph:
+    """Lower source and build its call graph.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "java").
+
+    Returns:
+        The CallGraph: every function, and every call site with the
+        functions it may reach, calls of function values included.
+        ``CallGraph.reachable_from()`` gives the functions not dead.
+    """
+    return _lower_with_call_graph(source, Language(language))[1]
+
+
+def dump_call_graph_mermaid(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> str:
+    """Build the call graph of source and return it as a Mermaid flowchart.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "java").
+
+    Returns:
+        A Mermaid flowchart string, one node per function.
+    """
+    from interpreter.interprocedural.call_graph import call_graph_to_mermaid
+
+    return call_graph_to_mermaid(call_graph(source, language))
+
+
+def recursive_functions(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> tuple[Recursion, ...]:
+    """Lower source and report each function that can call itself.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "java").
+
+    Returns:
+        The directly and mutually recursive functions, in source order.
+    """
+    instructions, graph = _lower_with_call_graph(source, Language(language))
+    return find_recursion(instructions, graph)
+
+
+def function_purity(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> tuple[Purity, ...]:
+    """Lower source and report whether each function is pure.
+
+    A pure function performs no I/O, uses no variable outside it, is
+    deterministic, mutates nothing it did not allocate and calls only pure
+    functions, so a call of it can be folded or memoized.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "java").
+
+    Returns:
+        One Purity per function, in source order, with the effects that
+        make an impure one impure.
+    """
+    frontend = get_frontend(Language(language))
+    instructions = frontend.lower(source.encode("utf-8"))
+    graph = _call_graph_of(frontend, instructions)
+    return find_purity(instructions, graph, frontend.scopes)
+
+
+def taint_flows(
+    source: str,
+    language: str | Language = Language.PYTHON,
+    policy: TaintPolicy = DEFAULT_POLICY,
+) -> tuple[TaintFlow, ...]:
+    """Lower source and report the untrusted values reaching sinks.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "java").
+        policy: The calls that are sources, sinks and sanitizers; by
+            default user input, and code evaluation, shell commands and
+            database queries.
+
+    Returns:
+        One TaintFlow per sink call that may be passed a value from a
+        source no sanitizer has cleaned, in source order.
+    """
+    instructions, graph = _lower_with_call_graph(source, Language(language))
+    return find_taint_flows(instructions, graph, policy)
+
+
+def syntax_diagnostics(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> tuple[SyntaxDiagnostic, ...]:
+    """Parse source and report every syntax error, without lowering it.
+
+    The parser recovers from each error and keeps going, so one call lists
+    all of them, in source order.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "go").
+
+    Returns:
+        One SyntaxDiagnostic per malformed region; empty for valid source.
+    """
+    encoded = source.encode("utf-8")
+    tree = TreeSitterParserFactory().get_parser(Language(language)).parse(encoded)
+    return collect_syntax_diagnostics(tree.root_node, encoded)
+
+
+def diagnostics(
+    source: str,
+    language: str | Language = Language.PYTHON,
+    config: DiagnosticConfig = DiagnosticConfig(),
+) -> tuple[Diagnostic, ...]:
+    """Run every check on source and report what they find, with their codes.
+
+    The checks are those of ``syntax_diagnostics``, ``check_types``,
+    ``declaration_conflicts``, ``unused_variables``, ``unassigned_reads``,
+    ``dead_stores``, ``range_checks`` and ``missing_returns``; the
+    recursion lint, which only some dialects want, is left out.  A
+    ``CompileError`` the frontend raises while lowering (Go's division by
+    a constant zero, an unused label, ...) is reported as an error
+    alongside the syntax errors, in place of the other checks.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "go", "java").
+        config: The level of each code: ``off``, ``warning`` or ``error``;
+            under ``strict``, every warning is an error.
+
+    Returns:
+        One Diagnostic per problem not turned off, in source order.
+    """
+    lang = Language(language)
+    frontend = get_frontend(lang)
+    syntax = syntax_diagnostics(source, lang)
+    try:
+        instructions = frontend.lower(source.encode("utf-8"))
+    except CompileError as err:
+        # Lowering stops at the first error the compiler would report, so
+        # there is no IR for the other checks.
+        found = [*syntax, err]
+    else:
+        checked = type_check.check_types(
+            instructions, _infer(frontend, instructions), lang
+        )
+        found = [
+            *syntax,
+            *checked.errors,
+            *find_declaration_conflicts(frontend.scopes, lang),
+            *find_unused_variables(frontend.scopes, instructions),
+            *find_unassigned_reads(frontend.scopes, instructions),
+            *find_dead_stores(frontend.scopes, instructions),
+            *(
+                check
+                for check in find_range_checks(frontend.scopes, instructions, lang)
+                if check.is_problem
+            ),
+            *find_missing_returns(
+                instructions, frontend.type_env_builder.func_return_types, lang
+            ),
+        ]
+    ordered = sorted(
+        (item.as_diagnostic() for item in found),
+        key=lambda d: (d.location.start_line, d.location.start_col),
+    )
+    return config.apply(tuple(ordered))
+
+
+def parse_cst(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> CstNode:
+    """Parse source into a lossless concrete syntax tree.
+
+    Each token carries the whitespace and comments before it, so
+    ``parse_cst(source, language).to_bytes()`` reproduces the UTF-8 encoded
+    source exactly.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "go").
+
+    Returns:
+        The root CstNode.
+    """
+    encoded = source.encode("utf-8")
+    tree = TreeSitterParserFactory().get_parser(Language(language)).parse(encoded)
+    return build_cst(tree.root_node, encoded)
+
+
+def dump_sexp(
+    source: str,
+    language: str | Language = Language.PYTHON,
+    anonymous: bool = False,
+) -> str:
+    """Parse source and return its concrete syntax tree as an S-expression.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "go").
+        anonymous: Include keywords and punctuation as quoted strings.
+
+    Returns:
+        The S-expression, one line per node that does not fit on one.
+    """
+    return cst_to_sexp(parse_cst(source, language), anonymous=anonymous)
+
+
+def diff_ast(
+    old_source: str,
+    new_source: str,
+    language: str | Language = Language.PYTHON,
+) -> tuple[TreeEdit, ...]:
+    """Diff two versions of a program by syntax tree rather than by line.
+
+    Args:
+        old_source: The earlier source code text.
+        new_source: The later source code text.
+        language: Source language name (e.g. "python", "go").
+
+    Returns:
+        The nodes inserted, deleted and moved, and the tokens whose text
+        changed, turning the old tree into the new; empty if the two differ
+        only in layout and comments.
+    """
+    return diff_trees(parse_cst(old_source, language), parse_cst(new_source, language))
+
+
+def format_code(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> str:
+    """Lay source out canonically (gofmt-style spacing and indentation).
+
+    Only whitespace between tokens changes, and formatting the result
+    again leaves it unchanged.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "python", "go").
+
+    Returns:
+        The formatted source text.
+    """
+    return format_source(source, Language(language))
+
+
 def build_cfg_from_source(
     source: str,
     language: str | Language = Language.PYTHON,
@@ -167,6 +723,63 @@ def build_cfg_from_source(
     return build_cfg(instructions)
 
 
+def function_cfgs(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> dict[str, CFG]:
+    """Lower source and build a CFG per function.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "java", "python").
+
+    Returns:
+        Each function's CFG by its entry label, with "" for the top level.
+    """
+    return build_function_cfgs(lower_source(source, language))
+
+
+def dominator_trees(
+    source: str,
+    language: str | Language = Language.PYTHON,
+) -> dict[str, DominatorTree]:
+    """Lower source and build the dominator tree of each function.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "java", "python").
+
+    Returns:
+        Each function's dominator tree, with its dominance frontiers, by its
+        entry label, with "" for the top level.
+    """
+    return {
+        function: build_dominator_tree(cfg)
+        for function, cfg in function_cfgs(source, language).items()
+    }
+
+
+def ssa_form(
+    source: str,
+    language: str | Language = Language.PYTHON,
+    mode: SSAMode = SSAMode.PRUNED,
+) -> SSAForm:
+    """Lower source and convert it to SSA form.
+
+    Args:
+        source: The source code text.
+        language: Source language name (e.g. "java", "python").
+        mode: Which joins get a phi: minimal, semi-pruned or pruned.
+
+    Returns:
+        The program's CFG with each function's local variables renamed to
+        versions, and the phis at each join.
+    """
+    frontend = get_frontend(Language(language))
+    instructions = frontend.lower(source.encode("utf-8"))
+    return to_ssa(frontend.scopes, instructions, mode)
+
+
 def dump_cfg(
     source: str,
     language: str | Language = Language.PYTHON,
@@ -213,6 +826,25 @@ def dump_mermaid(
     return cfg_to_mermaid(cfg)
 
 
+def dump_dominator_mermaid(
+    source: str,
+    language: str | Language = Language.PYTHON,
+    function_name: str = "",
+) -> str:
+    """Build a dominator tree from source and return a Mermaid flowchart diagram.
+
+    Args:
+        source: The source code text.
+        language: Source language name.
+        function_name: If non-empty, scope to this function.
+
+    Returns:
+        A Mermaid flowchart string, with dotted edges to dominance frontiers.
+    """
+    cfg = build_cfg_from_source(source, language, function_name=function_name)
+    return dominator_tree_to_mermaid(build_dominator_tree(cfg))
+
+
 def ir_stats(
     source: str,
     language: str | Language = Language.PYTHON,
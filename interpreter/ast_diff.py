@@ -0,0 +1,303 @@
+# pyright: standard
+"""AST diff — the tree edits that turn one syntax tree into another.
+
+A line diff of two versions of a program reports a re-indented block as
+changed throughout and a moved function as deleted and re-added.  This
+diff works on the concrete syntax trees instead (keywords, punctuation and
+trivia left out) and reports what happened to the nodes: which subtrees
+were inserted or deleted, which moved, and which tokens got new text.
+
+Nodes of the two trees are matched in the manner of GumTree (Falleri et
+al., 2014):
+
+1. Top down, the largest identical subtrees of at least ``_MIN_HEIGHT``
+   levels are matched whole.
+2. Bottom up, a node is matched to the node of its kind that holds the
+   most of its matched descendants, if they share at least half of them;
+   their remaining children are then matched, identical ones first and
+   the rest by kind, in order.  The roots always match.
+
+Unmatched nodes were deleted or inserted; a matched node whose parent
+matched elsewhere, or that left the longest run of siblings kept in
+order, moved; a matched token whose text differs was updated.
+"""
+
+from __future__ import annotations
+
+from collections import defaultdict
+from dataclasses import dataclass, field
+
+from interpreter.ast_diff_types import EditKind, TreeEdit
+from interpreter.cst_types import CstNode, CstToken
+from interpreter.ir import NO_SOURCE_LOCATION
+
+# Smallest subtree height matched top down; single tokens are too common
+# to match on their own without context.
+_MIN_HEIGHT = 2
+# Share of matched descendants above which two nodes match bottom up.
+_MIN_DICE = 0.5
+
+
+@dataclass
+class _Tree:
+    """One CST flattened in preorder; a node is its index."""
+
+    elements: list[CstNode | CstToken] = field(default_factory=list)
+    parents: list[int] = field(default_factory=list)
+    children: list[list[int]] = field(default_factory=list)
+    sizes: list[int] = field(default_factory=list)
+    heights: list[int] = field(default_factory=list)
+    digests: list[int] = field(default_factory=list)
+
+    def add(self, element: CstNode | CstToken, parent: int) -> int:
+        """Flatten *element* under node *parent* (``-1`` for the root)."""
+        index = len(self.elements)
+        self.elements.append(element)
+        self.parents.append(parent)
+        self.children.append([])
+        self.sizes.append(1)
+        self.heights.append(1)
+        self.digests.append(0)
+        if isinstance(element, CstToken):
+            self.digests[index] = hash((element.kind, element.text))
+            return index
+        for child in element.children:
+            if isinstance(child, CstToken) and _is_anonymous(child):
+                continue
+            child_index = self.add(child, index)
+            self.children[index].append(child_index)
+            self.sizes[index] += self.sizes[child_index]
+            self.heights[index] = max(
+                self.heights[index], self.heights[child_index] + 1
+            )
+        self.digests[index] = hash(
+            (element.kind, tuple(self.digests[c] for c in self.children[index]))
+        )
+        return index
+
+    def kind(self, node: int) -> str:
+        return self.elements[node].kind
+
+    def descendants(self, node: int) -> range:
+        return range(node + 1, node + self.sizes[node])
+
+    def text(self, node: int) -> str:
+        element = self.elements[node]
+        if isinstance(element, CstToken):
+            return element.text.decode("utf-8", errors="replace")
+        tokens = list(element.tokens())
+        source = b"".join(token.to_bytes() for token in tokens)
+        trivia = len(tokens[0].leading_trivia) if tokens else 0
+        return source[trivia:].decode("utf-8", errors="replace")
+
+
+def _is_anonymous(token: CstToken) -> bool:
+    """A keyword or punctuation token, whose kind is its own text."""
+    return token.kind.encode("utf-8") == token.text
+
+
+def _flatten(root: CstNode) -> _Tree:
+    tree = _Tree()
+    tree.add(root, -1)
+    return tree
+
+
+@dataclass
+class _Matching:
+    old: _Tree
+    new: _Tree
+    old_to_new: dict[int, int] = field(default_factory=dict)
+    new_to_old: dict[int, int] = field(default_factory=dict)
+
+    def add(self, old_node: int, new_node: int) -> None:
+        self.old_to_new[old_node] = new_node
+        self.new_to_old[new_node] = old_node
+
+    def add_subtrees(self, old_node: int, new_node: int) -> None:
+        """Match two identical subtrees node for node."""
+        for offset in range(self.old.sizes[old_node]):
+            self.add(old_node + offset, new_node + offset)
+
+
+def _match_top_down(matching: _Matching) -> None:
+    old, new = matching.old, matching.new
+    by_digest: dict[int, list[int]] = defaultdict(list)
+    for node in range(len(new.elements)):
+        if new.heights[node] >= _MIN_HEIGHT:
+            by_digest[new.digests[node]].append(node)
+    largest_first = sorted(range(len(old.elements)), key=lambda n: -old.heights[n])
+    for node in largest_first:
+        if old.heights[node] < _MIN_HEIGHT or node in matching.old_to_new:
+            continue
+        partner = next(
+            (
+                candidate
+                for candidate in by_digest[old.digests[node]]
+                if candidate not in matching.new_to_old
+            ),
+            -1,
+        )
+        if partner >= 0:
+            matching.add_subtrees(node, partner)
+
+
+def _match_children(matching: _Matching, old_node: int, new_node: int) -> None:
+    """Match the unmatched children of two matched nodes.
+
+    Identical children match first, wherever they are, then the rest by
+    kind, in order.
+    """
+    old, new = matching.old, matching.new
+    for child in old.children[old_node]:
+        if child in matching.old_to_new:
+            continue
+        digest = old.digests[child]
+        partner = next(
+            (
+                c
+                for c in new.children[new_node]
+                if c not in matching.new_to_old and new.digests[c] == digest
+            ),
+            -1,
+        )
+        if partner >= 0:
+            matching.add_subtrees(child, partner)
+    unmatched = [c for c in new.children[new_node] if c not in matching.new_to_old]
+    for child in old.children[old_node]:
+        if child in matching.old_to_new:
+            continue
+        kind = old.kind(child)
+        partner = next((c for c in unmatched if new.kind(c) == kind), -1)
+        if partner < 0:
+            continue
+        unmatched = unmatched[unmatched.index(partner) + 1 :]
+        matching.add(child, partner)
+        _match_children(matching, child, partner)
+
+
+def _dice(matching: _Matching, old_node: int, new_node: int) -> float:
+    old, new = matching.old, matching.new
+    new_descendants = new.descendants(new_node)
+    common = sum(
+        1
+        for d in old.descendants(old_node)
+        if matching.old_to_new.get(d, -1) in new_descendants
+    )
+    total = len(old.descendants(old_node)) + len(new_descendants)
+    return 2 * common / total if total else 0.0
+
+
+def _candidates(matching: _Matching, old_node: int) -> set[int]:
+    """Unmatched new nodes of *old_node*'s kind above its descendants' partners."""
+    old, new = matching.old, matching.new
+    kind = old.kind(old_node)
+    found: set[int] = set()
+    partners = (matching.old_to_new.get(d, -1) for d in old.descendants(old_node))
+    for ancestor in (new.parents[p] for p in partners if p >= 0):
+        while ancestor >= 0:
+            if ancestor not in matching.new_to_old and new.kind(ancestor) == kind:
+                found.add(ancestor)
+            ancestor = new.parents[ancestor]
+    return found
+
+
+def _match_bottom_up(matching: _Matching) -> None:
+    old = matching.old
+    # Reverse preorder visits every node after all of its descendants.
+    for node in reversed(range(len(old.elements))):
+        if node in matching.old_to_new or not old.children[node]:
+            continue
+        scored = sorted(
+            (_dice(matching, node, candidate), -candidate)
+            for candidate in _candidates(matching, node)
+        )
+        if scored and scored[-1][0] >= _MIN_DICE:
+            matching.add(node, -scored[-1][1])
+            _match_children(matching, node, -scored[-1][1])
+    _match_children(matching, 0, 0)
+
+
+def _kept_in_order(positions: list[int]) -> set[int]:
+    """Indices into *positions* of its longest increasing subsequence."""
+    best: list[list[int]] = []
+    for i, position in enumerate(positions):
+        longest = max(
+            (run for run in best if positions[run[-1]] < position),
+            key=len,
+            default=[],
+        )
+        best.append(longest + [i])
+    return set(max(best, key=len, default=[]))
+
+
+def _edit(
+    kind: EditKind, matching: _Matching, old_node: int, new_node: int
+) -> TreeEdit:
+    old, new = matching.old, matching.new
+    element = new.elements[new_node] if new_node >= 0 else old.elements[old_node]
+    return TreeEdit(
+        kind=kind,
+        node_kind=element.kind,
+        old_location=(
+            old.elements[old_node].location if old_node >= 0 else NO_SOURCE_LOCATION
+        ),
+        new_location=(
+            new.elements[new_node].location if new_node >= 0 else NO_SOURCE_LOCATION
+        ),
+        old_text=old.text(old_node) if old_node >= 0 else "",
+        new_text=new.text(new_node) if new_node >= 0 else "",
+    )
+
+
+def _moves(matching: _Matching) -> set[int]:
+    """The matched old nodes that moved."""
+    old, new = matching.old, matching.new
+    moved: set[int] = set()
+    for node, partner in matching.old_to_new.items():
+        parent = old.parents[node]
+        if parent >= 0 and matching.old_to_new.get(parent) != new.parents[partner]:
+            moved.add(node)
+    for node, partner in matching.old_to_new.items():
+        stayed = [
+            child
+            for child in old.children[node]
+            if child not in moved and child in matching.old_to_new
+        ]
+        siblings = new.children[partner]
+        positions = [siblings.index(matching.old_to_new[c]) for c in stayed]
+        in_order = _kept_in_order(positions)
+        moved.update(c for i, c in enumerate(stayed) if i not in in_order)
+    return moved
+
+
+def diff_trees(old_root: CstNode, new_root: CstNode) -> tuple[TreeEdit, ...]:
+    """The edit script that turns the tree *old_root* into *new_root*.
+
+    Deletes come first, then moves and updates, in the old tree's order,
+    then inserts in the new tree's order.  Identical trees have none.
+    """
+    matching = _Matching(_flatten(old_root), _flatten(new_root))
+    matching.add(0, 0)  # the roots always match
+    _match_top_down(matching)
+    _match_bottom_up(matching)
+    old, new = matching.old, matching.new
+    deletes = [
+        _edit(EditKind.DELETE, matching, node, -1)
+        for node in range(len(old.elements))
+        if node not in matching.old_to_new and old.parents[node] in matching.old_to_new
+    ]
+    changes: list[TreeEdit] = []
+    moved = _moves(matching)
+    for node in sorted(matching.old_to_new):
+        partner = matching.old_to_new[node]
+        if node in moved:
+            changes.append(_edit(EditKind.MOVE, matching, node, partner))
+        token = isinstance(old.elements[node], CstToken)
+        if token and old.text(node) != new.text(partner):
+            changes.append(_edit(EditKind.UPDATE, matching, node, partner))
+    inserts = [
+        _edit(EditKind.INSERT, matching, -1, node)
+        for node in range(len(new.elements))
+        if node not in matching.new_to_old and new.parents[node] in matching.new_to_old
+    ]
+    return tuple(deletes + changes + inserts)
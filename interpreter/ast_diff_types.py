@@ -0,0 +1,59 @@
+# pyright: standard
+"""Tree edit data types (pure data, no business logic)."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from enum import Enum
+
+from interpreter.ir import SourceLocation
+
+# Longest excerpt of source quoted when an edit is printed.
+_MAX_EXCERPT = 40
+
+
+class EditKind(str, Enum):
+    """One step of a tree edit script."""
+
+    INSERT = "insert"
+    DELETE = "delete"
+    MOVE = "move"
+    UPDATE = "update"
+
+
+def _excerpt(text: str) -> str:
+    first_line = text.strip().split("\n", 1)[0]
+    return (
+        first_line
+        if len(first_line) <= _MAX_EXCERPT
+        else first_line[:_MAX_EXCERPT] + "..."
+    )
+
+
+@dataclass(frozen=True)
+class TreeEdit:
+    """One node inserted, deleted, moved or (a token) given new text.
+
+    *old_location* and *old_text* place the node in the old tree, and
+    *new_location* and *new_text* in the new one; an insert has no old
+    side and a delete no new side (``NO_SOURCE_LOCATION`` and ``""``).
+    An inserted or deleted subtree is one edit, at its root.
+    """
+
+    kind: EditKind
+    node_kind: str
+    old_location: SourceLocation
+    new_location: SourceLocation
+    old_text: str
+    new_text: str
+
+    def __str__(self) -> str:
+        old, new = _excerpt(self.old_text), _excerpt(self.new_text)
+        if self.kind == EditKind.INSERT:
+            return f"insert {self.node_kind} at {self.new_location}: {new}"
+        if self.kind == EditKind.DELETE:
+            return f"delete {self.node_kind} at {self.old_location}: {old}"
+        span = f"{self.old_location} -> {self.new_location}"
+        if self.kind == EditKind.MOVE:
+            return f"move {self.node_kind} {span}: {new}"
+        return f"update {self.node_kind} {span}: {old} -> {new}"
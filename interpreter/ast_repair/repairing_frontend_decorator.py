@@ -14,6 +14,7 @@ from interpreter.frontend import Frontend
 from interpreter.instructions import InstructionBase
 from interpreter.llm.llm_client import LLMClient
 from interpreter.parser import ParserFactory
+from interpreter.syntax_diagnostic import SyntaxDiagnostic
 from interpreter.types.type_environment_builder import TypeEnvironmentBuilder
 
 logger = logging.getLogger(__name__)
@@ -45,6 +46,11 @@ class RepairingFrontendDecorator(Frontend):
     def type_env_builder(self) -> TypeEnvironmentBuilder:
         return self._inner_frontend.type_env_builder
 
+    @property
+    def diagnostics(self) -> tuple[SyntaxDiagnostic, ...]:
+        """Syntax errors left in ``last_lowered_source``: none after a repair."""
+        return self._inner_frontend.diagnostics
+
     @property
     def last_lowered_source(self) -> bytes:
         """The source bytes actually passed to the inner frontend on the most recent lower() call.
@@ -12,6 +12,7 @@ from interpreter.instructions import (
     BranchIf,
     CallCtorFunction,
     CallFunction,
+    Const,
     Halt_,
     InstructionBase,
     Label_,
@@ -20,6 +21,7 @@ from interpreter.instructions import (
     Throw_,
 )
 from interpreter.ir import CodeLabel, Opcode
+from interpreter.types.type_expr import FunctionType
 
 
 def build_cfg(instructions: list[InstructionBase]) -> CFG:
@@ -104,7 +106,63 @@ def build_cfg(instructions: list[InstructionBase]) -> CFG:
     return cfg
 
 
-def _escape_mermaid(text: str) -> str:
+def function_regions(
+    cfg: CFG, instructions: list[InstructionBase]
+) -> dict[CodeLabel, str]:
+    """The function each block belongs to, by entry label; "" is the top level.
+
+    A function's body is reached from its entry without crossing into
+    another function, which is branched around rather than entered.
+    """
+    entries = {
+        CodeLabel(str(inst.value))
+        for inst in instructions
+        if isinstance(inst, Const) and isinstance(inst.type_expr, FunctionType)
+    }
+    region: dict[CodeLabel, str] = {}
+    roots = [(label, str(label)) for label in entries]
+    if cfg.blocks:
+        roots.insert(0, (next(iter(cfg.blocks)), ""))
+    for root, name in roots:
+        pending = [root]
+        while pending:
+            label = pending.pop()
+            if label in region or label not in cfg.blocks:
+                continue
+            region[label] = name
+            pending.extend(
+                s for s in cfg.blocks[label].successors if s not in entries
+            )
+    return region
+
+
+def build_function_cfgs(instructions: list[InstructionBase]) -> dict[str, CFG]:
+    """A CFG per function, by entry label, with "" for the top level.
+
+    Each holds the blocks ``function_regions`` assigns the function, and
+    only the edges between them; its entry is the function's entry block.
+    Blocks no function reaches are left out.
+    """
+    cfg = build_cfg(instructions)
+    region = function_regions(cfg, instructions)
+    cfgs: dict[str, CFG] = {}
+    for label, block in cfg.blocks.items():
+        if label not in region:
+            continue
+        name = region[label]
+        if name not in cfgs:
+            entry = CodeLabel(name) if name else cfg.entry
+            cfgs[name] = CFG(entry=entry)
+        cfgs[name].blocks[label] = BasicBlock(
+            label=label,
+            instructions=block.instructions,
+            successors=[s for s in block.successors if region.get(s) == name],
+            predecessors=[p for p in block.predecessors if region.get(p) == name],
+        )
+    return cfgs
+
+
+def escape_mermaid(text: str) -> str:
     """Escape characters that break Mermaid node labels."""
     return text.replace('"', "#quot;").replace("<", "#lt;").replace(">", "#gt;")
 
@@ -113,10 +171,10 @@ def _instruction_summary(inst: InstructionBase, max_len: int = 60) -> str:
     """Return a truncated, Mermaid-safe string for an instruction."""
     raw = str(inst)
     truncated = raw[:max_len] + "..." if len(raw) > max_len else raw
-    return _escape_mermaid(truncated)
+    return escape_mermaid(truncated)
 
 
-def _node_id(label: CodeLabel | str) -> str:
+def node_id(label: CodeLabel | str) -> str:
     """Sanitise a block label into a valid Mermaid node ID."""
     return str(label).replace(" ", "_").replace("-", "_")
 
@@ -256,12 +314,12 @@ def _render_node(
     label: CodeLabel, block: BasicBlock, indent: str, is_entry: bool = False
 ) -> str:
     """Render a single Mermaid node definition."""
-    nid = _node_id(label)
+    nid = node_id(label)
     inst_lines = _collapse_inst_lines(
         [_instruction_summary(inst) for inst in block.instructions]
     )
     body = "<br/>".join(inst_lines) if inst_lines else "(empty)"
-    node_label = f"<b>{_escape_mermaid(str(label))}</b><br/>{body}"
+    node_label = f"<b>{escape_mermaid(str(label))}</b><br/>{body}"
     open_delim, close_delim = _node_shape(block, is_entry)
     return f"{indent}{nid}{open_delim}{node_label}{close_delim}"
 
@@ -285,7 +343,7 @@ def cfg_to_mermaid(cfg: CFG) -> str:
     for i, label in enumerate(block_labels):
         is_entry = label == cfg.entry
         if is_entry:
-            entry_node_id = _node_id(label)
+            entry_node_id = node_id(label)
         if i not in in_subgraph:
             lines.append(
                 _render_node(label, cfg.blocks[label], "    ", is_entry=is_entry)
@@ -293,13 +351,13 @@ def cfg_to_mermaid(cfg: CFG) -> str:
 
     # Emit subgraphs
     for sg_name, start, end in sg_ranges:
-        sg_id = _node_id(sg_name)
-        lines.append(f'    subgraph {sg_id}["{_escape_mermaid(sg_name)}"]')
+        sg_id = node_id(sg_name)
+        lines.append(f'    subgraph {sg_id}["{escape_mermaid(sg_name)}"]')
         for i in range(start, end):
             label = block_labels[i]
             is_entry = label == cfg.entry
             if is_entry:
-                entry_node_id = _node_id(label)
+                entry_node_id = node_id(label)
             lines.append(
                 _render_node(label, cfg.blocks[label], "        ", is_entry=is_entry)
             )
@@ -308,24 +366,24 @@ def cfg_to_mermaid(cfg: CFG) -> str:
     # Emit edges (only for reachable blocks)
     for label in block_labels:
         block = cfg.blocks[label]
-        src = _node_id(label)
+        src = node_id(label)
         last = block.instructions[-1] if block.instructions else None
         is_branch_if = last is not None and isinstance(last, BranchIf)
 
         if is_branch_if and len(block.successors) == 2:
             true_target = block.successors[0]
             false_target = block.successors[1]
-            lines.append(f'    {src} -->|"T"| {_node_id(true_target)}')
-            lines.append(f'    {src} -->|"F"| {_node_id(false_target)}')
+            lines.append(f'    {src} -->|"T"| {node_id(true_target)}')
+            lines.append(f'    {src} -->|"F"| {node_id(false_target)}')
         else:
             for succ in block.successors:
-                lines.append(f"    {src} --> {_node_id(succ)}")
+                lines.append(f"    {src} --> {node_id(succ)}")
 
     # Emit dashed call edges for CALL_FUNCTION instructions
     call_target_map = _build_call_target_map(block_labels)
     for label in block_labels:
         block = cfg.blocks[label]
-        src = _node_id(label)
+        src = node_id(label)
         for inst in block.instructions:
             t = inst
             if not isinstance(t, (CallFunction, CallCtorFunction)):
@@ -333,7 +391,7 @@ def cfg_to_mermaid(cfg: CFG) -> str:
             func_name = t.func_name
             target_label = call_target_map.get(str(func_name))
             if target_label:
-                lines.append(f'    {src} -.->|"call"| {_node_id(target_label)}')
+                lines.append(f'    {src} -.->|"call"| {node_id(target_label)}')
 
     if entry_node_id:
         lines.append(f"    style {entry_node_id} fill:#28a745,color:#fff")
@@ -18,6 +18,12 @@ class BasicBlock:
     predecessors: list[CodeLabel] = field(default_factory=list)
 
 
+@dataclass(frozen=True)
+class Edge:
+    source: CodeLabel
+    target: CodeLabel
+
+
 @dataclass
 class CFG:
     blocks: dict[CodeLabel, BasicBlock] = field(default_factory=dict)
@@ -25,6 +31,18 @@ class CFG:
         default_factory=lambda: CodeLabel(constants.CFG_ENTRY_LABEL)
     )
 
+    def edges(self) -> list[Edge]:
+        """Every edge, in block order and each block's successor order."""
+        return [
+            Edge(label, successor)
+            for label, block in self.blocks.items()
+            for successor in block.successors
+        ]
+
+    def exits(self) -> list[CodeLabel]:
+        """The blocks control leaves the graph from: those with no successors."""
+        return [label for label, block in self.blocks.items() if not block.successors]
+
     def __str__(self) -> str:
         lines = []
         for label, block in self.blocks.items():
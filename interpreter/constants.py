@@ -22,12 +22,24 @@ PRELUDE_CLASS_LABEL_PREFIX = "prelude_class_"
 PRELUDE_END_CLASS_LABEL_PREFIX = "prelude_end_class_"
 
 MAIN_FRAME_NAME = "<main>"
+GOROUTINE_FRAME_NAME = "<goroutine>"
+GOROUTINE_ENTRY_VAR = "__go_entry__"
+# Dynamic type of Go errors.New values, which implement the error interface
+GO_ERROR_STRING_TYPE = "errors.errorString"
 CFG_ENTRY_LABEL = "entry"
 
 CAUGHT_EXCEPTION_PREFIX = "caught_exception"
 
 DATAFLOW_MAX_ITERATIONS = 1000
 
+# Deepest syntax tree the frontends lower by default: far beyond hand-written
+# code, and shallow enough that lowering stays within Python's default
+# recursion limit of 1000.
+MAX_NESTING_DEPTH = 128
+# Most nested calls a run makes by default before stopping with a runtime
+# error, so runaway recursion is reported where it recurses.
+MAX_CALL_DEPTH = 10_000
+
 MERMAID_MAX_NODE_LINES = 6
 
 FRONTEND_DETERMINISTIC = "deterministic"
@@ -0,0 +1,64 @@
+# pyright: standard
+"""CST Builder — a lossless concrete syntax tree from a tree-sitter parse.
+
+Tree-sitter's tree keeps every token, punctuation included, but not the
+whitespace between tokens, and it hangs comments off the tree as "extra"
+nodes wherever the parser happened to be.  The CST keeps the tree's shape,
+drops the extras, and gives each token the exact source bytes between it
+and the previous token as leading trivia, so the comments and layout a
+formatter or refactoring tool has to preserve travel with the token they
+precede.
+"""
+
+from __future__ import annotations
+
+from typing import Any
+
+from interpreter.cst_types import CstNode, CstToken
+from interpreter.parser import node_location
+
+
+
+def _build(
+    node: Any, source: bytes, cursor: int
+) -> tuple[CstNode | CstToken, int]:  # Any: tree-sitter node
+    """The CST for *node*, with trivia from byte *cursor*, and the byte after it."""
+    if node.child_count == 0:
+        token = CstToken(
+            kind=node.type,
+            text=source[node.start_byte : node.end_byte],
+            leading_trivia=source[cursor : node.start_byte],
+            location=node_location(node),
+        )
+        return token, max(cursor, node.end_byte)
+    children, cursor = _build_children(node, source, cursor)
+    location = node_location(node)
+    return CstNode(kind=node.type, children=children, location=location), cursor
+
+
+def _build_children(
+    node: Any, source: bytes, cursor: int
+) -> tuple[tuple[CstNode | CstToken, ...], int]:  # Any: tree-sitter node
+    children: list[CstNode | CstToken] = []
+    for child in node.children:
+        if child.is_extra:
+            continue  # a comment: left in the gap, so it becomes trivia
+        element, cursor = _build(child, source, cursor)
+        children.append(element)
+    return tuple(children), cursor
+
+
+def build_cst(root: Any, source: bytes) -> CstNode:  # Any: tree-sitter node
+    """The lossless CST of *source*, parsed into the tree rooted at *root*.
+
+    ``build_cst(root, source).to_bytes() == source`` for any input, malformed
+    or not: an ERROR node becomes an ordinary node, and a MISSING token one
+    with empty text.
+    """
+    children, cursor = _build_children(root, source, 0)
+    return CstNode(
+        kind=root.type,
+        children=children,
+        location=node_location(root),
+        trailing_trivia=source[cursor:],
+    )
@@ -0,0 +1,122 @@
+# pyright: standard
+"""CST JSON — a stable, typed JSON form of the concrete syntax tree.
+
+For tools outside this package (notebooks, visualizers) that read CSTs or
+build them.  A document wraps the root with its format and version::
+
+    {"format": "red-dragon-cst", "version": 1, "root": {...}}
+
+and every element names its type, so a reader never has to guess::
+
+    {"type": "node", "kind": "module", "location": {...},
+     "children": [...], "trailing_trivia": ""}
+    {"type": "token", "kind": "identifier", "text": "x",
+     "leading_trivia": "  ", "location": {...}}
+
+Text and trivia are JSON strings when they are valid UTF-8, and
+``{"base64": "..."}`` otherwise, so every CST survives the round trip.
+When reading, ``location`` may be omitted, as may ``leading_trivia`` and
+``trailing_trivia`` (empty), so hand-built trees stay short.
+"""
+
+from __future__ import annotations
+
+import base64
+import json
+from typing import Any
+
+from interpreter.cst_types import CstNode, CstToken
+from interpreter.ir import NO_SOURCE_LOCATION, SourceLocation
+
+CST_JSON_FORMAT = "red-dragon-cst"
+CST_JSON_VERSION = 1
+
+
+def _encode_bytes(data: bytes) -> str | dict[str, str]:
+    try:
+        return data.decode("utf-8")
+    except UnicodeDecodeError:
+        return {"base64": base64.b64encode(data).decode("ascii")}
+
+
+def _decode_bytes(data: str | dict[str, str]) -> bytes:
+    if isinstance(data, str):
+        return data.encode("utf-8")
+    return base64.b64decode(data["base64"])
+
+
+def _location_from_dict(data: dict[str, int] | None) -> SourceLocation:
+    return SourceLocation(**data) if data else NO_SOURCE_LOCATION
+
+
+def cst_to_dict(element: CstNode | CstToken) -> dict[str, Any]:
+    """*element* and everything under it as JSON-ready dicts."""
+    location = element.location.model_dump()
+    if isinstance(element, CstToken):
+        return {
+            "type": "token",
+            "kind": element.kind,
+            "text": _encode_bytes(element.text),
+            "leading_trivia": _encode_bytes(element.leading_trivia),
+            "location": location,
+        }
+    return {
+        "type": "node",
+        "kind": element.kind,
+        "location": location,
+        "children": [cst_to_dict(child) for child in element.children],
+        "trailing_trivia": _encode_bytes(element.trailing_trivia),
+    }
+
+
+def cst_from_dict(data: dict[str, Any]) -> CstNode | CstToken:
+    """The element described by *data*, the inverse of ``cst_to_dict``.
+
+    Raises ``ValueError`` for an unknown element type.
+    """
+    element_type = data.get("type")
+    if element_type == "token":
+        return CstToken(
+            kind=data["kind"],
+            text=_decode_bytes(data["text"]),
+            leading_trivia=_decode_bytes(data.get("leading_trivia", "")),
+            location=_location_from_dict(data.get("location")),
+        )
+    if element_type == "node":
+        return CstNode(
+            kind=data["kind"],
+            children=tuple(cst_from_dict(child) for child in data["children"]),
+            location=_location_from_dict(data.get("location")),
+            trailing_trivia=_decode_bytes(data.get("trailing_trivia", "")),
+        )
+    raise ValueError(f"Unknown CST element type: {element_type!r}")
+
+
+def dumps_cst(root: CstNode, indent: int | None = None) -> str:
+    """*root* as a versioned JSON document."""
+    document = {
+        "format": CST_JSON_FORMAT,
+        "version": CST_JSON_VERSION,
+        "root": cst_to_dict(root),
+    }
+    return json.dumps(document, indent=indent, ensure_ascii=False)
+
+
+def loads_cst(text: str) -> CstNode:
+    """The CST in the JSON document *text*, the inverse of ``dumps_cst``.
+
+    Raises ``ValueError`` if *text* is not a CST document of a version this
+    reader understands, or its root is a token.
+    """
+    document = json.loads(text)
+    if not isinstance(document, dict) or document.get("format") != CST_JSON_FORMAT:
+        raise ValueError(f"Not a {CST_JSON_FORMAT} document")
+    if document.get("version") != CST_JSON_VERSION:
+        raise ValueError(
+            f"Unsupported {CST_JSON_FORMAT} version {document.get('version')!r};"
+            f" expected {CST_JSON_VERSION}"
+        )
+    root = cst_from_dict(document["root"])
+    if not isinstance(root, CstNode):
+        raise ValueError("The root of a CST document must be a node")
+    return root
@@ -0,0 +1,90 @@
+# pyright: standard
+"""CST S-expressions — a compact, diffable dump of the concrete syntax tree.
+
+Each node prints as ``(kind child ...)`` and each named token as
+``(kind text)``::
+
+    (function_definition
+      (identifier nth_prime)
+      (parameters (identifier n))
+      (block (return_statement (identifier n))))
+
+Keywords and punctuation (tokens whose kind is their own text) are left out
+unless asked for, as in tree-sitter's own S-expressions; trivia is always
+left out.  A token the parser had to assume prints as ``(MISSING ")")``.
+
+A node fits on one line when it can, and otherwise puts each child on its
+own line, indented two spaces past the node, so a change to the tree shows
+up as a change to the lines around it.
+"""
+
+from __future__ import annotations
+
+import json
+import re
+
+from interpreter.cst_types import CstNode, CstToken
+
+_DEFAULT_WIDTH = 80
+_INDENT = "  "
+_BARE_ATOM = re.compile(r'[^\s()";]+')
+
+
+def _atom(text: bytes) -> str:
+    """*text* bare if it reads back as one atom, else as a quoted string."""
+    decoded = text.decode("utf-8", errors="backslashreplace")
+    if _BARE_ATOM.fullmatch(decoded):
+        return decoded
+    return json.dumps(decoded, ensure_ascii=False)
+
+
+def _is_anonymous(token: CstToken) -> bool:
+    return token.kind.encode("utf-8") == token.text
+
+
+def _children(node: CstNode, anonymous: bool) -> list[CstNode | CstToken]:
+    return [
+        child
+        for child in node.children
+        if anonymous or not (isinstance(child, CstToken) and _is_anonymous(child))
+    ]
+
+
+def _flat(element: CstNode | CstToken, anonymous: bool) -> str:
+    if isinstance(element, CstToken):
+        if not element.text:
+            return f"(MISSING {_atom(element.kind.encode('utf-8'))})"
+        if _is_anonymous(element):
+            return json.dumps(element.kind, ensure_ascii=False)
+        return f"({element.kind} {_atom(element.text)})"
+    parts = [element.kind] + [
+        _flat(child, anonymous) for child in _children(element, anonymous)
+    ]
+    return f"({' '.join(parts)})"
+
+
+def _lines(
+    element: CstNode | CstToken, anonymous: bool, depth: int, width: int
+) -> list[str]:
+    indent = _INDENT * depth
+    flat = _flat(element, anonymous)
+    if isinstance(element, CstToken) or len(indent) + len(flat) <= width:
+        return [indent + flat]
+    lines = [f"{indent}({element.kind}"]
+    for child in _children(element, anonymous):
+        lines.extend(_lines(child, anonymous, depth + 1, width))
+    lines[-1] += ")"
+    return lines
+
+
+def cst_to_sexp(
+    root: CstNode | CstToken,
+    anonymous: bool = False,
+    width: int = _DEFAULT_WIDTH,
+) -> str:
+    """*root* as an S-expression, wrapped to *width* columns where it can be.
+
+    With *anonymous*, keywords and punctuation are included as quoted
+    strings (``"("``, ``"def"``).
+    """
+    return "\n".join(_lines(root, anonymous, 0, width))
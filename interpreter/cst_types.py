@@ -0,0 +1,51 @@
+# pyright: standard
+"""Concrete syntax tree data types (pure data, no business logic).
+
+Every byte of the source belongs to exactly one token: either to its text
+or to its leading trivia (the whitespace and comments before it), or else
+to the root's trailing trivia.  Concatenating the tokens in order therefore
+reproduces the source byte for byte.  Text is kept as bytes so that holds
+for any input, valid UTF-8 or not.
+"""
+
+from __future__ import annotations
+
+from collections.abc import Iterator
+from dataclasses import dataclass
+
+from interpreter.ir import SourceLocation
+
+
+@dataclass(frozen=True)
+class CstToken:
+    """A leaf: one token of the grammar, with the trivia that precedes it."""
+
+    kind: str
+    text: bytes
+    leading_trivia: bytes
+    location: SourceLocation
+
+    def to_bytes(self) -> bytes:
+        return self.leading_trivia + self.text
+
+
+@dataclass(frozen=True)
+class CstNode:
+    """An interior node; *trailing_trivia* is only non-empty on the root."""
+
+    kind: str
+    children: tuple[CstNode | CstToken, ...]
+    location: SourceLocation
+    trailing_trivia: bytes = b""
+
+    def tokens(self) -> Iterator[CstToken]:
+        """The tokens under this node, in source order."""
+        for child in self.children:
+            if isinstance(child, CstToken):
+                yield child
+            else:
+                yield from child.tokens()
+
+    def to_bytes(self) -> bytes:
+        """This node's source, including leading trivia; the input if it is the root."""
+        return b"".join(t.to_bytes() for t in self.tokens()) + self.trailing_trivia
@@ -4,13 +4,13 @@
 from __future__ import annotations
 
 import logging
-from collections import deque
 from dataclasses import dataclass, field
 from functools import reduce
 from typing import Any
 
-from interpreter import constants
 from interpreter.cfg import CFG, BasicBlock
+from interpreter.dataflow_solver import solve_dataflow
+from interpreter.dataflow_solver_types import DataflowProblem, Direction
 from interpreter.instructions import (
     DeclVar,
     InstructionBase,
@@ -175,49 +175,40 @@ def compute_gen_kill(
 
 
 def solve_reaching_definitions(cfg: CFG) -> dict[CodeLabel, BlockDataflowFacts]:
-    """Classic worklist-based reaching definitions analysis.
+    """Reaching definitions, solved forward by ``solve_dataflow``.
 
     Returns a mapping of block label -> BlockDataflowFacts with reach_in/reach_out populated.
     """
     all_defs = collect_all_definitions(cfg)
     defs_by_var = _build_defs_by_variable(all_defs)
+    gen_kill = {
+        label: compute_gen_kill(block, all_defs, defs_by_var)
+        for label, block in cfg.blocks.items()
+    }
 
-    facts: dict[CodeLabel, BlockDataflowFacts] = {}
-    for label, block in cfg.blocks.items():
-        gen, kill = compute_gen_kill(block, all_defs, defs_by_var)
-        facts[label] = BlockDataflowFacts(gen=gen, kill=kill)
-
-    worklist: deque[CodeLabel] = deque(cfg.blocks.keys())
-    iteration = 0
-
-    while worklist and iteration < constants.DATAFLOW_MAX_ITERATIONS:
-        iteration += 1
-        label = worklist.popleft()
-        block = cfg.blocks[label]
-        block_facts = facts[label]
-
-        new_reach_in = reduce(
-            set.union,
-            [facts[p].reach_out for p in block.predecessors],
-            set(),
-        )
-
-        new_reach_out = block_facts.gen | (new_reach_in - block_facts.kill)
-
-        if new_reach_out != block_facts.reach_out:
-            block_facts.reach_in = new_reach_in
-            block_facts.reach_out = new_reach_out
-            worklist.extend(s for s in block.successors if s not in worklist)
-        else:
-            block_facts.reach_in = new_reach_in
-
-    if iteration >= constants.DATAFLOW_MAX_ITERATIONS:
-        logger.warning(
-            "Reaching definitions did not converge within %d iterations",
-            constants.DATAFLOW_MAX_ITERATIONS,
+    def _reach_out(
+        block: BasicBlock, reach_in: frozenset[Definition]
+    ) -> frozenset[Definition]:
+        gen, kill = gen_kill[block.label]
+        return frozenset(gen) | (reach_in - kill)
+
+    problem: DataflowProblem[frozenset[Definition]] = DataflowProblem(
+        direction=Direction.FORWARD,
+        block_transfer=_reach_out,
+        merge=frozenset.union,
+        initial=frozenset(),
+        boundary=frozenset(),
+    )
+    solved = solve_dataflow(cfg, problem)
+    return {
+        label: BlockDataflowFacts(
+            gen=gen,
+            kill=kill,
+            reach_in=set(solved.at_entry[label]),
+            reach_out=set(solved.at_exit[label]),
         )
-
-    return facts
+        for label, (gen, kill) in gen_kill.items()
+    }
 
 
 def extract_def_use_chains(
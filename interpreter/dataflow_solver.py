@@ -0,0 +1,96 @@
+# pyright: standard
+"""Dataflow solver — one worklist engine for forward and backward analyses.
+
+An analysis is a ``DataflowProblem``: a direction, a transfer function over
+single instructions, and a merge over the facts of its lattice.
+``solve_dataflow`` iterates it to a fixpoint over a CFG.  Liveness, for
+one, is a backward problem over sets of variable names::
+
+    DataflowProblem(
+        direction=Direction.BACKWARD,
+        transfer=live_before,
+        merge=frozenset.union,
+        initial=frozenset(),
+        boundary=frozenset(),
+    )
+
+Every block is visited once, in program order for a forward problem and
+in reverse for a backward one, and again whenever a fact flowing into it
+changes.  Problems over infinite lattices, such as value ranges, widen the
+facts flowing into a block that keeps changing, and a problem can refine
+the fact along each edge, as by the condition of the branch taking it.
+"""
+
+from __future__ import annotations
+
+from collections import deque
+from functools import reduce
+
+from interpreter.cfg import CFG, BasicBlock
+from interpreter.dataflow_solver_types import (
+    DataflowFacts,
+    DataflowProblem,
+    Direction,
+    Fact,
+)
+from interpreter.ir import CodeLabel
+
+
+def solve_dataflow(cfg: CFG, problem: DataflowProblem[Fact]) -> DataflowFacts[Fact]:
+    """The fact holding at each end of every block of *cfg* once *problem*
+    reaches its fixpoint."""
+    forward = problem.direction == Direction.FORWARD
+    at_entry = {label: problem.initial for label in cfg.blocks}
+    at_exit = dict(at_entry)
+    # The end of a block facts flow into, and the end they leave from.
+    inflow, outflow = (at_entry, at_exit) if forward else (at_exit, at_entry)
+    boundaries = (
+        {cfg.entry} | {label for label, b in cfg.blocks.items() if not b.predecessors}
+        if forward
+        else set(cfg.exits())
+    )
+    changes: dict[CodeLabel, int] = {}
+    worklist: deque[CodeLabel] = deque(cfg.blocks if forward else reversed(cfg.blocks))
+    while worklist:
+        label = worklist.popleft()
+        block = cfg.blocks[label]
+        upstream = block.predecessors if forward else block.successors
+        downstream = block.successors if forward else block.predecessors
+        start = problem.boundary if label in boundaries else problem.initial
+        arriving = (
+            _along(cfg.blocks[u], label, outflow[u], problem)
+            for u in upstream
+            if u in cfg.blocks
+        )
+        fact = reduce(problem.merge, (f for f in arriving if f is not None), start)
+        if fact != inflow[label]:
+            if problem.widen and changes.get(label, 0) > problem.widen_after:
+                fact = problem.widen(inflow[label], fact)
+            changes[label] = changes.get(label, 0) + 1
+        inflow[label] = fact
+        fact = _across(block, fact, problem, forward)
+        if fact != outflow[label]:
+            outflow[label] = fact
+            worklist.extend(
+                d for d in downstream if d in cfg.blocks and d not in worklist
+            )
+    return DataflowFacts(at_entry=at_entry, at_exit=at_exit)
+
+
+def _along(
+    source: BasicBlock, target: CodeLabel, fact: Fact, problem: DataflowProblem[Fact]
+) -> Fact | None:
+    """The fact *source* passes to *target*; None if that edge is never taken."""
+    return problem.edge(source, target, fact) if problem.edge else fact
+
+
+def _across(
+    block: BasicBlock, fact: Fact, problem: DataflowProblem[Fact], forward: bool
+) -> Fact:
+    """The fact leaving *block*, given the fact flowing into it."""
+    if problem.block_transfer:
+        return problem.block_transfer(block, fact)
+    instructions = block.instructions if forward else block.instructions[::-1]
+    for inst in instructions:
+        fact = problem.transfer(inst, fact)
+    return fact
@@ -0,0 +1,71 @@
+# pyright: standard
+"""Dataflow problems — what a worklist analysis computes, and what it found."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+from dataclasses import dataclass, field
+from enum import Enum
+from typing import Generic, TypeVar
+
+from interpreter.cfg_types import BasicBlock
+from interpreter.instructions import InstructionBase
+from interpreter.ir import CodeLabel
+
+Fact = TypeVar("Fact")
+
+
+class Direction(str, Enum):
+    """Which way facts flow: along the edges of the CFG, or against them."""
+
+    FORWARD = "forward"
+    BACKWARD = "backward"
+
+
+def _unchanged(inst: InstructionBase, fact: Fact) -> Fact:
+    return fact
+
+
+@dataclass(frozen=True, kw_only=True)
+class DataflowProblem(Generic[Fact]):
+    """A dataflow analysis over facts of one lattice.
+
+    *transfer* gives the fact on the far side of an instruction from the
+    fact on its near side, in the direction facts flow.  An analysis whose
+    facts say where instructions are — a definition, a call site — gives
+    *block_transfer* instead, which crosses a whole block at once.  *merge*
+    joins the facts arriving from several blocks; *initial* is its identity,
+    the fact every block holds before the analysis reaches it.  *boundary*
+    is the fact arriving at each block nothing flows into: the CFG's entry
+    and any other block without predecessors when *direction* is
+    ``FORWARD``, and its exits when ``BACKWARD``.
+
+    *edge*, when given, gives the fact carried from a block to one of the
+    blocks it flows into, from the fact leaving it — narrowed by the branch
+    taken, say — or None when no execution takes that edge.
+
+    Facts must compare equal when nothing changed.  A finite lattice with a
+    monotone *transfer* guarantees the solver stops; over an infinite one,
+    such as intervals, *widen* must: once the fact flowing into a block
+    has changed more than *widen_after* times, it becomes ``widen(previous,
+    merged)``, which must stop growing after finitely many steps.
+    """
+
+    direction: Direction
+    merge: Callable[[Fact, Fact], Fact]
+    initial: Fact
+    boundary: Fact
+    transfer: Callable[[InstructionBase, Fact], Fact] = _unchanged
+    block_transfer: Callable[[BasicBlock, Fact], Fact] | None = None
+    edge: Callable[[BasicBlock, CodeLabel, Fact], Fact | None] | None = None
+    widen: Callable[[Fact, Fact], Fact] | None = None
+    widen_after: int = 2
+
+
+@dataclass(frozen=True)
+class DataflowFacts(Generic[Fact]):
+    """The fact holding at the start and at the end of each block, in
+    program order whichever way the analysis ran."""
+
+    at_entry: dict[CodeLabel, Fact] = field(default_factory=dict)
+    at_exit: dict[CodeLabel, Fact] = field(default_factory=dict)
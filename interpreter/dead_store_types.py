@@ -0,0 +1,37 @@
+# pyright: standard
+"""DeadStore — an assignment whose value is never read."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, Severity
+from interpreter.ir import SourceLocation
+
+
+@dataclass(frozen=True)
+class DeadStore:
+    """The value assigned to *name* at *location* is never read.
+
+    Every path from the assignment assigns *name* again, or ends, before
+    reading it.  The message follows Rust's: ``value assigned to x is never
+    read``.
+    """
+
+    location: SourceLocation
+    name: str
+
+    @property
+    def message(self) -> str:
+        return f"value assigned to {self.name} is never read"
+
+    def as_diagnostic(self) -> Diagnostic:
+        return Diagnostic(
+            code=DiagnosticCode.DEAD_STORE,
+            severity=Severity.WARNING,
+            location=self.location,
+            message=self.message,
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: warning: {self.message}"
@@ -0,0 +1,90 @@
+# pyright: standard
+"""Dead stores — assignments whose value is always overwritten or dropped.
+
+An assignment is dead when the variable is not live after it: every path
+from it assigns the variable again, or ends, before reading it::
+
+    while (divisor < candidate) {
+        if (candidate % divisor == 0) {
+            isPrime = 0;        // value assigned to isPrime is never read
+        }
+        isPrime = 1;
+        divisor++;
+    }
+
+Only ``STORE_VAR`` is judged.  An initializer (``int best = 0;``) is not:
+giving a variable a value where it is declared is idiomatic even when every
+path assigns it again.  A variable never read at all is an unused variable,
+not a dead store, and is left to that check; so is a name starting with
+``_``.  A variable also accessed from another function — a closure's
+captured variable, a global — may be read where this function cannot see,
+so its assignments are never reported.
+"""
+
+from __future__ import annotations
+
+from interpreter.cfg import build_cfg, function_regions
+from interpreter.dead_store_types import DeadStore
+from interpreter.definite_assignment import source_names
+from interpreter.frontends.scopes import Scope
+from interpreter.instructions import (
+    AddressOf,
+    DeclVar,
+    InstructionBase,
+    LoadVar,
+    StoreVar,
+)
+from interpreter.liveness import live_before, live_out
+from interpreter.unused_variables import is_exempt
+
+
+def accessed_variable(inst: InstructionBase) -> str:
+    """The variable *inst* reads, writes or takes the address of; "" if none."""
+    if isinstance(inst, (LoadVar, StoreVar, DeclVar)):
+        return str(inst.name)
+    if isinstance(inst, AddressOf):
+        return str(inst.var_name)
+    return ""
+
+
+def find_dead_stores(
+    scopes: Scope, instructions: list[InstructionBase]
+) -> tuple[DeadStore, ...]:
+    """Every assignment in *instructions* whose value is never read.
+
+    *scopes* and *instructions* come from lowering the same source; only
+    variables the scopes declare are judged.  Stores are in source order.
+    """
+    cfg = build_cfg(instructions)
+    region = function_regions(cfg, instructions)
+    names = source_names(scopes)
+    accessed_from: dict[str, set[str | None]] = {}
+    read: set[str] = set()
+    for label, block in cfg.blocks.items():
+        for inst in block.instructions:
+            name = accessed_variable(inst)
+            if name:
+                accessed_from.setdefault(name, set()).add(region.get(label))
+            if isinstance(inst, (LoadVar, AddressOf)):
+                read.add(name)
+    judged = {
+        name
+        for name, regions in accessed_from.items()
+        if name in names
+        and name in read
+        and len(regions) == 1
+        and not is_exempt(names[name])
+    }
+
+    live = live_out(cfg)
+    stores: list[DeadStore] = []
+    for label, block in cfg.blocks.items():
+        after = live[label]
+        for inst in reversed(block.instructions):
+            name = str(inst.name) if isinstance(inst, StoreVar) else ""
+            if name in judged and name not in after:
+                stores.append(DeadStore(inst.source_location, names[name]))
+            after = live_before(inst, after)
+    return tuple(
+        sorted(stores, key=lambda s: (s.location.start_line, s.location.start_col))
+    )
@@ -0,0 +1,72 @@
+# pyright: standard
+"""DeclarationConflict — a declaration that clashes with another of its name."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from enum import Enum
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, SecondarySpan, Severity
+from interpreter.ir import SourceLocation
+
+
+class Conflict(str, Enum):
+    """How a declaration clashes with the earlier one."""
+
+    REDECLARED = "redeclared"  # the same scope already declares the name
+    ALREADY_DEFINED = "already defined"  # an illegal shadow (Java, C#)
+    SHADOWS = "shadows"  # a legal shadow of an enclosing variable
+
+
+_CODES = {
+    Conflict.REDECLARED: DiagnosticCode.REDECLARED,
+    Conflict.ALREADY_DEFINED: DiagnosticCode.ALREADY_DEFINED,
+    Conflict.SHADOWS: DiagnosticCode.SHADOWED,
+}
+
+
+@dataclass(frozen=True)
+class DeclarationConflict:
+    """*name*, declared at *location*, clashes with its declaration at *previous*.
+
+    A redeclaration or illegal shadow is an error; a shadow is a warning.
+    """
+
+    location: SourceLocation
+    name: str
+    previous: SourceLocation
+    conflict: Conflict
+    previous_is_parameter: bool = False
+
+    @property
+    def severity(self) -> Severity:
+        if self.conflict == Conflict.SHADOWS:
+            return Severity.WARNING
+        return Severity.ERROR
+
+    @property
+    def message(self) -> str:
+        if self.conflict == Conflict.REDECLARED:
+            return f"{self.name} redeclared in this scope (previous at {self.previous})"
+        if self.conflict == Conflict.ALREADY_DEFINED:
+            return (
+                f"{self.name} is already defined in an enclosing scope "
+                f"(at {self.previous})"
+            )
+        what = "parameter" if self.previous_is_parameter else "variable"
+        return f"declaration of {self.name} shadows the {what} at {self.previous}"
+
+    def as_diagnostic(self) -> Diagnostic:
+        what = "shadowed" if self.conflict == Conflict.SHADOWS else "previous"
+        return Diagnostic(
+            code=_CODES[self.conflict],
+            severity=self.severity,
+            location=self.location,
+            message=self.message,
+            secondary=(
+                SecondarySpan(self.previous, f"{what} declaration of {self.name}"),
+            ),
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: {self.severity.value}: {self.message}"
@@ -0,0 +1,160 @@
+# pyright: standard
+"""Declaration conflicts — redeclared names and shadowed variables.
+
+Lowering folds a second declaration of a name in the same scope into the
+first, so ``int x = 1; int x = 2;`` quietly assigns.  Most statically
+typed languages reject it, and a declaration that hides a variable of an
+enclosing block is legal but a common source of confusion::
+
+    for (int i = 0; i < n; i++) {
+        for (int i = 0; i < m; i++) {   // i is already defined in an
+            ...                         // enclosing scope (at 1:9-1:18)
+        }
+    }
+
+This reports both.  A redeclaration in the scope that already declares the
+name is an error in the languages that forbid it (``REDECLARATION_LANGUAGES``;
+Rust's ``let`` may shadow in the same block, and JavaScript's ``var`` may be
+repeated).  A local or parameter named like a variable of an enclosing scope
+in the same function, nested functions included, is a shadow: an error in
+Java and C#, which forbid a local to hide another local of its method, and
+otherwise a warning that can be turned off.  Names starting with ``_``, and
+names lowering made up, are never reported.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.declaration_conflict_types import Conflict, DeclarationConflict
+from interpreter.frontends.scopes import (
+    NO_SYMBOL,
+    Scope,
+    ScopeKind,
+    Symbol,
+    SymbolKind,
+)
+
+# Languages that reject a second declaration of a name in one scope.
+REDECLARATION_LANGUAGES: frozenset[Language] = frozenset(
+    {
+        Language.C,
+        Language.CPP,
+        Language.CSHARP,
+        Language.GO,
+        Language.JAVA,
+        Language.KOTLIN,
+        Language.SCALA,
+    }
+)
+
+# Languages where a local may not hide another local of the same method.
+_NO_SHADOWING = frozenset({Language.JAVA, Language.CSHARP})
+
+_CHECKED = frozenset({SymbolKind.VARIABLE, SymbolKind.PARAMETER})
+
+# Scopes a shadowed variable is looked for in; a class or the module ends
+# the search.
+_NESTED = frozenset({ScopeKind.FUNCTION, ScopeKind.BLOCK})
+
+
+def _is_exempt(name: str) -> bool:
+    """``_``-prefixed names and names lowering made up."""
+    return name.startswith("_") or not name.isidentifier()
+
+
+def _is_before(earlier: Symbol, later: Symbol) -> bool:
+    start, end = earlier.location.pos(), later.location.pos()
+    return (start.line, start.col) < (end.line, end.col)
+
+
+def _redeclarations(scope: Scope) -> list[DeclarationConflict]:
+    first = {symbol.resolved_name: symbol for symbol in scope.symbols}
+    return [
+        DeclarationConflict(
+            location=symbol.location,
+            name=symbol.name,
+            previous=first[symbol.resolved_name].location,
+            conflict=Conflict.REDECLARED,
+            previous_is_parameter=first[symbol.resolved_name].kind
+            == SymbolKind.PARAMETER,
+        )
+        for symbol in scope.redeclared
+        if symbol.resolved_name in first and not _is_exempt(symbol.name)
+    ]
+
+
+def _shadowed(
+    symbol: Symbol, scope: Scope, enclosing: list[Scope]
+) -> tuple[Symbol, bool]:
+    """The earlier variable *symbol*, declared in *scope*, hides, or NO_SYMBOL.
+
+    Also says whether a function boundary lies between the two.
+    """
+    crossed = scope.kind == ScopeKind.FUNCTION
+    for outer_scope in reversed(enclosing):
+        for outer in outer_scope.symbols:
+            if (
+                outer.name == symbol.name
+                and outer.kind in _CHECKED
+                and _is_before(outer, symbol)
+            ):
+                return outer, crossed
+        crossed = crossed or outer_scope.kind == ScopeKind.FUNCTION
+    return NO_SYMBOL, crossed
+
+
+def _shadows(
+    scope: Scope, enclosing: list[Scope], language: Language
+) -> list[DeclarationConflict]:
+    """The declarations in *scope* that hide a variable of *enclosing*."""
+    shadows: list[DeclarationConflict] = []
+    for symbol in scope.symbols:
+        if symbol.kind not in _CHECKED or _is_exempt(symbol.name):
+            continue
+        outer, crossed = _shadowed(symbol, scope, enclosing)
+        if not outer.is_present():
+            continue
+        shadows.append(
+            DeclarationConflict(
+                location=symbol.location,
+                name=symbol.name,
+                previous=outer.location,
+                conflict=(
+                    Conflict.ALREADY_DEFINED
+                    if language in _NO_SHADOWING and not crossed
+                    else Conflict.SHADOWS
+                ),
+                previous_is_parameter=outer.kind == SymbolKind.PARAMETER,
+            )
+        )
+    return shadows
+
+
+def find_declaration_conflicts(
+    scopes: Scope, language: Language, shadowing: bool = True
+) -> tuple[DeclarationConflict, ...]:
+    """The redeclarations and shadowing declarations in *scopes*.
+
+    Only declarations in a function, or a block inside one, are checked.
+    Shadows that the language allows are reported only when *shadowing*.
+    Results are in source order.
+    """
+    conflicts: list[DeclarationConflict] = []
+
+    def visit(scope: Scope, enclosing: list[Scope]) -> None:
+        if scope.kind in _NESTED:
+            if language in REDECLARATION_LANGUAGES:
+                conflicts.extend(_redeclarations(scope))
+            conflicts.extend(
+                conflict
+                for conflict in _shadows(scope, enclosing, language)
+                if shadowing or conflict.conflict != Conflict.SHADOWS
+            )
+        inner = enclosing + [scope] if scope.kind in _NESTED else []
+        for child in scope.children:
+            visit(child, inner)
+
+    visit(scopes, [])
+    return tuple(
+        sorted(conflicts, key=lambda c: (c.location.start_line, c.location.start_col))
+    )
@@ -0,0 +1,106 @@
+# pyright: standard
+"""Definite assignment — reads of variables that may not have been assigned.
+
+A variable declared without an initializer holds ``Const.unassigned`` until
+something assigns it.  Java, C#, Kotlin and Rust refuse to compile a read
+that some path reaches before an assignment, and C and C++ read garbage;
+the VM would quietly read ``None``.  This reports each such read::
+
+    int x;
+    if (ready) {
+        x = 1;
+    }
+    print(x);       // variable x might not have been assigned
+
+The analysis runs forward over the CFG: a variable may be unassigned where
+any path from its declaration gets there without a ``STORE_VAR``, an
+initialized ``DECL_VAR``, or an ``ADDRESS_OF`` (``&x``, ``out x``) that lets
+a callee assign it.  Function bodies are analysed separately, so a nested
+function reading an enclosing variable is never reported.
+"""
+
+from __future__ import annotations
+
+from interpreter.cfg import CFG, build_cfg
+from interpreter.dataflow_solver import solve_dataflow
+from interpreter.dataflow_solver_types import DataflowProblem, Direction
+from interpreter.definite_assignment_types import UnassignedRead
+from interpreter.frontends.scopes import Scope
+from interpreter.instructions import (
+    AddressOf,
+    Const,
+    DeclVar,
+    InstructionBase,
+    LoadVar,
+    StoreVar,
+)
+from interpreter.ir import CodeLabel
+
+
+def source_names(scope: Scope) -> dict[str, str]:
+    """The source name of every variable, by IR name (``x$1`` → ``x``)."""
+    names = {symbol.resolved_name: symbol.name for symbol in scope.symbols}
+    for child in scope.children:
+        names.update(source_names(child))
+    return names
+
+
+def _transfer(
+    inst: InstructionBase, unassigned: frozenset[str], placeholders: set[str]
+) -> frozenset[str]:
+    """The variables that may be unassigned after *inst*."""
+    if isinstance(inst, DeclVar):
+        name = str(inst.name)
+        if str(inst.value_reg) in placeholders:
+            return unassigned | {name}
+        return unassigned - {name}
+    if isinstance(inst, StoreVar):
+        return unassigned - {str(inst.name)}
+    if isinstance(inst, AddressOf):
+        return unassigned - {str(inst.var_name)}
+    return unassigned
+
+
+def _solve(cfg: CFG, placeholders: set[str]) -> dict[CodeLabel, frozenset[str]]:
+    """The variables that may be unassigned on entry to each block."""
+    problem: DataflowProblem[frozenset[str]] = DataflowProblem(
+        direction=Direction.FORWARD,
+        transfer=lambda inst, unassigned: _transfer(inst, unassigned, placeholders),
+        merge=frozenset.union,
+        initial=frozenset(),
+        boundary=frozenset(),
+    )
+    return solve_dataflow(cfg, problem).at_entry
+
+
+def find_unassigned_reads(
+    scopes: Scope, instructions: list[InstructionBase]
+) -> tuple[UnassignedRead, ...]:
+    """Every read in *instructions* of a variable that may be unassigned.
+
+    *scopes* and *instructions* come from lowering the same source; the
+    scopes only give reads their source names.  Reads are in source order.
+    """
+    placeholders = {
+        str(inst.result_reg)
+        for inst in instructions
+        if isinstance(inst, Const) and not inst.has_value
+    }
+    if not placeholders:
+        return ()
+    cfg = build_cfg(instructions)
+    entry = _solve(cfg, placeholders)
+    names = source_names(scopes)
+    reads: list[UnassignedRead] = []
+    for label, block in cfg.blocks.items():
+        unassigned = entry[label]
+        for inst in block.instructions:
+            if isinstance(inst, LoadVar) and str(inst.name) in unassigned:
+                name = str(inst.name)
+                reads.append(
+                    UnassignedRead(inst.source_location, names.get(name, name))
+                )
+            unassigned = _transfer(inst, unassigned, placeholders)
+    return tuple(
+        sorted(reads, key=lambda r: (r.location.start_line, r.location.start_col))
+    )
@@ -0,0 +1,35 @@
+# pyright: standard
+"""UnassignedRead — a variable read where it may not have been assigned."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, Severity
+from interpreter.ir import SourceLocation
+
+
+@dataclass(frozen=True)
+class UnassignedRead:
+    """*name* is read at *location*, and some path there never assigns it.
+
+    The message follows Java's: ``variable x might not have been assigned``.
+    """
+
+    location: SourceLocation
+    name: str
+
+    @property
+    def message(self) -> str:
+        return f"variable {self.name} might not have been assigned"
+
+    def as_diagnostic(self) -> Diagnostic:
+        return Diagnostic(
+            code=DiagnosticCode.UNASSIGNED_READ,
+            severity=Severity.ERROR,
+            location=self.location,
+            message=self.message,
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: {self.message}"
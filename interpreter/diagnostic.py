@@ -0,0 +1,185 @@
+# pyright: standard
+"""Diagnostic — one problem a check found, in a form tools can rely on.
+
+Every check reports its findings as its own type (``TypeDiagnostic``,
+``UnusedVariable``, ``MissingReturn``, ...), each with an ``as_diagnostic()``
+that gives the common form::
+
+    main.go:6:1-6:9: warning[RD2004]: declared and not used: x
+      = note: rename it to _x if it is unused on purpose
+
+A ``Diagnostic`` has a *code*, a *severity*, a primary *location* and
+*message*, secondary spans pointing at related code, and notes that belong
+to no span.  Codes are stable: a code keeps its meaning when the wording of
+its message changes, so a test harness or an editor can filter on it.
+RD0xxx codes are syntax errors, RD1xxx type errors, RD2xxx problems with
+declarations and RD3xxx with control flow.  Errors a frontend finds while
+lowering, as the language's compiler would, are ``CompileError``s
+(``frontends/compile_error.py``).
+
+A ``DiagnosticConfig`` sets how each code is reported — ``off``,
+``warning`` or ``error`` — so one engine serves a lenient student run and
+strict grading alike::
+
+    config = DiagnosticConfig(strict=True).with_level(
+        DiagnosticCode.SHADOWED, Level.OFF
+    )
+    config.apply(found)     # warnings are errors, shadowing not reported
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, field, replace
+from enum import Enum
+from types import MappingProxyType
+
+from interpreter.ir import SourceLocation
+
+
+class Severity(str, Enum):
+    """How a diagnostic is reported: as a warning, or as an error."""
+
+    WARNING = "warning"
+    ERROR = "error"
+
+
+class DiagnosticCode(str, Enum):
+    """The stable code of each kind of diagnostic."""
+
+    SYNTAX_ERROR = "RD0001"  # missing ")", unexpected "=>"
+    INVALID_LITERAL = "RD0002"  # "\q": unknown escape sequence
+    INCOMPARABLE_TYPES = "RD1001"  # cannot compare Int with String
+    UNDEFINED_OPERATOR = "RD1002"  # operator - is not defined on String
+    NOT_INDEXABLE = "RD1003"  # cannot index a String
+    WRONG_ARGUMENT_COUNT = "RD1004"  # too many arguments in call to f
+    ARGUMENT_TYPE_MISMATCH = "RD1005"  # cannot use String as Int in argument 1
+    NOT_CALLABLE = "RD1006"  # cannot call non-function x of type Int
+    TYPE_MISMATCH = "RD1007"  # cannot use m (variable of type MyInt) as int value
+    INVALID_CONVERSION = "RD1008"  # cannot convert "7" to type int
+    UNTYPED_NIL = "RD1009"  # use of untyped nil in assignment
+    CONSTANT_DIVISION_BY_ZERO = "RD1010"  # invalid operation: division by zero
+    INVALID_COMPOSITE_LITERAL = "RD1011"  # cannot use "two" as int value
+    CONSTANT_OVERFLOW = "RD1012"  # constant 300 overflows int8
+    ASSIGNMENT_MISMATCH = "RD1013"  # assignment mismatch: 1 variable but f returns 2
+    INVALID_TYPE_ARGUMENT = "RD1014"  # in call to Max, cannot infer T
+    REDECLARED = "RD2001"  # x redeclared in this scope
+    ALREADY_DEFINED = "RD2002"  # a shadow the language forbids
+    SHADOWED = "RD2003"  # a shadow the language allows
+    UNUSED_VARIABLE = "RD2004"  # declared and not used: x
+    ASSIGNED_CONSTANT = "RD2005"  # cannot assign to Pi: Pi is a constant
+    INVALID_ENTRY_FUNCTION = "RD2006"  # func main must have no arguments
+    INITIALIZATION_CYCLE = "RD2007"  # initialization cycle: a refers to b
+    INVALID_LABEL = "RD2008"  # label L defined and not used
+    IMPORT_CYCLE = "RD2009"  # import cycle not allowed: a imports b
+    UNDEFINED_MEMBER = "RD2010"  # name helper not exported by package util
+    AMBIGUOUS_SELECTOR = "RD2011"  # ambiguous selector o.X
+    UNASSIGNED_READ = "RD3001"  # variable x might not have been assigned
+    MISSING_RETURN = "RD3002"  # missing return statement in f
+    RECURSION = "RD3003"  # recursion not allowed in this dialect
+    DEAD_STORE = "RD3004"  # value assigned to x is never read
+    IMPURE_FUNCTION = "RD3005"  # f must be pure
+    TAINTED_FLOW = "RD3006"  # untrusted value from input reaches eval
+    INDEX_OUT_OF_BOUNDS = "RD3007"  # index i of s is bounded by n
+    DIVISION_BY_ZERO = "RD3008"  # divisor d may be zero (0..9)
+
+
+@dataclass(frozen=True)
+class SecondarySpan:
+    """Related code a diagnostic points at, e.g. ``f declared here``."""
+
+    location: SourceLocation
+    message: str
+
+    def __str__(self) -> str:
+        return f"{self.location}: note: {self.message}"
+
+
+@dataclass(frozen=True)
+class Diagnostic:
+    """A problem at *location*, identified by its stable *code*."""
+
+    code: DiagnosticCode
+    severity: Severity
+    location: SourceLocation
+    message: str
+    secondary: tuple[SecondarySpan, ...] = ()
+    notes: tuple[str, ...] = ()
+
+    @property
+    def is_error(self) -> bool:
+        return self.severity == Severity.ERROR
+
+    def __str__(self) -> str:
+        return (
+            f"{self.location}: {self.severity.value}[{self.code.value}]: "
+            f"{self.message}"
+        )
+
+
+class Level(str, Enum):
+    """How a configured code is reported; ``off`` drops it."""
+
+    OFF = "off"
+    WARNING = "warning"
+    ERROR = "error"
+
+
+def _code(name: str) -> DiagnosticCode:
+    """The code *name* is, e.g. ``RD2004``, or its name, ``unused-variable``."""
+    key = name.upper().replace("-", "_")
+    for code in DiagnosticCode:
+        if key in (code.value, code.name):
+            return code
+    raise ValueError(f"unknown diagnostic code: {name}")
+
+
+def parse_level(option: str) -> tuple[DiagnosticCode, Level]:
+    """The code and level of a ``CODE=LEVEL`` option, e.g. ``RD2004=off``."""
+    name, separator, level = option.partition("=")
+    if not separator:
+        raise ValueError(f"expected CODE=LEVEL, got {option}")
+    code = _code(name.strip())
+    try:
+        return code, Level(level.strip().lower())
+    except ValueError:
+        raise ValueError(
+            f"unknown level in {option}: use off, warning or error"
+        ) from None
+
+
+@dataclass(frozen=True)
+class DiagnosticConfig:
+    """How each diagnostic is reported.
+
+    *levels* override the severity of the codes they name.  Under *strict*,
+    every other warning is reported as an error.
+    """
+
+    levels: MappingProxyType[DiagnosticCode, Level] = field(
+        default_factory=lambda: MappingProxyType({})
+    )
+    strict: bool = False
+
+    @classmethod
+    def from_options(cls, options: list[str], strict: bool = False) -> DiagnosticConfig:
+        """The config of ``CODE=LEVEL`` *options*; a later one wins."""
+        levels = dict(parse_level(option) for option in options)
+        return cls(levels=MappingProxyType(levels), strict=strict)
+
+    def with_level(self, code: DiagnosticCode, level: Level) -> DiagnosticConfig:
+        return replace(self, levels=MappingProxyType({**self.levels, code: level}))
+
+    def level_of(self, diagnostic: Diagnostic) -> Level:
+        if diagnostic.code in self.levels:
+            return self.levels[diagnostic.code]
+        if self.strict:
+            return Level.ERROR
+        return Level(diagnostic.severity.value)
+
+    def apply(self, diagnostics: tuple[Diagnostic, ...]) -> tuple[Diagnostic, ...]:
+        """*diagnostics* at their configured severity, without those off."""
+        return tuple(
+            replace(diagnostic, severity=Severity(level.value))
+            for diagnostic in diagnostics
+            if (level := self.level_of(diagnostic)) != Level.OFF
+        )
@@ -0,0 +1,66 @@
+# pyright: standard
+"""Dominator tree — which blocks every path from the entry passes through."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, field
+
+from interpreter.ir import CodeLabel
+
+
+@dataclass(frozen=True)
+class DominatorTree:
+    """The dominator tree of the blocks reachable from *entry*.
+
+    A block dominates another when every path from the entry to the other
+    passes through it; *idom* maps each block to its immediate dominator,
+    the closest of those, and the entry to itself.  *order* lists the
+    blocks in reverse postorder.  *frontiers* maps each block to its
+    dominance frontier: the joins it reaches a predecessor of without
+    dominating the join, where phis for its assignments go.
+    """
+
+    entry: CodeLabel
+    idom: dict[CodeLabel, CodeLabel] = field(default_factory=dict)
+    order: tuple[CodeLabel, ...] = ()
+    frontiers: dict[CodeLabel, frozenset[CodeLabel]] = field(default_factory=dict)
+
+    def __contains__(self, label: CodeLabel) -> bool:
+        return label in self.idom
+
+    def children(self, label: CodeLabel) -> list[CodeLabel]:
+        """The blocks *label* immediately dominates, in reverse postorder."""
+        return [
+            other
+            for other in self.order
+            if other != self.entry and self.idom[other] == label
+        ]
+
+    def dominators(self, label: CodeLabel) -> list[CodeLabel]:
+        """The blocks dominating *label*, from *label* itself up to the entry."""
+        chain = [label]
+        while chain[-1] != self.entry:
+            chain.append(self.idom[chain[-1]])
+        return chain
+
+    def dominates(self, a: CodeLabel, b: CodeLabel) -> bool:
+        """Whether every path from the entry to *b* passes through *a*."""
+        return b in self.idom and a in self.dominators(b)
+
+    def depth(self, label: CodeLabel) -> int:
+        """How many blocks strictly dominate *label*."""
+        return len(self.dominators(label)) - 1
+
+    def __str__(self) -> str:
+        """The tree indented by depth, each block with its frontier."""
+        lines = []
+        pending = [self.entry] if self.idom else []
+        while pending:
+            label = pending.pop()
+            frontier = ", ".join(
+                str(f) for f in self.order if f in self.frontiers.get(label, ())
+            )
+            suffix = f"  frontier: {frontier}" if frontier else ""
+            lines.append(f"{'  ' * self.depth(label)}{label}{suffix}")
+            pending.extend(reversed(self.children(label)))
+        return "\n".join(lines)
@@ -0,0 +1,160 @@
+# pyright: standard
+"""Dominators — the blocks every path from the entry passes through.
+
+``build_dominator_tree`` finds each block's immediate dominator with the
+Cooper–Harvey–Kennedy iterative algorithm: blocks are visited in reverse
+postorder, and a block's dominator becomes the nearest common dominator of
+its predecessors processed so far, until no dominator changes.  Walking up
+the tree from each predecessor of a join then gives the dominance
+frontiers::
+
+    entry → cond → body → cond      idom(cond) = entry, idom(body) = cond
+            cond → done             idom(done) = cond
+                                    frontier(body) = frontier(cond) = {cond}
+
+An edge whose target dominates its source is a back edge, closing a loop
+whose header is that target; ``natural_loop`` gives the blocks of it.
+"""
+
+from __future__ import annotations
+
+from interpreter.cfg import CFG, escape_mermaid, node_id
+from interpreter.cfg_types import Edge
+from interpreter.dominator_types import DominatorTree
+from interpreter.ir import CodeLabel
+
+
+def _reverse_postorder(cfg: CFG) -> list[CodeLabel]:
+    """The blocks reachable from the entry, each before its successors but
+    for the targets of back edges."""
+    if cfg.entry not in cfg.blocks:
+        return []
+    seen = {cfg.entry}
+    order: list[CodeLabel] = []
+    stack = [(cfg.entry, iter(cfg.blocks[cfg.entry].successors))]
+    while stack:
+        label, successors = stack[-1]
+        successor = next(
+            (s for s in successors if s in cfg.blocks and s not in seen), None
+        )
+        if successor is None:
+            order.append(label)
+            stack.pop()
+            continue
+        seen.add(successor)
+        stack.append((successor, iter(cfg.blocks[successor].successors)))
+    return order[::-1]
+
+
+def _intersect(
+    idom: dict[CodeLabel, CodeLabel],
+    index: dict[CodeLabel, int],
+    a: CodeLabel,
+    b: CodeLabel,
+) -> CodeLabel:
+    """The nearest block dominating both *a* and *b*."""
+    while a != b:
+        while index[a] > index[b]:
+            a = idom[a]
+        while index[b] > index[a]:
+            b = idom[b]
+    return a
+
+
+def _immediate_dominators(
+    cfg: CFG, order: list[CodeLabel]
+) -> dict[CodeLabel, CodeLabel]:
+    """Each block's immediate dominator, *order* being the reachable blocks
+    in reverse postorder; the entry's is itself."""
+    index = {label: i for i, label in enumerate(order)}
+    idom = {cfg.entry: cfg.entry} if order else {}
+    changed = True
+    while changed:
+        changed = False
+        for label in order[1:]:
+            preds = [p for p in cfg.blocks[label].predecessors if p in idom]
+            new = preds[0]
+            for pred in preds[1:]:
+                new = _intersect(idom, index, pred, new)
+            if idom.get(label) != new:
+                idom[label] = new
+                changed = True
+    return idom
+
+
+def _dominance_frontiers(
+    cfg: CFG, idom: dict[CodeLabel, CodeLabel]
+) -> dict[CodeLabel, frozenset[CodeLabel]]:
+    """Each block's dominance frontier, found by walking up from each
+    predecessor of a join to the join's immediate dominator."""
+    frontiers: dict[CodeLabel, set[CodeLabel]] = {label: set() for label in idom}
+    for label in idom:
+        preds = [p for p in cfg.blocks[label].predecessors if p in idom]
+        if len(preds) < 2:
+            continue
+        for pred in preds:
+            runner = pred
+            while runner != idom[label]:
+                frontiers[runner].add(label)
+                runner = idom[runner]
+    return {label: frozenset(frontier) for label, frontier in frontiers.items()}
+
+
+def build_dominator_tree(cfg: CFG) -> DominatorTree:
+    """The dominator tree and dominance frontiers of *cfg*, from its entry.
+
+    Blocks the entry does not reach are left out.  Build it over one
+    function's CFG (``build_function_cfgs``): a whole program's branches
+    around each function body, leaving the bodies unreached.
+    """
+    order = _reverse_postorder(cfg)
+    idom = _immediate_dominators(cfg, order)
+    return DominatorTree(
+        entry=cfg.entry,
+        idom=idom,
+        order=tuple(order),
+        frontiers=_dominance_frontiers(cfg, idom),
+    )
+
+
+def back_edges(cfg: CFG, tree: DominatorTree) -> list[Edge]:
+    """The edges of *cfg* whose target dominates their source: each closes
+    a loop headed by its target."""
+    return [
+        edge
+        for edge in cfg.edges()
+        if edge.source in tree and tree.dominates(edge.target, edge.source)
+    ]
+
+
+def natural_loop(cfg: CFG, edge: Edge) -> frozenset[CodeLabel]:
+    """The blocks of the loop the back edge *edge* closes: its header, and
+    every block reaching the edge's source without passing the header."""
+    loop = {edge.target, edge.source}
+    pending = [edge.source] if edge.source != edge.target else []
+    while pending:
+        for pred in cfg.blocks[pending.pop()].predecessors:
+            if pred not in loop:
+                loop.add(pred)
+                pending.append(pred)
+    return frozenset(loop)
+
+
+def dominator_tree_to_mermaid(tree: DominatorTree) -> str:
+    """Convert a dominator tree to a Mermaid flowchart TD diagram.
+
+    One node per block, one solid edge from each block to those it
+    immediately dominates, and one dotted edge from each block to each
+    join in its dominance frontier.
+    """
+    lines: list[str] = ["flowchart TD"]
+    for label in tree.order:
+        lines.append(f'    {node_id(label)}["{escape_mermaid(str(label))}"]')
+    for label in tree.order[1:]:
+        lines.append(f"    {node_id(tree.idom[label])} --> {node_id(label)}")
+    for label in tree.order:
+        for join in (j for j in tree.order if j in tree.frontiers[label]):
+            lines.append(f"    {node_id(label)} -.-> {node_id(join)}")
+    if tree.order:
+        lines.append(f"    style {node_id(tree.entry)} fill:#28a745,color:#fff")
+    return "\n".join(lines)
@@ -0,0 +1,158 @@
+# pyright: standard
+"""Source formatter — canonical layout for any tree-sitter language.
+
+The formatter only ever changes whitespace between tokens, so the token
+stream (and therefore the program) is unchanged, and formatting its own
+output changes nothing:
+
+- runs of spaces and tabs inside a line become one space; adjacent tokens
+  stay adjacent;
+- trailing whitespace goes, blank lines collapse to one, and the file ends
+  in exactly one newline;
+- in brace languages every line is re-indented to its bracket depth, one
+  tab per level for Go (as gofmt does) and four spaces elsewhere.  Go
+  outdents ``case``/``default`` to the level of its ``switch``, the others
+  indent the statements under a ``case X:`` one level past it;
+- where indentation is syntax or is hung on keywords (Python, Scala,
+  Ruby, Lua, Pascal), each line keeps its indentation.
+
+Strings, comments and other literals are copied verbatim, as is any gap
+between tokens holding more than whitespace (a ``\\`` line continuation).
+"""
+
+from __future__ import annotations
+
+from typing import Any
+
+from interpreter.constants import Language
+from interpreter.parser import ParserFactory, TreeSitterParserFactory
+
+# Node kinds copied verbatim as one unit: their inner whitespace is content.
+_VERBATIM_KIND_MARKERS: tuple[str, ...] = (
+    "string",
+    "heredoc",
+    "nowdoc",
+    "comment",
+    "regex",
+    "char",
+    "rune",
+)
+
+_OPENERS = frozenset({b"{", b"(", b"["})
+_CLOSERS = frozenset({b"}", b")", b"]"})
+_CASE_KEYWORDS = frozenset({b"case", b"default"})
+
+_INDENT_PRESERVING_LANGUAGES = frozenset(
+    {Language.PYTHON, Language.SCALA, Language.RUBY, Language.LUA, Language.PASCAL}
+)
+_INDENT_UNITS: dict[Language, bytes] = {Language.GO: b"\t"}
+_DEFAULT_INDENT_UNIT = b"    "
+_CASE_OUTDENTING_LANGUAGES = frozenset({Language.GO})
+
+_MAX_NEWLINES = 2
+
+
+def _is_verbatim(node: Any) -> bool:  # Any: tree-sitter node
+    return (
+        node.child_count == 0
+        or node.is_extra
+        or any(marker in node.type for marker in _VERBATIM_KIND_MARKERS)
+    )
+
+
+def _spans(node: Any) -> list[tuple[int, int]]:  # Any: tree-sitter node
+    """Byte spans of the tokens, comments and literals under *node*, in order."""
+    if _is_verbatim(node):
+        return [(node.start_byte, node.end_byte)]
+    return [span for child in node.children for span in _spans(child)]
+
+
+def _lines(
+    source: bytes, spans: list[tuple[int, int]]
+) -> list[list[tuple[int, int]]]:
+    """*spans* grouped into source lines."""
+    lines: list[list[tuple[int, int]]] = []
+    prev_end = 0
+    for start, end in spans:
+        if not lines or b"\n" in source[prev_end - 1 : start]:
+            lines.append([])
+        lines[-1].append((start, end))
+        prev_end = end
+    return lines
+
+
+def _is_case_label(source: bytes, line: list[tuple[int, int]]) -> bool:
+    """Is *line* a ``case X:`` or ``default:`` label?"""
+    first, last = line[0], line[-1]
+    return (
+        source[first[0] : first[1]] in _CASE_KEYWORDS
+        and source[last[0] : last[1]] == b":"
+    )
+
+
+def _separator(gap: bytes, indent: bytes, after_newline: bool) -> bytes:
+    """What replaces the whitespace *gap* before a token indented by *indent*.
+
+    *after_newline* is set when the previous token (a line comment, in some
+    grammars) ends with the newline itself.
+    """
+    if gap.strip():
+        return gap
+    newlines = gap.count(b"\n") + after_newline
+    if newlines:
+        return b"\n" * (min(newlines, _MAX_NEWLINES) - after_newline) + indent
+    return b" " if gap else b""
+
+
+def _format_bytes(source: bytes, root: Any, language: Language) -> bytes:
+    spans = _spans(root)
+    if not spans:
+        return source if source.strip() else b""
+    preserve = language in _INDENT_PRESERVING_LANGUAGES
+    unit = _INDENT_UNITS.get(language, _DEFAULT_INDENT_UNIT)
+    leading = source[: spans[0][0]]
+    out: list[bytes] = [leading if leading.strip() else b""]
+    prev_end = spans[0][0]
+    depth = 0
+    case_levels: list[int] = []
+    for line in _lines(source, spans):
+        level = max(depth - (source[line[0][0] : line[0][1]] in _CLOSERS), 0)
+        case_levels = [c for c in case_levels if c <= level]
+        extra = len(case_levels)
+        if _is_case_label(source, line):
+            if language in _CASE_OUTDENTING_LANGUAGES:
+                extra -= 1
+            else:
+                case_levels = [c for c in case_levels if c < level] + [level]
+                extra = len(case_levels) - 1
+        gap = source[prev_end : line[0][0]]
+        indent = (
+            gap.rsplit(b"\n", 1)[-1] if preserve else unit * max(level + extra, 0)
+        )
+        for start, end in line:
+            text = source[start:end]
+            after_newline = source[prev_end - 1 : prev_end] == b"\n"
+            out.append(_separator(source[prev_end:start], indent, after_newline))
+            out.append(text)
+            depth = max(depth + (text in _OPENERS) - (text in _CLOSERS), 0)
+            prev_end = end
+    trailing = source[prev_end:]
+    ends_line = source[prev_end - 1 : prev_end] == b"\n"
+    out.append(trailing if trailing.strip() else b"" if ends_line else b"\n")
+    return b"".join(out)
+
+
+def format_source(
+    source: str,
+    language: Language,
+    parser_factory: ParserFactory = TreeSitterParserFactory(),
+) -> str:
+    """*source* laid out canonically; see the module docstring for the rules.
+
+    Raises ``ValueError`` for COBOL, which is not parsed by tree-sitter.
+    """
+    if language == Language.COBOL:
+        raise ValueError("The formatter does not support COBOL")
+    encoded = source.encode("utf-8")
+    tree = parser_factory.get_parser(language).parse(encoded)
+    return _format_bytes(encoded, tree.root_node, language).decode("utf-8")
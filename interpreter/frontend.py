@@ -12,7 +12,7 @@ from interpreter import constants
 from interpreter.cobol.cobol_parser import (
     make_cobol_parser as make_cobol_parser,
 )  # noqa: F401 — re-exported for callers outside interpreter.cobol
-from interpreter.constants import Language, LLMProvider
+from interpreter.constants import MAX_NESTING_DEPTH, Language, LLMProvider
 from interpreter.frontend_extension import DialectParser
 from interpreter.frontend_extension_lowering import RedDragonExtensionLoweringStrategy
 from interpreter.frontend_observer import FrontendObserver, NullFrontendObserver
@@ -21,9 +21,11 @@ from interpreter.ir import CodeLabel
 from interpreter.namespace_resolver import NamespaceResolver
 from interpreter.refs.class_ref import ClassRef
 from interpreter.refs.func_ref import FuncRef
+from interpreter.syntax_diagnostic import SyntaxDiagnostic
 from interpreter.types.type_environment_builder import TypeEnvironmentBuilder
 
 if TYPE_CHECKING:
+    from interpreter.frontends.scopes import Scope
     from interpreter.frontends.symbol_table import SymbolTable
 
 _NO_REPAIR_CLIENT = object()  # sentinel — distinct from None
@@ -73,6 +75,27 @@ class Frontend(ABC):
 
         return SymbolTable.empty()
 
+    @property
+    def scopes(self) -> Scope:
+        """Scopes and declarations the last ``lower()`` resolved names against.
+
+        ``scopes.lookup_at(pos, name)`` is the declaration *name* written at
+        *pos* refers to.  Frontends that do not track scopes return one
+        empty module scope.
+        """
+        from interpreter.frontends.scopes import EMPTY_SCOPE
+
+        return EMPTY_SCOPE
+
+    @property
+    def diagnostics(self) -> tuple[SyntaxDiagnostic, ...]:
+        """Syntax errors the last ``lower()`` recovered from, in source order.
+
+        Override in frontends whose parser recovers from errors; lowering
+        skips each malformed region and still returns IR for the rest.
+        """
+        return ()
+
 
 def get_frontend(
     language: Language,
@@ -84,6 +107,8 @@ def get_frontend(
     copybook_dirs: list[Path] = [],
     extension_strategies: Sequence[RedDragonExtensionLoweringStrategy] = (),
     dialect_parsers: Sequence[DialectParser] = (),
+    go_entry_func: str = "main",
+    max_nesting_depth: int = MAX_NESTING_DEPTH,
 ) -> Frontend:
     """Build a frontend for the given language.
 
@@ -101,6 +126,10 @@ def get_frontend(
             Passed to CobolFrontend. COBOL only.
         dialect_parsers: Dialect parsers (e.g. CICS/SQL). Passed to
             CobolFrontend. COBOL only.
+        go_entry_func: Function a Go program runs in place of ``main``,
+            after package initialization. Go only.
+        max_nesting_depth: Deepest syntax tree a deterministic frontend
+            lowers; deeper source raises NestingTooDeepError.
 
     Returns:
         A Frontend instance.
@@ -133,7 +162,12 @@ def get_frontend(
     if frontend_type == constants.FRONTEND_DETERMINISTIC:
         from interpreter.frontends import get_deterministic_frontend
 
-        frontend = get_deterministic_frontend(language, observer=observer)
+        frontend = get_deterministic_frontend(
+            language,
+            observer=observer,
+            go_entry_func=go_entry_func,
+            max_nesting_depth=max_nesting_depth,
+        )
         if repair_client is not _NO_REPAIR_CLIENT:
             from interpreter.ast_repair.repairing_frontend_decorator import (
                 RepairingFrontendDecorator,
@@ -0,0 +1,64 @@
+# pyright: standard
+"""Pluggable frontend seam: FrontendPlugin.
+
+A plugin describes one source language to the rest of the pipeline — the
+name callers select it by, the file extensions it claims, and a factory for
+the ``Frontend`` that lowers its source into the shared IR.  Third parties
+implement the protocol in their own package and register it with a
+``FrontendRegistry``; nothing under ``interpreter`` needs to change.
+
+Frontends report malformed source the way the built-in ones do, by raising
+from ``lower()``; the IR they return is the common representation every
+later stage (CFG, registry, VM, dataflow) consumes.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from typing import Protocol, runtime_checkable
+
+from interpreter import constants
+from interpreter.frontend import Frontend, get_frontend
+from interpreter.frontend_observer import FrontendObserver
+from interpreter.language import Language
+
+
+@runtime_checkable
+class FrontendPlugin(Protocol):
+    """One source language a FrontendRegistry can build frontends for."""
+
+    @property
+    def name(self) -> str:
+        """Name callers select the language by (e.g. "python")."""
+        ...
+
+    @property
+    def extensions(self) -> tuple[str, ...]:
+        """File suffixes, with the leading dot, of this language's sources."""
+        ...
+
+    def create(self, observer: FrontendObserver) -> Frontend:
+        """Build a fresh frontend; *observer* receives its parse/lower timings."""
+        ...
+
+
+@dataclass(frozen=True)
+class BuiltinFrontendPlugin:
+    """Plugin for a language RedDragon ships a frontend for."""
+
+    language: Language
+    extensions: tuple[str, ...]
+
+    @property
+    def name(self) -> str:
+        return self.language.value
+
+    def create(self, observer: FrontendObserver) -> Frontend:
+        frontend_type = (
+            constants.FRONTEND_COBOL
+            if self.language == Language.COBOL
+            else constants.FRONTEND_DETERMINISTIC
+        )
+        return get_frontend(
+            self.language, frontend_type=frontend_type, observer=observer
+        )
@@ -0,0 +1,75 @@
+# pyright: standard
+"""FrontendRegistry — the set of languages a pipeline can lower.
+
+The registry is an immutable value: ``register()`` returns a new registry,
+so a caller extends the default one with its own plugins and injects the
+result wherever frontends are built, without touching any shared state::
+
+    registry = default_frontend_registry().register(ZigFrontendPlugin())
+    frontend = registry.create("zig")
+    ir = frontend.lower(source)
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter.frontend import Frontend
+from interpreter.frontend_observer import FrontendObserver, NullFrontendObserver
+from interpreter.frontend_plugin import BuiltinFrontendPlugin, FrontendPlugin
+from interpreter.language import Language
+
+
+@dataclass(frozen=True)
+class FrontendRegistry:
+    """Frontend plugins by name, in registration order."""
+
+    plugins: tuple[FrontendPlugin, ...] = ()
+
+    def register(self, plugin: FrontendPlugin) -> FrontendRegistry:
+        """A registry holding this one's plugins and *plugin*.
+
+        Raises ``ValueError`` if a plugin of the same name is registered.
+        """
+        if plugin.name in self.names():
+            raise ValueError(f"Frontend already registered: {plugin.name}")
+        return FrontendRegistry(plugins=(*self.plugins, plugin))
+
+    def names(self) -> tuple[str, ...]:
+        return tuple(plugin.name for plugin in self.plugins)
+
+    def get(self, name: str) -> FrontendPlugin:
+        """The plugin registered as *name*.
+
+        Raises ``ValueError``, listing the registered names, if there is none.
+        """
+        for plugin in self.plugins:
+            if plugin.name == name:
+                return plugin
+        raise ValueError(
+            f"No frontend registered for {name!r}; known: {', '.join(self.names())}"
+        )
+
+    def for_extension(self, extension: str) -> tuple[FrontendPlugin, ...]:
+        """Plugins claiming files ending in *extension* (e.g. ".h"), in order."""
+        return tuple(
+            plugin for plugin in self.plugins if extension.lower() in plugin.extensions
+        )
+
+    def create(
+        self, name: str, observer: FrontendObserver = NullFrontendObserver()
+    ) -> Frontend:
+        """A fresh frontend from the plugin registered as *name*."""
+        return self.get(name).create(observer)
+
+
+def default_frontend_registry() -> FrontendRegistry:
+    """A registry of every language RedDragon ships a frontend for."""
+    from interpreter.project.compiler import LANGUAGE_EXTENSIONS
+
+    return FrontendRegistry(
+        plugins=tuple(
+            BuiltinFrontendPlugin(language, LANGUAGE_EXTENSIONS.get(language, ()))
+            for language in Language
+        )
+    )
@@ -2,7 +2,7 @@
 
 from __future__ import annotations
 
-from interpreter.constants import Language
+from interpreter.constants import MAX_NESTING_DEPTH, Language
 from interpreter.frontend_observer import FrontendObserver, NullFrontendObserver
 from interpreter.frontends._base import BaseFrontend
 from interpreter.parser import TreeSitterParserFactory
@@ -30,9 +30,14 @@ _FRONTEND_CLASSES: dict[Language, str] = {
 def get_deterministic_frontend(
     language: Language,
     observer: FrontendObserver = NullFrontendObserver(),
+    go_entry_func: str = "main",
+    max_nesting_depth: int = MAX_NESTING_DEPTH,
 ) -> BaseFrontend:
     """Instantiate the deterministic frontend for *language*.
 
+    *go_entry_func* names the function a Go program runs in place of
+    ``main``; other languages ignore it.  *max_nesting_depth* is the
+    deepest tree the frontend lowers.
     Raises ``ValueError`` if *language* has no registered frontend.
     """
     spec = _FRONTEND_CLASSES.get(language)
@@ -43,7 +48,13 @@ def get_deterministic_frontend(
 
     mod = importlib.import_module(f".{module_name}", package=__package__)
     cls = getattr(mod, class_name)
-    return cls(TreeSitterParserFactory(), language, observer)
+    frontend = (
+        cls(TreeSitterParserFactory(), language, observer, go_entry_func)
+        if language == Language.GO
+        else cls(TreeSitterParserFactory(), language, observer)
+    )
+    frontend.max_nesting_depth = max_nesting_depth
+    return frontend
 
 
 SUPPORTED_DETERMINISTIC_LANGUAGES: tuple[str, ...] = tuple(_FRONTEND_CLASSES.keys())
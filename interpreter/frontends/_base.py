@@ -19,7 +19,12 @@ from typing import Any
 
 from interpreter import constants
 from interpreter.class_name import ClassName
-from interpreter.constants import DEFAULT_EXCEPTION_TYPE, CanonicalLiteral, Language
+from interpreter.constants import (
+    DEFAULT_EXCEPTION_TYPE,
+    MAX_NESTING_DEPTH,
+    CanonicalLiteral,
+    Language,
+)
 from interpreter.field_name import FieldName
 from interpreter.frontend import Frontend
 from interpreter.frontend_observer import FrontendObserver, NullFrontendObserver
@@ -29,7 +34,11 @@ from interpreter.frontends.context import (
     GrammarConstants,
     TreeSitterEmitContext,
 )
+from interpreter.frontends.nesting import check_nesting, recursion_headroom
+from interpreter.frontends.offset_map import NO_REWRITES, OffsetMap
+from interpreter.frontends.scopes import EMPTY_SCOPE, Scope, ScopeBuilder
 from interpreter.frontends.symbol_table import SymbolTable
+from interpreter.frontends.syntax_diagnostics import collect_syntax_diagnostics
 from interpreter.frontends.type_alias_prepass import (
     NullTypeAliasExtractor,
     TypeAliasExtractor,
@@ -73,10 +82,12 @@ from interpreter.ir import (
 )
 from interpreter.namespace_resolver import NamespaceResolver
 from interpreter.operator_kind import resolve_binop, resolve_unop
-from interpreter.parser import ParserFactory
+from interpreter.parser import Parser, ParserFactory, node_location
 from interpreter.refs.class_ref import ClassRef
 from interpreter.refs.func_ref import FuncRef
 from interpreter.register import NO_REGISTER, Register
+from interpreter.stream_source import SourceBytes, StreamSource
+from interpreter.syntax_diagnostic import SyntaxDiagnostic
 from interpreter.type_name import TypeName
 from interpreter.types.type_environment_builder import TypeEnvironmentBuilder
 from interpreter.types.type_expr import scalar
@@ -143,6 +154,11 @@ class BaseFrontend(Frontend):
 
     BLOCK_SCOPED: bool = False
 
+    # Whether lower() takes a StreamSource: every read of source text goes
+    # through ctx.node_text or a slice of the source, never node.text, and
+    # _prepare_source rewrites nothing.
+    STREAMABLE: bool = False
+
     # ── init ─────────────────────────────────────────────────────
 
     def __init__(
@@ -158,11 +174,17 @@ class BaseFrontend(Frontend):
         self._func_symbol_table: dict[CodeLabel, FuncRef] = {}
         self._class_symbol_table: dict[CodeLabel, ClassRef] = {}
         self._symbol_table: SymbolTable = SymbolTable.empty()
+        self._diagnostics: tuple[SyntaxDiagnostic, ...] = ()
+        self._scopes: Scope = EMPTY_SCOPE
+        # Deepest tree lower() accepts; NestingTooDeepError beyond it
+        self.max_nesting_depth: int = MAX_NESTING_DEPTH
         # Legacy state (used only by unconverted frontends)
         self._reg_counter: int = 0
         self._label_counter: int = 0
         self._instructions: list[InstructionBase] = []
-        self._source: bytes = b""
+        self._source: SourceBytes = b""
+        # Where positions in the parsed source lie in the file (_prepare_source)
+        self._offsets: OffsetMap = NO_REWRITES
         self._loop_stack: list[dict[str, str]] = []
         self._break_target_stack: list[str] = []
         # Type alias prepass: injected per-frontend, defaults to no-op
@@ -232,13 +254,7 @@ class BaseFrontend(Frontend):
     def _source_loc(
         self, node: Any
     ) -> SourceLocation:  # Any: tree-sitter node — untyped at Python boundary
-        s, e = node.start_point, node.end_point
-        return SourceLocation(
-            start_line=s[0] + 1,
-            start_col=s[1],
-            end_line=e[0] + 1,
-            end_col=e[1],
-        )
+        return self._offsets.location(node_location(node))
 
     @property
     def type_env_builder(self) -> TypeEnvironmentBuilder:
@@ -256,6 +272,14 @@ class BaseFrontend(Frontend):
     def symbol_table(self) -> SymbolTable:
         return self._symbol_table
 
+    @property
+    def diagnostics(self) -> tuple[SyntaxDiagnostic, ...]:
+        return self._diagnostics
+
+    @property
+    def scopes(self) -> Scope:
+        return self._scopes
+
     def _emit_class_ref(
         self,
         class_name: str,
@@ -325,43 +349,87 @@ class BaseFrontend(Frontend):
         """Override to return a raw-type → canonical-type mapping."""
         return {}
 
+    # ── lowered syntax ───────────────────────────────────────────
+
+    def statement_node_types(self) -> frozenset[str]:
+        """Node types this frontend lowers as statements, blocks included."""
+        grammar_constants = self._build_constants()
+        if grammar_constants is None:
+            return frozenset(self._STMT_DISPATCH) | self.BLOCK_NODE_TYPES
+        return (
+            frozenset(self._build_stmt_dispatch())
+            | grammar_constants.block_node_types
+        )
+
+    def expression_node_types(self) -> frozenset[str]:
+        """Node types this frontend lowers as expressions."""
+        if self._build_constants() is None:
+            return frozenset(self._EXPR_DISPATCH)
+        return frozenset(self._build_expr_dispatch())
+
+    def skipped_node_types(self) -> frozenset[str]:
+        """Node types this frontend accepts as statements and emits nothing for."""
+        grammar_constants = self._build_constants()
+        if grammar_constants is None:
+            return self.COMMENT_TYPES | self.NOISE_TYPES
+        return grammar_constants.comment_types | grammar_constants.noise_types
+
     # ── entry point ──────────────────────────────────────────────
 
     _NULL_RESOLVER = NamespaceResolver()
 
     def lower(
         self,
-        source: bytes,
+        source: bytes | StreamSource,
         namespace_resolver: NamespaceResolver = _NULL_RESOLVER,
     ) -> list[InstructionBase]:
+        """Lower *source* to IR.
+
+        A ``StreamSource`` is parsed and lowered through its window, without
+        the whole file in memory; only a ``STREAMABLE`` frontend takes one.
+        """
         t0 = time.perf_counter()
-        parser = self._parser_factory.get_parser(self._language)
-        tree = parser.parse(source)
+        if isinstance(source, StreamSource):
+            if not self.STREAMABLE:
+                raise ValueError(f"{self._language} cannot be lowered from a stream")
+            self._offsets = NO_REWRITES
+            tree = Parser(self._parser_factory).parse_stream(source, self._language)
+        else:
+            source, self._offsets = self._prepare_source(source)
+            parser = self._parser_factory.get_parser(self._language)
+            tree = parser.parse(source)
         self._observer.on_parse(time.perf_counter() - t0)
 
         t1 = time.perf_counter()
         root = tree.root_node
+        check_nesting(root, self.max_nesting_depth, self._offsets)
+        with recursion_headroom(self.max_nesting_depth):
+            result = self._lower_tree(source, root, namespace_resolver)
+        self._observer.on_lower(time.perf_counter() - t1)
+        return result
+
+    def _lower_tree(
+        self, source: SourceBytes, root: Any, namespace_resolver: NamespaceResolver
+    ) -> list[InstructionBase]:  # Any: tree-sitter node
+        self._diagnostics = collect_syntax_diagnostics(root, source, self._offsets)
+        # Set first: _extract_symbols reads text from it.
+        self._source = source
 
         grammar_constants = self._build_constants()
         if grammar_constants is not None:
-            result = self._lower_with_context(source, root, namespace_resolver)
-        else:
-            self._reg_counter = 0
-            self._label_counter = 0
-            self._instructions = []
-            self._source = source
-            self._loop_stack = []
-            self._break_target_stack = []
-            self._emit_inst(Label_(label=CodeLabel(constants.CFG_ENTRY_LABEL)))
-            self._lower_block(root)
-            result = self._instructions
-
-        self._observer.on_lower(time.perf_counter() - t1)
-        return result
+            return self._lower_with_context(source, root, namespace_resolver)
+        self._reg_counter = 0
+        self._label_counter = 0
+        self._instructions = []
+        self._loop_stack = []
+        self._break_target_stack = []
+        self._emit_inst(Label_(label=CodeLabel(constants.CFG_ENTRY_LABEL)))
+        self._lower_block(root)
+        return self._instructions
 
     def _lower_with_context(
         self,
-        source: bytes,
+        source: SourceBytes,
         root: Any,
         namespace_resolver: NamespaceResolver = _NULL_RESOLVER,
     ) -> list[InstructionBase]:  # Any: tree-sitter node — untyped at Python boundary
@@ -379,6 +447,8 @@ class BaseFrontend(Frontend):
             block_scoped=self.BLOCK_SCOPED,
             symbol_table=symbol_table,
             namespace_resolver=namespace_resolver,
+            scopes=ScopeBuilder(self._source_loc(root)),
+            offsets=self._offsets,
         )
         ctx.emit_inst(Label_(label=CodeLabel(constants.CFG_ENTRY_LABEL)))
         self._emit_prelude(ctx)
@@ -389,8 +459,16 @@ class BaseFrontend(Frontend):
         self._func_symbol_table = ctx.func_symbol_table
         self._class_symbol_table = ctx.class_symbol_table
         self._symbol_table = ctx.symbol_table
+        self._scopes = ctx.scopes.build()
         return ctx.instructions
 
+    def _prepare_source(self, source: bytes) -> tuple[bytes, OffsetMap]:
+        """Override to rewrite *source* before it is parsed.
+
+        Returns the source to parse and where its positions lie in *source*.
+        """
+        return source, NO_REWRITES
+
     def _extract_symbols(
         self, root: Any
     ) -> SymbolTable:  # Any: tree-sitter node — untyped at Python boundary
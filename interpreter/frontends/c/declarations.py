@@ -13,6 +13,7 @@ from interpreter.frontends.common.declarations import emit_implicit_return
 from interpreter.frontends.common.expressions import (
     lower_int_literal,
     lower_null_literal,
+    lower_unassigned,
 )
 from interpreter.frontends.context import TreeSitterEmitContext
 from interpreter.frontends.symbol_table import (
@@ -134,7 +135,7 @@ def lower_declaration(
                     node=node,
                 )
             else:
-                val_reg = lower_null_literal(ctx, node)
+                val_reg = lower_unassigned(ctx, node)
             ctx.emit_inst(DeclVar(name=VarName(var_name), value_reg=val_reg), node=node)
             ctx.seed_var_type(var_name, effective_type)
 
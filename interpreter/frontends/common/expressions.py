@@ -29,6 +29,7 @@ the expression's value.
 #       frontend story; this helper just wraps Const.string.
 #
 #   lower_null_literal(ctx, node)  → Const.null_
+#   lower_unassigned(ctx, node)  → Const.unassigned (no initializer)
 #   lower_bool_literal(ctx, node, value: bool)  → Const.bool_
 #   lower_canonical_none/true/false(ctx, node)  → typed wrappers
 #
@@ -160,6 +161,16 @@ def lower_null_literal(
     return reg
 
 
+def lower_unassigned(
+    ctx: TreeSitterEmitContext,
+    node: Any,
+) -> Register:  # Any: tree-sitter node — untyped at Python boundary
+    """Emit the value of a variable declared without an initializer."""
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.unassigned(reg), node=node)
+    return reg
+
+
 def lower_bool_literal(
     ctx: TreeSitterEmitContext,
     node: Any,
@@ -0,0 +1,47 @@
+# pyright: standard
+"""Compile errors — source the language's compiler would reject.
+
+A frontend that checks what its compiler checks (Go's constant division by
+zero, unused labels, mismatched types, ...) raises a subclass of
+``CompileError`` part-way through lowering.  Each subclass names its stable
+``DiagnosticCode``, so ``api.diagnostics`` reports it like any other
+finding::
+
+    main.go:4:7-4:12: error[RD1010]: invalid operation: division by zero
+
+A raise site that knows the offending node places the error with ``at``;
+one that does not leaves it to the lowering context, which places it at the
+innermost node being lowered when it escapes.
+"""
+
+from __future__ import annotations
+
+from typing import ClassVar, Self
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, Severity
+from interpreter.ir import NO_SOURCE_LOCATION, SourceLocation
+
+
+class CompileError(Exception):
+    """A compile-time error in the source being lowered, at *location*."""
+
+    code: ClassVar[DiagnosticCode]
+    location: SourceLocation = NO_SOURCE_LOCATION
+
+    def at(self, location: SourceLocation) -> Self:
+        """This error, placed at *location* unless it is placed already."""
+        if self.location.is_unknown():
+            self.location = location
+        return self
+
+    @property
+    def message(self) -> str:
+        return str(self.args[0]) if self.args else ""
+
+    def as_diagnostic(self) -> Diagnostic:
+        return Diagnostic(
+            code=self.code,
+            severity=Severity.ERROR,
+            location=self.location,
+            message=self.message,
+        )
@@ -16,6 +16,9 @@ from interpreter import constants
 from interpreter.class_name import ClassName
 from interpreter.constants import CanonicalLiteral, Language
 from interpreter.frontend_observer import FrontendObserver
+from interpreter.frontends.compile_error import CompileError
+from interpreter.frontends.offset_map import NO_REWRITES, OffsetMap
+from interpreter.frontends.scopes import ScopeBuilder, ScopeKind, SymbolKind
 from interpreter.frontends.symbol_table import SymbolTable
 from interpreter.func_name import FuncName
 from interpreter.instructions import (
@@ -23,23 +26,28 @@ from interpreter.instructions import (
     DeclVar,
     Instruction,
     InstructionBase,
+    StoreVar,
     Symbolic,
 )
 from interpreter.ir import (
+    NO_LABEL,
+    NO_SOURCE_LOCATION,
     CodeLabel,
     Opcode,
     SourceLocation,
 )
 from interpreter.namespace_resolver import NamespaceResolver
+from interpreter.parser import node_location
 from interpreter.path_name import PathName
 from interpreter.refs.class_ref import ClassRef
 from interpreter.refs.func_ref import FuncRef
 from interpreter.register import Register
+from interpreter.stream_source import SourceBytes
 from interpreter.type_name import TypeName
 from interpreter.types.type_environment_builder import TypeEnvironmentBuilder
 from interpreter.types.type_expr import UNKNOWN, TypeExpr
 from interpreter.types.var_scope_info import VarScopeInfo
-from interpreter.var_name import VarName
+from interpreter.var_name import NO_VAR_NAME, VarName
 
 logger = logging.getLogger(__name__)
 
@@ -47,6 +55,15 @@ NO_NODE = (
     object()
 )  # sentinel: no source-tree node available (diagnostics/source-location only)
 
+# Prefix of the label after a function body ("end_<name>_<n>")
+_END_LABEL_PREFIX = "end_"
+
+# What a handler raises on a subtree without the shape it expects: a
+# missing child is None (AttributeError on None, TypeError), a child list
+# is short (IndexError), a child has a kind no table lists (KeyError), or
+# a token's text does not parse (ValueError).
+_MALFORMED_SHAPE_ERRORS = (AttributeError, IndexError, KeyError, TypeError, ValueError)
+
 
 @dataclass
 class GrammarConstants:
@@ -116,6 +133,49 @@ class GrammarConstants:
     attribute_node_type: str = "attribute"
 
 
+@dataclass(frozen=True)
+class FunctionExit:
+    """How ``return`` leaves the function body currently being lowered.
+
+    *result_vars* are the variables holding the function's results (Go named
+    results).  When *exit_label* is present, returns store into *result_vars*
+    and branch to it instead of emitting RETURN — Go functions containing
+    ``defer`` run their deferred calls there, from *defer_stack_var*.
+    """
+
+    result_vars: tuple[VarName, ...] = ()
+    exit_label: CodeLabel = NO_LABEL
+    defer_stack_var: VarName = NO_VAR_NAME
+
+
+@dataclass
+class GoLabel:
+    """A label declared in the Go function body currently being lowered.
+
+    ``goto`` branches to *code_label*, which is unique across the program.
+    While the labeled loop, switch or select is lowered, *break_depth* and
+    *continue_depth* index the ``break_target_stack`` / ``loop_stack``
+    entries it pushed, so ``break L`` and ``continue L`` reach them;
+    otherwise they are -1.
+    """
+
+    code_label: CodeLabel
+    break_depth: int = -1
+    continue_depth: int = -1
+
+
+@dataclass(frozen=True)
+class GoGenericFunc:
+    """A Go function declared with type parameters (``func Max[T cmp.Ordered]``).
+
+    *node* is its function_declaration and *type_params* pairs each type
+    parameter's name with its constraint node, in declaration order.
+    """
+
+    node: Any  # Any: tree-sitter node — untyped at Python boundary
+    type_params: tuple[tuple[str, Any], ...]
+
+
 @dataclass
 class TreeSitterEmitContext:
     """Shared mutable state for tree-sitter IR lowering.
@@ -123,7 +183,7 @@ class TreeSitterEmitContext:
     All pure-function lowerers receive this as their first argument.
     """
 
-    source: bytes
+    source: SourceBytes
     language: Language
     observer: FrontendObserver
     constants: GrammarConstants
@@ -139,6 +199,10 @@ class TreeSitterEmitContext:
     # Per-language type map: raw type string -> canonical FoundationTypeName
     type_map: dict[str, str] = field(default_factory=dict)
 
+    # Where positions in the parsed source lie in the file, for a frontend
+    # that rewrites its source before parsing (BaseFrontend._prepare_source)
+    offsets: OffsetMap = NO_REWRITES
+
     # Dispatch tables: node_type -> Callable[[TreeSitterEmitContext, node], ...]
     # Any: tree-sitter node objects are untyped at the Python boundary
     stmt_dispatch: dict[str, Callable[[TreeSitterEmitContext, Any], None]] = field(
@@ -164,6 +228,11 @@ class TreeSitterEmitContext:
     _var_scope_metadata: dict[str, VarScopeInfo] = field(default_factory=dict)
     _base_declared_vars: set[str] = field(default_factory=set)
 
+    # Scopes and the names declared in them, as resolved (see frontends.scopes)
+    scopes: ScopeBuilder = field(default_factory=ScopeBuilder)
+    # Nodes whose handlers are running, innermost last
+    _node_stack: list[Any] = field(default_factory=list)
+
     # Function reference symbol table: func_label -> FuncRef
     func_symbol_table: dict[CodeLabel, FuncRef] = field(default_factory=dict)
 
@@ -178,6 +247,8 @@ class TreeSitterEmitContext:
 
     # Tracks names declared in current method scope (params + locals), for implicit-this suppression
     _method_declared_names: set[str] = field(default_factory=set)
+    # Registers holding a parameter's incoming argument (SYMBOLIC param:<name>)
+    _param_regs: set[str] = field(default_factory=set)
 
     # Namespace resolver — injectable strategy for qualified name resolution
     namespace_resolver: NamespaceResolver = field(default_factory=NamespaceResolver)
@@ -195,6 +266,40 @@ class TreeSitterEmitContext:
     # Default parameter resolution helper — lazily emitted
     _resolve_default_emitted: bool = False
 
+    # Enclosing function bodies, innermost last (Go results and defer exits)
+    func_exit_stack: list[FunctionExit] = field(default_factory=list)
+
+    # Go constants by resolved variable name → folded value (None if not folded)
+    const_values: dict[str, Any] = field(default_factory=dict)
+
+    # Go package clause of the file being lowered
+    go_package: str = "main"
+
+    # Go function whose body runs as the program, after package initialization
+    go_entry: str = "main"
+
+    # Go imported packages by local name → import path ("str" → "strings")
+    go_imports: dict[str, str] = field(default_factory=dict)
+
+    # Go top-level functions by name → declared result types, one per result
+    go_func_results: dict[str, tuple[TypeExpr, ...]] = field(default_factory=dict)
+
+    # Go variables statically of a []byte or []rune type, by resolved name
+    # → element kind ("byte" or "rune")
+    go_slice_elements: dict[str, str] = field(default_factory=dict)
+
+    # Go generic functions by name
+    go_generics: dict[str, GoGenericFunc] = field(default_factory=dict)
+
+    # Instantiations of Go generic functions by instance name ("Max[int]")
+    # → (generic name, canonical type arguments), lowered after the file
+    go_instances: dict[str, tuple[str, tuple[str, ...]]] = field(
+        default_factory=dict
+    )
+
+    # Labels of the Go function body being lowered, by name
+    go_labels: dict[str, GoLabel] = field(default_factory=dict)
+
     # ── utility methods ──────────────────────────────────────────
 
     def fresh_reg(self) -> Register:
@@ -222,10 +327,23 @@ class TreeSitterEmitContext:
             inst = dataclasses.replace(inst, source_location=loc)
 
         self._track_label(inst.opcode, inst.label)
+        self._track_scope(inst.opcode, inst.label)
         self.instructions.append(inst)
 
-        if isinstance(inst, DeclVar):
+        if isinstance(inst, Symbolic) and inst.hint.startswith(constants.PARAM_PREFIX):
+            self._param_regs.add(str(inst.result_reg))
+        elif isinstance(inst, DeclVar):
             self._method_declared_names.add(inst.name)
+            kind = (
+                SymbolKind.PARAMETER
+                if str(inst.value_reg) in self._param_regs
+                else SymbolKind.VARIABLE
+            )
+            self._declare_var(inst.name, inst.source_location, kind)
+        elif isinstance(inst, StoreVar) and not self.block_scoped:
+            self.scopes.declare_assigned(
+                str(inst.name), self._inst_loc(inst.source_location)
+            )
         return inst
 
     def emit_decl_var(
@@ -249,6 +367,7 @@ class TreeSitterEmitContext:
         self.func_symbol_table[func_label] = FuncRef(
             name=FuncName(func_name), label=func_label
         )
+        self.scopes.declare(func_name, SymbolKind.FUNCTION, self._node_loc(node))
         return self.emit_inst(
             Const.func_ref(result_reg=result_reg, value=str(func_label)),
             node=node,
@@ -272,6 +391,7 @@ class TreeSitterEmitContext:
             label=class_label,
             parents=tuple(ClassName(p) for p in parents),
         )
+        self.scopes.declare(class_name, SymbolKind.CLASS, self._node_loc(node))
         return self.emit_inst(
             Const.class_ref(
                 result_reg=result_reg, value=str(class_label), class_type=UNKNOWN
@@ -298,6 +418,44 @@ class TreeSitterEmitContext:
         else:
             self._current_func_label = ""
 
+    def _track_scope(self, opcode: Opcode, label: CodeLabel) -> None:
+        """Open and close function and class scopes at their labels."""
+        if opcode != Opcode.LABEL or not label.is_present():
+            return
+        if label.starts_with(constants.FUNC_LABEL_PREFIX):
+            name = label.extract_name(constants.FUNC_LABEL_PREFIX)
+            self.scopes.enter(ScopeKind.FUNCTION, self._node_loc(NO_NODE), name)
+        elif label.starts_with(constants.CLASS_LABEL_PREFIX):
+            self.scopes.enter(ScopeKind.CLASS, self._node_loc(NO_NODE))
+        elif label.starts_with(constants.END_CLASS_LABEL_PREFIX):
+            self.scopes.exit(ScopeKind.CLASS)
+        elif label.starts_with(_END_LABEL_PREFIX):
+            name = label.extract_name(_END_LABEL_PREFIX)
+            self.scopes.exit(ScopeKind.FUNCTION, name)
+
+    def _node_loc(self, node: Any) -> SourceLocation:  # Any: tree-sitter node
+        """Where *node* is, or the node being lowered if there is none."""
+        if node is not NO_NODE:
+            return self.source_loc(node)
+        if self._node_stack:
+            return self.source_loc(self._node_stack[-1])
+        return NO_SOURCE_LOCATION
+
+    def _inst_loc(self, location: SourceLocation) -> SourceLocation:
+        """*location*, or the node being lowered's if it is unknown."""
+        return self._node_loc(NO_NODE) if location.is_unknown() else location
+
+    def _declare_var(
+        self,
+        name: VarName,
+        location: SourceLocation,
+        kind: SymbolKind = SymbolKind.VARIABLE,
+    ) -> None:
+        resolved = str(name)
+        info = self._var_scope_metadata.get(resolved)
+        original = info.original_name if info else resolved
+        self.scopes.declare(original, kind, self._inst_loc(location), resolved)
+
     def seed_func_return_type(
         self, func_label: str | CodeLabel, return_type: TypeExpr
     ) -> None:
@@ -315,6 +473,7 @@ class TreeSitterEmitContext:
         """Seed the type for a variable."""
         if var_name and type_name:
             self.type_env_builder.var_types[var_name] = type_name
+            self.scopes.set_type(var_name, type_name)
 
     def seed_interface_impl(self, class_name: str, interface_name: str) -> None:
         """Seed that a class implements an interface."""
@@ -338,18 +497,14 @@ class TreeSitterEmitContext:
     def node_text(
         self, node: Any
     ) -> str:  # Any: tree-sitter node — untyped at Python boundary
-        return self.source[node.start_byte : node.end_byte].decode("utf-8")
+        return self.source[node.start_byte : node.end_byte].decode(
+            "utf-8", errors="replace"
+        )
 
     def source_loc(
         self, node: Any
     ) -> SourceLocation:  # Any: tree-sitter node — untyped at Python boundary
-        s, e = node.start_point, node.end_point
-        return SourceLocation(
-            start_line=s[0] + 1,
-            start_col=s[1],
-            end_line=e[0] + 1,
-            end_col=e[1],
-        )
+        return self.offsets.location(node_location(node))
 
     # ── recursive descent entry points ───────────────────────────
 
@@ -367,11 +522,11 @@ class TreeSitterEmitContext:
         ntype = node.type
         handler = self.stmt_dispatch.get(ntype)
         if handler is not None and ntype not in self.constants.block_node_types:
-            handler(self, node)
+            self._run_handler(handler, node)
             return
         scope_entered = self.block_scoped and ntype in self.constants.block_node_types
         if scope_entered:
-            self.enter_block_scope()
+            self.enter_block_scope(node)
         for child in node.children:
             if not child.is_named:
                 continue
@@ -387,7 +542,7 @@ class TreeSitterEmitContext:
             return
         handler = self.stmt_dispatch.get(ntype)
         if handler:
-            handler(self, node)
+            self._run_handler(handler, node)
             return
         if ntype in self.constants.block_node_types:
             self.lower_block(node)
@@ -401,8 +556,12 @@ class TreeSitterEmitContext:
         """Lower an expression, return the register holding its value."""
         handler = self.expr_dispatch.get(node.type)
         if handler:
-            return handler(self, node)
-        # Fallback: symbolic
+            return self._run_handler(handler, node)
+        return self._lower_unsupported(node)
+
+    def _lower_unsupported(
+        self, node: Any
+    ) -> Register:  # Any: tree-sitter node — untyped at Python boundary
         reg = self.fresh_reg()
         self.emit_inst(
             Symbolic(result_reg=reg, hint=f"unsupported:{node.type}"),
@@ -410,6 +569,55 @@ class TreeSitterEmitContext:
         )
         return reg
 
+    def _run_handler(
+        self, handler: Callable[[TreeSitterEmitContext, Any], Any], node: Any
+    ) -> Any:  # Any: tree-sitter node; stmt handlers return None, expr ones a Register
+        """Run *handler* on *node*, recovering if *node* is malformed.
+
+        Handlers assume the grammar's shape (a field is present, a child has
+        some type), which a subtree holding a syntax error need not have.
+        If the handler fails on such a subtree with one of the errors a
+        missing or unexpected child causes, whatever it emitted and pushed
+        is undone and the subtree lowers to SYMBOLIC, like any unsupported
+        node.  Any other error, and every error in a well-formed subtree,
+        propagates: it is a bug in the handler.
+        A ``CompileError`` raised without a location is placed at *node*,
+        the innermost node being lowered.
+        """
+        self._node_stack.append(node)
+        try:
+            return self._run_guarded(handler, node)
+        except CompileError as err:
+            err.at(self.source_loc(node))
+            raise
+        finally:
+            self._node_stack.pop()
+
+    def _run_guarded(
+        self, handler: Callable[[TreeSitterEmitContext, Any], Any], node: Any
+    ) -> Any:  # Any: tree-sitter node; stmt handlers return None, expr ones a Register
+        if not node.has_error:
+            return handler(self, node)
+        stacks = (
+            self.instructions,
+            self.loop_stack,
+            self.break_target_stack,
+            self.switch_result_stack,
+            self._block_scope_stack,
+            self.func_exit_stack,
+            self.scopes.open,
+        )
+        lengths = [len(stack) for stack in stacks]
+        try:
+            return handler(self, node)
+        except _MALFORMED_SHAPE_ERRORS as err:
+            # An attribute missing from a real object is a bug, not a hole.
+            if isinstance(err, AttributeError) and err.obj is not None:
+                raise
+            for stack, length in zip(stacks, lengths):
+                del stack[length:]
+            return self._lower_unsupported(node)
+
     # ── loop stack management ────────────────────────────────────
 
     def push_loop(self, continue_label: str, end_label: str) -> None:
@@ -429,13 +637,19 @@ class TreeSitterEmitContext:
         """Metadata for mangled variable names: mangled_name → VarScopeInfo."""
         return self._var_scope_metadata
 
-    def enter_block_scope(self) -> None:
-        """Push a new block scope onto the scope stack."""
+    def enter_block_scope(self, node: Any = NO_NODE) -> None:  # Any: ts node
+        """Push a new block scope onto the scope stack.
+
+        The scope covers *node*, or the node being lowered if not given.
+        """
         self._block_scope_stack.append({})
+        self.scopes.enter(ScopeKind.BLOCK, self._node_loc(node))
 
     def exit_block_scope(self) -> None:
-        """Pop the innermost block scope."""
-        self._block_scope_stack.pop()
+        """Pop the innermost block scope, forgetting constants declared in it."""
+        for name in self._block_scope_stack.pop().values():
+            self.const_values.pop(name, None)
+        self.scopes.exit(ScopeKind.BLOCK)
 
     def declare_block_var(self, name: str) -> str:
         """Declare a variable in the current block scope.
@@ -474,6 +688,7 @@ class TreeSitterEmitContext:
             )
             if self._block_scope_stack:
                 self._block_scope_stack[-1][name] = mangled
+            self._declare_var(VarName(mangled), self._node_loc(NO_NODE))
             return mangled
 
         # No shadowing — record in current scope (if any) or base
@@ -481,8 +696,13 @@ class TreeSitterEmitContext:
             self._block_scope_stack[-1][name] = name
         else:
             self._base_declared_vars.add(name)
+        self._declare_var(VarName(name), self._node_loc(NO_NODE))
         return name
 
+    def bind_block_var(self, name: str, resolved: str) -> None:
+        """Make *name* resolve to *resolved* in the current block scope."""
+        self._block_scope_stack[-1][name] = resolved
+
     def resolve_var(self, name: str) -> str:
         """Resolve a variable name through the block scope stack.
 
@@ -494,6 +714,18 @@ class TreeSitterEmitContext:
                 return scope[name]
         return name
 
+    def is_block_var(self, name: str) -> bool:
+        """True when *name* is declared in an enclosing or base-level scope."""
+        return name in self._base_declared_vars or any(
+            name in scope for scope in self._block_scope_stack
+        )
+
+    def is_declared_in_block(self, name: str) -> bool:
+        """True when *name* is declared in the innermost scope itself."""
+        if self._block_scope_stack:
+            return name in self._block_scope_stack[-1]
+        return name in self._base_declared_vars
+
     def reset_block_scopes(self) -> None:
         """Clear all block scopes (used at function boundaries)."""
         self._block_scope_stack.clear()
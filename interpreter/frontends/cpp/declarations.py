@@ -21,7 +21,7 @@ from interpreter.frontends.common.declarations import (
 )
 from interpreter.frontends.common.expressions import (
     lower_default_return,
-    lower_null_literal,
+    lower_unassigned,
 )
 from interpreter.frontends.context import TreeSitterEmitContext
 from interpreter.frontends.cpp.node_types import CppNodeType
@@ -69,7 +69,7 @@ def lower_cpp_declaration(
                     node=node,
                 )
             else:
-                val_reg = lower_null_literal(ctx, node)
+                val_reg = lower_unassigned(ctx, node)
             ctx.emit_inst(DeclVar(name=VarName(var_name), value_reg=val_reg), node=node)
             ctx.seed_var_type(var_name, type_hint)
 
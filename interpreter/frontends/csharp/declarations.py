@@ -16,6 +16,7 @@ from interpreter.frontends.common.declarations import (
 from interpreter.frontends.common.expressions import (
     lower_null_literal,
     lower_string_literal,
+    lower_unassigned,
 )
 from interpreter.frontends.context import TreeSitterEmitContext
 from interpreter.frontends.csharp.expressions import lower_csharp_params
@@ -95,7 +96,7 @@ def _lower_csharp_declarator(
     if value_node:
         val_reg = ctx.lower_expr(value_node)
     else:
-        val_reg = lower_null_literal(ctx, node)
+        val_reg = lower_unassigned(ctx, node)
     ctx.emit_inst(DeclVar(name=VarName(var_name), value_reg=val_reg), node=node)
     ctx.seed_var_type(var_name, type_hint)
     if is_ref:
@@ -0,0 +1,63 @@
+# pyright: standard
+"""Doc comments — the comment block that documents a declaration.
+
+A declaration's doc comment is the run of comments directly above it, as
+``go/ast.CommentMap`` and godoc read it: no blank line between one comment
+and the next, nor between the last and the declaration.  A comment that
+trails code on the line above, or that a blank line separates from the
+declaration, documents nothing.  ``doc_comment`` returns the text of the
+block with the comment markers taken off — ``//``, ``#`` or ``--`` before
+a line comment, ``/*`` and ``*/`` around a block comment and the ``*``
+starting each of its lines.
+"""
+
+from __future__ import annotations
+
+import re
+
+# The comment node types of the tree-sitter grammars.
+COMMENT_TYPES = frozenset({"comment", "line_comment", "block_comment"})
+
+_LINE_MARKER = re.compile(r"^(?://+!?|#+|--+)\s?")
+_BLOCK_LINE_MARKER = re.compile(r"^\s*\*? ?")
+
+
+def leading_comments(node, comment_types: frozenset[str] = COMMENT_TYPES) -> tuple:
+    """The comment nodes that document *node*, first to last."""
+    comments: list = []
+    below = node
+    sibling = node.prev_sibling
+    while (
+        sibling is not None
+        and sibling.type in comment_types
+        and sibling.end_point[0] + 1 >= below.start_point[0]
+    ):
+        comments.append(sibling)
+        below = sibling
+        sibling = sibling.prev_sibling
+    if comments and sibling is not None:
+        if sibling.end_point[0] == comments[-1].start_point[0]:
+            comments.pop()  # trails the code before it
+    return tuple(reversed(comments))
+
+
+def _comment_lines(text: str) -> list[str]:
+    if not text.startswith("/*"):
+        return [_LINE_MARKER.sub("", text).rstrip()]
+    body = text[2:].removesuffix("*/")
+    return [_BLOCK_LINE_MARKER.sub("", line).rstrip() for line in body.split("\n")]
+
+
+def comment_text(comments: tuple) -> str:
+    """The text of *comments* without their markers, one line per line."""
+    lines = [
+        line
+        for comment in comments
+        for line in _comment_lines(comment.text.decode("utf-8", errors="replace"))
+    ]
+    return "\n".join(lines).strip("\n")
+
+
+def doc_comment(node, comment_types: frozenset[str] = COMMENT_TYPES) -> str:
+    """The doc comment of the declaration *node*; ``""`` if it has none."""
+    return comment_text(leading_comments(node, comment_types))
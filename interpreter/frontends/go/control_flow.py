@@ -3,14 +3,40 @@
 from __future__ import annotations
 
 import logging
+from collections.abc import Callable
 from typing import Any
 
+from interpreter import constants
+from interpreter.constants import GOROUTINE_ENTRY_VAR
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.frontends.common.declarations import emit_implicit_return
 from interpreter.frontends.common.expressions import lower_default_return
-from interpreter.frontends.context import TreeSitterEmitContext
+from interpreter.frontends.common.control_flow import lower_break, lower_continue
+from interpreter.frontends.compile_error import CompileError
+from interpreter.frontends.context import (
+    FunctionExit,
+    GoLabel,
+    TreeSitterEmitContext,
+)
 from interpreter.frontends.go.expressions import (
+    GO_BUILTIN_FUNCS,
+    GoMismatchedTypesError,
+    emit_go_chan_recv,
+    emit_go_panic_unless,
+    emit_go_tuple,
+    emit_go_type_check,
     extract_expression_list,
+    get_expression_list_children,
+    go_mismatched_kinds,
+    is_go_chan_operand,
+    is_go_func_field,
+    is_go_int_operand,
     lower_expression_list,
+    lower_go_call_args,
+    lower_go_chan_recv_ok,
     lower_go_store_target,
+    lower_go_update_target,
+    unpack_go_tuple,
 )
 from interpreter.frontends.go.node_types import GoNodeType
 from interpreter.func_name import FuncName
@@ -19,20 +45,29 @@ from interpreter.instructions import (
     Branch,
     BranchIf,
     CallFunction,
+    CallMethod,
+    CallUnknown,
     Const,
     DeclVar,
+    Halt_,
     Label_,
     LoadIndex,
     LoadVar,
     Return_,
     StoreVar,
+    TryPop,
+    TryPush,
 )
-from interpreter.ir import CodeLabel
+from interpreter.ir import SpreadArguments
 from interpreter.operator_kind import resolve_binop
+from interpreter.register import NO_REGISTER, Register
 from interpreter.var_name import VarName
 
 logger = logging.getLogger(__name__)
 
+# Top-level variable holding the runtime.Error of a panic nothing recovered
+GO_PANIC_VAR = "__go_panic__"
+
 
 # -- Go: if statement ------------------------------------------------------
 
@@ -166,21 +201,84 @@ def _lower_go_for_clause(ctx: TreeSitterEmitContext, clause, body_node, parent)
 
 
 def _lower_go_range(ctx: TreeSitterEmitContext, clause, body_node, parent) -> None:
-    # for k, v := range expr { body }
+    """Lower ``for k, v := range expr { body }``.
+
+    Ranging over an integer n counts from 0 to n-1.  For anything else the
+    keys are materialised up front by ``__go_range_keys__`` (byte offsets
+    of each rune for strings; indices for slices and arrays; keys for maps)
+    and the value for each key is fetched with ``__go_range_value__``, so
+    ranging over a string binds runes rather than one-character strings.
+    ``=`` stores into existing variables; ``:=`` declares fresh ones scoped
+    to the loop body.
+    """
     left = clause.child_by_field_name(ctx.constants.assign_left_field)
     right = clause.child_by_field_name(ctx.constants.assign_right_field)
+    is_define = any(c.type == ":=" for c in clause.children)
+    targets = get_expression_list_children(left) if left else []
 
-    iter_reg = ctx.lower_expr(right) if right else ctx.fresh_reg()
-    raw_names = extract_expression_list(ctx, left) if left else ["__range_var"]
+    iter_reg = ctx.lower_expr(right)
+    if is_go_chan_operand(ctx, right):
+        _lower_go_range_chan(ctx, iter_reg, left, body_node, is_define, parent)
+        return
 
-    init_idx = ctx.fresh_reg()
-    ctx.emit_inst(Const.int_(init_idx, 0))
-    ctx.emit_inst(DeclVar(name=VarName("__for_idx"), value_reg=init_idx))
+    def bind_count(idx_reg: Register) -> None:
+        if targets:
+            _bind_range_target(ctx, targets[0], idx_reg, is_define)
+
+    if is_go_int_operand(ctx, right):
+        _lower_go_counting_loop(ctx, iter_reg, body_node, bind_count)
+        return
+
+    keys_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=keys_reg,
+            func_name=FuncName("__go_range_keys__"),
+            args=(iter_reg,),
+        )
+    )
     len_reg = ctx.fresh_reg()
     ctx.emit_inst(
-        CallFunction(result_reg=len_reg, func_name=FuncName("len"), args=(iter_reg,))
+        CallFunction(result_reg=len_reg, func_name=FuncName("len"), args=(keys_reg,))
     )
 
+    def bind_key(idx_reg: Register) -> None:
+        if not targets:
+            return
+        key_reg = ctx.fresh_reg()
+        ctx.emit_inst(
+            LoadIndex(result_reg=key_reg, arr_reg=keys_reg, index_reg=idx_reg)
+        )
+        _bind_range_target(ctx, targets[0], key_reg, is_define)
+        if len(targets) >= 2:
+            value_reg = ctx.fresh_reg()
+            ctx.emit_inst(
+                CallFunction(
+                    result_reg=value_reg,
+                    func_name=FuncName("__go_range_value__"),
+                    args=(iter_reg, key_reg),
+                )
+            )
+            _bind_range_target(ctx, targets[1], value_reg, is_define)
+
+    _lower_go_counting_loop(ctx, len_reg, body_node, bind_key)
+
+
+def _lower_go_counting_loop(
+    ctx: TreeSitterEmitContext,
+    count_reg: Register,
+    body_node,
+    bind: Callable[[Register], None],
+) -> None:
+    """Run *body_node* for each index 0..count-1.
+
+    *bind* binds the range variables from the index register at the top of
+    each iteration, inside the body's block scope.
+    """
+    init_idx = ctx.fresh_reg()
+    ctx.emit_inst(Const.int_(init_idx, 0))
+    ctx.emit_inst(DeclVar(name=VarName("__for_idx"), value_reg=init_idx))
+
     loop_label = ctx.fresh_label("range_cond")
     body_label = ctx.fresh_label("range_body")
     end_label = ctx.fresh_label("range_end")
@@ -194,24 +292,14 @@ def _lower_go_range(ctx: TreeSitterEmitContext, clause, body_node, parent) -> No
             result_reg=cond_reg,
             operator=resolve_binop("<"),
             left=idx_reg,
-            right=len_reg,
+            right=count_reg,
         )
     )
     ctx.emit_inst(BranchIf(cond_reg=cond_reg, branch_targets=(body_label, end_label)))
 
     ctx.emit_inst(Label_(label=body_label))
     ctx.enter_block_scope()
-    var_names = [ctx.declare_block_var(n) for n in raw_names]
-    # Store index variable
-    if len(var_names) >= 1:
-        ctx.emit_inst(DeclVar(name=VarName(var_names[0]), value_reg=idx_reg))
-    # Store value variable
-    if len(var_names) >= 2:
-        elem_reg = ctx.fresh_reg()
-        ctx.emit_inst(
-            LoadIndex(result_reg=elem_reg, arr_reg=iter_reg, index_reg=idx_reg)
-        )
-        ctx.emit_inst(DeclVar(name=VarName(var_names[1]), value_reg=elem_reg))
+    bind(idx_reg)
 
     update_label = ctx.fresh_label("range_update")
     ctx.push_loop(update_label, end_label)
@@ -235,6 +323,49 @@ def _lower_go_range(ctx: TreeSitterEmitContext, clause, body_node, parent) -> No
     ctx.emit_inst(Label_(label=end_label))
 
 
+def _lower_go_range_chan(
+    ctx: TreeSitterEmitContext,
+    chan_reg: Register,
+    left,
+    body_node,
+    is_define: bool,
+    parent,
+) -> None:
+    """Lower ``for v := range ch``: receive until the channel is closed and drained."""
+    loop_label = ctx.fresh_label("range_chan_recv")
+    body_label = ctx.fresh_label("range_chan_body")
+    end_label = ctx.fresh_label("range_chan_end")
+
+    ctx.emit_inst(Label_(label=loop_label))
+    value_reg, ok_reg = lower_go_chan_recv_ok(ctx, chan_reg, parent)
+    ctx.emit_inst(BranchIf(cond_reg=ok_reg, branch_targets=(body_label, end_label)))
+
+    ctx.emit_inst(Label_(label=body_label))
+    ctx.enter_block_scope()
+    targets = get_expression_list_children(left) if left else []
+    if targets:
+        _bind_range_target(ctx, targets[0], value_reg, is_define)
+    ctx.push_loop(loop_label, end_label)
+    if body_node:
+        ctx.lower_block(body_node)
+    ctx.pop_loop()
+    ctx.exit_block_scope()
+    ctx.emit_inst(Branch(label=loop_label))
+
+    ctx.emit_inst(Label_(label=end_label))
+
+
+def _bind_range_target(
+    ctx: TreeSitterEmitContext, target, value_reg, is_define: bool
+) -> None:
+    """Bind one range variable: declare it for ``:=``, store into it for ``=``."""
+    if is_define:
+        name = ctx.declare_block_var(ctx.node_text(target))
+        ctx.emit_inst(DeclVar(name=VarName(name), value_reg=value_reg))
+    else:
+        lower_go_store_target(ctx, target, value_reg, target)
+
+
 def _lower_go_bare_for(
     ctx: TreeSitterEmitContext, node: Any, body_node
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
@@ -285,47 +416,27 @@ def _lower_go_bare_for(
 def lower_go_inc(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    children = [c for c in node.children if c.is_named]
-    if not children:
-        return
-    operand = children[0]
-    operand_reg = ctx.lower_expr(operand)
-    one_reg = ctx.fresh_reg()
-    ctx.emit_inst(Const.int_(one_reg, 1))
-    result_reg = ctx.fresh_reg()
-    ctx.emit_inst(
-        Binop(
-            result_reg=result_reg,
-            operator=resolve_binop("+"),
-            left=operand_reg,
-            right=one_reg,
-        ),
-        node=node,
-    )
-    lower_go_store_target(ctx, operand, result_reg, node)
+    _lower_go_step(ctx, node, "+")
 
 
 def lower_go_dec(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    _lower_go_step(ctx, node, "-")
+
+
+def _lower_go_step(ctx: TreeSitterEmitContext, node, op: str) -> None:
+    """Lower ``x++`` / ``x--``: store ``x op 1`` back into x.
+
+    Go makes these statements rather than expressions, so they have no
+    value; the operand may be any assignable target (``m[k]++``, ``p.n--``).
+    """
     children = [c for c in node.children if c.is_named]
     if not children:
         return
-    operand = children[0]
-    operand_reg = ctx.lower_expr(operand)
     one_reg = ctx.fresh_reg()
     ctx.emit_inst(Const.int_(one_reg, 1))
-    result_reg = ctx.fresh_reg()
-    ctx.emit_inst(
-        Binop(
-            result_reg=result_reg,
-            operator=resolve_binop("-"),
-            left=operand_reg,
-            right=one_reg,
-        ),
-        node=node,
-    )
-    lower_go_store_target(ctx, operand, result_reg, node)
+    lower_go_update_target(ctx, children[0], op, one_reg, node)
 
 
 # -- Go: return statement --------------------------------------------------
@@ -334,18 +445,42 @@ def lower_go_dec(
 def lower_go_return(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """Lower return, routing it through the defer exit when the function has one.
+
+    A bare ``return`` in a function with named results returns their current
+    values.  Several results travel as one tuple (``go_expr.emit_go_tuple``),
+    which ``return f()`` passes straight through.
+    """
+    frame = ctx.func_exit_stack[-1] if ctx.func_exit_stack else FunctionExit()
     children = [c for c in node.children if c.type != GoNodeType.RETURN and c.is_named]
-    if not children:
-        val_reg = lower_default_return(ctx, node, ctx.constants.default_return_value)
-        ctx.emit_inst(Return_(value_reg=val_reg), node=node)
-        return
     # If expression_list, lower each value
     if len(children) == 1 and children[0].type == GoNodeType.EXPRESSION_LIST:
         regs = lower_expression_list(ctx, children[0])
-    else:
+    elif children:
         regs = [ctx.lower_expr(c) for c in children]
-    for reg in regs:
-        ctx.emit_inst(Return_(value_reg=reg), node=node)
+    elif frame.result_vars and not frame.exit_label.is_present():
+        regs = [_load_go_var(ctx, var) for var in frame.result_vars]
+    else:
+        regs = []
+    if frame.exit_label.is_present():
+        if len(regs) == 1 and len(frame.result_vars) > 1:
+            regs = unpack_go_tuple(ctx, regs[0], len(frame.result_vars), node)
+        for var, reg in zip(frame.result_vars, regs):
+            ctx.emit_inst(StoreVar(name=var, value_reg=reg), node=node)
+        ctx.emit_inst(Branch(label=frame.exit_label), node=node)
+        return
+    if not regs:
+        val_reg = lower_default_return(ctx, node, ctx.constants.default_return_value)
+        ctx.emit_inst(Return_(value_reg=val_reg), node=node)
+        return
+    val_reg = regs[0] if len(regs) == 1 else emit_go_tuple(ctx, regs, node)
+    ctx.emit_inst(Return_(value_reg=val_reg), node=node)
+
+
+def _load_go_var(ctx: TreeSitterEmitContext, name: VarName) -> Register:
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(LoadVar(result_reg=reg, name=name))
+    return reg
 
 
 # -- Go: defer statement ---------------------------------------------------
@@ -354,20 +489,237 @@ def lower_go_return(
 def lower_defer_stmt(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower defer statement: lower call child, then CALL_FUNCTION('defer', call_reg)."""
+    """Lower ``defer f(args)``: push the call onto the function's defer stack.
+
+    The call is evaluated now by ``_lower_go_call_entry``; the function's
+    exit block makes it when the function returns.
+    """
     call_node = next(
         (c for c in node.children if c.is_named and c.type != GoNodeType.DEFER),
         None,
     )
     if not call_node:
         return
-    call_reg = ctx.lower_expr(call_node)
+    frame = ctx.func_exit_stack[-1] if ctx.func_exit_stack else FunctionExit()
+    if (
+        call_node.type != GoNodeType.CALL_EXPRESSION
+        or not frame.exit_label.is_present()
+    ):
+        ctx.lower_expr(call_node)
+        return
+    entry_reg = _lower_go_call_entry(ctx, call_node, node)
+    stack_reg = _load_go_var(ctx, frame.defer_stack_var)
+    pushed_reg = ctx.fresh_reg()
     ctx.emit_inst(
         CallFunction(
-            result_reg=ctx.fresh_reg(), func_name=FuncName("defer"), args=(call_reg,)
+            result_reg=pushed_reg,
+            func_name=FuncName("__go_append__"),
+            args=(stack_reg, entry_reg),
         ),
         node=node,
     )
+    ctx.emit_inst(StoreVar(name=frame.defer_stack_var, value_reg=pushed_reg))
+
+
+def _lower_go_call_entry(ctx: TreeSitterEmitContext, call_node, node) -> Register:
+    """Evaluate a deferred or ``go`` call now and pack it for a later call.
+
+    The arguments — and the receiver of ``obj.m(...)``, or the function
+    value for anything but a named function — are evaluated and packed with
+    a thunk into ``arrayOf(thunk, captured..., args...)``, which
+    ``_emit_go_call_entry`` calls as ``thunk(captured..., args...)``.
+    """
+    func_node = call_node.child_by_field_name(ctx.constants.call_function_field)
+    args_node = call_node.child_by_field_name(ctx.constants.call_arguments_field)
+
+    method_name = ""
+    if func_node.type == GoNodeType.SELECTOR_EXPRESSION and not is_go_func_field(
+        ctx, ctx.node_text(func_node.child_by_field_name("field"))
+    ):
+        method_name = ctx.node_text(func_node.child_by_field_name("field"))
+        captured = [ctx.lower_expr(func_node.child_by_field_name("operand"))]
+    elif func_node.type == GoNodeType.IDENTIFIER:
+        captured = []
+    else:
+        captured = [ctx.lower_expr(func_node)]
+    arg_regs = lower_go_call_args(ctx, args_node)
+
+    thunk_reg = _lower_go_defer_thunk(ctx, func_node, method_name, len(captured))
+    entry_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=entry_reg,
+            func_name=FuncName("arrayOf"),
+            args=(thunk_reg, *captured, *arg_regs),
+        ),
+        node=node,
+    )
+    return entry_reg
+
+
+def _emit_go_call_entry(
+    ctx: TreeSitterEmitContext, entry_reg: Register, node: Any
+) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """Call an entry built by ``_lower_go_call_entry``: ``thunk(entry[1:]...)``."""
+    thunk_index_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.int_(thunk_index_reg, 0))
+    thunk_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        LoadIndex(result_reg=thunk_reg, arr_reg=entry_reg, index_reg=thunk_index_reg)
+    )
+    rest_start_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.int_(rest_start_reg, 1))
+    rest_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=rest_reg,
+            func_name=FuncName("slice"),
+            args=(entry_reg, rest_start_reg),
+        )
+    )
+    ctx.emit_inst(
+        CallUnknown(
+            result_reg=ctx.fresh_reg(),
+            target_reg=thunk_reg,
+            args=(SpreadArguments(register=rest_reg),),
+        ),
+        node=node,
+    )
+
+
+def _lower_go_defer_thunk(
+    ctx: TreeSitterEmitContext, func_node, method_name: str, captured_count: int
+) -> Register:
+    """Emit the function a deferred or ``go`` call runs through; return its ref.
+
+    The thunk reads the receiver or function value (if captured) and the
+    call arguments from ``arguments``, so ``xs...`` spreads pass through.
+    """
+    thunk_name = f"__defer_{ctx.label_counter}"
+    thunk_label = ctx.fresh_label(f"{constants.FUNC_LABEL_PREFIX}{thunk_name}")
+    end_label = ctx.fresh_label(f"end_{thunk_name}")
+    ctx.emit_inst(Branch(label=end_label), node=func_node)
+    ctx.emit_inst(Label_(label=thunk_label))
+
+    args_reg = _load_go_var(ctx, VarName("arguments"))
+    start_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.int_(start_reg, captured_count))
+    call_args_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=call_args_reg,
+            func_name=FuncName("slice"),
+            args=(args_reg, start_reg),
+        )
+    )
+    spread = (SpreadArguments(register=call_args_reg),)
+    if not captured_count:
+        name = ctx.node_text(func_node)
+        ctx.emit_inst(
+            CallFunction(
+                result_reg=ctx.fresh_reg(),
+                func_name=FuncName(GO_BUILTIN_FUNCS.get(name, name)),
+                args=spread,
+            ),
+            node=func_node,
+        )
+    else:
+        zero_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.int_(zero_reg, 0))
+        target_reg = ctx.fresh_reg()
+        ctx.emit_inst(
+            LoadIndex(result_reg=target_reg, arr_reg=args_reg, index_reg=zero_reg)
+        )
+        call = (
+            CallMethod(
+                result_reg=ctx.fresh_reg(),
+                obj_reg=target_reg,
+                method_name=FuncName(method_name),
+                args=spread,
+            )
+            if method_name
+            else CallUnknown(
+                result_reg=ctx.fresh_reg(), target_reg=target_reg, args=spread
+            )
+        )
+        ctx.emit_inst(call, node=func_node)
+    emit_implicit_return(ctx, func_node)
+    ctx.emit_inst(Label_(label=end_label))
+
+    reg = ctx.fresh_reg()
+    ctx.emit_func_ref(thunk_name, thunk_label, result_reg=reg)
+    return reg
+
+
+def emit_go_run_defers(
+    ctx: TreeSitterEmitContext, stack_var: VarName, node: Any
+) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """Call every deferred entry on *stack_var*, last pushed first.
+
+    Each entry is ``[thunk, captured..., args...]`` and is called as
+    ``thunk(entry[1:]...)``.
+    """
+    index_var = VarName(f"{stack_var}_i")
+    stack_reg = _load_go_var(ctx, stack_var)
+    len_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(result_reg=len_reg, func_name=FuncName("len"), args=(stack_reg,))
+    )
+    one_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.int_(one_reg, 1))
+    last_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        Binop(
+            result_reg=last_reg,
+            operator=resolve_binop("-"),
+            left=len_reg,
+            right=one_reg,
+        )
+    )
+    ctx.emit_inst(DeclVar(name=index_var, value_reg=last_reg))
+
+    check_label = ctx.fresh_label("defer_check")
+    body_label = ctx.fresh_label("defer_call")
+    done_label = ctx.fresh_label("defer_done")
+    ctx.emit_inst(Label_(label=check_label))
+    idx_reg = _load_go_var(ctx, index_var)
+    zero_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.int_(zero_reg, 0))
+    cond_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        Binop(
+            result_reg=cond_reg,
+            operator=resolve_binop(">="),
+            left=idx_reg,
+            right=zero_reg,
+        )
+    )
+    ctx.emit_inst(
+        BranchIf(cond_reg=cond_reg, branch_targets=(body_label, done_label)),
+        node=node,
+    )
+
+    ctx.emit_inst(Label_(label=body_label))
+    entry_stack_reg = _load_go_var(ctx, stack_var)
+    entry_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        LoadIndex(result_reg=entry_reg, arr_reg=entry_stack_reg, index_reg=idx_reg)
+    )
+    _emit_go_call_entry(ctx, entry_reg, node)
+    step_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.int_(step_reg, 1))
+    next_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        Binop(
+            result_reg=next_reg,
+            operator=resolve_binop("-"),
+            left=idx_reg,
+            right=step_reg,
+        )
+    )
+    ctx.emit_inst(StoreVar(name=index_var, value_reg=next_reg))
+    ctx.emit_inst(Branch(label=check_label))
+    ctx.emit_inst(Label_(label=done_label))
 
 
 # -- Go: go statement ------------------------------------------------------
@@ -376,20 +728,70 @@ def lower_defer_stmt(
 def lower_go_stmt(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower go statement: lower call child, then CALL_FUNCTION('go', call_reg)."""
+    """Lower ``go f(args)``: start the call in a new goroutine.
+
+    The call is evaluated now by ``_lower_go_call_entry`` and handed to
+    ``__go_spawn__`` with the label of an out-of-line entry block.  The
+    goroutine starts there with the entry in ``GOROUTINE_ENTRY_VAR``, makes
+    the call, and finishes with ``__go_exit__``; a panic it does not
+    recover ends the program like one in ``main``.
+    """
     call_node = next(
         (c for c in node.children if c.is_named and c.type != GoNodeType.GO),
         None,
     )
     if not call_node:
         return
-    call_reg = ctx.lower_expr(call_node)
+    if call_node.type != GoNodeType.CALL_EXPRESSION:
+        ctx.lower_expr(call_node)
+        return
+    entry_reg = _lower_go_call_entry(ctx, call_node, node)
+    start_label = ctx.fresh_label("goroutine_start")
+    panic_label = ctx.fresh_label("goroutine_panic")
+    end_label = ctx.fresh_label("goroutine_end")
+    label_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.string(label_reg, str(start_label)))
     ctx.emit_inst(
         CallFunction(
-            result_reg=ctx.fresh_reg(), func_name=FuncName("go"), args=(call_reg,)
+            result_reg=ctx.fresh_reg(),
+            func_name=FuncName("__go_spawn__"),
+            args=(label_reg, entry_reg),
         ),
         node=node,
     )
+    ctx.emit_inst(Branch(label=end_label))
+
+    ctx.emit_inst(Label_(label=start_label))
+    ctx.emit_inst(TryPush(catch_labels=(panic_label,)), node=node)
+    _emit_go_call_entry(ctx, _load_go_var(ctx, VarName(GOROUTINE_ENTRY_VAR)), node)
+    ctx.emit_inst(TryPop())
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=ctx.fresh_reg(), func_name=FuncName("__go_exit__"), args=()
+        ),
+        node=node,
+    )
+    ctx.emit_inst(Label_(label=panic_label))
+    emit_go_uncaught_panic(ctx, node)
+    ctx.emit_inst(Label_(label=end_label))
+
+
+def emit_go_uncaught_panic(
+    ctx: TreeSitterEmitContext, node: Any
+) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """End the program on a panic nothing recovered.
+
+    The panic is stored as a ``runtime.Error`` in ``GO_PANIC_VAR``.
+    """
+    error_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=error_reg, func_name=FuncName("__go_runtime_error__"), args=()
+        ),
+        node=node,
+    )
+    ctx.emit_inst(DeclVar(name=VarName(GO_PANIC_VAR), value_reg=error_reg))
+    ctx.emit_inst(Halt_())
 
 
 # -- Go: expression switch statement ---------------------------------------
@@ -398,10 +800,14 @@ def lower_go_stmt(
 def lower_expression_switch(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower expression_switch_statement as if/else chain.
+    """Lower expression_switch_statement as a dispatch chain plus case bodies.
 
     Go allows ``switch x := expr; x { }``.  The init variable is scoped
-    to the switch body.
+    to the switch body.  ``case a, b:`` tests each value in order; a
+    switch without a tag tests each case expression for truth.  The
+    default clause is taken only after every other case fails, wherever
+    it appears.  Bodies are laid out in source order so that a trailing
+    ``fallthrough`` branches straight into the next clause's body.
     """
     init_node = node.child_by_field_name("initializer")
     scope_entered = init_node is not None and ctx.block_scoped
@@ -411,11 +817,7 @@ def lower_expression_switch(
         ctx.lower_stmt(init_node)
 
     value_node = node.child_by_field_name("value")
-    val_reg = (
-        ctx.lower_expr(value_node)
-        if value_node
-        else _make_const_val(ctx, ctx.constants.true_literal)
-    )
+    val_reg = ctx.lower_expr(value_node) if value_node else NO_REGISTER
 
     end_label = ctx.fresh_label("switch_end")
     cases = [
@@ -423,83 +825,81 @@ def lower_expression_switch(
         for c in node.children
         if c.type in (GoNodeType.EXPRESSION_CASE, GoNodeType.DEFAULT_CASE)
     ]
+    body_labels = [ctx.fresh_label("case_body") for _ in cases]
+    default_label = next(
+        (
+            label
+            for case, label in zip(cases, body_labels)
+            if case.type == GoNodeType.DEFAULT_CASE
+        ),
+        end_label,
+    )
 
-    ctx.push_loop(end_label, end_label)
-    for case in cases:
-        if case.type == GoNodeType.DEFAULT_CASE:
-            body_children = [c for c in case.children if c.is_named]
-            for child in body_children:
-                ctx.lower_stmt(child)
-            ctx.emit_inst(Branch(label=end_label))
-        else:
-            value_nodes = [
-                c for c in case.children if c.type == GoNodeType.EXPRESSION_LIST
-            ]
-            body_label = ctx.fresh_label("case_body")
+    for case, body_label in zip(cases, body_labels):
+        value_lists = [
+            c for c in case.children if c.type == GoNodeType.EXPRESSION_LIST
+        ]
+        case_values = (
+            get_expression_list_children(value_lists[0]) if value_lists else []
+        )
+        for case_value in case_values:
+            if value_node:
+                _check_go_case_kind(ctx, value_node, case_value)
+            case_reg = ctx.lower_expr(case_value)
+            cond_reg = case_reg
+            if value_node:
+                cond_reg = ctx.fresh_reg()
+                ctx.emit_inst(
+                    Binop(
+                        result_reg=cond_reg,
+                        operator=resolve_binop("=="),
+                        left=val_reg,
+                        right=case_reg,
+                    ),
+                    node=case,
+                )
             next_label = ctx.fresh_label("case_next")
-
-            if value_nodes:
-                case_exprs = lower_expression_list(ctx, value_nodes[0])
-                if case_exprs:
-                    cmp_reg = ctx.fresh_reg()
-                    ctx.emit_inst(
-                        Binop(
-                            result_reg=cmp_reg,
-                            operator=resolve_binop("=="),
-                            left=val_reg,
-                            right=case_exprs[0],
-                        ),
-                        node=case,
-                    )
-                    ctx.emit_inst(
-                        BranchIf(
-                            cond_reg=cmp_reg,
-                            branch_targets=(body_label, next_label),
-                        )
-                    )
-                else:
-                    ctx.emit_inst(Branch(label=body_label))
-            else:
-                ctx.emit_inst(Branch(label=body_label))
-
-            ctx.emit_inst(Label_(label=body_label))
-            body_children = [
-                c
-                for c in case.children
-                if c.is_named and c.type != GoNodeType.EXPRESSION_LIST
-            ]
-            for child in body_children:
-                ctx.lower_stmt(child)
-            ctx.emit_inst(Branch(label=end_label))
+            ctx.emit_inst(
+                BranchIf(cond_reg=cond_reg, branch_targets=(body_label, next_label))
+            )
             ctx.emit_inst(Label_(label=next_label))
+    ctx.emit_inst(Branch(label=default_label))
 
-    ctx.pop_loop()
+    ctx.break_target_stack.append(end_label)
+    for i, (case, body_label) in enumerate(zip(cases, body_labels)):
+        ctx.emit_inst(Label_(label=body_label))
+        body_children = [
+            c
+            for c in case.children
+            if c.is_named and c.type != GoNodeType.EXPRESSION_LIST
+        ]
+        ctx.enter_block_scope()
+        for child in body_children:
+            ctx.lower_stmt(child)
+        ctx.exit_block_scope()
+        statements = [c for c in body_children if c.type != GoNodeType.COMMENT]
+        falls_through = (
+            bool(statements)
+            and statements[-1].type == GoNodeType.FALLTHROUGH_STATEMENT
+            and i + 1 < len(body_labels)
+        )
+        ctx.emit_inst(Branch(label=body_labels[i + 1] if falls_through else end_label))
+    ctx.break_target_stack.pop()
     ctx.emit_inst(Label_(label=end_label))
 
     if scope_entered:
         ctx.exit_block_scope()
 
 
-def _make_const_val(ctx: TreeSitterEmitContext, value: str) -> str:
-    """Emit a typed CONST for a canonical literal string and return its register.
-
-    Used for switch fallback (true sentinel).  Handles True/False/None/int.
-    """
-    from interpreter.constants import CanonicalLiteral
-
-    reg = ctx.fresh_reg()
-    if value == CanonicalLiteral.TRUE:
-        ctx.emit_inst(Const.bool_(reg, True))
-    elif value == CanonicalLiteral.FALSE:
-        ctx.emit_inst(Const.bool_(reg, False))
-    elif value == CanonicalLiteral.NONE:
-        ctx.emit_inst(Const.null_(reg))
-    else:
-        try:
-            ctx.emit_inst(Const.int_(reg, int(value)))
-        except (ValueError, TypeError):
-            ctx.emit_inst(Const.string(reg, value))
-    return reg
+def _check_go_case_kind(ctx: TreeSitterEmitContext, value_node, case_value) -> None:
+    """Reject ``case "G":`` in a switch on a byte such as ``s[i]``."""
+    case_kind, value_kind = go_mismatched_kinds(ctx, case_value, value_node)
+    if case_kind:
+        raise GoMismatchedTypesError(
+            f"invalid case {ctx.node_text(case_value)} in switch on "
+            f"{ctx.node_text(value_node)} "
+            f"(mismatched types {case_kind} and {value_kind})"
+        )
 
 
 # -- Go: type switch statement ---------------------------------------------
@@ -508,15 +908,26 @@ def _make_const_val(ctx: TreeSitterEmitContext, value: str) -> str:
 def lower_type_switch(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower type_switch_statement with CALL_FUNCTION('type_check') per case."""
+    """Lower type_switch_statement as a chain of ``__go_type_is__`` checks.
+
+    ``case A, B:`` tests each type in order with ``go_expr.emit_go_type_check``,
+    and the default clause is taken only after every other case fails,
+    wherever it appears.  With ``switch v := x.(type)`` each case body
+    declares its own ``v`` bound to x.
+    """
     header = next(
-        (c for c in node.children if c.type == GoNodeType.TYPE_SWITCH_HEADER), None
+        (c for c in node.children if c.type == GoNodeType.TYPE_SWITCH_HEADER), node
     )
-    expr_reg = ctx.fresh_reg()
-    if header:
-        named = [c for c in header.children if c.is_named]
-        if named:
-            expr_reg = ctx.lower_expr(named[-1])
+    init_node = header.child_by_field_name("initializer")
+    alias_node = header.child_by_field_name("alias")
+    value_node = header.child_by_field_name("value")
+    scope_entered = init_node is not None and ctx.block_scoped
+    if scope_entered:
+        ctx.enter_block_scope()
+    if init_node:
+        ctx.lower_stmt(init_node)
+    val_reg = ctx.lower_expr(value_node) if value_node else ctx.fresh_reg()
+    alias_names = extract_expression_list(ctx, alias_node) if alias_node else []
 
     end_label = ctx.fresh_label("type_switch_end")
     cases = [
@@ -524,53 +935,59 @@ def lower_type_switch(
         for c in node.children
         if c.type in (GoNodeType.TYPE_CASE, GoNodeType.DEFAULT_CASE)
     ]
+    body_labels = [ctx.fresh_label("type_case_body") for _ in cases]
+    default_label = next(
+        (
+            label
+            for case, label in zip(cases, body_labels)
+            if case.type == GoNodeType.DEFAULT_CASE
+        ),
+        end_label,
+    )
 
-    ctx.push_loop(end_label, end_label)
-    for case in cases:
+    for case, body_label in zip(cases, body_labels):
         if case.type == GoNodeType.DEFAULT_CASE:
-            body_children = [c for c in case.children if c.is_named]
-            for child in body_children:
-                ctx.lower_stmt(child)
-            ctx.emit_inst(Branch(label=end_label))
-        else:
-            type_nodes = [
-                c
-                for c in case.children
-                if c.type not in (GoNodeType.CASE, GoNodeType.COLON) and c.is_named
-            ]
-            body_label = ctx.fresh_label("type_case_body")
+            continue
+        type_nodes, _ = _split_case_clause(case)
+        for type_node in type_nodes:
+            check_reg = emit_go_type_check(ctx, val_reg, type_node, case)
             next_label = ctx.fresh_label("type_case_next")
-
-            if type_nodes:
-                type_text = ctx.node_text(type_nodes[0])
-                check_reg = ctx.fresh_reg()
-                ctx.emit_inst(
-                    CallFunction(
-                        result_reg=check_reg,
-                        func_name=FuncName("type_check"),
-                        args=(expr_reg, type_text),
-                    ),
-                    node=case,
-                )
-                ctx.emit_inst(
-                    BranchIf(
-                        cond_reg=check_reg,
-                        branch_targets=(body_label, next_label),
-                    )
-                )
-            else:
-                ctx.emit_inst(Branch(label=body_label))
-
-            ctx.emit_inst(Label_(label=body_label))
-            body_children = type_nodes[1:] if len(type_nodes) > 1 else []
-            for child in body_children:
-                ctx.lower_stmt(child)
-            ctx.emit_inst(Branch(label=end_label))
+            ctx.emit_inst(
+                BranchIf(cond_reg=check_reg, branch_targets=(body_label, next_label))
+            )
             ctx.emit_inst(Label_(label=next_label))
+    ctx.emit_inst(Branch(label=default_label))
 
-    ctx.pop_loop()
+    ctx.break_target_stack.append(end_label)
+    for case, body_label in zip(cases, body_labels):
+        ctx.emit_inst(Label_(label=body_label))
+        _, body_children = _split_case_clause(case)
+        ctx.enter_block_scope()
+        for name in alias_names:
+            ctx.emit_inst(
+                DeclVar(name=VarName(ctx.declare_block_var(name)), value_reg=val_reg)
+            )
+        for child in body_children:
+            ctx.lower_stmt(child)
+        ctx.exit_block_scope()
+        ctx.emit_inst(Branch(label=end_label))
+    ctx.break_target_stack.pop()
     ctx.emit_inst(Label_(label=end_label))
 
+    if scope_entered:
+        ctx.exit_block_scope()
+
+
+def _split_case_clause(case) -> tuple[list, list]:
+    """Split a case clause into the named nodes before and after its colon."""
+    colon_idx = next(
+        (i for i, c in enumerate(case.children) if c.type == GoNodeType.COLON),
+        len(case.children),
+    )
+    head = [c for c in case.children[:colon_idx] if c.is_named]
+    body = [c for c in case.children[colon_idx + 1 :] if c.is_named]
+    return head, body
+
 
 # -- Go: select statement --------------------------------------------------
 
@@ -578,32 +995,148 @@ def lower_type_switch(
 def lower_select_stmt(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower select_statement: lower each communication_case."""
+    """Lower select_statement to a ``__go_select__`` choice plus case bodies.
+
+    Every case's channel, and a send's value, is evaluated once in source
+    order on entering the select.  ``__go_select__`` returns the index of
+    a ready case chosen with the scheduler's seeded random generator, -1
+    for ``default`` when no case is ready, or blocks until one is.  The
+    chosen case then performs its send or receive before its body runs.
+    ``break`` inside a case exits the select, not an enclosing loop.
+    """
     end_label = ctx.fresh_label("select_end")
     cases = [
         c
         for c in node.children
         if c.type in (GoNodeType.COMMUNICATION_CASE, GoNodeType.DEFAULT_CASE)
     ]
+    body_labels = [ctx.fresh_label("select_case") for _ in cases]
+    operations = [
+        (
+            _lower_select_operands(ctx, case)
+            if case.type == GoNodeType.COMMUNICATION_CASE
+            else None
+        )
+        for case in cases
+    ]
+    comm_labels = [
+        label for label, op in zip(body_labels, operations) if op is not None
+    ]
+    default_label = next(
+        (label for label, op in zip(body_labels, operations) if op is None),
+        end_label,
+    )
 
-    for case in cases:
-        case_label = ctx.fresh_label("select_case")
-        ctx.emit_inst(Label_(label=case_label))
-        body_children = [c for c in case.children if c.is_named]
+    has_default_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.bool_(has_default_reg, default_label != end_label))
+    select_args = [has_default_reg]
+    for _, chan_reg, value_reg in filter(None, operations):
+        is_send_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.bool_(is_send_reg, value_reg is not None))
+        select_args.extend((chan_reg, is_send_reg))
+    choice_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=choice_reg,
+            func_name=FuncName("__go_select__"),
+            args=tuple(select_args),
+        ),
+        node=node,
+    )
+    for index, body_label in enumerate(comm_labels):
+        index_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.int_(index_reg, index))
+        cond_reg = ctx.fresh_reg()
+        ctx.emit_inst(
+            Binop(
+                result_reg=cond_reg,
+                operator=resolve_binop("=="),
+                left=choice_reg,
+                right=index_reg,
+            )
+        )
+        next_label = ctx.fresh_label("select_next")
+        ctx.emit_inst(
+            BranchIf(cond_reg=cond_reg, branch_targets=(body_label, next_label))
+        )
+        ctx.emit_inst(Label_(label=next_label))
+    ctx.emit_inst(Branch(label=default_label))
+
+    ctx.break_target_stack.append(end_label)
+    for case, body_label, operation in zip(cases, body_labels, operations):
+        ctx.emit_inst(Label_(label=body_label))
+        ctx.enter_block_scope()
+        if operation is not None:
+            _lower_select_operation(ctx, *operation)
+        _, body_children = _split_case_clause(case)
         for child in body_children:
             ctx.lower_stmt(child)
+        ctx.exit_block_scope()
         ctx.emit_inst(Branch(label=end_label))
+    ctx.break_target_stack.pop()
 
     ctx.emit_inst(Label_(label=end_label))
 
 
+def _lower_select_operands(
+    ctx: TreeSitterEmitContext, case: Any
+) -> tuple[Any, Register, Register | None]:  # Any: tree-sitter nodes
+    """Evaluate a select case's channel and, for a send, its value.
+
+    Returns the case's send or receive statement, the channel register and
+    the value register, which is None for a receive.
+    """
+    (comm,) = _split_case_clause(case)[0]
+    if comm.type == GoNodeType.SEND_STATEMENT:
+        chan_reg = ctx.lower_expr(comm.child_by_field_name("channel"))
+        return comm, chan_reg, ctx.lower_expr(comm.child_by_field_name("value"))
+    right = comm.child_by_field_name(ctx.constants.assign_right_field)
+    chan_node = right.child_by_field_name("operand") if right else None
+    chan_reg = ctx.lower_expr(chan_node) if chan_node else ctx.fresh_reg()
+    return comm, chan_reg, None
+
+
+def _lower_select_operation(
+    ctx: TreeSitterEmitContext,
+    comm: Any,  # Any: tree-sitter node — untyped at Python boundary
+    chan_reg: Register,
+    value_reg: Register | None,
+) -> None:
+    """Perform the chosen select case's send or receive.
+
+    ``v := <-ch`` and ``v, ok := <-ch`` declare their variables in the
+    case's scope; ``v = <-ch`` assigns existing ones.
+    """
+    if value_reg is not None:
+        emit_go_chan_send(ctx, chan_reg, value_reg, comm)
+        return
+    left = comm.child_by_field_name(ctx.constants.assign_left_field)
+    targets = get_expression_list_children(left) if left else []
+    if len(targets) == 2:
+        recv_regs = list(lower_go_chan_recv_ok(ctx, chan_reg, comm))
+    else:
+        recv_regs = [emit_go_chan_recv(ctx, chan_reg, comm)]
+    declares = any(c.type == ":=" for c in comm.children)
+    for target, recv_reg in zip(targets, recv_regs):
+        if declares:
+            var_name = ctx.declare_block_var(ctx.node_text(target))
+            ctx.emit_inst(
+                DeclVar(name=VarName(var_name), value_reg=recv_reg), node=comm
+            )
+        else:
+            lower_go_store_target(ctx, target, recv_reg, comm)
+
+
 # -- Go: send statement ----------------------------------------------------
 
 
 def lower_send_stmt(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower send_statement: ch <- val -> CALL_FUNCTION('chan_send', ch, val)."""
+    """Lower ``ch <- val`` to CALL_FUNCTION __go_chan_send__, which may block.
+
+    Sending on a closed channel panics.
+    """
     channel_node = node.child_by_field_name("channel")
     value_node = node.child_by_field_name("value")
     if not channel_node or not value_node:
@@ -613,49 +1146,163 @@ def lower_send_stmt(
 
     chan_reg = ctx.lower_expr(channel_node) if channel_node else ctx.fresh_reg()
     val_reg = ctx.lower_expr(value_node) if value_node else ctx.fresh_reg()
+    emit_go_chan_send(ctx, chan_reg, val_reg, node)
+
+
+def emit_go_chan_send(
+    ctx: TreeSitterEmitContext, chan_reg: Register, val_reg: Register, node
+) -> None:
+    """Send *val_reg* on the channel in *chan_reg*, panicking if it is closed."""
+    sent_reg = ctx.fresh_reg()
     ctx.emit_inst(
         CallFunction(
-            result_reg=ctx.fresh_reg(),
-            func_name=FuncName("chan_send"),
+            result_reg=sent_reg,
+            func_name=FuncName("__go_chan_send__"),
             args=(chan_reg, val_reg),
         ),
         node=node,
     )
+    emit_go_panic_unless(ctx, sent_reg, "send on closed channel", node)
+
+
+# -- Go: labels, labeled break / continue, goto ----------------------------
+
+
+class GoLabelError(CompileError):
+    """A label Go rejects.
+
+    Labels may not be declared twice or left unused, ``goto`` / ``break`` /
+    ``continue`` must name a declared label, and ``break L`` / ``continue L``
+    must sit inside the loop (or switch / select, for break) labeled L.
+    """
+
+    code = DiagnosticCode.INVALID_LABEL
+
+
+_GO_BREAKABLE_STATEMENTS = frozenset(
+    {
+        GoNodeType.FOR_STATEMENT,
+        GoNodeType.EXPRESSION_SWITCH_STATEMENT,
+        GoNodeType.TYPE_SWITCH_STATEMENT,
+        GoNodeType.SELECT_STATEMENT,
+    }
+)
+
+_GO_LABEL_REFERENCES = frozenset(
+    {
+        GoNodeType.GOTO_STATEMENT,
+        GoNodeType.BREAK_STATEMENT,
+        GoNodeType.CONTINUE_STATEMENT,
+    }
+)
+
+
+def _go_label_name(ctx: TreeSitterEmitContext, node) -> str:
+    """Label named by a labeled, goto, break or continue statement, or ""."""
+    label_node = next(
+        (c for c in node.children if c.type == GoNodeType.LABEL_NAME), None
+    )
+    return ctx.node_text(label_node) if label_node is not None else ""
 
 
-# -- Go: labeled statement -------------------------------------------------
+def collect_go_labels(ctx: TreeSitterEmitContext, body_node) -> dict[str, GoLabel]:
+    """Labels declared in a function body, each with a fresh IR label.
+
+    Go labels are scoped to the function, so nested function literals are
+    skipped.  Raises GoLabelError for a label declared twice, a ``goto`` /
+    ``break`` / ``continue`` naming an undeclared label, and a label
+    nothing refers to.
+    """
+    declared: dict[str, Any] = {}  # Any: tree-sitter node
+    referenced: dict[str, Any] = {}  # Any: tree-sitter node
+
+    def walk(node) -> None:
+        if node.type == GoNodeType.FUNC_LITERAL:
+            return
+        name = _go_label_name(ctx, node)
+        if name and node.type == GoNodeType.LABELED_STATEMENT:
+            if name in declared:
+                error = GoLabelError(f"label {name} already defined")
+                raise error.at(ctx.source_loc(node))
+            declared[name] = node
+        elif name and node.type in _GO_LABEL_REFERENCES:
+            referenced.setdefault(name, node)
+        for child in node.children:
+            walk(child)
+
+    walk(body_node)
+    undefined = next((n for n in referenced if n not in declared), "")
+    if undefined:
+        error = GoLabelError(f"label {undefined} not defined")
+        raise error.at(ctx.source_loc(referenced[undefined]))
+    unused = next((n for n in declared if n not in referenced), "")
+    if unused:
+        error = GoLabelError(f"label {unused} defined and not used")
+        raise error.at(ctx.source_loc(declared[unused]))
+    return {name: GoLabel(ctx.fresh_label(f"label_{name}")) for name in declared}
 
 
 def lower_labeled_stmt(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower labeled_statement: LABEL(name) + lower body."""
-    label_node = next(
-        (c for c in node.children if c.type == GoNodeType.LABEL_NAME),
-        None,
-    )
-    label_name = ctx.node_text(label_node) if label_node else "__label"
-    ctx.emit_inst(Label_(label=CodeLabel(label_name)))
+    """Lower labeled_statement: LABEL(L) + lower body.
+
+    A labeled loop, switch or select records where its break (and, for a
+    loop, continue) targets sit while its body is lowered.
+    """
+    name = _go_label_name(ctx, node)
+    label = ctx.go_labels.get(name) or GoLabel(ctx.fresh_label(f"label_{name}"))
+    ctx.emit_inst(Label_(label=label.code_label))
     body_children = [
         c for c in node.children if c.is_named and c.type != GoNodeType.LABEL_NAME
     ]
     for child in body_children:
+        if child.type in _GO_BREAKABLE_STATEMENTS:
+            label.break_depth = len(ctx.break_target_stack)
+        if child.type == GoNodeType.FOR_STATEMENT:
+            label.continue_depth = len(ctx.loop_stack)
         ctx.lower_stmt(child)
+        label.break_depth = label.continue_depth = -1
 
 
-# -- Go: goto statement ----------------------------------------------------
+def lower_go_break(
+    ctx: TreeSitterEmitContext, node: Any
+) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """Lower break; ``break L`` leaves the enclosing statement labeled L."""
+    name = _go_label_name(ctx, node)
+    if not name:
+        lower_break(ctx, node)
+        return
+    label = ctx.go_labels.get(name)
+    if label is None or label.break_depth < 0:
+        raise GoLabelError(f"invalid break label {name}")
+    ctx.emit_inst(Branch(label=ctx.break_target_stack[label.break_depth]), node=node)
+
+
+def lower_go_continue(
+    ctx: TreeSitterEmitContext, node: Any
+) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """Lower continue; ``continue L`` starts the next iteration of loop L."""
+    name = _go_label_name(ctx, node)
+    if not name:
+        lower_continue(ctx, node)
+        return
+    label = ctx.go_labels.get(name)
+    if label is None or label.continue_depth < 0:
+        raise GoLabelError(f"invalid continue label {name}")
+    continue_label = ctx.loop_stack[label.continue_depth]["continue_label"]
+    ctx.emit_inst(Branch(label=continue_label), node=node)
 
 
 def lower_goto_stmt(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower goto_statement as BRANCH(label_name)."""
-    label_node = next(
-        (c for c in node.children if c.type == GoNodeType.LABEL_NAME),
-        None,
-    )
-    label_name = ctx.node_text(label_node) if label_node else "__unknown_label"
-    ctx.emit_inst(Branch(label=CodeLabel(label_name)), node=node)
+    """Lower goto_statement as BRANCH to the label's IR label."""
+    name = _go_label_name(ctx, node)
+    label = ctx.go_labels.get(name)
+    if label is None:
+        raise GoLabelError(f"label {name} not defined")
+    ctx.emit_inst(Branch(label=label.code_label), node=node)
 
 
 # -- Go: receive statement -------------------------------------------------
@@ -664,27 +1311,19 @@ def lower_goto_stmt(
 def lower_receive_stmt(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower receive_statement: v := <-ch -> CALL_FUNCTION('chan_recv', ch) + STORE_VAR."""
+    """Lower receive_statement (``v := <-ch`` in a select case).
+
+    The right-hand side is the receive expression itself, lowered by
+    ``go_expr.lower_go_unary``.
+    """
     left = node.child_by_field_name(ctx.constants.assign_left_field)
     right = node.child_by_field_name(ctx.constants.assign_right_field)
-
-    if right:
-        chan_reg = ctx.lower_expr(right)
-    else:
-        # Bare receive -- find the unary_expression child (<-ch)
-        unary = next(
+    if not right:
+        right = next(
             (c for c in node.children if c.type == GoNodeType.UNARY_EXPRESSION),
             None,
         )
-        chan_reg = ctx.lower_expr(unary) if unary else ctx.fresh_reg()
-
-    recv_reg = ctx.fresh_reg()
-    ctx.emit_inst(
-        CallFunction(
-            result_reg=recv_reg, func_name=FuncName("chan_recv"), args=(chan_reg,)
-        ),
-        node=node,
-    )
+    recv_reg = ctx.lower_expr(right) if right else ctx.fresh_reg()
 
     if left:
         left_names = extract_expression_list(ctx, left)
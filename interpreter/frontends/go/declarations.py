@@ -7,36 +7,91 @@ from typing import Any
 
 from interpreter import constants
 from interpreter.class_name import ClassName
+from interpreter.diagnostic import DiagnosticCode
 from interpreter.field_name import FieldName
 from interpreter.frontends.common.declarations import emit_implicit_return
-from interpreter.frontends.context import NO_NODE, TreeSitterEmitContext
+from interpreter.frontends.compile_error import CompileError
+from interpreter.frontends.context import FunctionExit, TreeSitterEmitContext
+from interpreter.frontends.doc_comments import COMMENT_TYPES, doc_comment
+from interpreter.frontends.go.control_flow import (
+    collect_go_labels,
+    emit_go_run_defers,
+    emit_go_uncaught_panic,
+)
+from interpreter.frontends.go.embedding import (
+    go_embedded_field_name,
+    go_method_set,
+    go_promoted_methods,
+)
 from interpreter.frontends.go.expressions import (
+    GO_COLLECTION_TYPES,
+    GoUntypedNilError,
+    check_go_assignable,
+    check_go_constant,
+    convert_go_const,
+    emit_go_const_value,
+    emit_go_tuple,
+    emit_go_zero_value,
     extract_expression_list,
+    fold_go_const,
     get_expression_list_children,
+    go_call_result_types,
+    go_default_type,
+    go_result_types,
+    go_type_hint,
+    is_go_chan_receive,
+    is_go_map_index,
+    is_go_nil,
+    is_go_struct_type,
     lower_expression_list,
+    lower_go_chan_recv_ok,
+    lower_go_map_lookup,
     lower_go_store_target,
+    lower_go_type_assertion_ok,
+    lower_go_update_target,
+    note_go_slice_elements,
+    parse_go_type,
+    unpack_go_tuple,
 )
+from interpreter.frontends.go.generics import go_instance_type_map
 from interpreter.frontends.go.node_types import GoNodeType
+from interpreter.frontends.go.namespace import go_var_specs
+from interpreter.frontends.go.packages import (
+    declare_go_var,
+    enter_go_package,
+    go_qualified_name,
+    record_go_imports,
+)
+from interpreter.frontends.scopes import ScopeKind
 from interpreter.frontends.symbol_table import (
     ClassInfo,
     FieldInfo,
     FunctionInfo,
     SymbolTable,
 )
-from interpreter.frontends.type_extraction import (
-    extract_type_from_field,
-    normalize_type_hint,
-)
+from interpreter.frontends.type_extraction import normalize_type_hint
 from interpreter.func_name import FuncName
 from interpreter.instructions import (
     Branch,
+    BranchIf,
+    CallFunction,
+    CallMethod,
     Const,
     DeclVar,
     Label_,
+    LoadField,
+    LoadVar,
+    Return_,
+    StoreVar,
     Symbolic,
+    Throw_,
+    TryPop,
+    TryPush,
 )
+from interpreter.ir import NO_LABEL, SpreadArguments
 from interpreter.register import Register
-from interpreter.var_name import VarName
+from interpreter.types.type_expr import UNKNOWN, TypeExpr, array_of
+from interpreter.var_name import NO_VAR_NAME, VarName
 
 logger = logging.getLogger(__name__)
 
@@ -50,11 +105,127 @@ def lower_short_var_decl(
     left = node.child_by_field_name(ctx.constants.assign_left_field)
     right = node.child_by_field_name(ctx.constants.assign_right_field)
     left_names = extract_expression_list(ctx, left)
-    right_regs = lower_expression_list(ctx, right)
-
-    for name, val_reg in zip(left_names, right_regs):
+    right_nodes = get_expression_list_children(right)
+    _check_go_untyped_nil(right_nodes, "assignment")
+    for right_node in right_nodes:
+        check_go_constant(ctx, right_node, "")
+    right_regs = _lower_assigned_values(ctx, right, len(left_names))
+    right_types = _go_assigned_types(ctx, right_nodes, len(left_names))
+
+    # ``v, err := g()`` after ``u, err := f()`` assigns err: a := only
+    # redeclares a name when it declares no new one.
+    declared = {name for name in left_names if ctx.is_declared_in_block(name)}
+    reused = declared if len(declared) < len(set(left_names)) else set()
+    for i, (name, val_reg) in enumerate(zip(left_names, right_regs)):
+        if name in reused:
+            var_name = ctx.resolve_var(name)
+            ctx.emit_inst(
+                StoreVar(name=VarName(var_name), value_reg=val_reg), node=node
+            )
+            continue
         var_name = ctx.declare_block_var(name)
         ctx.emit_inst(DeclVar(name=VarName(var_name), value_reg=val_reg), node=node)
+        if len(right_nodes) == len(left_names):
+            _seed_collection_type(ctx, var_name, right_nodes[i])
+            note_go_slice_elements(ctx, var_name, right_nodes[i])
+        if len(right_types) == len(left_names):
+            ctx.seed_var_type(var_name, right_types[i])
+
+
+def _check_go_untyped_nil(value_nodes: list, where: str) -> None:
+    """Reject ``x := nil`` and ``var x = nil``: nil alone gives x no type."""
+    if any(is_go_nil(value_node) for value_node in value_nodes):
+        raise GoUntypedNilError(f"use of untyped nil in {where}")
+
+
+class GoAssignmentMismatchError(CompileError):
+    """A call's result count differs from the number of assigned variables.
+
+    ``q := divmod(7, 2)`` when divmod returns two values is rejected, as
+    is ``v, err := f()`` when f returns one.
+    """
+
+    code = DiagnosticCode.ASSIGNMENT_MISMATCH
+
+
+def _check_go_result_count(
+    ctx: TreeSitterEmitContext, value_node, target_count: int
+) -> None:
+    """Reject assigning a known function's results to the wrong number of variables.
+
+    Calls of unknown functions, and functions without results, are not checked.
+    """
+    results = go_call_result_types(ctx, value_node)
+    if not results or len(results) == target_count:
+        return
+    variables = "variable" if target_count == 1 else "variables"
+    values = "value" if len(results) == 1 else "values"
+    raise GoAssignmentMismatchError(
+        f"assignment mismatch: {target_count} {variables} but "
+        f"{ctx.node_text(value_node)} returns {len(results)} {values}"
+    )
+
+
+def _go_assigned_types(
+    ctx: TreeSitterEmitContext, right_nodes: list, target_count: int
+) -> list[TypeExpr]:
+    """Static types of the values assigned to *target_count* variables.
+
+    ``v, err := f()`` takes f's declared result types; otherwise each value
+    has its own default type.
+    """
+    if target_count >= 2 and len(right_nodes) == 1:
+        results = go_call_result_types(ctx, right_nodes[0])
+        if results is not None and len(results) == target_count:
+            return list(results)
+    return [go_default_type(ctx, n) for n in right_nodes]
+
+
+def _lower_assigned_values(
+    ctx: TreeSitterEmitContext, right, target_count: int
+) -> list[Register]:
+    """Lower the right-hand side of := / =, expanding the multi-value forms.
+
+    ``v, ok := m[k]``, ``v, ok := x.(T)`` and ``v, ok := <-ch`` yield two
+    registers from a single expression, and ``v, err := f()`` unpacks the
+    tuple f returns its results in (``emit_go_tuple``).
+    """
+    right_nodes = get_expression_list_children(right)
+    if len(right_nodes) == 1:
+        _check_go_result_count(ctx, right_nodes[0], target_count)
+    if target_count >= 2 and len(right_nodes) == 1:
+        (value_node,) = right_nodes
+        if value_node.type == GoNodeType.CALL_EXPRESSION:
+            tuple_reg = ctx.lower_expr(value_node)
+            return unpack_go_tuple(ctx, tuple_reg, target_count, value_node)
+    if target_count == 2 and len(right_nodes) == 1:
+        (value_node,) = right_nodes
+        if is_go_map_index(ctx, value_node):
+            return list(lower_go_map_lookup(ctx, value_node))
+        if value_node.type == GoNodeType.TYPE_ASSERTION_EXPRESSION:
+            return list(lower_go_type_assertion_ok(ctx, value_node))
+        if is_go_chan_receive(value_node):
+            chan_reg = ctx.lower_expr(value_node.child_by_field_name("operand"))
+            return list(lower_go_chan_recv_ok(ctx, chan_reg, value_node))
+    return lower_expression_list(ctx, right)
+
+
+def _seed_collection_type(ctx: TreeSitterEmitContext, var_name: str, val_node) -> None:
+    """Record the static type of ``x := [N]T{...}`` / ``x := make(map[K]V)``.
+
+    Knowing that a variable holds an array, slice or map lets index
+    expressions on it be bounds-checked or read with a zero-value default.
+    """
+    type_node = None
+    if val_node.type == GoNodeType.COMPOSITE_LITERAL:
+        type_node = val_node.child_by_field_name("type")
+    elif val_node.type == GoNodeType.CALL_EXPRESSION:
+        func_node = val_node.child_by_field_name("function")
+        args_node = val_node.child_by_field_name("arguments")
+        if func_node and args_node and ctx.node_text(func_node) == "make":
+            type_node = next((c for c in args_node.children if c.is_named), None)
+    if type_node is not None and type_node.type in GO_COLLECTION_TYPES:
+        ctx.seed_var_type(var_name, parse_go_type(ctx, type_node))
 
 
 # -- Go: assignment statement (=) ------------------------------------------
@@ -66,7 +237,28 @@ def lower_go_assignment(
     left = node.child_by_field_name(ctx.constants.assign_left_field)
     right = node.child_by_field_name(ctx.constants.assign_right_field)
     left_nodes = get_expression_list_children(left)
-    right_regs = lower_expression_list(ctx, right)
+    right_nodes = get_expression_list_children(right)
+    op_node = node.child_by_field_name("operator")
+    op_text = ctx.node_text(op_node) if op_node else "="
+    if op_text != "=" and left_nodes and right_nodes:
+        rhs_reg = ctx.lower_expr(right_nodes[0])
+        lower_go_update_target(
+            ctx,
+            left_nodes[0],
+            op_text.removesuffix("="),
+            rhs_reg,
+            node,
+            right_nodes[0],
+        )
+        return
+    if len(right_nodes) == len(left_nodes):
+        for target, value_node in zip(left_nodes, right_nodes):
+            if target.type == GoNodeType.IDENTIFIER:
+                target_type = ctx.type_env_builder.var_types.get(
+                    ctx.resolve_var(ctx.node_text(target)), UNKNOWN
+                )
+                check_go_assignable(ctx, value_node, target_type, "assignment")
+    right_regs = _lower_assigned_values(ctx, right, len(left_nodes))
 
     for target, val_reg in zip(left_nodes, right_regs):
         lower_go_store_target(ctx, target, val_reg, node)
@@ -74,55 +266,308 @@ def lower_go_assignment(
 
 # -- Go: function declaration ----------------------------------------------
 
-_GO_MAIN_FUNC_NAME = "main"
+_GO_INIT_FUNC_NAME = "init"
+
+
+class GoEntryFunctionError(CompileError):
+    """``init`` or the entry function declares parameters or results."""
+
+    code = DiagnosticCode.INVALID_ENTRY_FUNCTION
+
+    def __init__(self, func_name: str):
+        super().__init__(
+            f"func {func_name} must have no arguments and no return values"
+        )
 
 
 def lower_go_func_decl(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
     name_node = node.child_by_field_name(ctx.constants.func_name_field)
-    params_node = node.child_by_field_name(ctx.constants.func_params_field)
-    body_node = node.child_by_field_name(ctx.constants.func_body_field)
-
     func_name = ctx.node_text(name_node) if name_node else "__anon"
 
-    if func_name == _GO_MAIN_FUNC_NAME:
-        _lower_go_main_hoisted(ctx, body_node)
+    if func_name == ctx.go_entry:
+        _check_go_niladic(ctx, node, func_name)
+        body_node = node.child_by_field_name(ctx.constants.func_body_field)
+        _lower_go_main_hoisted(ctx, node, body_node)
         return
+    _lower_go_func(ctx, node, func_name)
+
+
+def _check_go_niladic(ctx: TreeSitterEmitContext, node, func_name: str) -> None:
+    """Reject a declaration of *func_name* with parameters or results."""
+    params_node = node.child_by_field_name(ctx.constants.func_params_field)
+    has_params = params_node is not None and any(
+        c.type == GoNodeType.PARAMETER_DECLARATION
+        or c.type == GoNodeType.VARIADIC_PARAMETER_DECLARATION
+        for c in params_node.children
+    )
+    if has_params or node.child_by_field_name("result") is not None:
+        raise GoEntryFunctionError(func_name)
+
+
+def _lower_go_func(ctx: TreeSitterEmitContext, node, decl_name: str) -> None:
+    """Lower a function declaration, declared under *decl_name*.
 
+    That is the function's own name, or the instance name (``Max[int]``)
+    when a generic function is lowered for one instantiation.
+    """
+    name_node = node.child_by_field_name(ctx.constants.func_name_field)
+    params_node = node.child_by_field_name(ctx.constants.func_params_field)
+    body_node = node.child_by_field_name(ctx.constants.func_body_field)
+
+    func_name = ctx.node_text(name_node) if name_node else "__anon"
     func_label = ctx.fresh_label(f"{constants.FUNC_LABEL_PREFIX}{func_name}")
     end_label = ctx.fresh_label(f"end_{func_name}")
 
-    raw_return = extract_type_from_field(ctx, node, "result")
-    return_hint = normalize_type_hint(raw_return, ctx.type_map)
+    return_hint = go_type_hint(ctx, node.child_by_field_name("result"))
 
     ctx.emit_inst(Branch(label=end_label), node=node)
     ctx.emit_inst(Label_(label=func_label))
     ctx.seed_func_return_type(func_label, return_hint)
 
+    ctx.enter_block_scope()
     if params_node:
         lower_go_params(ctx, params_node)
 
-    if body_node:
-        ctx.lower_block(body_node)
+    lower_go_func_body(ctx, node, body_node)
+    ctx.exit_block_scope()
 
     emit_implicit_return(ctx, node)
     ctx.emit_inst(Label_(label=end_label))
 
     func_reg = ctx.fresh_reg()
-    ctx.emit_func_ref(func_name, func_label, result_reg=func_reg)
-    ctx.emit_inst(DeclVar(name=VarName(func_name), value_reg=func_reg))
+    ctx.emit_func_ref(decl_name, func_label, result_reg=func_reg)
+    ctx.emit_inst(
+        DeclVar(name=VarName(go_qualified_name(ctx, decl_name)), value_reg=func_reg)
+    )
+
+
+def _lower_go_body_statements(ctx: TreeSitterEmitContext, body_node) -> None:
+    """Lower a function body's statements in the caller's block scope.
+
+    Go declares parameters and named results in the body's outermost
+    block, so the body opens no block scope of its own.
+    """
+    for child in body_node.named_children:
+        if child.type == GoNodeType.STATEMENT_LIST:
+            for statement in child.named_children:
+                ctx.lower_stmt(statement)
+        else:
+            ctx.lower_stmt(child)
 
 
-def _lower_go_main_hoisted(ctx: TreeSitterEmitContext, body_node) -> None:
+def _lower_go_main_hoisted(ctx: TreeSitterEmitContext, node, body_node) -> None:
     """Hoist func main() body to top level so its locals land in frame 0.
 
-    Go's ``func main()`` is the program entry point.  Rather than
-    wrapping it in a function definition (which the VM would skip),
-    we emit its statements directly on the top-level path.
+    Go's ``func main()`` is the program entry point (``ctx.go_entry`` names
+    another function to run in its place).  Rather than wrapping it in a
+    function definition (which the VM would skip), we emit its statements
+    directly on the top-level path, guarded by ``_guard_go_top_level``.
+    Its locals are still recorded in a function scope of their own.
     """
+    ctx.scopes.enter(ScopeKind.FUNCTION, ctx.source_loc(node), ctx.go_entry)
+    ctx.enter_block_scope(body_node)
+    _guard_go_top_level(
+        ctx, node, lambda: lower_go_func_body(ctx, node, body_node, returns=False)
+    )
+    ctx.exit_block_scope()
+    ctx.scopes.exit(ScopeKind.FUNCTION, ctx.go_entry)
+
+
+def _guard_go_top_level(ctx: TreeSitterEmitContext, node, lower_body) -> None:
+    """Run *lower_body* inside a handler for panics that nothing recovers.
+
+    The handler stores the panic as a ``runtime.Error`` in
+    ``GO_PANIC_VAR`` and halts the program.
+    """
+    uncaught_label = ctx.fresh_label("go_uncaught_panic")
+    end_label = ctx.fresh_label("main_end")
+    ctx.emit_inst(TryPush(catch_labels=(uncaught_label,)), node=node)
+    lower_body()
+    ctx.emit_inst(TryPop())
+    ctx.emit_inst(Branch(label=end_label))
+
+    ctx.emit_inst(Label_(label=uncaught_label))
+    emit_go_uncaught_panic(ctx, node)
+    ctx.emit_inst(Label_(label=end_label))
+
+
+def collect_go_func_results(ctx: TreeSitterEmitContext, root) -> None:
+    """Record each top-level function's result types in ``ctx.go_func_results``.
+
+    Runs before lowering, so ``v, err := f()`` is checked and typed even
+    when f is declared further down the file.  The results of a generic
+    function depend on its type arguments and are typed per call instead.
+    """
+    for child in root.children:
+        if child.type != GoNodeType.FUNCTION_DECLARATION:
+            continue
+        if child.child_by_field_name("type_parameters") is not None:
+            continue
+        name_node = child.child_by_field_name("name")
+        if name_node is not None:
+            ctx.go_func_results[ctx.node_text(name_node)] = go_result_types(
+                ctx, child.child_by_field_name("result")
+            )
+
+
+# -- Go: function body (named results, defer) ------------------------------
+
+
+def lower_go_func_body(
+    ctx: TreeSitterEmitContext, func_node, body_node, *, returns: bool = True
+) -> None:
+    """Lower a function body together with its named results and defers.
+
+    Named results (``func f() (n int)``) are declared with their zero values,
+    so a bare ``return`` returns them.  When the body contains ``defer``,
+    every ``return`` stores its values into the results and branches to one
+    exit block, which runs the deferred calls last-in-first-out and then
+    returns the results — so a deferred closure can still change a named
+    result.  A panic in the body is caught (TRY_PUSH) and runs the same
+    deferred calls; unless one of them recover()s, the panic continues to
+    the caller.  The hoisted ``main`` passes ``returns=False``: its exit
+    block falls through to the rest of the top level.  The body's labels
+    (``collect_go_labels``) replace the enclosing function's while it
+    is lowered.  Its statements are lowered in the block scope the caller
+    declared the parameters in (``_lower_go_body_statements``).
+    """
+    has_defer = body_node is not None and _contains_go_defer(body_node)
+    suffix = ctx.label_counter
+    exit_label = ctx.fresh_label("defer_exit") if has_defer else NO_LABEL
+    panic_label = ctx.fresh_label("defer_panic") if has_defer else NO_LABEL
+    stack_var = VarName(f"__defers_{suffix}") if has_defer else NO_VAR_NAME
+    panicking_var = VarName(f"__panicking_{suffix}") if has_defer else NO_VAR_NAME
+    result_vars = _declare_go_results(
+        ctx, func_node.child_by_field_name("result"), suffix if has_defer else -1
+    )
+    if has_defer:
+        stack_reg = ctx.fresh_reg()
+        ctx.emit_inst(
+            CallFunction(result_reg=stack_reg, func_name=FuncName("arrayOf"), args=())
+        )
+        ctx.emit_inst(DeclVar(name=stack_var, value_reg=stack_reg))
+        flag_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.bool_(flag_reg, False))
+        ctx.emit_inst(DeclVar(name=panicking_var, value_reg=flag_reg))
+        ctx.emit_inst(TryPush(catch_labels=(panic_label,)), node=func_node)
+
+    ctx.func_exit_stack.append(FunctionExit(result_vars, exit_label, stack_var))
+    outer_labels = ctx.go_labels
+    ctx.go_labels = collect_go_labels(ctx, body_node) if body_node else {}
     if body_node:
-        ctx.lower_block(body_node)
+        _lower_go_body_statements(ctx, body_node)
+    ctx.go_labels = outer_labels
+    ctx.func_exit_stack.pop()
+
+    if not has_defer:
+        return
+    run_label = ctx.fresh_label("defer_run")
+    ctx.emit_inst(Branch(label=exit_label))
+    ctx.emit_inst(Label_(label=exit_label))
+    ctx.emit_inst(TryPop())
+    ctx.emit_inst(Branch(label=run_label))
+    ctx.emit_inst(Label_(label=panic_label))
+    true_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.bool_(true_reg, True))
+    ctx.emit_inst(StoreVar(name=panicking_var, value_reg=true_reg))
+    ctx.emit_inst(Label_(label=run_label))
+    emit_go_run_defers(ctx, stack_var, func_node)
+    _emit_go_repanic(ctx, panicking_var, func_node)
+    if returns and result_vars:
+        regs = []
+        for var in result_vars:
+            reg = ctx.fresh_reg()
+            ctx.emit_inst(LoadVar(result_reg=reg, name=var))
+            regs.append(reg)
+        result_reg = regs[0] if len(regs) == 1 else emit_go_tuple(ctx, regs, func_node)
+        ctx.emit_inst(Return_(value_reg=result_reg), node=func_node)
+
+
+def _emit_go_repanic(ctx: TreeSitterEmitContext, panicking_var: VarName, node) -> None:
+    """Panic again after a panicking function's defers ran, unless one recovered."""
+    check_label = ctx.fresh_label("defer_recovered")
+    repanic_label = ctx.fresh_label("defer_repanic")
+    done_label = ctx.fresh_label("defer_return")
+    flag_reg = ctx.fresh_reg()
+    ctx.emit_inst(LoadVar(result_reg=flag_reg, name=panicking_var))
+    ctx.emit_inst(
+        BranchIf(cond_reg=flag_reg, branch_targets=(check_label, done_label))
+    )
+    ctx.emit_inst(Label_(label=check_label))
+    in_flight_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=in_flight_reg, func_name=FuncName("__go_panicking__"), args=()
+        )
+    )
+    ctx.emit_inst(
+        BranchIf(cond_reg=in_flight_reg, branch_targets=(repanic_label, done_label))
+    )
+    ctx.emit_inst(Label_(label=repanic_label))
+    value_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=value_reg, func_name=FuncName("__go_panic_value__"), args=()
+        )
+    )
+    ctx.emit_inst(Throw_(value_reg=value_reg), node=node)
+    ctx.emit_inst(Label_(label=done_label))
+
+
+def _declare_go_results(
+    ctx: TreeSitterEmitContext, result_node, hidden_suffix: int
+) -> tuple[VarName, ...]:
+    """Declare a function's result variables, returning their names.
+
+    Named results are declared under their own names with zero values.
+    Unnamed results only get variables — ``__result<i>_<suffix>``, initially
+    nil — when *hidden_suffix* is non-negative, i.e. when returns have to
+    park their values for the defer exit block.
+    """
+    if result_node is None:
+        return ()
+    decls = (
+        [c for c in result_node.children if c.type == GoNodeType.PARAMETER_DECLARATION]
+        if result_node.type == GoNodeType.PARAMETER_LIST
+        else []
+    )
+    named = [
+        (name_node, decl.child_by_field_name("type"))
+        for decl in decls
+        for name_node in decl.children_by_field_name("name")
+    ]
+    if named:
+        for name_node, type_node in named:
+            name = ctx.node_text(name_node)
+            zero_reg = emit_go_zero_value(ctx, type_node)
+            ctx.emit_inst(
+                DeclVar(name=VarName(name), value_reg=zero_reg), node=name_node
+            )
+            ctx.seed_var_type(name, go_type_hint(ctx, type_node))
+            note_go_slice_elements(ctx, name, type_node)
+            _hide_go_package_name(ctx, name)
+        return tuple(VarName(ctx.node_text(name_node)) for name_node, _ in named)
+    if hidden_suffix < 0:
+        return ()
+    hidden = tuple(
+        VarName(f"__result{i}_{hidden_suffix}") for i in range(max(len(decls), 1))
+    )
+    for var in hidden:
+        reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.null_(reg))
+        ctx.emit_inst(DeclVar(name=var, value_reg=reg))
+    return hidden
+
+
+def _contains_go_defer(node) -> bool:
+    """True if *node* holds a defer statement outside any nested func literal."""
+    if node.type == GoNodeType.DEFER_STATEMENT:
+        return True
+    if node.type == GoNodeType.FUNC_LITERAL:
+        return False
+    return any(_contains_go_defer(c) for c in node.children)
 
 
 # -- Go: method declaration ------------------------------------------------
@@ -131,6 +576,32 @@ def _lower_go_main_hoisted(ctx: TreeSitterEmitContext, body_node) -> None:
 def lower_go_method_decl(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """Lower a method declaration inside a class block for its receiver type.
+
+    Go methods are declared outside the struct, so each one gets its own
+    ``class_T`` block; the registry merges every block registered for ``T``
+    into T's method table, which is what CALL_METHOD dispatches on.
+    """
+    receiver_node = node.child_by_field_name("receiver")
+    receiver_type = go_receiver_type_name(receiver_node) if receiver_node else ""
+    if not receiver_type:
+        _lower_go_method_func(ctx, node)
+        return
+
+    class_label = ctx.fresh_label(f"{constants.CLASS_LABEL_PREFIX}{receiver_type}")
+    end_label = ctx.fresh_label(f"{constants.END_CLASS_LABEL_PREFIX}{receiver_type}")
+
+    ctx.emit_inst(Branch(label=end_label), node=node)
+    ctx.emit_inst(Label_(label=class_label))
+    _lower_go_method_func(ctx, node)
+    ctx.emit_inst(Label_(label=end_label))
+
+    cls_reg = ctx.fresh_reg()
+    ctx.emit_class_ref(receiver_type, class_label, [], result_reg=cls_reg)
+    ctx.emit_inst(DeclVar(name=VarName(receiver_type), value_reg=cls_reg))
+
+
+def _lower_go_method_func(ctx: TreeSitterEmitContext, node) -> None:
     name_node = node.child_by_field_name(ctx.constants.func_name_field)
     params_node = node.child_by_field_name(ctx.constants.func_params_field)
     body_node = node.child_by_field_name(ctx.constants.func_body_field)
@@ -140,22 +611,22 @@ def lower_go_method_decl(
     func_label = ctx.fresh_label(f"{constants.FUNC_LABEL_PREFIX}{func_name}")
     end_label = ctx.fresh_label(f"end_{func_name}")
 
-    raw_return = extract_type_from_field(ctx, node, "result")
-    return_hint = normalize_type_hint(raw_return, ctx.type_map)
+    return_hint = go_type_hint(ctx, node.child_by_field_name("result"))
 
     ctx.emit_inst(Branch(label=end_label), node=node)
     ctx.emit_inst(Label_(label=func_label))
     ctx.seed_func_return_type(func_label, return_hint)
 
     # Lower receiver as parameter
+    ctx.enter_block_scope()
     if receiver_node:
-        lower_go_params(ctx, receiver_node)
+        _lower_go_receiver(ctx, receiver_node)
 
     if params_node:
         lower_go_params(ctx, params_node)
 
-    if body_node:
-        ctx.lower_block(body_node)
+    lower_go_func_body(ctx, node, body_node)
+    ctx.exit_block_scope()
 
     emit_implicit_return(ctx, node)
     ctx.emit_inst(Label_(label=end_label))
@@ -165,14 +636,85 @@ def lower_go_method_decl(
     ctx.emit_inst(DeclVar(name=VarName(func_name), value_reg=func_reg))
 
 
+def _lower_go_receiver(ctx: TreeSitterEmitContext, receiver_node) -> None:
+    """Bind the receiver as the method's first parameter.
+
+    CALL_METHOD passes the object as the first parameter, so an unnamed
+    receiver (``func (T) M()``) still gets a ``_`` slot.  A value receiver
+    of struct type is rebound to a shallow copy, so field writes inside the
+    method do not reach the caller; a pointer receiver shares the object.
+    """
+    decl = next(
+        (
+            c
+            for c in receiver_node.children
+            if c.type == GoNodeType.PARAMETER_DECLARATION
+        ),
+        None,
+    )
+    if decl is None:
+        return
+    name_node = decl.child_by_field_name("name")
+    if name_node is None:
+        ctx.emit_inst(
+            Symbolic(result_reg=ctx.fresh_reg(), hint=f"{constants.PARAM_PREFIX}_"),
+            node=decl,
+        )
+        ctx.emit_inst(
+            DeclVar(name=VarName("_"), value_reg=Register(f"%{ctx.reg_counter - 1}"))
+        )
+        return
+    lower_go_params(ctx, receiver_node)
+    type_node = decl.child_by_field_name("type")
+    if type_node is None or not is_go_struct_type(ctx, type_node):
+        return
+    receiver = VarName(ctx.node_text(name_node))
+    obj_reg = ctx.fresh_reg()
+    ctx.emit_inst(LoadVar(result_reg=obj_reg, name=receiver), node=decl)
+    copy_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(result_reg=copy_reg, func_name=FuncName("clone"), args=(obj_reg,)),
+        node=decl,
+    )
+    ctx.emit_inst(StoreVar(name=receiver, value_reg=copy_reg), node=decl)
+
+
+def go_receiver_type_name(receiver_node) -> str:
+    """Name of the type a method is declared on: ``(p *Point)`` -> ``Point``.
+
+    Generic receivers drop their type arguments (``(s *Stack[T])`` ->
+    ``Stack``).  Returns "" when the receiver has no type.
+    """
+    decl = next(
+        (
+            c
+            for c in receiver_node.children
+            if c.type == GoNodeType.PARAMETER_DECLARATION
+        ),
+        None,
+    )
+    type_node = decl.child_by_field_name("type") if decl is not None else None
+    if type_node is None:
+        return ""
+    return type_node.text.decode().lstrip("*").split("[")[0].strip()
+
+
 def lower_go_params(ctx: TreeSitterEmitContext, params_node) -> None:
+    """Declare each parameter from its SYMBOLIC argument.
+
+    Every name of a grouped declaration (``a, b int``) is a parameter of
+    its own.  A parameter named like a constant hides it, so it is no
+    longer folded or protected from assignment.
+    """
+    param_index = 0
     for child in params_node.children:
-        if child.type == GoNodeType.PARAMETER_DECLARATION:
-            name_node = child.child_by_field_name("name")
-            if name_node:
+        if child.type == GoNodeType.VARIADIC_PARAMETER_DECLARATION:
+            _lower_go_variadic_param(ctx, child, param_index)
+        elif child.type == GoNodeType.PARAMETER_DECLARATION:
+            param_index += max(len(child.children_by_field_name("name")), 1)
+            type_hint = go_type_hint(ctx, child.child_by_field_name("type"))
+            for name_node in child.children_by_field_name("name"):
                 pname = ctx.node_text(name_node)
-                raw_type = extract_type_from_field(ctx, child, "type")
-                type_hint = normalize_type_hint(raw_type, ctx.type_map)
                 param_reg = ctx.fresh_reg()
                 ctx.emit_inst(
                     Symbolic(
@@ -190,7 +732,10 @@ def lower_go_params(ctx: TreeSitterEmitContext, params_node) -> None:
                     )
                 )
                 ctx.seed_var_type(pname, type_hint)
+                note_go_slice_elements(ctx, pname, child.child_by_field_name("type"))
+                _hide_go_package_name(ctx, pname)
         elif child.type == GoNodeType.IDENTIFIER:
+            param_index += 1
             pname = ctx.node_text(child)
             ctx.emit_inst(
                 Symbolic(
@@ -204,6 +749,50 @@ def lower_go_params(ctx: TreeSitterEmitContext, params_node) -> None:
                     name=VarName(pname), value_reg=Register(f"%{ctx.reg_counter - 1}")
                 )
             )
+            _hide_go_package_name(ctx, pname)
+
+
+def _hide_go_package_name(ctx: TreeSitterEmitContext, pname: str) -> None:
+    """Declare parameter *pname* in the body's block, hiding any outer *pname*.
+
+    Callers enter a block scope before the parameters and lower the body's
+    statements in it, so the binding ends with the function, a ``:=`` in
+    the body assigns *pname*, and a nested block's ``pname :=`` shadows it.
+    """
+    ctx.const_values.pop(pname, None)
+    ctx.bind_block_var(pname, pname)
+
+
+def _lower_go_variadic_param(
+    ctx: TreeSitterEmitContext, child, start_index: int
+) -> None:
+    """Lower ``nums ...T`` as slice(arguments, start_index).
+
+    The trailing call arguments are packed into a fresh []T, which is empty
+    when none are passed; ``f(xs...)`` spreads xs into those arguments.
+    """
+    name_node = child.child_by_field_name("name")
+    if name_node is None:
+        return
+    pname = ctx.node_text(name_node)
+    args_reg = ctx.fresh_reg()
+    ctx.emit_inst(LoadVar(result_reg=args_reg, name=VarName("arguments")))
+    idx_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.int_(idx_reg, start_index))
+    rest_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=rest_reg,
+            func_name=FuncName("slice"),
+            args=(args_reg, idx_reg),
+        ),
+        node=child,
+    )
+    ctx.emit_inst(DeclVar(name=VarName(pname), value_reg=rest_reg))
+    _hide_go_package_name(ctx, pname)
+    type_node = child.child_by_field_name("type")
+    if type_node is not None:
+        ctx.seed_var_type(pname, array_of(parse_go_type(ctx, type_node)))
 
 
 # -- Go: type declaration (struct) -----------------------------------------
@@ -212,8 +801,14 @@ def lower_go_params(ctx: TreeSitterEmitContext, params_node) -> None:
 def lower_go_type_decl(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """Lower type specs (``type MyInt int``) and aliases (``type C = float64``).
+
+    Structs and interfaces become classes; any other type — named or alias —
+    is bound to a ``type:Name`` symbol; conversions such as ``MyInt(n)``
+    are resolved when the call is lowered.
+    """
     for child in node.children:
-        if child.type == GoNodeType.TYPE_SPEC:
+        if child.type in (GoNodeType.TYPE_SPEC, GoNodeType.TYPE_ALIAS):
             name_node = child.child_by_field_name("name")
             type_node = child.child_by_field_name("type")
             if name_node:
@@ -241,6 +836,8 @@ def _lower_go_struct_type(
     ctx.emit_inst(Branch(label=end_label), node=parent_node)
     ctx.emit_inst(Label_(label=class_label))
     # Struct fields are handled at instantiation time (composite_literal)
+    for method, path in go_promoted_methods(ctx, type_name).items():
+        _lower_go_forwarding_method(ctx, method, path, parent_node)
     ctx.emit_inst(Label_(label=end_label))
 
     cls_reg = ctx.fresh_reg()
@@ -248,6 +845,55 @@ def _lower_go_struct_type(
     ctx.emit_inst(DeclVar(name=VarName(type_name), value_reg=cls_reg))
 
 
+def _lower_go_forwarding_method(
+    ctx: TreeSitterEmitContext,
+    method: FuncName,
+    path: tuple[FieldName, ...],
+    node,
+) -> None:
+    """Emit method *method* promoted through the embedded fields *path*.
+
+    Like the wrapper the Go compiler generates, it calls *method* on the
+    embedded value with the arguments it was given, so the embedded type's
+    method runs with that value as its receiver.
+    """
+    func_label = ctx.fresh_label(f"{constants.FUNC_LABEL_PREFIX}{method}")
+    end_label = ctx.fresh_label(f"end_{method}")
+
+    ctx.emit_inst(Branch(label=end_label), node=node)
+    ctx.emit_inst(Label_(label=func_label))
+    recv_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        Symbolic(result_reg=recv_reg, hint=f"{constants.PARAM_PREFIX}recv"),
+        node=node,
+    )
+    for field_name in path:
+        field_reg = ctx.fresh_reg()
+        ctx.emit_inst(
+            LoadField(result_reg=field_reg, obj_reg=recv_reg, field_name=field_name),
+            node=node,
+        )
+        recv_reg = field_reg
+    args_reg = ctx.fresh_reg()
+    ctx.emit_inst(LoadVar(result_reg=args_reg, name=VarName("arguments")))
+    result_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallMethod(
+            result_reg=result_reg,
+            obj_reg=recv_reg,
+            method_name=method,
+            args=(SpreadArguments(register=args_reg),),
+        ),
+        node=node,
+    )
+    ctx.emit_inst(Return_(value_reg=result_reg), node=node)
+    ctx.emit_inst(Label_(label=end_label))
+
+    func_reg = ctx.fresh_reg()
+    ctx.emit_func_ref(str(method), func_label, result_reg=func_reg)
+    ctx.emit_inst(DeclVar(name=VarName(str(method)), value_reg=func_reg))
+
+
 def _lower_go_interface_type(
     ctx: TreeSitterEmitContext, type_name: str, type_node, parent_node
 ) -> None:
@@ -264,6 +910,13 @@ def _lower_go_interface_type(
 
     ctx.emit_inst(Label_(label=end_label))
 
+    # Go interfaces are satisfied implicitly by any struct with the methods,
+    # declared or promoted from an embedded field.
+    required = set(ctx.symbol_table.interfaces.get(ClassName(type_name), ()))
+    for implementor in ctx.symbol_table.classes:
+        if required <= go_method_set(ctx, implementor):
+            ctx.seed_interface_impl(implementor.value, type_name)
+
     cls_reg = ctx.fresh_reg()
     ctx.emit_class_ref(type_name, class_label, [], result_reg=cls_reg)
     ctx.emit_inst(DeclVar(name=VarName(type_name), value_reg=cls_reg))
@@ -319,15 +972,7 @@ def _extract_go_method_elem_return_type(ctx: TreeSitterEmitContext, method_node)
 def lower_go_var_decl(
     ctx: TreeSitterEmitContext, node: Any
 ) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    specs = [c for c in node.children if c.type == GoNodeType.VAR_SPEC]
-    # Handle var (...) block form: var_spec_list contains var_spec children
-    spec_list = next(
-        (c for c in node.children if c.type == GoNodeType.VAR_SPEC_LIST),
-        None,
-    )
-    if spec_list is not None:
-        specs = [c for c in spec_list.children if c.type == GoNodeType.VAR_SPEC]
-    for spec in specs:
+    for spec in go_var_specs(node):
         _lower_var_spec(ctx, spec, node)
 
 
@@ -335,35 +980,302 @@ def _lower_var_spec(ctx: TreeSitterEmitContext, spec, parent_node) -> None:
     """Lower a single var_spec, supporting multiple names: `var a, b = 1, 2`."""
     names = [c for c in spec.children if c.type == GoNodeType.IDENTIFIER]
     value_node = spec.child_by_field_name("value")
-    raw_type = extract_type_from_field(ctx, spec, "type")
-    type_hint = normalize_type_hint(raw_type, ctx.type_map)
+    type_node = spec.child_by_field_name("type")
+    type_hint = go_type_hint(ctx, type_node)
 
     if value_node:
-        val_regs = lower_expression_list(ctx, value_node)
-        for name_node, val_reg in zip(names, val_regs):
-            name_str = ctx.declare_block_var(ctx.node_text(name_node))
+        val_nodes = get_expression_list_children(value_node)
+        type_name = ctx.node_text(type_node) if type_node is not None else ""
+        if type_node is not None:
+            for val_node in val_nodes:
+                check_go_assignable(ctx, val_node, type_hint, "variable declaration")
+        else:
+            _check_go_untyped_nil(val_nodes, "variable declaration")
+        for val_node in val_nodes:
+            check_go_constant(ctx, val_node, type_name)
+        val_types = _go_assigned_types(ctx, val_nodes, len(names))
+        val_regs = _lower_assigned_values(ctx, value_node, len(names))
+        for i, (name_node, val_reg) in enumerate(zip(names, val_regs)):
+            name_str = declare_go_var(ctx, ctx.node_text(name_node))
             ctx.emit_inst(
                 DeclVar(name=VarName(name_str), value_reg=val_reg), node=parent_node
             )
             ctx.seed_var_type(name_str, type_hint)
+            if type_node is not None:
+                note_go_slice_elements(ctx, name_str, type_node)
+            if type_node is None and len(val_nodes) == len(names):
+                _seed_collection_type(ctx, name_str, val_nodes[i])
+                note_go_slice_elements(ctx, name_str, val_nodes[i])
+            if type_node is None and len(val_types) == len(names):
+                ctx.seed_var_type(name_str, val_types[i])
         # If more names than values (e.g. `var a, b int`), store None for remainder
         for name_node in names[len(val_regs) :]:
-            name_str = ctx.declare_block_var(ctx.node_text(name_node))
+            name_str = declare_go_var(ctx, ctx.node_text(name_node))
             val_reg = ctx.fresh_reg()
             ctx.emit_inst(Const.null_(val_reg))
             ctx.emit_inst(
                 DeclVar(name=VarName(name_str), value_reg=val_reg), node=parent_node
             )
             ctx.seed_var_type(name_str, type_hint)
+            note_go_slice_elements(ctx, name_str, type_node)
     else:
         for name_node in names:
-            name_str = ctx.declare_block_var(ctx.node_text(name_node))
-            val_reg = ctx.fresh_reg()
-            ctx.emit_inst(Const.null_(val_reg))
+            name_str = declare_go_var(ctx, ctx.node_text(name_node))
+            if type_node is not None:
+                val_reg = emit_go_zero_value(ctx, type_node)
+            else:
+                val_reg = ctx.fresh_reg()
+                ctx.emit_inst(Const.null_(val_reg))
             ctx.emit_inst(
                 DeclVar(name=VarName(name_str), value_reg=val_reg), node=parent_node
             )
             ctx.seed_var_type(name_str, type_hint)
+            note_go_slice_elements(ctx, name_str, type_node)
+
+
+# -- Go: source file (package initialization) ------------------------------
+
+
+class GoInitializationCycleError(CompileError):
+    """Package-level variable initializers refer to each other in a cycle.
+
+    The Go compiler rejects such programs, so lowering refuses them too.
+    """
+
+    code = DiagnosticCode.INITIALIZATION_CYCLE
+
+    def __init__(self, names: list[str]):
+        chain = " refers to ".join([*names, names[0]])
+        super().__init__(f"initialization cycle: {chain}")
+
+
+def lower_go_source_file(
+    ctx: TreeSitterEmitContext, node: Any
+) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """Lower a source file in Go's package initialization order.
+
+    Constants come first, then type, function and method declarations, so
+    every function exists before an initializer can call it.  Package-level
+    variables follow in initialization order (``_go_init_order``), then
+    the file's ``init`` functions are called in declaration order, and the
+    hoisted ``main`` body runs last, wherever each appears in the file.
+    Each ``init`` is declared as ``init.0``, ``init.1``, ..., names no
+    program can refer to, since several may share a package.
+    Package variables live in the file's scope, visible to every function;
+    their static types are seeded before any function body is lowered.
+    Imports are recorded first so calls into standard packages resolve.
+    The package clause is set up first of all (``enter_go_package``);
+    outside package main, package-level names are declared qualified.
+    """
+    ctx.enter_block_scope()
+    enter_go_package(ctx, node)
+    consts, others, inits, mains = [], [], [], []
+    var_specs: list[tuple[Any, Any]] = []  # Any: (var_declaration, var_spec)
+    for child in node.children:
+        if not child.is_named:
+            continue
+        if child.type == GoNodeType.IMPORT_DECLARATION:
+            record_go_imports(ctx, child)
+        elif child.type == GoNodeType.CONST_DECLARATION:
+            consts.append(child)
+        elif child.type == GoNodeType.VAR_DECLARATION:
+            var_specs.extend((child, spec) for spec in go_var_specs(child))
+        elif _go_func_decl_name(ctx, child) == ctx.go_entry:
+            mains.append(child)
+        elif _go_func_decl_name(ctx, child) == _GO_INIT_FUNC_NAME:
+            _check_go_niladic(ctx, child, _GO_INIT_FUNC_NAME)
+            inits.append(child)
+        else:
+            others.append(child)
+
+    for child in consts:
+        ctx.lower_stmt(child)
+    for _, spec in var_specs:
+        _seed_var_spec_types(ctx, spec)
+    for child in others:
+        ctx.lower_stmt(child)
+    init_names = [f"{_GO_INIT_FUNC_NAME}.{i}" for i in range(len(inits))]
+    for child, init_name in zip(inits, init_names):
+        _lower_go_func(ctx, child, init_name)
+    instances_at = len(ctx.instructions)
+    for decl, spec in _go_init_order(ctx, var_specs, others):
+        _lower_var_spec(ctx, spec, decl)
+    if inits:
+        _guard_go_top_level(ctx, node, lambda: _call_go_inits(ctx, init_names))
+    for child in mains:
+        ctx.lower_stmt(child)
+    _lower_go_instances(ctx, instances_at)
+    ctx.exit_block_scope()
+
+
+def _lower_go_instances(ctx: TreeSitterEmitContext, insert_at: int) -> None:
+    """Lower every instantiation of a generic function the file calls.
+
+    An instance is the generic declaration lowered under its instance name
+    (``Max[int]``) with the type parameters bound in the type map; lowering
+    one may instantiate more.  Instances are known only once the calls are
+    lowered, so they are moved to *insert_at*, after the other function
+    declarations, to be declared before an initializer or ``main`` runs.
+    """
+    start = len(ctx.instructions)
+    lowered: set[str] = set()
+    while len(lowered) < len(ctx.go_instances):
+        for instance, (name, type_args) in list(ctx.go_instances.items()):
+            if instance in lowered:
+                continue
+            lowered.add(instance)
+            generic = ctx.go_generics[name]
+            outer_map = ctx.type_map
+            ctx.type_map = go_instance_type_map(ctx, generic, type_args)
+            _lower_go_func(ctx, generic.node, instance)
+            ctx.type_map = outer_map
+    instances = ctx.instructions[start:]
+    del ctx.instructions[start:]
+    ctx.instructions[insert_at:insert_at] = instances
+
+
+def _call_go_inits(ctx: TreeSitterEmitContext, init_names: list[str]) -> None:
+    """Call the file's ``init`` functions, in declaration order."""
+    for init_name in init_names:
+        ctx.emit_inst(
+            CallFunction(
+                result_reg=ctx.fresh_reg(),
+                func_name=FuncName(go_qualified_name(ctx, init_name)),
+                args=(),
+            )
+        )
+
+
+def _go_func_decl_name(ctx: TreeSitterEmitContext, node) -> str:
+    """Name a function declaration declares; "" for any other node."""
+    if node.type != GoNodeType.FUNCTION_DECLARATION:
+        return ""
+    name_node = node.child_by_field_name(ctx.constants.func_name_field)
+    return ctx.node_text(name_node) if name_node is not None else ""
+
+
+def _seed_var_spec_types(ctx: TreeSitterEmitContext, spec) -> None:
+    """Seed the static types of a package-level var_spec's names."""
+    names = [ctx.node_text(c) for c in spec.children_by_field_name("name")]
+    type_node = spec.child_by_field_name("type")
+    value_nodes = get_expression_list_children(spec.child_by_field_name("value"))
+    for i, name in enumerate(map(ctx.resolve_var, names)):
+        if type_node is not None:
+            ctx.seed_var_type(name, go_type_hint(ctx, type_node))
+            note_go_slice_elements(ctx, name, type_node)
+        elif len(value_nodes) == len(names):
+            _seed_collection_type(ctx, name, value_nodes[i])
+            note_go_slice_elements(ctx, name, value_nodes[i])
+            ctx.seed_var_type(name, go_default_type(ctx, value_nodes[i]))
+
+
+def _go_init_order(
+    ctx: TreeSitterEmitContext, var_specs: list[tuple[Any, Any]], decls: list
+) -> list[tuple[Any, Any]]:  # Any: tree-sitter nodes — untyped at Python boundary
+    """Order package-level var specs the way Go initializes them.
+
+    A spec depends on the package variables its initializer refers to,
+    directly or through the top-level functions in *decls* that it calls.
+    Repeatedly the earliest spec whose dependencies are all initialized
+    goes next.  Raises GoInitializationCycleError when no spec is ready.
+    """
+    spec_index = {
+        ctx.node_text(name_node): i
+        for i, (_, spec) in enumerate(var_specs)
+        for name_node in spec.children_by_field_name("name")
+    }
+    funcs = [
+        decl
+        for decl in decls
+        if decl.type == GoNodeType.FUNCTION_DECLARATION
+        and decl.child_by_field_name("name") is not None
+    ]
+    func_refs = {
+        ctx.node_text(decl.child_by_field_name("name")): _go_free_names(ctx, decl)
+        for decl in funcs
+    }
+
+    def dependencies(spec) -> set[int]:
+        value_node = spec.child_by_field_name("value")
+        pending = list(_go_free_names(ctx, value_node)) if value_node else []
+        found: set[int] = set()
+        visited: set[str] = set()
+        while pending:
+            name = pending.pop()
+            if name in spec_index:
+                found.add(spec_index[name])
+            elif name in func_refs and name not in visited:
+                visited.add(name)
+                pending.extend(func_refs[name])
+        return found
+
+    deps = [dependencies(spec) for _, spec in var_specs]
+    done: set[int] = set()
+    order: list[tuple[Any, Any]] = []
+    while len(order) < len(var_specs):
+        ready = next(
+            (i for i in range(len(var_specs)) if i not in done and deps[i] <= done),
+            None,
+        )
+        if ready is None:
+            cycle = _go_init_cycle(ctx, var_specs, deps, done)
+            first = min(i for i in range(len(var_specs)) if i not in done)
+            error = GoInitializationCycleError(cycle)
+            raise error.at(ctx.source_loc(var_specs[first][1]))
+        done.add(ready)
+        order.append(var_specs[ready])
+    return order
+
+
+def _go_init_cycle(
+    ctx: TreeSitterEmitContext,
+    var_specs: list[tuple[Any, Any]],
+    deps: list[set[int]],
+    done: set[int],
+) -> list[str]:
+    """Names of the variables on one initialization cycle among the pending specs."""
+    path: list[int] = []
+    current = min(i for i in range(len(var_specs)) if i not in done)
+    while current not in path:
+        path.append(current)
+        current = min(deps[current] - done)
+    return [
+        ctx.node_text(var_specs[i][1].child_by_field_name("name"))
+        for i in path[path.index(current) :]
+    ]
+
+
+def _go_free_names(ctx: TreeSitterEmitContext, node) -> set[str]:
+    """Identifiers used in *node* other than those it declares itself.
+
+    A name declared anywhere inside *node* — a parameter, ``:=``, ``var``,
+    ``const`` or range variable — counts as local throughout it.
+    """
+    used: set[str] = set()
+    declared: set[str] = set()
+    stack = [node]
+    while stack:
+        current = stack.pop()
+        if current.type == GoNodeType.IDENTIFIER:
+            used.add(ctx.node_text(current))
+        if current.type in (
+            GoNodeType.SHORT_VAR_DECLARATION,
+            GoNodeType.RANGE_CLAUSE,
+            GoNodeType.RECEIVE_STATEMENT,
+        ):
+            left = current.child_by_field_name(ctx.constants.assign_left_field)
+            declared.update(extract_expression_list(ctx, left))
+        elif current.type in (
+            GoNodeType.PARAMETER_DECLARATION,
+            GoNodeType.VARIADIC_PARAMETER_DECLARATION,
+            GoNodeType.VAR_SPEC,
+            GoNodeType.CONST_SPEC,
+        ):
+            declared.update(
+                ctx.node_text(c) for c in current.children_by_field_name("name")
+            )
+        stack.extend(current.children)
+    return used - declared
 
 
 # -- Go: const declaration -------------------------------------------------
@@ -375,64 +1287,55 @@ def lower_go_const_decl(
     """Lower const_declaration: iterate const_spec children with iota tracking.
 
     In Go, `iota` starts at 0 and increments per const_spec in a block.
-    Value-less specs replay the previous expression with the new iota value.
+    Value-less specs replay the previous type and expressions with the new
+    iota value.
     """
-    iota_counter = 0
-    prev_value_node: Any = NO_NODE
     old_iota = getattr(ctx, "_go_iota_value", 0)
-    for child in node.children:
-        if child.type == GoNodeType.CONST_SPEC:
-            ctx._go_iota_value = iota_counter
-            _lower_const_spec(ctx, child, prev_value_node)
-            raw_value = child.child_by_field_name("value")
-            if raw_value is not None:
-                prev_value_node = _unwrap_expression_list(raw_value)
-            iota_counter += 1
+    type_node: Any = None
+    value_nodes: list = []
+    specs = [c for c in node.children if c.type == GoNodeType.CONST_SPEC]
+    for iota, spec in enumerate(specs):
+        ctx._go_iota_value = iota
+        raw_value = spec.child_by_field_name("value")
+        if raw_value is not None:
+            type_node = spec.child_by_field_name("type")
+            value_nodes = get_expression_list_children(raw_value)
+        _lower_const_spec(ctx, spec, type_node, value_nodes)
     ctx._go_iota_value = old_iota
 
 
-def _unwrap_expression_list(
-    node: Any,
-) -> Any:  # Any: tree-sitter node — untyped at Python boundary
-    """Unwrap a single-element expression_list to its inner expression."""
-    if node.type == GoNodeType.EXPRESSION_LIST:
-        named = [c for c in node.children if c.is_named]
-        if len(named) == 1:
-            return named[0]
-    return node
-
-
 def _lower_const_spec(
-    ctx: TreeSitterEmitContext, node: Any, prev_value_node: Any = NO_NODE
-) -> None:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower a single const_spec: lower value, DECL_VAR.
-
-    If the spec has no value but a previous expression exists (iota pattern),
-    re-lower the previous expression with the current iota counter.
+    ctx: TreeSitterEmitContext, node: Any, type_node: Any, value_nodes: list
+) -> None:  # Any: tree-sitter nodes — untyped at Python boundary
+    """Lower a single const_spec ``const a, b T = x, y``.
+
+    Each value is folded at compile time where possible (``1 << iota``,
+    ``KB * 1024``) and emitted as one CONST; anything the folder does not
+    understand is lowered as an ordinary expression.  The names are
+    recorded in ``ctx.const_values`` so later constants can build on them
+    and assignments to them are rejected.
     """
-    name_node = node.child_by_field_name("name")
-    value_node = node.child_by_field_name("value")
-    if value_node:
-        value_node = _unwrap_expression_list(value_node)
-    if name_node and value_node:
-        val_reg = ctx.lower_expr(value_node)
-        ctx.emit_inst(
-            DeclVar(name=VarName(ctx.node_text(name_node)), value_reg=val_reg),
-            node=node,
-        )
-    elif name_node and prev_value_node is not NO_NODE:
-        val_reg = ctx.lower_expr(prev_value_node)
-        ctx.emit_inst(
-            DeclVar(name=VarName(ctx.node_text(name_node)), value_reg=val_reg),
-            node=node,
-        )
-    elif name_node:
-        val_reg = ctx.fresh_reg()
-        ctx.emit_inst(Const.null_(val_reg))
-        ctx.emit_inst(
-            DeclVar(name=VarName(ctx.node_text(name_node)), value_reg=val_reg),
-            node=node,
-        )
+    type_name = ctx.node_text(type_node) if type_node is not None else ""
+    for i, name_node in enumerate(node.children_by_field_name("name")):
+        name = ctx.node_text(name_node)
+        if name == "_":
+            continue
+        value_node = value_nodes[i] if i < len(value_nodes) else None
+        value = fold_go_const(ctx, value_node) if value_node is not None else None
+        if value is not None and type_name:
+            value = convert_go_const(ctx, type_name, value)
+        if value is not None:
+            val_reg = emit_go_const_value(ctx, value, value_node)
+        elif value_node is not None:
+            val_reg = ctx.lower_expr(value_node)
+        else:
+            val_reg = ctx.fresh_reg()
+            ctx.emit_inst(Const.null_(val_reg))
+        var_name = declare_go_var(ctx, name)
+        ctx.emit_inst(DeclVar(name=VarName(var_name), value_reg=val_reg), node=node)
+        if type_node is not None:
+            ctx.seed_var_type(var_name, go_type_hint(ctx, type_node))
+        ctx.const_values[var_name] = value
 
 
 # ---------------------------------------------------------------------------
@@ -441,7 +1344,11 @@ def _lower_const_spec(
 
 
 def _extract_go_struct_fields(field_declaration_list) -> dict[str, FieldInfo]:
-    """Extract fields from a Go struct field_declaration_list node."""
+    """Extract fields from a Go struct field_declaration_list node.
+
+    An embedded field (``Inner``, ``*Inner``, ``pkg.Inner``) is named after
+    its type, and its type hint keeps the ``*`` of an embedded pointer.
+    """
 
     fields: dict[FieldName, FieldInfo] = {}
     for child in field_declaration_list.children:
@@ -450,35 +1357,91 @@ def _extract_go_struct_fields(field_declaration_list) -> dict[str, FieldInfo]:
         # A field_declaration can have multiple names before the type
         type_node = child.child_by_field_name("type")
         type_hint = type_node.text.decode() if type_node is not None else ""
-        for subchild in child.children:
-            if subchild.type == GoNodeType.FIELD_IDENTIFIER:
-                fname = subchild.text.decode()
-                fields[FieldName(fname)] = FieldInfo(
-                    name=FieldName(fname), type_hint=type_hint, has_initializer=False
-                )
+        names = [
+            subchild.text.decode()
+            for subchild in child.children
+            if subchild.type == GoNodeType.FIELD_IDENTIFIER
+        ]
+        if not names and type_node is not None:
+            names = [go_embedded_field_name(type_node)]
+            if any(subchild.type == "*" for subchild in child.children):
+                type_hint = f"*{type_hint}"
+        for fname in names:
+            fields[FieldName(fname)] = FieldInfo(
+                name=FieldName(fname), type_hint=type_hint, has_initializer=False
+            )
     return fields
 
 
+def _go_embedded_types(field_declaration_list) -> tuple[ClassName, ...]:
+    """Types a struct embeds, in declaration order (see embedding.py)."""
+    return tuple(
+        ClassName(go_embedded_field_name(type_node))
+        for child in field_declaration_list.children
+        if child.type == "field_declaration"
+        and not any(c.type == GoNodeType.FIELD_IDENTIFIER for c in child.children)
+        for type_node in [child.child_by_field_name("type")]
+        if type_node is not None
+    )
+
+
 def _extract_go_method_params(params_node) -> tuple[str, ...]:
     """Extract parameter names from a Go parameter_list node (skip receiver)."""
     return tuple(
         subchild.text.decode()
         for child in params_node.children
-        if child.type == GoNodeType.PARAMETER_DECLARATION
+        if child.type
+        in (
+            GoNodeType.PARAMETER_DECLARATION,
+            GoNodeType.VARIADIC_PARAMETER_DECLARATION,
+        )
         for subchild in child.children
         if subchild.type == GoNodeType.IDENTIFIER
     )
 
 
+def _go_spec_doc(spec, declaration) -> str:
+    """The doc comment of one spec of a type, var or const declaration.
+
+    A spec's own comment documents it; failing that, the comment above the
+    declaration does, if the spec is the declaration's only one.
+    """
+    own = doc_comment(spec)
+    if own:
+        return own
+    specs = [c for c in declaration.named_children if c.type not in COMMENT_TYPES]
+    if len(specs) == 1 and specs[0].type == GoNodeType.VAR_SPEC_LIST:
+        specs = [c for c in specs[0].named_children if c.type not in COMMENT_TYPES]
+    return doc_comment(declaration) if len(specs) == 1 else ""
+
+
 def _collect_go_structs(
-    node, classes: dict[ClassName, ClassInfo], methods: dict[FuncName, FunctionInfo]
+    node,
+    classes: dict[ClassName, ClassInfo],
+    methods: dict[FuncName, FunctionInfo],
+    receiver_methods: list[tuple[ClassName, FunctionInfo]],
+    interfaces: dict[ClassName, tuple[FuncName, ...]],
+    docs: dict[str, str],
 ) -> None:
-    """Walk AST to collect structs (as ClassInfo) and top-level/method functions."""
+    """Walk AST to collect structs (as ClassInfo), interfaces and functions.
+
+    Methods are returned in *receiver_methods* rather than attached directly,
+    because a method may be declared before its receiver's struct.  The doc
+    comment of every package-level declaration goes into *docs*.
+    """
 
+    package_level = (
+        node.parent is not None and node.parent.type == GoNodeType.SOURCE_FILE
+    )
     if node.type == GoNodeType.TYPE_DECLARATION:
         for child in node.children:
+            if child.type not in (GoNodeType.TYPE_SPEC, GoNodeType.TYPE_ALIAS):
+                continue
+            name_node = child.child_by_field_name("name")
+            doc = _go_spec_doc(child, node)
+            if package_level and name_node is not None:
+                docs[name_node.text.decode()] = doc
             if child.type == GoNodeType.TYPE_SPEC:
-                name_node = child.child_by_field_name("name")
                 type_node = child.child_by_field_name("type")
                 if (
                     name_node is not None
@@ -504,7 +1467,24 @@ def _collect_go_structs(
                         fields=fields,
                         methods={},
                         constants={},
-                        parents=(),
+                        parents=(
+                            _go_embedded_types(field_list)
+                            if field_list is not None
+                            else ()
+                        ),
+                        doc=doc,
+                    )
+                elif (
+                    name_node is not None
+                    and type_node is not None
+                    and type_node.type == GoNodeType.INTERFACE_TYPE
+                ):
+                    interfaces[ClassName(name_node.text.decode())] = tuple(
+                        FuncName(ident.text.decode())
+                        for elem in type_node.children
+                        if elem.type == GoNodeType.METHOD_ELEM
+                        for ident in elem.children
+                        if ident.type == GoNodeType.FIELD_IDENTIFIER
                     )
     elif node.type == GoNodeType.FUNCTION_DECLARATION:
         name_node = node.child_by_field_name("name")
@@ -516,8 +1496,10 @@ def _collect_go_structs(
                 if params_node is not None
                 else ()
             )
+            doc = doc_comment(node)
+            docs[fname] = doc
             methods[FuncName(fname)] = FunctionInfo(
-                name=FuncName(fname), params=params, return_type=""
+                name=FuncName(fname), params=params, return_type="", doc=doc
             )
     elif node.type == GoNodeType.METHOD_DECLARATION:
         # Attach method to its receiver type
@@ -531,28 +1513,62 @@ def _collect_go_structs(
                 if params_node is not None
                 else ()
             )
-            minfo = FunctionInfo(name=FuncName(mname), params=params, return_type="")
-            # Find receiver type name from parameter_declaration > type_identifier
-            receiver_type = next(
-                (
-                    sub.text.decode().lstrip("*")
-                    for rchild in receiver.children
-                    if rchild.type == GoNodeType.PARAMETER_DECLARATION
-                    for sub in rchild.children
-                    if sub.type == GoNodeType.TYPE_IDENTIFIER
-                ),
-                None,
+            doc = doc_comment(node)
+            minfo = FunctionInfo(
+                name=FuncName(mname), params=params, return_type="", doc=doc
             )
-            if receiver_type is not None and ClassName(receiver_type) in classes:
-                classes[ClassName(receiver_type)].methods[FuncName(mname)] = minfo
+            receiver_type = go_receiver_type_name(receiver)
+            if receiver_type:
+                receiver_methods.append((ClassName(receiver_type), minfo))
+                docs[f"{receiver_type}.{mname}"] = doc
+    elif package_level and node.type in (
+        GoNodeType.VAR_DECLARATION,
+        GoNodeType.CONST_DECLARATION,
+    ):
+        specs = (
+            go_var_specs(node)
+            if node.type == GoNodeType.VAR_DECLARATION
+            else [c for c in node.children if c.type == GoNodeType.CONST_SPEC]
+        )
+        for spec in specs:
+            doc = _go_spec_doc(spec, node)
+            for name_node in spec.children_by_field_name("name"):
+                if name_node.text != b"_":
+                    docs[name_node.text.decode()] = doc
     for child in node.children:
-        _collect_go_structs(child, classes, methods)
+        _collect_go_structs(child, classes, methods, receiver_methods, interfaces, docs)
+
 
+# The predeclared ``error`` interface, satisfied by any ``Error() string``.
+GO_ERROR_INTERFACE = ClassName("error")
 
-def extract_go_symbols(root) -> SymbolTable:
-    """Walk the Go AST and return a SymbolTable of all struct and function definitions."""
+
+def extract_go_symbols(*roots) -> SymbolTable:
+    """Walk Go ASTs and return a SymbolTable of all struct and function definitions.
+
+    Several roots — the files of one package — share one table, so a method
+    may be declared in a different file from its receiver's struct.  The
+    predeclared ``error`` interface is included unless the files declare
+    their own ``error`` type.
+    """
 
     classes: dict[ClassName, ClassInfo] = {}
     top_level_functions: dict[FuncName, FunctionInfo] = {}
-    _collect_go_structs(root, classes, top_level_functions)
-    return SymbolTable(classes=classes, functions=top_level_functions)
+    receiver_methods: list[tuple[ClassName, FunctionInfo]] = []
+    interfaces: dict[ClassName, tuple[FuncName, ...]] = {
+        GO_ERROR_INTERFACE: (FuncName("Error"),)
+    }
+    docs: dict[str, str] = {}
+    for root in roots:
+        _collect_go_structs(
+            root, classes, top_level_functions, receiver_methods, interfaces, docs
+        )
+    for receiver_type, minfo in receiver_methods:
+        if receiver_type in classes:
+            classes[receiver_type].methods[minfo.name] = minfo
+    return SymbolTable(
+        classes=classes,
+        functions=top_level_functions,
+        interfaces=interfaces,
+        docs=docs,
+    )
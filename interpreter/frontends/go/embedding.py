@@ -0,0 +1,234 @@
+# pyright: standard
+"""Go struct embedding: promoted fields and methods.
+
+A field declared by its type alone (``Inner``, ``*Inner``, ``geo.Point``)
+is an embedded field named after the type; the symbol table lists it
+among the struct's fields and, by name, in ``ClassInfo.parents``.  The
+fields and methods of an embedded type are promoted to the outer struct:
+``o.X`` selects the X at the shallowest embedding depth, and is ambiguous
+when that depth has more than one.
+
+Promoted fields are resolved statically: ``o.X`` is lowered as
+``o.Inner.X`` when the struct type of ``o`` is known.  Promoted methods
+are part of the outer struct's method set, so it satisfies interfaces
+through them; its class gets a forwarding method for each, which calls
+the method on the embedded value, so calls dispatched at run time reach
+it too.
+"""
+
+from __future__ import annotations
+
+from interpreter.class_name import ClassName
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.field_name import FieldName
+from interpreter.frontends.compile_error import CompileError
+from interpreter.frontends.context import TreeSitterEmitContext
+from interpreter.frontends.go.node_types import GoNodeType
+from interpreter.frontends.go.packages import go_project_type
+from interpreter.func_name import FuncName
+from interpreter.types.type_expr import ParameterizedType, ScalarType
+
+
+class GoAmbiguousSelectorError(CompileError):
+    """``o.X`` names an X promoted at the same depth through two embedded fields."""
+
+    code = DiagnosticCode.AMBIGUOUS_SELECTOR
+
+    def __init__(self, selector: str):
+        super().__init__(f"ambiguous selector {selector}")
+
+
+def go_embedded_field_name(type_node) -> str:
+    """Name of the field that embeds *type_node*: ``pkg.T`` and ``T[int]`` are T."""
+    if type_node.type == GoNodeType.QUALIFIED_TYPE:
+        type_node = type_node.child_by_field_name("name")
+    elif type_node.type == GoNodeType.GENERIC_TYPE:
+        type_node = type_node.child_by_field_name("type")
+    return type_node.text.decode() if type_node is not None else ""
+
+
+def _go_declares(ctx: TreeSitterEmitContext, type_name: ClassName, member: str) -> str:
+    """``"field"`` or ``"method"`` when *type_name* itself declares *member*, else "".
+
+    An embedded interface contributes its methods.
+    """
+    class_info = ctx.symbol_table.classes.get(type_name)
+    if class_info is not None:
+        if FieldName(member) in class_info.fields:
+            return "field"
+        return "method" if FuncName(member) in class_info.methods else ""
+    interface = ctx.symbol_table.interfaces.get(type_name, ())
+    return "method" if FuncName(member) in interface else ""
+
+
+def _go_promotions(
+    ctx: TreeSitterEmitContext, type_name: ClassName, member: str
+) -> list[tuple[tuple[FieldName, ...], str]]:
+    """Embedding paths to the shallowest declarations of *member*, with their kind.
+
+    A path lists the embedded fields to select, from *type_name* down to
+    the type declaring *member*; it is empty when *type_name* declares it.
+    Types already searched at a shallower depth are not searched again,
+    so recursive embedding through pointers terminates.
+    """
+    level: list[tuple[tuple[FieldName, ...], ClassName]] = [((), type_name)]
+    seen: set[ClassName] = set()
+    while level:
+        found = [
+            (path, kind)
+            for path, owner in level
+            for kind in [_go_declares(ctx, owner, member)]
+            if kind
+        ]
+        if found:
+            return found
+        seen.update(owner for _, owner in level)
+        level = [
+            (path + (FieldName(embedded.value),), embedded)
+            for path, owner in level
+            if owner in ctx.symbol_table.classes
+            for embedded in ctx.symbol_table.classes[owner].parents
+            if embedded not in seen
+        ]
+    return []
+
+
+def go_selector_path(
+    ctx: TreeSitterEmitContext, type_name: str, member: str, selector: str
+) -> tuple[FieldName, ...]:
+    """Embedded fields through which struct *type_name* reaches *member*.
+
+    Empty when the struct declares *member* itself, or when no embedded
+    type declares it.  Raises GoAmbiguousSelectorError, naming *selector*,
+    when two embedded fields promote it at the same depth.
+    """
+    promotions = _go_promotions(ctx, ClassName(type_name), member)
+    if len(promotions) > 1:
+        raise GoAmbiguousSelectorError(selector)
+    return promotions[0][0] if promotions else ()
+
+
+def go_promoted_field(
+    ctx: TreeSitterEmitContext, type_name: str, member: str
+) -> tuple[FieldName, ...]:
+    """Embedded fields through which struct *type_name* reaches field *member*.
+
+    Empty unless *member* is a field promoted from exactly one embedded
+    field at the shallowest depth.
+    """
+    promotions = _go_promotions(ctx, ClassName(type_name), member)
+    if len(promotions) != 1:
+        return ()
+    path, kind = promotions[0]
+    return path if kind == "field" else ()
+
+
+def go_promoted_methods(
+    ctx: TreeSitterEmitContext, type_name: str
+) -> dict[FuncName, tuple[FieldName, ...]]:
+    """Methods struct *type_name* gets from its embedded fields, with their paths.
+
+    A method the struct declares itself, or that a field of the same name
+    shadows, is not promoted, nor is one promoted ambiguously.
+    """
+    outer = ctx.symbol_table.classes.get(ClassName(type_name))
+    candidates: list[FuncName] = []
+    pending = list(outer.parents) if outer is not None else []
+    visited: set[ClassName] = set()
+    while pending:
+        embedded = pending.pop(0)
+        if embedded in visited:
+            continue
+        visited.add(embedded)
+        class_info = ctx.symbol_table.classes.get(embedded)
+        methods = (
+            class_info.methods
+            if class_info is not None
+            else ctx.symbol_table.interfaces.get(embedded, ())
+        )
+        candidates.extend(m for m in methods if m not in candidates)
+        pending.extend(class_info.parents if class_info is not None else ())
+    promoted: dict[FuncName, tuple[FieldName, ...]] = {}
+    for method in candidates:
+        promotions = _go_promotions(ctx, ClassName(type_name), str(method))
+        if len(promotions) != 1:
+            continue
+        path, kind = promotions[0]
+        if path and kind == "method":
+            promoted[method] = path
+    return promoted
+
+
+def go_method_set(ctx: TreeSitterEmitContext, type_name: ClassName) -> set[FuncName]:
+    """Methods of struct *type_name*, declared and promoted."""
+    class_info = ctx.symbol_table.classes[type_name]
+    return set(class_info.methods) | set(go_promoted_methods(ctx, type_name.value))
+
+
+def go_struct_type(ctx: TreeSitterEmitContext, node) -> str:
+    """Name of the struct type *node* statically has, through pointers; "" if unknown.
+
+    Covers variables of a known type, ``T{...}``, ``&T{...}`` and ``*p``, elements
+    of slices of structs, and fields of struct type, promoted or not.
+    """
+    if node is None:
+        return ""
+    if node.type == GoNodeType.PARENTHESIZED_EXPRESSION:
+        return go_struct_type(ctx, next((c for c in node.children if c.is_named), None))
+    if node.type == GoNodeType.UNARY_EXPRESSION:
+        operand = node.child_by_field_name("operand")
+        operator = node.child_by_field_name("operator")
+        if operator is not None and ctx.node_text(operator) in ("&", "*"):
+            return go_struct_type(ctx, operand)
+        return ""
+    if node.type == GoNodeType.COMPOSITE_LITERAL:
+        type_node = node.child_by_field_name("type")
+        if type_node is not None and type_node.type == GoNodeType.QUALIFIED_TYPE:
+            return _go_struct_name(ctx, go_project_type(ctx, type_node))
+        return _go_struct_name(ctx, ctx.node_text(type_node) if type_node else "")
+    if node.type == GoNodeType.IDENTIFIER:
+        var_name = ctx.resolve_var(ctx.node_text(node))
+        var_type = ctx.type_env_builder.var_types.get(var_name)
+        return _go_struct_name(ctx, _go_pointee(var_type))
+    if node.type == GoNodeType.INDEX_EXPRESSION:
+        operand = node.child_by_field_name("operand")
+        if operand is None or operand.type != GoNodeType.IDENTIFIER:
+            return ""
+        var_name = ctx.resolve_var(ctx.node_text(operand))
+        var_type = ctx.type_env_builder.var_types.get(var_name)
+        if isinstance(var_type, ParameterizedType) and var_type.constructor == "Array":
+            return _go_struct_name(ctx, _go_pointee(var_type.arguments[0]))
+        return ""
+    if node.type == GoNodeType.SELECTOR_EXPRESSION:
+        return _go_field_struct_type(ctx, node)
+    return ""
+
+
+def _go_field_struct_type(ctx: TreeSitterEmitContext, node) -> str:
+    """Struct type of the field ``o.f`` selects, when o's struct type is known."""
+    owner = go_struct_type(ctx, node.child_by_field_name("operand"))
+    field_node = node.child_by_field_name("field")
+    if not owner or field_node is None:
+        return ""
+    field_name = ctx.node_text(field_node)
+    path = go_selector_path(ctx, owner, field_name, ctx.node_text(node))
+    for embedded in path:
+        owner = embedded.value
+    class_info = ctx.symbol_table.classes.get(ClassName(owner))
+    field_info = class_info.fields.get(FieldName(field_name)) if class_info else None
+    if field_info is None:
+        return ""
+    return _go_struct_name(ctx, field_info.type_hint.lstrip("*").split(".")[-1])
+
+
+def _go_pointee(type_expr) -> str:
+    """Name of a scalar type, or of the scalar a Pointer[...] points to."""
+    if isinstance(type_expr, ParameterizedType) and type_expr.constructor == "Pointer":
+        type_expr = type_expr.arguments[0]
+    return type_expr.name.value if isinstance(type_expr, ScalarType) else ""
+
+
+def _go_struct_name(ctx: TreeSitterEmitContext, type_name: str) -> str:
+    """*type_name*, bound through the type map, if it is a declared struct."""
+    type_name = ctx.type_map.get(type_name, type_name)
+    return type_name if ClassName(type_name) in ctx.symbol_table.classes else ""
@@ -3,37 +3,82 @@
 from __future__ import annotations
 
 import logging
+import operator
+from collections.abc import Callable
 from typing import Any
 
 from interpreter import constants
-from interpreter.field_name import FieldName
+from interpreter.class_name import ClassName
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.field_name import FieldKind, FieldName
 from interpreter.frontends.common.declarations import emit_implicit_return
 from interpreter.frontends.common.expressions import (
-    extract_call_args,
+    lower_binop,
+    lower_float_literal,
+    lower_int_literal,
+    lower_spread_arg,
     lower_string_literal,
+    lower_unop,
 )
+from interpreter.frontends.compile_error import CompileError
 from interpreter.frontends.context import TreeSitterEmitContext
+from interpreter.frontends.go.embedding import (
+    go_method_set,
+    go_promoted_field,
+    go_selector_path,
+    go_struct_type,
+)
 from interpreter.frontends.go.node_types import GoNodeType
+from interpreter.frontends.go.packages import (
+    go_project_member,
+    go_project_type,
+    go_stdlib_const,
+    go_stdlib_func,
+    go_stdlib_result_type,
+)
+from interpreter.frontends.symbol_table import ClassInfo
+from interpreter.frontends.type_extraction import normalize_type_hint
 from interpreter.func_name import FuncName
 from interpreter.instructions import (
+    AddressOf,
+    Binop,
     Branch,
+    BranchIf,
     CallCtorFunction,
     CallFunction,
     CallMethod,
     CallUnknown,
     Const,
+    DeclVar,
     Label_,
     LoadField,
     LoadIndex,
+    LoadIndirect,
+    LoadVar,
     NewArray,
     NewObject,
     StoreField,
     StoreIndex,
+    StoreIndirect,
     StoreVar,
+    Throw_,
 )
+from interpreter.operator_kind import resolve_binop
 from interpreter.register import Register
 from interpreter.type_name import TypeName
-from interpreter.types.type_expr import TypeExpr, array_of, map_of, scalar
+from interpreter.types.type_expr import (
+    UNKNOWN,
+    FunctionType,
+    ParameterizedType,
+    ScalarType,
+    TypeExpr,
+    array_of,
+    fn_type,
+    map_of,
+    pointer,
+    scalar,
+    tuple_of,
+)
 from interpreter.var_name import VarName
 
 
@@ -49,224 +94,2229 @@ def lower_go_iota(
 
 logger = logging.getLogger(__name__)
 
+_GO_ARRAY_TYPES = frozenset(
+    {GoNodeType.ARRAY_TYPE, GoNodeType.IMPLICIT_LENGTH_ARRAY_TYPE}
+)
+GO_SEQUENCE_TYPES = _GO_ARRAY_TYPES | {GoNodeType.SLICE_TYPE}
+GO_COLLECTION_TYPES = GO_SEQUENCE_TYPES | {
+    GoNodeType.MAP_TYPE,
+    GoNodeType.CHANNEL_TYPE,
+}
+
 
-def _parse_go_type(ctx: TreeSitterEmitContext, type_node) -> TypeExpr:
+def parse_go_type(ctx: TreeSitterEmitContext, type_node) -> TypeExpr:
     """Convert a Go tree-sitter type node into a TypeExpr.
 
-    Handles slice_type ([]T → Array[T]), map_type (map[K]V → Map[K, V]),
-    and falls back to scalar(text) for simple type identifiers.
+    Handles slice_type ([]T → Array[T]), array_type ([N]T → Array[T]),
+    map_type (map[K]V → Map[K, V]), pointer_type (*T → Pointer[T]),
+    channel_type (chan T / <-chan T / chan<- T → Chan[T]), and falls back
+    to scalar(text) for simple type identifiers; ``pkg.T`` of a project
+    package is plain T.
     """
 
+    if type_node.type in _GO_ARRAY_TYPES:
+        elem_node = type_node.child_by_field_name("element")
+        if elem_node:
+            return array_of(parse_go_type(ctx, elem_node))
+        return array_of(scalar(TypeName(ctx.node_text(type_node))))
+
     if type_node.type == "slice_type":
         named = [c for c in type_node.children if c.is_named]
         if named:
-            elem = _parse_go_type(ctx, named[0])
+            elem = parse_go_type(ctx, named[0])
             return array_of(elem)
         return array_of(scalar(TypeName(ctx.node_text(type_node))))
 
     if type_node.type == "map_type":
         named = [c for c in type_node.children if c.is_named]
         if len(named) >= 2:
-            return map_of(_parse_go_type(ctx, named[0]), _parse_go_type(ctx, named[1]))
+            return map_of(parse_go_type(ctx, named[0]), parse_go_type(ctx, named[1]))
         return scalar(TypeName(ctx.node_text(type_node)))
 
+    if type_node.type == GoNodeType.POINTER_TYPE:
+        named = [c for c in type_node.children if c.is_named]
+        if named:
+            return pointer(parse_go_type(ctx, named[0]))
+
+    if type_node.type == GoNodeType.CHANNEL_TYPE:
+        elem_node = type_node.child_by_field_name("value")
+        if elem_node:
+            return ParameterizedType("Chan", (parse_go_type(ctx, elem_node),))
+
+    if type_node.type == GoNodeType.QUALIFIED_TYPE:
+        type_name = go_project_type(ctx, type_node)
+        if type_name:
+            return scalar(TypeName(type_name))
+
     return scalar(TypeName(ctx.node_text(type_node)))
 
 
+def go_type_hint(ctx: TreeSitterEmitContext, type_node) -> TypeExpr:
+    """TypeExpr for a declared Go type.
+
+    Arrays, slices and maps get structured Array[...] / Map[...] types,
+    pointers Pointer[...] and function types Fn(...) -> R, and ``pkg.T``
+    of a project package is T; everything else is canonicalised through
+    the type map.
+    """
+    if type_node is None:
+        return UNKNOWN
+    if type_node.type in GO_COLLECTION_TYPES:
+        return parse_go_type(ctx, type_node)
+    if type_node.type == GoNodeType.POINTER_TYPE:
+        named = [c for c in type_node.children if c.is_named]
+        if named:
+            return pointer(go_type_hint(ctx, named[0]))
+    if type_node.type == GoNodeType.FUNCTION_TYPE:
+        return _go_function_type(ctx, type_node)
+    if type_node.type == GoNodeType.PARAMETER_LIST:
+        return _go_result_list_type(ctx, type_node)
+    if type_node.type == GoNodeType.QUALIFIED_TYPE and go_project_type(ctx, type_node):
+        return parse_go_type(ctx, type_node)
+    return normalize_type_hint(ctx.node_text(type_node), ctx.type_map)
+
+
+def _go_param_types(ctx: TreeSitterEmitContext, params_node) -> list[TypeExpr]:
+    """One TypeExpr per parameter of a parameter_list (``a, b int`` is two)."""
+    types: list[TypeExpr] = []
+    for child in params_node.children:
+        if child.type == GoNodeType.VARIADIC_PARAMETER_DECLARATION:
+            type_node = child.child_by_field_name("type")
+            types.append(array_of(go_type_hint(ctx, type_node)))
+        elif child.type == GoNodeType.PARAMETER_DECLARATION:
+            hint = go_type_hint(ctx, child.child_by_field_name("type"))
+            count = max(len(child.children_by_field_name("name")), 1)
+            types.extend([hint] * count)
+    return types
+
+
+def _go_result_list_type(ctx: TreeSitterEmitContext, result_node) -> TypeExpr:
+    """Type of a result list: ``(int)`` is Int, ``(int, error)`` a Tuple."""
+    types = _go_param_types(ctx, result_node)
+    if not types:
+        return UNKNOWN
+    return types[0] if len(types) == 1 else tuple_of(*types)
+
+
+def go_result_types(ctx: TreeSitterEmitContext, result_node) -> tuple[TypeExpr, ...]:
+    """One TypeExpr per result of a function: ``int`` is one, ``(int, error)`` two."""
+    if result_node is None:
+        return ()
+    if result_node.type == GoNodeType.PARAMETER_LIST:
+        return tuple(_go_param_types(ctx, result_node))
+    return (go_type_hint(ctx, result_node),)
+
+
+def go_call_result_types(
+    ctx: TreeSitterEmitContext, node
+) -> tuple[TypeExpr, ...] | None:
+    """Declared result types of a call to a top-level function, or None.
+
+    Only plain calls ``f(...)`` of functions recorded in
+    ``ctx.go_func_results`` are known; methods and closures are not.
+    """
+    if node.type != GoNodeType.CALL_EXPRESSION:
+        return None
+    func_node = node.child_by_field_name("function")
+    if func_node is None or func_node.type != GoNodeType.IDENTIFIER:
+        return None
+    return ctx.go_func_results.get(ctx.node_text(func_node))
+
+
+def _go_function_type(ctx: TreeSitterEmitContext, type_node) -> FunctionType:
+    """``func(int, string) bool`` -> Fn(Int, String) -> Bool."""
+    params_node = type_node.child_by_field_name("parameters")
+    result_node = type_node.child_by_field_name("result")
+    params = _go_param_types(ctx, params_node) if params_node is not None else []
+    return fn_type(params, go_type_hint(ctx, result_node))
+
+
 # -- Go: call expression ---------------------------------------------------
 
 
-def lower_go_call(
-    ctx: TreeSitterEmitContext, node: Any
-) -> Register:  # Any: tree-sitter node — untyped at Python boundary
-    func_node = node.child_by_field_name(ctx.constants.call_function_field)
-    args_node = node.child_by_field_name(ctx.constants.call_arguments_field)
+_NUMERIC_CONVERSION_BUILTINS: dict[str, str] = {
+    constants.FoundationTypeName.INT.value: "int",
+    constants.FoundationTypeName.FLOAT.value: "float",
+}
 
-    # Desugar make(): emit NEW_OBJECT or NEW_ARRAY based on type argument.
-    # Must intercept BEFORE extract_call_args to avoid lowering type nodes as expressions.
-    if (
-        func_node
-        and func_node.type == GoNodeType.IDENTIFIER
-        and ctx.node_text(func_node) == "make"
-        and args_node
+
+GO_BUILTIN_FUNCS: dict[str, str] = {
+    "len": "__go_len__",
+    "cap": "__go_cap__",
+    "delete": "__go_map_delete__",
+    "recover": "__go_recover__",
+}
+
+_GO_BUILTIN_RESULT_TYPES: dict[str, TypeExpr] = {
+    "len": scalar(constants.FoundationTypeName.INT),
+    "cap": scalar(constants.FoundationTypeName.INT),
+}
+
+
+# -- Go: named types and aliases -------------------------------------------
+
+
+# Conversion builtin for each underlying type.  string(x) goes through
+# __go_string__, which turns an integer into the UTF-8 encoding of that
+# code point rather than its decimal digits.
+_CONVERSION_BUILTINS: dict[str, str] = {
+    **_NUMERIC_CONVERSION_BUILTINS,
+    constants.FoundationTypeName.STRING.value: "__go_string__",
+    constants.FoundationTypeName.BOOL.value: "bool",
+}
+
+# Underlying type of a conversion → underlying types it accepts.
+_GO_NUMERIC_KINDS = frozenset(_NUMERIC_CONVERSION_BUILTINS)
+_GO_CONVERTIBLE: dict[str, frozenset[str]] = {
+    **dict.fromkeys(_GO_NUMERIC_KINDS, _GO_NUMERIC_KINDS),
+    constants.FoundationTypeName.STRING.value: frozenset(
+        {
+            constants.FoundationTypeName.INT.value,
+            constants.FoundationTypeName.STRING.value,
+        }
+    ),
+    constants.FoundationTypeName.BOOL.value: frozenset(
+        {constants.FoundationTypeName.BOOL.value}
+    ),
+}
+
+# Go's own names for the canonical types, used in compiler-style messages.
+_GO_TYPE_NAMES: dict[str, str] = {
+    constants.FoundationTypeName.INT.value: "int",
+    constants.FoundationTypeName.FLOAT.value: "float64",
+    constants.FoundationTypeName.STRING.value: "string",
+    constants.FoundationTypeName.BOOL.value: "bool",
+}
+
+_GO_PREDECLARED_TYPES = frozenset(
+    {
+        constants.FoundationTypeName.INT.value,
+        constants.FoundationTypeName.FLOAT.value,
+        constants.FoundationTypeName.STRING.value,
+        constants.FoundationTypeName.BOOL.value,
+    }
+)
+
+
+class GoTypeMismatchError(CompileError):
+    """A value of one named type is used where another is required.
+
+    Go only converts between a named type and its underlying type (or
+    another named type) explicitly, so the compiler rejects the program.
+    """
+
+    code = DiagnosticCode.TYPE_MISMATCH
+
+    def __init__(
+        self,
+        expr: str,
+        kind: str,
+        value_type: TypeExpr,
+        target_type: TypeExpr,
+        where: str,
     ):
-        type_args = [c for c in args_node.children if c.is_named]
-        if type_args:
-            type_node = type_args[0]
-            if type_node.type == "slice_type":
-                elem_type = _parse_go_type(ctx, type_node)
-                reg = ctx.fresh_reg()
-                size_reg = (
-                    ctx.lower_expr(type_args[1])
-                    if len(type_args) > 1
-                    else ctx.fresh_reg()
-                )
-                ctx.emit_inst(
-                    NewArray(result_reg=reg, type_hint=elem_type, size_reg=size_reg),
-                    node=node,
-                )
-                return reg
-            type_hint = _parse_go_type(ctx, type_node)
-            reg = ctx.fresh_reg()
-            ctx.emit_inst(NewObject(result_reg=reg, type_hint=type_hint), node=node)
-            return reg
+        super().__init__(
+            f"cannot use {expr} ({kind} of type {value_type}) "
+            f"as {target_type} value in {where}"
+        )
 
-    arg_regs = extract_call_args(ctx, args_node) if args_node else []
 
-    # Method call via selector: obj.Method(...)
-    if func_node and func_node.type == GoNodeType.SELECTOR_EXPRESSION:
-        operand_node = func_node.child_by_field_name("operand")
-        field_node = func_node.child_by_field_name("field")
-        if operand_node and field_node:
-            obj_reg = ctx.lower_expr(operand_node)
-            method_name = ctx.node_text(field_node)
-            reg = ctx.fresh_reg()
-            ctx.emit_inst(
-                CallMethod(
-                    result_reg=reg,
-                    obj_reg=obj_reg,
-                    method_name=FuncName(method_name),
-                    args=tuple(arg_regs),
-                ),
-                node=node,
+def _go_declared_types(ctx: TreeSitterEmitContext) -> dict[TypeName, TypeExpr]:
+    """Named types and aliases declared anywhere in the file."""
+    return {**ctx.type_env_builder.named_types, **ctx.type_env_builder.type_aliases}
+
+
+def _resolve_go_aliases(ctx: TreeSitterEmitContext, type_expr: TypeExpr) -> TypeExpr:
+    """Follow aliases until *type_expr* names a type that is not an alias."""
+    aliases = ctx.type_env_builder.type_aliases
+    seen: set[TypeName] = set()
+    while isinstance(type_expr, ScalarType) and type_expr.name in aliases:
+        if type_expr.name in seen:
+            break
+        seen.add(type_expr.name)
+        type_expr = aliases[type_expr.name]
+    return type_expr
+
+
+def go_underlying_type(ctx: TreeSitterEmitContext, type_name: str) -> str:
+    """Canonical name of *type_name*'s underlying type (``MyInt`` → ``Int``).
+
+    Follows named types and aliases declared anywhere in the file and
+    canonicalises predeclared types through the type map.  Returns "" when
+    *type_name* is not a known type, e.g. the name of a function.
+    """
+    declared = _go_declared_types(ctx)
+    if type_name not in ctx.type_map and TypeName(type_name) not in declared:
+        return ""
+    resolved = normalize_type_hint(type_name, ctx.type_map)
+    seen: set[TypeName] = set()
+    while isinstance(resolved, ScalarType) and resolved.name in declared:
+        if resolved.name in seen:
+            break
+        seen.add(resolved.name)
+        resolved = declared[resolved.name]
+    return str(resolved) if isinstance(resolved, ScalarType) else ""
+
+
+def go_conversion_builtin(ctx: TreeSitterEmitContext, type_name: str) -> str:
+    """Builtin that performs the conversion ``type_name(x)``, or "".
+
+    Predeclared types map onto the int/float/bool builtins and
+    ``__go_string__``; a declared named type or alias converts through its
+    underlying type, so ``Celsius(f)`` and ``UserName(s)`` execute concretely.
+    """
+    return _CONVERSION_BUILTINS.get(go_underlying_type(ctx, type_name), "")
+
+
+class GoConversionError(CompileError):
+    """An explicit conversion Go does not allow, e.g. ``int("7")``.
+
+    Numeric types convert to each other, integers and strings to strings,
+    and bool only to bool, so the compiler rejects anything else.
+    """
+
+    code = DiagnosticCode.INVALID_CONVERSION
+
+
+_GO_UNTYPED_CONSTANTS: dict[str, tuple[str, str]] = {
+    GoNodeType.INT_LITERAL: (constants.FoundationTypeName.INT.value, "int"),
+    GoNodeType.FLOAT_LITERAL: (constants.FoundationTypeName.FLOAT.value, "float"),
+    GoNodeType.RUNE_LITERAL: (constants.FoundationTypeName.INT.value, "rune"),
+    GoNodeType.INTERPRETED_STRING_LITERAL: (
+        constants.FoundationTypeName.STRING.value,
+        "string",
+    ),
+    GoNodeType.RAW_STRING_LITERAL: (
+        constants.FoundationTypeName.STRING.value,
+        "string",
+    ),
+    GoNodeType.TRUE: (constants.FoundationTypeName.BOOL.value, "bool"),
+    GoNodeType.FALSE: (constants.FoundationTypeName.BOOL.value, "bool"),
+}
+
+
+def _go_conversion_operand(ctx: TreeSitterEmitContext, node) -> tuple[str, str]:
+    """Underlying type of a conversion operand and how Go describes it.
+
+    Returns ("", "") when the operand's type is not statically known.
+    """
+    if node.type in _GO_UNTYPED_CONSTANTS:
+        underlying, kind = _GO_UNTYPED_CONSTANTS[node.type]
+        return underlying, f"untyped {kind} constant"
+    static_type = go_static_type(ctx, node)
+    if not isinstance(static_type, ScalarType):
+        return "", ""
+    type_name = str(static_type)
+    underlying = go_underlying_type(ctx, type_name)
+    kind = "variable" if node.type == GoNodeType.IDENTIFIER else "value"
+    return underlying, f"{kind} of type {go_type_name(type_name)}"
+
+
+def go_type_name(type_name: str) -> str:
+    """Go's spelling of the canonical *type_name* (``Float`` → ``float64``)."""
+    return _GO_TYPE_NAMES.get(type_name, type_name)
+
+
+def check_go_conversion(
+    ctx: TreeSitterEmitContext, type_name: str, operand_node
+) -> None:
+    """Reject ``type_name(x)`` when x's type cannot convert to *type_name*.
+
+    Raises GoConversionError, e.g. ``cannot convert s (variable of type
+    string) to type int``, or GoConstantOverflowError for a constant that
+    does not fit (``int8(300)``).  Operands of unknown type are accepted.
+    """
+    check_go_constant(ctx, operand_node, type_name)
+    target = go_underlying_type(ctx, type_name)
+    underlying, description = _go_conversion_operand(ctx, operand_node)
+    if not underlying or underlying in _GO_CONVERTIBLE.get(target, {underlying}):
+        return
+    raise GoConversionError(
+        f"cannot convert {ctx.node_text(operand_node)} ({description}) "
+        f"to type {type_name}"
+    )
+
+
+def go_static_type(ctx: TreeSitterEmitContext, node) -> TypeExpr:
+    """Declared type of a typed expression, or UNKNOWN.
+
+    Covers variables with a declared or propagated type, explicit
+    conversions such as ``MyInt(n)`` and ``[]byte(s)``, ``len`` and ``cap``,
+    standard library calls, bytes indexed out of a string and substrings;
+    literals and other expressions are untyped here.
+    """
+    if node is None:
+        return UNKNOWN
+    if node.type == GoNodeType.PARENTHESIZED_EXPRESSION:
+        inner = next((c for c in node.children if c.is_named), None)
+        return go_static_type(ctx, inner)
+    if node.type == GoNodeType.IDENTIFIER:
+        var_name = ctx.resolve_var(ctx.node_text(node))
+        return ctx.type_env_builder.var_types.get(var_name, UNKNOWN)
+    if node.type == GoNodeType.CALL_EXPRESSION:
+        func_node = node.child_by_field_name("function")
+        if func_node is not None and func_node.type == GoNodeType.IDENTIFIER:
+            func_name = ctx.node_text(func_node)
+            if go_conversion_builtin(ctx, func_name):
+                return normalize_type_hint(func_name, ctx.type_map)
+            if func_name in _GO_BUILTIN_RESULT_TYPES:
+                return _GO_BUILTIN_RESULT_TYPES[func_name]
+        if func_node is not None and func_node.type == GoNodeType.SELECTOR_EXPRESSION:
+            operand_node = func_node.child_by_field_name("operand")
+            field_node = func_node.child_by_field_name("field")
+            stdlib_func = (
+                go_stdlib_func(ctx, operand_node, ctx.node_text(field_node))
+                if operand_node is not None and field_node is not None
+                else ""
             )
-            return reg
+            if stdlib_func:
+                return go_stdlib_result_type(stdlib_func)
+    if node.type == GoNodeType.INDEX_EXPRESSION:
+        operand_node = node.child_by_field_name("operand")
+        if operand_node is not None and _is_go_string_operand(ctx, operand_node):
+            return normalize_type_hint("byte", ctx.type_map)
+    if node.type == GoNodeType.SLICE_EXPRESSION:
+        operand_node = node.child_by_field_name("operand")
+        if operand_node is not None and _is_go_string_operand(ctx, operand_node):
+            return go_default_type(ctx, operand_node)
+    if node.type == GoNodeType.TYPE_CONVERSION_EXPRESSION:
+        type_node = node.child_by_field_name("type")
+        type_text = ctx.node_text(type_node).replace(" ", "") if type_node else ""
+        if type_text in _GO_STRING_SPLIT_CONVERSIONS:
+            return array_of(scalar(constants.FoundationTypeName.INT))
+    return UNKNOWN
+
+
+_GO_STRING_LITERALS = frozenset(
+    {GoNodeType.INTERPRETED_STRING_LITERAL, GoNodeType.RAW_STRING_LITERAL}
+)
 
-    # Plain function call
-    if func_node and func_node.type == GoNodeType.IDENTIFIER:
-        func_name = ctx.node_text(func_node)
 
+def go_default_type(ctx: TreeSitterEmitContext, node) -> TypeExpr:
+    """Type a ``:=`` or untyped ``var`` takes from its initializer *node*.
+
+    Like go_static_type, but an untyped string literal gives its default
+    type ``string``, so ``s := "GATTACA"`` makes ``s[i]`` index bytes, and
+    ``T{...}`` of a declared struct gives T and ``&T{...}`` or ``&t`` *T,
+    so fields promoted from T's embedded structs resolve.  Any other
+    untyped constant takes its default type, and operator expressions the
+    type their operands give them (``_go_operation_type``).
+    """
+    if node is not None and node.type in _GO_STRING_LITERALS:
+        return scalar(constants.FoundationTypeName.STRING)
+    if node is not None and node.type == GoNodeType.COMPOSITE_LITERAL:
+        struct_type = go_struct_type(ctx, node)
+        if struct_type:
+            return scalar(TypeName(struct_type))
+    if node is not None and node.type == GoNodeType.UNARY_EXPRESSION:
+        operand = node.child_by_field_name("operand")
+        operator = node.child_by_field_name("operator")
+        if (
+            operand is not None
+            and operator is not None
+            and ctx.node_text(operator) == "&"
+            and not _is_go_pointer_operand(ctx, operand)
+        ):
+            struct_type = go_struct_type(ctx, operand)
+            if struct_type:
+                return pointer(scalar(TypeName(struct_type)))
+    if node is None:
+        return UNKNOWN
+    return (
+        go_static_type(ctx, node)
+        or _go_constant_default_type(ctx, node)
+        or _go_operation_type(ctx, node)
+    )
+
+
+# The type an untyped constant defaults to, by the kind of its folded value.
+# A rune constant folds to an int, and both default to the canonical Int.
+_GO_CONSTANT_DEFAULTS: dict[type, str] = {
+    bool: "bool",
+    int: "int",
+    float: "float64",
+    str: "string",
+}
+
+_GO_COMPARISON_OPS = frozenset({"==", "!=", "<", "<=", ">", ">="})
+_GO_SHIFT_OPS = frozenset({"<<", ">>"})
+_GO_SIGN_OPS = frozenset({"+", "-", "^"})
+
+
+def _go_constant_default_type(ctx: TreeSitterEmitContext, node) -> TypeExpr:
+    """Default type of untyped constant expression *node*, or UNKNOWN.
+
+    The constant is folded, so its kind follows Go's rules: ``1 + 2.5`` is a
+    float constant and ``x := 1 + 2.5`` declares a float64, ``7 / 2`` is an
+    int constant, and a comparison of constants an untyped bool.
+    """
+    kind = _GO_CONSTANT_DEFAULTS.get(type(fold_go_const(ctx, node)), "")
+    return normalize_type_hint(kind, ctx.type_map)
+
+
+def _go_operation_type(ctx: TreeSitterEmitContext, node) -> TypeExpr:
+    """Type of a non-constant operator expression *node*, or UNKNOWN.
+
+    A comparison or ``!``, ``&&`` and ``||`` give bool; a shift has the type
+    of its left operand, and other arithmetic the type of its first typed
+    operand, so with ``n`` a float64, ``n * 2`` is a float64.
+    """
+    if node.type == GoNodeType.PARENTHESIZED_EXPRESSION:
+        inner = next((c for c in node.children if c.is_named), None)
+        return go_default_type(ctx, inner)
+    op_node = node.child_by_field_name("operator")
+    op = ctx.node_text(op_node) if op_node is not None else ""
+    bool_type = normalize_type_hint("bool", ctx.type_map)
+    if node.type == GoNodeType.UNARY_EXPRESSION:
+        if op == "!":
+            return bool_type
+        if op in _GO_SIGN_OPS:
+            return go_default_type(ctx, node.child_by_field_name("operand"))
+        return UNKNOWN
+    if node.type != GoNodeType.BINARY_EXPRESSION:
+        return UNKNOWN
+    if op in _GO_COMPARISON_OPS or op in _GO_LOGICAL_OPS:
+        return bool_type
+    left = node.child_by_field_name("left")
+    if op in _GO_SHIFT_OPS:
+        return go_default_type(ctx, left)
+    typed = [
+        operand
+        for operand in (left, node.child_by_field_name("right"))
+        if operand is not None and fold_go_const(ctx, operand) is None
+    ]
+    return go_default_type(ctx, typed[0]) if typed else UNKNOWN
+
+
+def _is_go_string_operand(ctx: TreeSitterEmitContext, node) -> bool:
+    """True for a string literal or an expression statically of a string type."""
+    if node.type in _GO_STRING_LITERALS:
+        return True
+    static_type = go_static_type(ctx, node)
+    return (
+        isinstance(static_type, ScalarType)
+        and go_underlying_type(ctx, str(static_type))
+        == constants.FoundationTypeName.STRING.value
+    )
+
+
+def is_go_int_operand(ctx: TreeSitterEmitContext, node) -> bool:
+    """True for an integer constant or an expression statically of an integer type."""
+    static_type = go_default_type(ctx, node)
+    if not isinstance(static_type, ScalarType):
+        return False
+    type_name = str(static_type)
+    underlying = go_underlying_type(ctx, type_name) or type_name
+    return underlying == constants.FoundationTypeName.INT.value
+
+
+def _go_untyped_kind(ctx: TreeSitterEmitContext, node) -> str:
+    """Go's name for the byte / rune / string kind of *node*, or "".
+
+    Only the shapes Go compares by kind are classified: ``s[i]`` on a
+    string is a ``byte``, a rune literal an ``untyped rune`` and a string
+    literal an ``untyped string``.
+    """
+    if node.type == GoNodeType.PARENTHESIZED_EXPRESSION:
+        inner = next((c for c in node.children if c.is_named), None)
+        return _go_untyped_kind(ctx, inner) if inner is not None else ""
+    if node.type == GoNodeType.RUNE_LITERAL:
+        return "untyped rune"
+    if node.type in _GO_STRING_LITERALS:
+        return "untyped string"
+    if node.type == GoNodeType.INDEX_EXPRESSION:
+        operand_node = node.child_by_field_name("operand")
+        if operand_node is not None and _is_go_string_operand(ctx, operand_node):
+            return "byte"
+    return ""
+
+
+class GoMismatchedTypesError(CompileError):
+    """Operands Go cannot compare, so the compiler rejects the program.
+
+    Indexing a string yields a byte, which Go never compares with a string
+    operand (``s[i] == "G"``), and nil only compares with pointers, slices,
+    maps, channels, functions and interfaces (``n == nil`` with n an int).
+    Only integers, floats and strings are ordered (``<``, ``>=``, ...), and
+    only against a value of the same type.
+    """
+
+    code = DiagnosticCode.INCOMPARABLE_TYPES
+
+
+class GoUntypedNilError(CompileError):
+    """nil is used where Go cannot give it a type.
+
+    ``x := nil`` and ``var x = nil`` have no type to infer, and nil is no
+    value of a basic or struct type (``var n int = nil``).
+    """
+
+    code = DiagnosticCode.UNTYPED_NIL
+
+
+def is_go_nil(node) -> bool:
+    """True for the predeclared ``nil``, possibly parenthesized."""
+    while node is not None and node.type == GoNodeType.PARENTHESIZED_EXPRESSION:
+        node = next((c for c in node.children if c.is_named), None)
+    return node is not None and node.type == GoNodeType.NIL
+
+
+def go_has_nil(ctx: TreeSitterEmitContext, type_expr: TypeExpr) -> bool:
+    """False for basic and struct types, whose values are never nil.
+
+    Pointers, slices, maps, channels, functions and interfaces have nil as
+    their zero value; types this frontend cannot see are assumed to.
+    """
+    type_expr = _resolve_go_aliases(ctx, type_expr)
+    if not isinstance(type_expr, ScalarType):
+        return True
+    name = type_expr.name.value
+    if ClassName(ctx.type_map.get(name, name)) in ctx.symbol_table.classes:
+        return False
+    underlying = (
+        name if name in _GO_PREDECLARED_TYPES else go_underlying_type(ctx, name)
+    )
+    return underlying not in _GO_PREDECLARED_TYPES
+
+
+def _check_go_nil_comparison(
+    ctx: TreeSitterEmitContext, left, op: str, right, node
+) -> None:
+    """Reject ``x == nil`` / ``x != nil`` when x's type has no nil.
+
+    ``n == nil`` with n an int, string or struct raises
+    GoMismatchedTypesError, as does ``nil == nil``.  Operands of unknown
+    type are accepted.
+    """
+    left_nil, right_nil = is_go_nil(left), is_go_nil(right)
+    if op not in ("==", "!=") or not (left_nil or right_nil):
+        return
+    if left_nil and right_nil:
+        raise GoMismatchedTypesError(
+            f"invalid operation: {ctx.node_text(node)} "
+            f"(operator {op} not defined on untyped nil)"
+        )
+    other_type = go_default_type(ctx, right if left_nil else left)
+    if not other_type or go_has_nil(ctx, other_type):
+        return
+    other_kind = go_type_name(str(other_type))
+    left_kind, right_kind = (
+        ("untyped nil", other_kind) if left_nil else (other_kind, "untyped nil")
+    )
+    raise GoMismatchedTypesError(
+        f"invalid operation: {ctx.node_text(node)} "
+        f"(mismatched types {left_kind} and {right_kind})"
+    )
+
+
+_GO_ORDERING_OPS = frozenset({"<", "<=", ">", ">="})
+
+# Go's name for the kind of each structured type, none of which is ordered.
+_GO_UNORDERED_KINDS: dict[str, str] = {
+    "Array": "slice",
+    "Map": "map",
+    "Pointer": "pointer",
+    "Chan": "chan",
+}
+
+
+def _go_ordering_type(ctx: TreeSitterEmitContext, node) -> TypeExpr:
+    """Static type of an ordering operand, with aliases and the type map applied."""
+    type_expr = _resolve_go_aliases(ctx, go_static_type(ctx, node))
+    if isinstance(type_expr, ScalarType):
+        return normalize_type_hint(type_expr.name.value, ctx.type_map)
+    return type_expr
+
+
+def _go_unordered_kind(ctx: TreeSitterEmitContext, node) -> str:
+    """Go's name for the kind of *node* when its values have no order, else "".
+
+    Operands of unknown type are assumed to be ordered.
+    """
+    if node.type in (GoNodeType.TRUE, GoNodeType.FALSE):
+        return "untyped bool"
+    type_expr = _go_ordering_type(ctx, node)
+    if isinstance(type_expr, FunctionType):
+        return "func"
+    if isinstance(type_expr, ParameterizedType):
+        return _GO_UNORDERED_KINDS.get(type_expr.constructor, "")
+    if not isinstance(type_expr, ScalarType):
+        return ""
+    name = type_expr.name.value
+    if ClassName(name) in ctx.symbol_table.classes:
+        return "struct"
+    if ClassName(name) in ctx.symbol_table.interfaces:
+        return "interface"
+    if go_underlying_type(ctx, name) == constants.FoundationTypeName.BOOL.value:
+        return go_type_name(name)
+    return ""
+
+
+def _check_go_ordering(ctx: TreeSitterEmitContext, left, op: str, right, node) -> None:
+    """Reject ``<``, ``<=``, ``>`` and ``>=`` on operands Go does not order.
+
+    Integers, floats and strings are ordered, strings byte-wise, which is
+    also the order of their code points.  A bool, struct, pointer, slice,
+    map, channel, function or interface operand, or two operands of
+    different types (``s < n`` with n an int), raise GoMismatchedTypesError.
+    """
+    if op not in _GO_ORDERING_OPS:
+        return
+    for operand in (left, right):
+        kind = _go_unordered_kind(ctx, operand)
+        if kind:
+            raise GoMismatchedTypesError(
+                f"invalid operation: {ctx.node_text(node)} "
+                f"(operator {op} not defined on {kind})"
+            )
+    left_type = _go_ordering_type(ctx, left)
+    right_type = _go_ordering_type(ctx, right)
+    if not left_type or not right_type or left_type == right_type:
+        return
+    raise GoMismatchedTypesError(
+        f"invalid operation: {ctx.node_text(node)} "
+        f"(mismatched types {go_type_name(str(left_type))} "
+        f"and {go_type_name(str(right_type))})"
+    )
+
+
+def go_mismatched_kinds(ctx: TreeSitterEmitContext, left, right) -> tuple[str, str]:
+    """Kinds of *left* and *right* when Go rejects comparing them, else ("", "")."""
+    kinds = (_go_untyped_kind(ctx, left), _go_untyped_kind(ctx, right))
+    if "untyped string" in kinds and ({"byte", "untyped rune"} & set(kinds)):
+        return kinds
+    return "", ""
+
+
+_GO_LOGICAL_OPS = frozenset({"&&", "||"})
+
+# Builtin implementing Go's integer semantics for each division operator.
+_GO_DIVISION_BUILTINS: dict[str, str] = {"/": "__go_quo__", "%": "__go_rem__"}
+
+
+class GoDivisionByZeroError(CompileError):
+    """An integer ``/`` or ``%`` by a constant zero, which Go rejects."""
+
+    code = DiagnosticCode.CONSTANT_DIVISION_BY_ZERO
+
+    def __init__(self) -> None:
+        super().__init__("invalid operation: division by zero")
+
+
+def _is_go_float_operand(ctx: TreeSitterEmitContext, node) -> bool:
+    """True for a float constant or an expression statically of a float type."""
+    if node is None:
+        return False
+    if isinstance(fold_go_const(ctx, node), float):
+        return True
+    static_type = go_static_type(ctx, node)
+    return (
+        isinstance(static_type, ScalarType)
+        and go_underlying_type(ctx, str(static_type))
+        == constants.FoundationTypeName.FLOAT.value
+    )
+
+
+def emit_go_arith(
+    ctx: TreeSitterEmitContext,
+    op: str,
+    left_reg: Register,
+    right_reg: Register,
+    operands: tuple[Any, Any],  # Any: tree-sitter nodes, the right one maybe None
+    node,
+) -> Register:
+    """Emit ``left op right``, giving ``/`` and ``%`` Go's integer semantics.
+
+    Integer quotients truncate toward zero and remainders take the sign of
+    the dividend (``__go_quo__`` / ``__go_rem__``); a zero divisor panics
+    with ``runtime error: integer divide by zero`` at *node*, and a constant
+    zero one raises GoDivisionByZeroError.  Operands statically of a float
+    type divide with an ordinary BINOP.
+    """
+    left, right = operands
+    if op not in _GO_DIVISION_BUILTINS or any(
+        _is_go_float_operand(ctx, operand) for operand in operands
+    ):
         reg = ctx.fresh_reg()
         ctx.emit_inst(
-            CallFunction(
-                result_reg=reg, func_name=FuncName(func_name), args=tuple(arg_regs)
+            Binop(
+                result_reg=reg,
+                operator=resolve_binop(op),
+                left=left_reg,
+                right=right_reg,
             ),
             node=node,
         )
         return reg
-
-    # Dynamic / unknown call
-    target_reg = ctx.lower_expr(func_node) if func_node else ctx.fresh_reg()
+    divisor = fold_go_const(ctx, right) if right is not None else None
+    if not isinstance(divisor, bool) and divisor == 0:
+        raise GoDivisionByZeroError()
+    ok_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=ok_reg,
+            func_name=FuncName("__go_divisor_ok__"),
+            args=(left_reg, right_reg),
+        ),
+        node=node,
+    )
+    emit_go_panic_unless(ctx, ok_reg, "runtime error: integer divide by zero", node)
     reg = ctx.fresh_reg()
     ctx.emit_inst(
-        CallUnknown(result_reg=reg, target_reg=target_reg, args=tuple(arg_regs)),
+        CallFunction(
+            result_reg=reg,
+            func_name=FuncName(_GO_DIVISION_BUILTINS[op]),
+            args=(left_reg, right_reg),
+        ),
         node=node,
     )
+    result_type = go_static_type(ctx, left) or go_static_type(ctx, right)
+    if result_type:
+        ctx.seed_register_type(reg, result_type)
     return reg
 
 
-# -- Go: selector expression (obj.field) -----------------------------------
-
-
-def lower_selector(
+def lower_go_binop(
     ctx: TreeSitterEmitContext, node: Any
 ) -> Register:  # Any: tree-sitter node — untyped at Python boundary
-    operand_node = node.child_by_field_name("operand")
-    field_node = node.child_by_field_name("field")
-    if operand_node is None or field_node is None:
-        return lower_string_literal(ctx, node, ctx.node_text(node))
-    obj_reg = ctx.lower_expr(operand_node)
-    field_name = ctx.node_text(field_node)
-    reg = ctx.fresh_reg()
-    ctx.emit_inst(
-        LoadField(result_reg=reg, obj_reg=obj_reg, field_name=FieldName(field_name)),
-        node=node,
-    )
-    return reg
+    """Lower a binary expression, rejecting comparisons Go does not allow.
+
+    A byte never compares with a string, nor nil with a value of a type
+    that has no nil, and only integers, floats and strings are ordered
+    (``_check_go_ordering``).  An expression of constants is folded into
+    one CONST (``60 * 60`` is 3600, ``"a" + "b"`` is "ab").  Otherwise
+    ``&&`` and ``||`` short-circuit (see ``_lower_go_logical``), and ``/``
+    and ``%`` follow Go's integer division (see ``emit_go_arith``).
+    """
+    left = node.child_by_field_name("left")
+    right = node.child_by_field_name("right")
+    if left is not None and right is not None:
+        left_kind, right_kind = go_mismatched_kinds(ctx, left, right)
+        if left_kind:
+            raise GoMismatchedTypesError(
+                f"invalid operation: {ctx.node_text(node)} "
+                f"(mismatched types {left_kind} and {right_kind})"
+            )
+        op_node = node.child_by_field_name("operator")
+        op = ctx.node_text(op_node) if op_node else ""
+        _check_go_nil_comparison(ctx, left, op, right, node)
+        _check_go_ordering(ctx, left, op, right, node)
+        value = fold_go_const(ctx, node)
+        if value is not None:
+            return emit_go_const_value(ctx, value, node)
+        if op in _GO_LOGICAL_OPS:
+            return _lower_go_logical(ctx, left, op, right, node)
+        if op in _GO_DIVISION_BUILTINS:
+            left_reg = ctx.lower_expr(left)
+            right_reg = ctx.lower_expr(right)
+            return emit_go_arith(ctx, op, left_reg, right_reg, (left, right), node)
+    return lower_binop(ctx, node)
+
+
+def _lower_go_logical(
+    ctx: TreeSitterEmitContext, left, op: str, right, node
+) -> Register:
+    """Lower ``a && b`` / ``a || b`` so that b runs only when a does not decide.
+
+    a is evaluated first; when it is false (``&&``) or true (``||``) the
+    result is a and b is never evaluated, otherwise the result is b.
+    """
+    left_reg = ctx.lower_expr(left)
+    rhs_label = ctx.fresh_label("logical_rhs")
+    short_label = ctx.fresh_label("logical_short")
+    end_label = ctx.fresh_label("logical_end")
+    result_var = VarName(f"__logical_{ctx.label_counter}")
+    targets = (rhs_label, short_label) if op == "&&" else (short_label, rhs_label)
+
+    ctx.emit_inst(BranchIf(cond_reg=left_reg, branch_targets=targets), node=node)
+    ctx.emit_inst(Label_(label=rhs_label))
+    right_reg = ctx.lower_expr(right)
+    ctx.emit_inst(DeclVar(name=result_var, value_reg=right_reg))
+    ctx.emit_inst(Branch(label=end_label))
+    ctx.emit_inst(Label_(label=short_label))
+    ctx.emit_inst(DeclVar(name=result_var, value_reg=left_reg))
+    ctx.emit_inst(Branch(label=end_label))
+    ctx.emit_inst(Label_(label=end_label))
+    result_reg = ctx.fresh_reg()
+    ctx.emit_inst(LoadVar(result_reg=result_reg, name=result_var))
+    ctx.seed_register_type(result_reg, scalar(constants.FoundationTypeName.BOOL))
+    return result_reg
 
 
-# -- Go: index expression (arr[i]) -----------------------------------------
+def _is_go_named_type(ctx: TreeSitterEmitContext, type_expr: TypeExpr) -> bool:
+    """True for a predeclared scalar type or a declared named type."""
+    return isinstance(type_expr, ScalarType) and (
+        type_expr.name.value in _GO_PREDECLARED_TYPES
+        or type_expr.name in ctx.type_env_builder.named_types
+    )
 
 
-def lower_go_index(
-    ctx: TreeSitterEmitContext, node: Any
-) -> Register:  # Any: tree-sitter node — untyped at Python boundary
-    operand_node = node.child_by_field_name("operand")
-    index_node = node.child_by_field_name("index")
-    if operand_node is None or index_node is None:
-        return lower_string_literal(ctx, node, ctx.node_text(node))
-    obj_reg = ctx.lower_expr(operand_node)
-    idx_reg = ctx.lower_expr(index_node)
-    reg = ctx.fresh_reg()
-    ctx.emit_inst(
-        LoadIndex(result_reg=reg, arr_reg=obj_reg, index_reg=idx_reg), node=node
+def check_go_assignable(
+    ctx: TreeSitterEmitContext, value_node, target_type: TypeExpr, where: str
+) -> None:
+    """Reject assigning a typed value to a different named type.
+
+    Aliases are interchangeable with their targets.  Two distinct named
+    types never are, whatever their underlying types, so ``var n int = m``
+    with ``m MyInt`` raises GoTypeMismatchError.  Untyped values (literals,
+    constant expressions) and types this frontend cannot see are accepted,
+    except nil, which a basic or struct type cannot hold (GoUntypedNilError).
+    """
+    if is_go_nil(value_node):
+        if target_type and not go_has_nil(ctx, target_type):
+            raise GoUntypedNilError(
+                f"cannot use nil as {go_type_name(str(target_type))} value in {where}"
+            )
+        return
+    value_type = _resolve_go_aliases(ctx, go_static_type(ctx, value_node))
+    target_type = _resolve_go_aliases(ctx, target_type)
+    if not value_type or not target_type or value_type == target_type:
+        return
+    named = ctx.type_env_builder.named_types
+    declared = any(
+        isinstance(t, ScalarType) and t.name in named for t in (value_type, target_type)
     )
-    return reg
+    if (
+        declared
+        and _is_go_named_type(ctx, value_type)
+        and _is_go_named_type(ctx, target_type)
+    ):
+        kind = "variable" if value_node.type == GoNodeType.IDENTIFIER else "value"
+        raise GoTypeMismatchError(
+            ctx.node_text(value_node), kind, value_type, target_type, where
+        )
 
 
-# -- Go: composite literal -------------------------------------------------
+class GoCompositeLiteralError(CompileError):
+    """A composite literal Go rejects.
 
+    An element whose type does not fit its slot (``[]int{1, "two"}``), a
+    field the struct does not declare, or more positional values than the
+    struct has fields.
+    """
 
-def lower_composite_literal(
-    ctx: TreeSitterEmitContext, node: Any
-) -> Register:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower Go composite literal: Point{X: 1} or []int{1, 2, 3}."""
-    type_node = node.child_by_field_name("type")
-    body_node = node.child_by_field_name("body") or next(
-        (c for c in node.children if c.type == GoNodeType.LITERAL_VALUE), None
+    code = DiagnosticCode.INVALID_COMPOSITE_LITERAL
+
+
+def _fits_go_basic_type(ctx: TreeSitterEmitContext, value_node, target: str) -> bool:
+    """True when *value_node*, of known basic type, can fill a *target* slot.
+
+    Typed values need the same underlying type.  Untyped integer and rune
+    constants fit any numeric type, and an untyped float constant fits an
+    integer type only when it has no fractional part.
+    """
+    underlying, _ = _go_conversion_operand(ctx, value_node)
+    if underlying == target:
+        return True
+    if value_node.type in (GoNodeType.INT_LITERAL, GoNodeType.RUNE_LITERAL):
+        return target in _GO_NUMERIC_KINDS
+    if value_node.type == GoNodeType.FLOAT_LITERAL:
+        value = _go_float_value(ctx.node_text(value_node))
+        return target in _GO_NUMERIC_KINDS and value.is_integer()
+    return False
+
+
+def check_go_element(
+    ctx: TreeSitterEmitContext, value_node, type_text: str, where: str
+) -> None:
+    """Reject a composite literal element that cannot fill a *type_text* slot.
+
+    Named types follow check_go_assignable.  When both the slot and the
+    element have a basic underlying type, the element must fit it, so
+    ``"two"`` in a ``[]int`` raises GoCompositeLiteralError (``cannot use
+    "two" (untyped string constant) as int value in array or slice
+    literal``).  Elements or slots of any other type are accepted.
+    """
+    check_go_assignable(
+        ctx, value_node, normalize_type_hint(type_text, ctx.type_map), where
+    )
+    target = go_underlying_type(ctx, type_text)
+    underlying, description = _go_conversion_operand(ctx, value_node)
+    if (
+        target not in _GO_PREDECLARED_TYPES
+        or underlying not in _GO_PREDECLARED_TYPES
+        or _fits_go_basic_type(ctx, value_node, target)
+    ):
+        return
+    raise GoCompositeLiteralError(
+        f"cannot use {ctx.node_text(value_node)} ({description}) "
+        f"as {type_text} value in {where}"
+    )
+
+
+def _lower_go_make_slice(
+    ctx: TreeSitterEmitContext, type_node, size_args: list, node
+) -> Register:
+    """Lower make([]T, len[, cap]) to a zero-filled NEW_ARRAY.
+
+    The array records its length (and capacity, when given) in SPECIAL
+    fields so len(), cap() and append() see concrete values.
+    """
+    if size_args:
+        size_reg = ctx.lower_expr(size_args[0])
+    else:
+        size_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.int_(size_reg, 0))
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        NewArray(
+            result_reg=reg, type_hint=parse_go_type(ctx, type_node), size_reg=size_reg
+        ),
+        node=node,
+    )
+    ctx.emit_inst(
+        StoreField(
+            obj_reg=reg,
+            field_name=FieldName("length", FieldKind.SPECIAL),
+            value_reg=size_reg,
+        )
+    )
+    if len(size_args) > 1:
+        ctx.emit_inst(
+            StoreField(
+                obj_reg=reg,
+                field_name=FieldName("capacity", FieldKind.SPECIAL),
+                value_reg=ctx.lower_expr(size_args[1]),
+            )
+        )
+    elem_type_node = type_node.child_by_field_name("element")
+    if elem_type_node is not None:
+        _emit_go_array_zero_fill(ctx, reg, elem_type_node, None)
+    return reg
+
+
+def _lower_go_append(ctx: TreeSitterEmitContext, args_node, node) -> Register:
+    """Lower append(s, x, y) / append(s, t...) to CALL_FUNCTION __go_append__.
+
+    A trailing ``t...`` argument is passed as a spread so its elements are
+    appended individually.
+    """
+    arg_regs = lower_go_call_args(ctx, args_node)
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=reg, func_name=FuncName("__go_append__"), args=tuple(arg_regs)
+        ),
+        node=node,
+    )
+    return reg
+
+
+def _lower_go_panic(ctx: TreeSitterEmitContext, args_node, node) -> Register:
+    """Lower panic(v) to THROW v.
+
+    The VM unwinds to the nearest function running deferred calls, where
+    recover() can stop the panic.
+    """
+    args = [c for c in (args_node.children if args_node else []) if c.is_named]
+    if args:
+        val_reg = ctx.lower_expr(args[0])
+    else:
+        val_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.null_(val_reg))
+    ctx.emit_inst(Throw_(value_reg=val_reg), node=node)
+    return val_reg
+
+
+def _lower_go_make_chan(
+    ctx: TreeSitterEmitContext, type_node, size_args: list, node
+) -> Register:
+    """Lower make(chan T[, n]) to an empty channel object.
+
+    The channel keeps its buffered values as INDEX fields and its
+    ``length``, ``capacity`` (0 when unbuffered) and element ``zero`` value
+    as SPECIAL fields; the ``__go_chan_*__`` builtins maintain them.
+    """
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        NewObject(result_reg=reg, type_hint=parse_go_type(ctx, type_node)), node=node
+    )
+    length_reg = _make_const_zero(ctx)
+    capacity_reg = ctx.lower_expr(size_args[0]) if size_args else _make_const_zero(ctx)
+    elem_node = type_node.child_by_field_name("value")
+    zero_reg = (
+        emit_go_zero_value(ctx, elem_node)
+        if elem_node is not None
+        else _make_const_null(ctx)
+    )
+    for name, value_reg in (
+        ("length", length_reg),
+        ("capacity", capacity_reg),
+        ("zero", zero_reg),
+    ):
+        ctx.emit_inst(
+            StoreField(
+                obj_reg=reg,
+                field_name=FieldName(name, FieldKind.SPECIAL),
+                value_reg=value_reg,
+            )
+        )
+    return reg
+
+
+def _lower_go_close(ctx: TreeSitterEmitContext, args_node, node) -> Register:
+    """Lower close(ch); closing a nil or closed channel panics."""
+    arg_regs = lower_go_call_args(ctx, args_node)
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=reg,
+            func_name=FuncName("__go_chan_close__"),
+            args=tuple(arg_regs),
+        ),
+        node=node,
+    )
+    emit_go_panic_unless(ctx, reg, "close of closed channel", node)
+    return reg
+
+
+def emit_go_panic_unless(
+    ctx: TreeSitterEmitContext, ok_reg: Register, message: str, node
+) -> None:
+    """Panic with *message* unless *ok_reg* is true."""
+    ok_label = ctx.fresh_label("panic_check_ok")
+    panic_label = ctx.fresh_label("panic_check_fail")
+    ctx.emit_inst(BranchIf(cond_reg=ok_reg, branch_targets=(ok_label, panic_label)))
+    ctx.emit_inst(Label_(label=panic_label))
+    msg_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.string(msg_reg, message))
+    ctx.emit_inst(Throw_(value_reg=msg_reg), node=node)
+    ctx.emit_inst(Label_(label=ok_label))
+
+
+def lower_go_chan_recv(ctx: TreeSitterEmitContext, chan_node, node) -> Register:
+    """Lower ``<-ch`` to CALL_FUNCTION __go_chan_recv__, which may block."""
+    return emit_go_chan_recv(ctx, ctx.lower_expr(chan_node), node)
+
+
+def emit_go_chan_recv(ctx: TreeSitterEmitContext, chan_reg: Register, node) -> Register:
+    """Receive from the channel in *chan_reg*."""
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=reg, func_name=FuncName("__go_chan_recv__"), args=(chan_reg,)
+        ),
+        node=node,
+    )
+    return reg
+
+
+def lower_go_chan_recv_ok(
+    ctx: TreeSitterEmitContext, chan_reg: Register, node
+) -> tuple[Register, Register]:
+    """Receive ``v, ok`` from the channel in *chan_reg*; ok is false once drained."""
+    pair_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=pair_reg,
+            func_name=FuncName("__go_chan_recv_ok__"),
+            args=(chan_reg,),
+        ),
+        node=node,
+    )
+    regs = []
+    for index in (0, 1):
+        index_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.int_(index_reg, index))
+        reg = ctx.fresh_reg()
+        ctx.emit_inst(LoadIndex(result_reg=reg, arr_reg=pair_reg, index_reg=index_reg))
+        regs.append(reg)
+    return regs[0], regs[1]
+
+
+def is_go_chan_receive(node) -> bool:
+    """True when *node* is a receive expression ``<-ch``."""
+    if node.type != GoNodeType.UNARY_EXPRESSION:
+        return False
+    op_node = node.child_by_field_name("operator")
+    return op_node is not None and op_node.type == "<-"
+
+
+def is_go_chan_operand(ctx: TreeSitterEmitContext, operand_node) -> bool:
+    """True when *operand_node* names a variable statically typed as a channel."""
+    return _go_static_collection_type(ctx, operand_node, "Chan") is not None
+
+
+def lower_go_call_args(ctx: TreeSitterEmitContext, args_node) -> list:
+    """Lower call arguments; a trailing ``xs...`` becomes a spread of xs."""
+    return [
+        (
+            lower_spread_arg(ctx, c)
+            if c.type == GoNodeType.VARIADIC_ARGUMENT
+            else ctx.lower_expr(c)
+        )
+        for c in (args_node.children if args_node else [])
+        if c.is_named
+    ]
+
+
+def lower_go_call(
+    ctx: TreeSitterEmitContext, node: Any
+) -> Register:  # Any: tree-sitter node — untyped at Python boundary
+    func_node = node.child_by_field_name(ctx.constants.call_function_field)
+    args_node = node.child_by_field_name(ctx.constants.call_arguments_field)
+
+    # Desugar make(): emit NEW_OBJECT or NEW_ARRAY based on type argument.
+    # Must intercept BEFORE lowering the arguments so type nodes are not lowered
+    # as expressions.
+    if (
+        func_node
+        and func_node.type == GoNodeType.IDENTIFIER
+        and ctx.node_text(func_node) == "make"
+        and args_node
+    ):
+        type_args = [c for c in args_node.children if c.is_named]
+        if type_args:
+            type_node = type_args[0]
+            if type_node.type == GoNodeType.SLICE_TYPE:
+                return _lower_go_make_slice(ctx, type_node, type_args[1:], node)
+            if type_node.type == GoNodeType.CHANNEL_TYPE:
+                return _lower_go_make_chan(ctx, type_node, type_args[1:], node)
+            type_hint = parse_go_type(ctx, type_node)
+            reg = ctx.fresh_reg()
+            ctx.emit_inst(NewObject(result_reg=reg, type_hint=type_hint), node=node)
+            return reg
+
+    if (
+        func_node
+        and func_node.type == GoNodeType.IDENTIFIER
+        and ctx.node_text(func_node) == "append"
+    ):
+        return _lower_go_append(ctx, args_node, node)
+
+    if (
+        func_node
+        and func_node.type == GoNodeType.IDENTIFIER
+        and ctx.node_text(func_node) == "panic"
+    ):
+        return _lower_go_panic(ctx, args_node, node)
+
+    if (
+        func_node
+        and func_node.type == GoNodeType.IDENTIFIER
+        and ctx.node_text(func_node) == "close"
+    ):
+        return _lower_go_close(ctx, args_node, node)
+
+    arg_regs = lower_go_call_args(ctx, args_node)
+
+    # Generic function: Max(a, b) or Max[int](a, b) calls an instance.
+    from interpreter.frontends.go.generics import (
+        go_generic_callee,
+        lower_go_generic_call,
+    )
+
+    generic_name = go_generic_callee(ctx, func_node)
+    if generic_name:
+        return lower_go_generic_call(ctx, generic_name, node, arg_regs)
+
+    # Method call via selector: obj.Method(...)
+    if func_node and func_node.type == GoNodeType.SELECTOR_EXPRESSION:
+        operand_node = func_node.child_by_field_name("operand")
+        field_node = func_node.child_by_field_name("field")
+        if operand_node and field_node:
+            method_name = ctx.node_text(field_node)
+            stdlib_func = go_stdlib_func(ctx, operand_node, method_name)
+            if stdlib_func:
+                return _lower_go_stdlib_call(ctx, stdlib_func, arg_regs, node)
+            member = go_project_member(ctx, operand_node, method_name)
+            if member:
+                reg = ctx.fresh_reg()
+                ctx.emit_inst(
+                    CallFunction(
+                        result_reg=reg, func_name=FuncName(member), args=tuple(arg_regs)
+                    ),
+                    node=node,
+                )
+                return reg
+            obj_reg = ctx.lower_expr(operand_node)
+            if _is_go_interface_operand(ctx, operand_node):
+                emit_go_nil_check(ctx, obj_reg, node)
+            obj_reg = _emit_go_promotion(
+                ctx, obj_reg, operand_node, method_name, func_node
+            )
+            if is_go_func_field(ctx, method_name):
+                return _lower_go_func_field_call(
+                    ctx, obj_reg, method_name, arg_regs, node
+                )
+            reg = ctx.fresh_reg()
+            ctx.emit_inst(
+                CallMethod(
+                    result_reg=reg,
+                    obj_reg=obj_reg,
+                    method_name=FuncName(method_name),
+                    args=tuple(arg_regs),
+                ),
+                node=node,
+            )
+            return reg
+
+    # Plain function call
+    if func_node and func_node.type == GoNodeType.IDENTIFIER:
+        func_name = ctx.node_text(func_node)
+
+        # Conversions such as float64(n), int64(x) or Celsius(f) map onto the
+        # int/float/str/bool builtins so they execute concretely.
+        conversion = go_conversion_builtin(ctx, func_name)
+        operands = [c for c in args_node.children if c.is_named] if args_node else []
+        if conversion and len(operands) == 1:
+            check_go_conversion(ctx, func_name, operands[0])
+            # string([]byte) and string([]rune) decode differently.
+            if conversion == "__go_string__":
+                conversion = _GO_SLICE_STRING_BUILTINS.get(
+                    go_slice_element_kind(ctx, operands[0]), conversion
+                )
+        builtin_name = conversion or GO_BUILTIN_FUNCS.get(
+            func_name, ctx.resolve_var(func_name)
+        )
+        reg = ctx.fresh_reg()
+        ctx.emit_inst(
+            CallFunction(
+                result_reg=reg, func_name=FuncName(builtin_name), args=tuple(arg_regs)
+            ),
+            node=node,
+        )
+        if conversion:
+            ctx.seed_register_type(reg, normalize_type_hint(func_name, ctx.type_map))
+        if func_name in _GO_BUILTIN_RESULT_TYPES:
+            ctx.seed_register_type(reg, _GO_BUILTIN_RESULT_TYPES[func_name])
+        # Indirect call through a variable of function type, e.g. a
+        # ``f func(int) int`` parameter: the result has f's return type.
+        callee_type = ctx.type_env_builder.var_types.get(ctx.resolve_var(func_name))
+        if isinstance(callee_type, FunctionType):
+            ctx.seed_register_type(reg, callee_type.return_type)
+        return reg
+
+    # Dynamic / unknown call
+    target_reg = ctx.lower_expr(func_node) if func_node else ctx.fresh_reg()
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallUnknown(result_reg=reg, target_reg=target_reg, args=tuple(arg_regs)),
+        node=node,
+    )
+    return reg
+
+
+def _lower_go_stdlib_call(
+    ctx: TreeSitterEmitContext, func_name: str, arg_regs: list, node
+) -> Register:
+    """Emit ``CALL_FUNCTION pkg.Member(args)`` for a standard library call."""
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=reg, func_name=FuncName(func_name), args=tuple(arg_regs)
+        ),
+        node=node,
+    )
+    ctx.seed_register_type(reg, go_stdlib_result_type(func_name))
+    return reg
+
+
+def is_go_func_field(ctx: TreeSitterEmitContext, name: str) -> bool:
+    """True when ``x.name(...)`` calls a function-typed struct field.
+
+    That is, some struct declares a ``name func(...)`` field and no type
+    declares a method called *name*.
+    """
+    classes = ctx.symbol_table.classes.values()
+    if any(FuncName(name) in info.methods for info in classes):
+        return False
+    return any(
+        field.type_hint.startswith("func")
+        for info in classes
+        for field in info.fields.values()
+        if str(field.name) == name
+    )
+
+
+def _lower_go_func_field_call(
+    ctx: TreeSitterEmitContext,
+    obj_reg: Register,
+    field_name: str,
+    arg_regs: list,
+    node,
+) -> Register:
+    """Lower ``s.fn(args)`` as LOAD_FIELD fn + CALL_UNKNOWN (no receiver)."""
+    fn_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        LoadField(result_reg=fn_reg, obj_reg=obj_reg, field_name=FieldName(field_name)),
+        node=node,
+    )
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallUnknown(result_reg=reg, target_reg=fn_reg, args=tuple(arg_regs)),
+        node=node,
+    )
+    return reg
+
+
+# -- Go: selector expression (obj.field) -----------------------------------
+
+
+def lower_selector(
+    ctx: TreeSitterEmitContext, node: Any
+) -> Register:  # Any: tree-sitter node — untyped at Python boundary
+    operand_node = node.child_by_field_name("operand")
+    field_node = node.child_by_field_name("field")
+    if operand_node is None or field_node is None:
+        return lower_string_literal(ctx, node, ctx.node_text(node))
+    field_name = ctx.node_text(field_node)
+    package_const = go_stdlib_const(ctx, operand_node, field_name)
+    if package_const is not None:
+        return emit_go_const_value(ctx, package_const, node)
+    member = go_project_member(ctx, operand_node, field_name)
+    if member:
+        reg = ctx.fresh_reg()
+        ctx.emit_inst(LoadVar(result_reg=reg, name=VarName(member)), node=node)
+        return reg
+    obj_reg = ctx.lower_expr(operand_node)
+    if _is_go_pointer_operand(ctx, operand_node):
+        emit_go_nil_check(ctx, obj_reg, node)
+    obj_reg = _emit_go_promotion(ctx, obj_reg, operand_node, field_name, node)
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        LoadField(result_reg=reg, obj_reg=obj_reg, field_name=FieldName(field_name)),
+        node=node,
+    )
+    return reg
+
+
+def _emit_go_promotion(
+    ctx: TreeSitterEmitContext, obj_reg: Register, operand_node, member: str, node
+) -> Register:
+    """Load the embedded value of *obj_reg* that declares *member*.
+
+    For ``o.X`` with X promoted from an embedded field, this loads
+    ``o.Inner``; *obj_reg* is returned as is when the operand's struct
+    type declares *member* itself or is not statically known.
+    """
+    struct_type = go_struct_type(ctx, operand_node)
+    if not struct_type:
+        return obj_reg
+    for embedded in go_selector_path(ctx, struct_type, member, ctx.node_text(node)):
+        field_reg = ctx.fresh_reg()
+        ctx.emit_inst(
+            LoadField(result_reg=field_reg, obj_reg=obj_reg, field_name=embedded),
+            node=node,
+        )
+        obj_reg = field_reg
+    return obj_reg
+
+
+# -- Go: index expression (arr[i]) -----------------------------------------
+
+
+def lower_go_index(
+    ctx: TreeSitterEmitContext, node: Any
+) -> Register:  # Any: tree-sitter node — untyped at Python boundary
+    """Lower ``x[i]``: a map read, a string's i-th byte, or an element load."""
+    operand_node = node.child_by_field_name("operand")
+    index_node = node.child_by_field_name("index")
+    if operand_node is None or index_node is None:
+        return lower_string_literal(ctx, node, ctx.node_text(node))
+    map_type = _go_static_collection_type(ctx, operand_node, "Map")
+    obj_reg = ctx.lower_expr(operand_node)
+    idx_reg = ctx.lower_expr(index_node)
+    if map_type is not None:
+        return _emit_go_map_get(ctx, obj_reg, idx_reg, map_type, node)
+    emit_go_bounds_check(ctx, operand_node, obj_reg, idx_reg, node)
+    reg = ctx.fresh_reg()
+    if _is_go_string_operand(ctx, operand_node):
+        ctx.emit_inst(
+            CallFunction(
+                result_reg=reg,
+                func_name=FuncName("__go_string_byte__"),
+                args=(obj_reg, idx_reg),
+            ),
+            node=node,
+        )
+        ctx.seed_register_type(reg, scalar(constants.FoundationTypeName.INT))
+        return reg
+    ctx.emit_inst(
+        LoadIndex(result_reg=reg, arr_reg=obj_reg, index_reg=idx_reg), node=node
+    )
+    return reg
+
+
+def _go_static_collection_type(
+    ctx: TreeSitterEmitContext, operand_node, constructor: str
+) -> ParameterizedType | None:
+    """Static Array[...] / Map[...] / Pointer[...] type of a variable, if known."""
+    if operand_node.type != GoNodeType.IDENTIFIER:
+        return None
+    var_name = ctx.resolve_var(ctx.node_text(operand_node))
+    var_type = ctx.type_env_builder.var_types.get(var_name)
+    if isinstance(var_type, ParameterizedType) and var_type.constructor == constructor:
+        return var_type
+    return None
+
+
+def _is_go_array_operand(ctx: TreeSitterEmitContext, operand_node) -> bool:
+    """True when *operand_node* names a variable statically typed as [N]T or []T."""
+    return _go_static_collection_type(ctx, operand_node, "Array") is not None
+
+
+def is_go_map_index(ctx: TreeSitterEmitContext, node) -> bool:
+    """True when *node* is ``m[k]`` on a variable statically typed as a map."""
+    if node.type != GoNodeType.INDEX_EXPRESSION:
+        return False
+    operand_node = node.child_by_field_name("operand")
+    return (
+        operand_node is not None
+        and _go_static_collection_type(ctx, operand_node, "Map") is not None
+    )
+
+
+def _emit_go_map_write_check(
+    ctx: TreeSitterEmitContext, map_reg: Register, node
+) -> None:
+    """Panic when writing into a nil map; reading one yields zero values."""
+    nil_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.null_(nil_reg))
+    ok_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        Binop(
+            result_reg=ok_reg, operator=resolve_binop("!="), left=map_reg, right=nil_reg
+        ),
+        node=node,
+    )
+    emit_go_panic_unless(ctx, ok_reg, "assignment to entry in nil map", node)
+
+
+def _emit_go_map_get(
+    ctx: TreeSitterEmitContext,
+    map_reg: Register,
+    key_reg: Register,
+    map_type: ParameterizedType,
+    node,
+) -> Register:
+    """Emit ``CALL_FUNCTION __go_map_get__(m, k, zero)`` for a map read.
+
+    Passing the value type's zero value makes a missing key read as 0 / "" /
+    false rather than an unknown symbolic.
+    """
+    zero_reg = _emit_go_scalar_zero(ctx, str(map_type.arguments[-1]), node)
+    val_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=val_reg,
+            func_name=FuncName("__go_map_get__"),
+            args=(map_reg, key_reg, zero_reg),
+        ),
+        node=node,
+    )
+    return val_reg
+
+
+def lower_go_map_lookup(ctx: TreeSitterEmitContext, node) -> tuple[Register, Register]:
+    """Lower the comma-ok ``m[k]`` of ``v, ok := m[k]`` to (value, ok) registers.
+
+    *node* must satisfy ``is_go_map_index``; ok is ``__go_map_has__(m, k)``.
+    """
+    operand_node = node.child_by_field_name("operand")
+    index_node = node.child_by_field_name("index")
+    map_type = _go_static_collection_type(ctx, operand_node, "Map")
+    map_reg = ctx.lower_expr(operand_node)
+    key_reg = ctx.lower_expr(index_node)
+    val_reg = _emit_go_map_get(ctx, map_reg, key_reg, map_type, node)
+    ok_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=ok_reg,
+            func_name=FuncName("__go_map_has__"),
+            args=(map_reg, key_reg),
+        ),
+        node=node,
+    )
+    return val_reg, ok_reg
+
+
+def emit_go_bounds_check(
+    ctx: TreeSitterEmitContext,
+    operand_node,
+    obj_reg: Register,
+    idx_reg: Register,
+    node,
+) -> None:
+    """Panic when an array, slice or string is indexed outside 0..len-1.
+
+    Only operands whose static type is known to be an array, slice or
+    string are checked — map indexing falls through unguarded.  A string's
+    length is its UTF-8 byte count.
+    """
+    if not (
+        _is_go_array_operand(ctx, operand_node)
+        or _is_go_string_operand(ctx, operand_node)
+    ):
+        return
+    ok_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=ok_reg,
+            func_name=FuncName("__go_in_bounds__"),
+            args=(obj_reg, idx_reg),
+        ),
+        node=node,
+    )
+    ok_label = ctx.fresh_label("index_ok")
+    panic_label = ctx.fresh_label("index_out_of_range")
+    ctx.emit_inst(BranchIf(cond_reg=ok_reg, branch_targets=(ok_label, panic_label)))
+    ctx.emit_inst(Label_(label=panic_label))
+    msg_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.string(msg_reg, "runtime error: index out of range"))
+    ctx.emit_inst(Throw_(value_reg=msg_reg), node=node)
+    ctx.emit_inst(Label_(label=ok_label))
+
+
+# -- Go: pointers ----------------------------------------------------------
+
+
+def lower_go_unary(
+    ctx: TreeSitterEmitContext, node: Any
+) -> Register:  # Any: tree-sitter node — untyped at Python boundary
+    """Route unary_expression.
+
+    ``&x`` / ``*p`` are pointer ops and ``<-ch`` a channel receive; the
+    rest are generic unops.
+    """
+    op_node = node.child_by_field_name("operator")
+    operand_node = node.child_by_field_name("operand")
+    op = ctx.node_text(op_node) if op_node is not None else ""
+    if operand_node is not None and op == "<-":
+        return lower_go_chan_recv(ctx, operand_node, node)
+    if operand_node is None or op not in ("&", "*"):
+        return lower_unop(ctx, node)
+    if op == "&":
+        return lower_go_address_of(ctx, operand_node, node)
+    ptr_reg = ctx.lower_expr(operand_node)
+    emit_go_nil_check(ctx, ptr_reg, node)
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(LoadIndirect(result_reg=reg, ptr_reg=ptr_reg), node=node)
+    return reg
+
+
+def lower_go_address_of(ctx: TreeSitterEmitContext, operand_node, node) -> Register:
+    """Lower ``&x``.
+
+    A plain variable goes through ADDRESS_OF, which promotes it to the heap
+    so writes through the pointer are seen by later reads of the variable.
+    Struct values already live on the heap, so ``&T{...}`` yields the
+    object reference itself and ``&s`` is the same reference as ``s``.
+    """
+    if operand_node.type != GoNodeType.IDENTIFIER:
+        return ctx.lower_expr(operand_node)
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        AddressOf(
+            result_reg=reg,
+            var_name=VarName(ctx.resolve_var(ctx.node_text(operand_node))),
+        ),
+        node=node,
+    )
+    return reg
+
+
+def _is_go_pointer_operand(ctx: TreeSitterEmitContext, operand_node) -> bool:
+    """True when *operand_node* names a variable statically typed as *T."""
+    return _go_static_collection_type(ctx, operand_node, "Pointer") is not None
+
+
+def _is_go_interface_operand(ctx: TreeSitterEmitContext, operand_node) -> bool:
+    """True when *operand_node* is statically of an interface type, e.g. error."""
+    static_type = go_static_type(ctx, operand_node)
+    return (
+        isinstance(static_type, ScalarType)
+        and ClassName(static_type.name.value) in ctx.symbol_table.interfaces
+    )
+
+
+def emit_go_nil_check(ctx: TreeSitterEmitContext, ptr_reg: Register, node) -> None:
+    """Panic when *ptr_reg* is nil; the message carries the source position."""
+    nil_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.null_(nil_reg))
+    is_nil_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        Binop(
+            result_reg=is_nil_reg,
+            operator=resolve_binop("=="),
+            left=ptr_reg,
+            right=nil_reg,
+        ),
+        node=node,
+    )
+    ok_label = ctx.fresh_label("deref_ok")
+    panic_label = ctx.fresh_label("nil_deref")
+    ctx.emit_inst(BranchIf(cond_reg=is_nil_reg, branch_targets=(panic_label, ok_label)))
+    ctx.emit_inst(Label_(label=panic_label))
+    location = ctx.source_loc(node)
+    msg_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        Const.string(
+            msg_reg,
+            "runtime error: invalid memory address or nil pointer dereference"
+            f" at {location.start_line}:{location.start_col + 1}",
+        )
+    )
+    ctx.emit_inst(Throw_(value_reg=msg_reg), node=node)
+    ctx.emit_inst(Label_(label=ok_label))
+
+
+# -- Go: composite literal -------------------------------------------------
+
+
+def lower_composite_literal(
+    ctx: TreeSitterEmitContext, node: Any
+) -> Register:  # Any: tree-sitter node — untyped at Python boundary
+    """Lower Go composite literal: Point{X: 1} or []int{1, 2, 3}."""
+    type_node = node.child_by_field_name("type")
+    body_node = node.child_by_field_name("body") or next(
+        (c for c in node.children if c.type == GoNodeType.LITERAL_VALUE), None
+    )
+    return _lower_go_typed_literal(ctx, type_node, body_node, node)
+
+
+def _lower_go_typed_literal(
+    ctx: TreeSitterEmitContext, type_node, body_node, node
+) -> Register:
+    """Lower the literal_value *body_node* as a value of the type *type_node*."""
+    if type_node and type_node.type in GO_SEQUENCE_TYPES:
+        return _lower_go_array_literal(ctx, type_node, body_node, node)
+    if type_node and type_node.type == GoNodeType.MAP_TYPE:
+        return _lower_go_map_literal(ctx, type_node, body_node, node)
+    struct_info = _go_struct_info(ctx, type_node)
+    if struct_info is not None:
+        return _lower_go_struct_literal(ctx, struct_info, body_node, node)
+
+    type_name = ctx.node_text(type_node) if type_node else "Object"
+    obj_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        NewObject(result_reg=obj_reg, type_hint=scalar(TypeName(type_name))), node=node
+    )
+
+    if not body_node:
+        return obj_reg
+
+    elements = [c for c in body_node.children if c.is_named]
+    for i, elem in enumerate(elements):
+        if elem.type == GoNodeType.KEYED_ELEMENT:
+            # Key-value pair: {Key: Value}
+            children = [c for c in elem.children if c.is_named]
+            key_elem = children[0] if children else None
+            val_elem = children[1] if len(children) > 1 else None
+            key_name = (
+                ctx.node_text(
+                    next((c for c in key_elem.children if c.is_named), key_elem)
+                )
+                if key_elem
+                else str(i)
+            )
+            val_reg = (
+                ctx.lower_expr(
+                    next((c for c in val_elem.children if c.is_named), val_elem)
+                )
+                if val_elem
+                else ctx.fresh_reg()
+            )
+            ctx.emit_inst(
+                StoreField(
+                    obj_reg=obj_reg, field_name=FieldName(key_name), value_reg=val_reg
+                ),
+                node=elem,
+            )
+        elif elem.type == GoNodeType.LITERAL_ELEMENT:
+            # Positional element
+            inner = next((c for c in elem.children if c.is_named), elem)
+            val_reg = ctx.lower_expr(inner)
+            idx_reg = ctx.fresh_reg()
+            ctx.emit_inst(Const.int_(idx_reg, i))
+            ctx.emit_inst(
+                StoreIndex(arr_reg=obj_reg, index_reg=idx_reg, value_reg=val_reg),
+                node=elem,
+            )
+        else:
+            # Direct expression element
+            val_reg = ctx.lower_expr(elem)
+            idx_reg = ctx.fresh_reg()
+            ctx.emit_inst(Const.int_(idx_reg, i))
+            ctx.emit_inst(
+                StoreIndex(arr_reg=obj_reg, index_reg=idx_reg, value_reg=val_reg),
+                node=elem,
+            )
+
+    return obj_reg
+
+
+def _lower_go_map_literal(
+    ctx: TreeSitterEmitContext, type_node, body_node, node
+) -> Register:
+    """Lower map[K]V{k: v, ...} to NEW_OBJECT + STORE_INDEX per entry.
+
+    Keys are lowered as expressions, so ``"a": 1`` stores under the string
+    ``a`` exactly as a later ``m["a"]`` reads it.  Keys and values are
+    checked against K and V, and either may be an elided literal.
+    """
+    map_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        NewObject(result_reg=map_reg, type_hint=parse_go_type(ctx, type_node)),
+        node=node,
+    )
+    entries = (
+        [c for c in body_node.children if c.type == GoNodeType.KEYED_ELEMENT]
+        if body_node
+        else []
+    )
+    key_type_node = type_node.child_by_field_name("key")
+    value_type_node = type_node.child_by_field_name("value")
+    for entry in entries:
+        children = [c for c in entry.children if c.is_named]
+        key_reg = _lower_go_element(ctx, children[0], key_type_node, "map literal")
+        val_reg = _lower_go_element(ctx, children[-1], value_type_node, "map literal")
+        ctx.emit_inst(
+            StoreIndex(arr_reg=map_reg, index_reg=key_reg, value_reg=val_reg),
+            node=entry,
+        )
+    return map_reg
+
+
+def go_declared_type_node(
+    node, name: str
+) -> Any:  # Any: tree-sitter node — untyped at Python boundary
+    """The type node of the file's ``type name T`` declaration, or None.
+
+    *node* is any node of the file, which is searched from its root.
+    """
+    root = node
+    while root.parent is not None:
+        root = root.parent
+    for decl in root.children:
+        if decl.type != GoNodeType.TYPE_DECLARATION:
+            continue
+        for spec in decl.children:
+            name_node = spec.child_by_field_name("name")
+            if (
+                spec.type == GoNodeType.TYPE_SPEC
+                and name_node is not None
+                and name_node.text.decode() == name
+            ):
+                return spec.child_by_field_name("type")
+    return None
+
+
+def _go_struct_info(ctx: TreeSitterEmitContext, type_node) -> ClassInfo | None:
+    """Symbol-table entry for a named struct type, or None.
+
+    ``pkg.T`` names struct T of an imported project package.
+    """
+    if type_node is None:
+        return None
+    if type_node.type == GoNodeType.QUALIFIED_TYPE:
+        type_name = go_project_type(ctx, type_node)
+    elif type_node.type == GoNodeType.TYPE_IDENTIFIER:
+        type_name = ctx.node_text(type_node)
+    else:
+        return None
+    return ctx.symbol_table.classes.get(ClassName(type_name)) if type_name else None
+
+
+def is_go_struct_type(ctx: TreeSitterEmitContext, type_node) -> bool:
+    """True when *type_node* names a struct declared in this program."""
+    return _go_struct_info(ctx, type_node) is not None
+
+
+def _lower_go_struct_literal(
+    ctx: TreeSitterEmitContext, struct_info: ClassInfo, body_node, node
+) -> Register:
+    """Lower T{...} for a declared struct T, or the zero value of T.
+
+    Positional elements are matched to fields in declaration order, and
+    every field the literal leaves out is set to its zero value, so each
+    field of the resulting object is always present.  A key may name a
+    field promoted from an embedded struct, which is set once the
+    embedded struct exists.  Each value is checked against its field's
+    type; unknown fields and surplus positional values raise
+    GoCompositeLiteralError.
+    """
+    obj_reg = ctx.fresh_reg()
+    type_hint = scalar(TypeName(struct_info.name.value))
+    ctx.emit_inst(NewObject(result_reg=obj_reg, type_hint=type_hint), node=node)
+    field_names = list(struct_info.fields)
+    elements = (
+        [c for c in body_node.children if c.is_named and c.type != GoNodeType.COMMENT]
+        if body_node
+        else []
+    )
+    assigned: set[FieldName] = set()
+    promoted: list[tuple[tuple[FieldName, ...], FieldName, Register, Any]] = []
+    for i, elem in enumerate(elements):
+        if elem.type == GoNodeType.KEYED_ELEMENT:
+            children = [c for c in elem.children if c.is_named]
+            field_name = FieldName(ctx.node_text(_unwrap_literal_element(children[0])))
+            value_node = _unwrap_literal_element(children[-1])
+        elif i < len(field_names):
+            field_name = field_names[i]
+            value_node = _unwrap_literal_element(elem)
+        else:
+            raise GoCompositeLiteralError(
+                f"too many values in struct literal of type {struct_info.name}"
+            )
+        path, owner = _go_literal_field_owner(ctx, struct_info, field_name)
+        check_go_element(
+            ctx, value_node, owner.fields[field_name].type_hint, "struct literal"
+        )
+        val_reg = ctx.lower_expr(value_node)
+        if path:
+            promoted.append((path, field_name, val_reg, elem))
+            continue
+        ctx.emit_inst(
+            StoreField(obj_reg=obj_reg, field_name=field_name, value_reg=val_reg),
+            node=elem,
+        )
+        assigned.add(field_name)
+    for path, field_name, _, _ in promoted:
+        if path[0] in assigned:
+            raise GoCompositeLiteralError(
+                f"cannot specify promoted field {field_name} "
+                f"and enclosing embedded field {path[0]}"
+            )
+    for field_name, field_info in struct_info.fields.items():
+        if field_name in assigned:
+            continue
+        type_node = _go_field_type_node(node, struct_info, field_name)
+        zero_reg = (
+            emit_go_zero_value(ctx, type_node)
+            if type_node is not None
+            else _emit_go_zero_for_type_text(ctx, field_info.type_hint, node)
+        )
+        ctx.emit_inst(
+            StoreField(obj_reg=obj_reg, field_name=field_name, value_reg=zero_reg),
+            node=node,
+        )
+    for path, field_name, val_reg, elem in promoted:
+        embedded_reg = obj_reg
+        for embedded in path:
+            field_reg = ctx.fresh_reg()
+            ctx.emit_inst(
+                LoadField(
+                    result_reg=field_reg, obj_reg=embedded_reg, field_name=embedded
+                ),
+                node=elem,
+            )
+            embedded_reg = field_reg
+        ctx.emit_inst(
+            StoreField(obj_reg=embedded_reg, field_name=field_name, value_reg=val_reg),
+            node=elem,
+        )
+    return obj_reg
+
+
+def _go_field_type_node(
+    node, struct_info: ClassInfo, field_name: FieldName
+) -> Any:  # Any: tree-sitter node — untyped at Python boundary
+    """Declared type node of a named field of struct T in this file, or None.
+
+    Embedded fields, and structs declared in another file, have none.
+    """
+    struct_node = go_declared_type_node(node, struct_info.name.value)
+    if struct_node is None or struct_node.type != GoNodeType.STRUCT_TYPE:
+        return None
+    field_list = next(
+        (c for c in struct_node.children if c.type == "field_declaration_list"), None
+    )
+    for decl in field_list.children if field_list is not None else []:
+        names = [c for c in decl.children if c.type == GoNodeType.FIELD_IDENTIFIER]
+        if any(n.text.decode() == field_name.value for n in names):
+            return decl.child_by_field_name("type")
+    return None
+
+
+def _go_literal_field_owner(
+    ctx: TreeSitterEmitContext, struct_info: ClassInfo, field_name: FieldName
+) -> tuple[tuple[FieldName, ...], ClassInfo]:
+    """Path of embedded fields to the struct declaring literal key *field_name*.
+
+    Returns the path with that struct's symbol-table entry; the path is
+    empty for a field of the literal's own type.  A promoted field cannot
+    be reached through an embedded pointer, which may be nil.
+    """
+    if field_name in struct_info.fields:
+        return (), struct_info
+    path = go_promoted_field(ctx, struct_info.name.value, str(field_name))
+    if not path:
+        raise GoCompositeLiteralError(
+            f"unknown field {field_name} in struct literal of type {struct_info.name}"
+        )
+    owner = struct_info
+    for embedded in path:
+        if owner.fields[embedded].type_hint.startswith("*"):
+            raise GoCompositeLiteralError(
+                f"invalid implicit pointer indirection to reach {field_name}"
+            )
+        owner = ctx.symbol_table.classes[ClassName(embedded.value)]
+    return path, owner
+
+
+def _static_go_int(ctx: TreeSitterEmitContext, node) -> int | None:
+    """Value of a constant integer expression (``3``, ``N``, ``N * 2``), or None."""
+    value = fold_go_const(ctx, node) if node is not None else None
+    return value if isinstance(value, int) and not isinstance(value, bool) else None
+
+
+def _unwrap_literal_element(node):
+    """Return the expression inside a literal_element wrapper."""
+    if node.type == GoNodeType.LITERAL_ELEMENT:
+        return next((c for c in node.children if c.is_named), node)
+    return node
+
+
+def _lower_go_element(
+    ctx: TreeSitterEmitContext, elem_node, elem_type_node, where: str
+) -> Register:
+    """Lower an element or map key of a literal whose elements are *elem_type_node*.
+
+    An elided literal such as ``{1, 2}`` in ``[]Point{{1, 2}}`` takes the
+    element type, or the pointee for ``[]*Point``; any other value is
+    checked against the element type (check_go_element).
+    """
+    value_node = _unwrap_literal_element(elem_node)
+    if elem_type_node is None:
+        return ctx.lower_expr(value_node)
+    if value_node.type == GoNodeType.LITERAL_VALUE:
+        literal_type = elem_type_node
+        if literal_type.type == GoNodeType.POINTER_TYPE:
+            literal_type = next(c for c in literal_type.children if c.is_named)
+        return _lower_go_typed_literal(ctx, literal_type, value_node, value_node)
+    check_go_element(ctx, value_node, ctx.node_text(elem_type_node), where)
+    return ctx.lower_expr(value_node)
+
+
+def _lower_go_array_literal(
+    ctx: TreeSitterEmitContext, type_node, body_node, node
+) -> Register:
+    """Lower [N]T{...} / [...]T{...} / []T{...}, or the zero value of [N]T.
+
+    Allocates a NEW_ARRAY with a concrete length field, fills every slot with
+    the element type's zero value, then stores each element — positionally or
+    at an explicit ``index: value`` key — after checking it against T.
+    """
+    elem_type_node = type_node.child_by_field_name("element")
+    length_node = type_node.child_by_field_name("length")
+    elements = [c for c in body_node.children if c.is_named] if body_node else []
+
+    # (static index or None, key node or None, value node) per element
+    placements: list[tuple[int | None, Any, Any]] = []
+    next_index: int | None = 0
+    for elem in elements:
+        if elem.type == GoNodeType.KEYED_ELEMENT:
+            children = [c for c in elem.children if c.is_named]
+            key_node = _unwrap_literal_element(children[0])
+            next_index = _static_go_int(ctx, key_node)
+            placements.append((next_index, key_node, children[-1]))
+        else:
+            placements.append((next_index, None, elem))
+        next_index = next_index + 1 if next_index is not None else None
+
+    static_length = (
+        _static_go_int(ctx, length_node)
+        if length_node is not None
+        else max((i + 1 for i, _, _ in placements if i is not None), default=0)
+    )
+    if static_length is not None:
+        size_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.int_(size_reg, static_length))
+    else:
+        size_reg = ctx.lower_expr(length_node)
+
+    arr_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        NewArray(
+            result_reg=arr_reg,
+            type_hint=parse_go_type(ctx, type_node),
+            size_reg=size_reg,
+        ),
+        node=node,
     )
-
-    type_name = ctx.node_text(type_node) if type_node else "Object"
-    obj_reg = ctx.fresh_reg()
     ctx.emit_inst(
-        NewObject(result_reg=obj_reg, type_hint=scalar(TypeName(type_name))), node=node
+        StoreField(
+            obj_reg=arr_reg,
+            field_name=FieldName("length", FieldKind.SPECIAL),
+            value_reg=size_reg,
+        )
     )
+    if elem_type_node is not None:
+        _emit_go_array_zero_fill(ctx, arr_reg, elem_type_node, static_length)
 
-    if not body_node:
-        return obj_reg
-
-    elements = [c for c in body_node.children if c.is_named]
-    for i, elem in enumerate(elements):
-        if elem.type == GoNodeType.KEYED_ELEMENT:
-            # Key-value pair: {Key: Value}
-            children = [c for c in elem.children if c.is_named]
-            key_elem = children[0] if children else None
-            val_elem = children[1] if len(children) > 1 else None
-            key_name = (
-                ctx.node_text(
-                    next((c for c in key_elem.children if c.is_named), key_elem)
-                )
-                if key_elem
-                else str(i)
-            )
-            val_reg = (
-                ctx.lower_expr(
-                    next((c for c in val_elem.children if c.is_named), val_elem)
-                )
-                if val_elem
-                else ctx.fresh_reg()
-            )
-            ctx.emit_inst(
-                StoreField(
-                    obj_reg=obj_reg, field_name=FieldName(key_name), value_reg=val_reg
-                ),
-                node=elem,
-            )
-        elif elem.type == GoNodeType.LITERAL_ELEMENT:
-            # Positional element
-            inner = next((c for c in elem.children if c.is_named), elem)
-            val_reg = ctx.lower_expr(inner)
+    for index, key_node, val_node in placements:
+        if index is not None:
             idx_reg = ctx.fresh_reg()
-            ctx.emit_inst(Const.int_(idx_reg, i))
-            ctx.emit_inst(
-                StoreIndex(arr_reg=obj_reg, index_reg=idx_reg, value_reg=val_reg),
-                node=elem,
-            )
+            ctx.emit_inst(Const.int_(idx_reg, index))
         else:
-            # Direct expression element
-            val_reg = ctx.lower_expr(elem)
+            idx_reg = ctx.lower_expr(key_node)
+        val_reg = _lower_go_element(
+            ctx, val_node, elem_type_node, "array or slice literal"
+        )
+        ctx.emit_inst(
+            StoreIndex(arr_reg=arr_reg, index_reg=idx_reg, value_reg=val_reg),
+            node=val_node,
+        )
+    return arr_reg
+
+
+def _emit_go_array_zero_fill(
+    ctx: TreeSitterEmitContext,
+    arr_reg: Register,
+    elem_type_node,
+    static_length: int | None,
+) -> None:
+    """Store the element zero value into every slot of a fresh array.
+
+    Nested arrays and structs need a distinct zero value per slot, so they
+    are unrolled (statically sized outer arrays only); scalar zero values
+    are shared and written in one ``__go_array_fill__`` call.
+    """
+    if elem_type_node.type == GoNodeType.ARRAY_TYPE or is_go_struct_type(
+        ctx, elem_type_node
+    ):
+        for i in range(static_length or 0):
+            val_reg = emit_go_zero_value(ctx, elem_type_node)
             idx_reg = ctx.fresh_reg()
             ctx.emit_inst(Const.int_(idx_reg, i))
             ctx.emit_inst(
-                StoreIndex(arr_reg=obj_reg, index_reg=idx_reg, value_reg=val_reg),
-                node=elem,
+                StoreIndex(arr_reg=arr_reg, index_reg=idx_reg, value_reg=val_reg)
             )
+        return
+    zero_reg = emit_go_zero_value(ctx, elem_type_node)
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=ctx.fresh_reg(),
+            func_name=FuncName("__go_array_fill__"),
+            args=(arr_reg, zero_reg),
+        )
+    )
+
+
+_GO_SCALAR_ZEROS: dict[str, Callable[[Register], Const]] = {
+    constants.FoundationTypeName.INT.value: lambda reg: Const.int_(reg, 0),
+    constants.FoundationTypeName.FLOAT.value: lambda reg: Const.float_(reg, 0.0),
+    constants.FoundationTypeName.BOOL.value: lambda reg: Const.bool_(reg, False),
+    constants.FoundationTypeName.STRING.value: lambda reg: Const.string(reg, ""),
+}
 
-    return obj_reg
+
+def emit_go_zero_value(ctx: TreeSitterEmitContext, type_node) -> Register:
+    """Emit the zero value of a Go type and return its register.
+
+    Numeric, bool and string types yield 0 / 0.0 / false / "", array types
+    yield a zero-filled array, struct types an object whose fields are all
+    zero, and pointers, slices, maps, channels, functions and interfaces
+    nil.
+    """
+    if type_node.type == GoNodeType.ARRAY_TYPE:
+        return _lower_go_array_literal(ctx, type_node, None, type_node)
+    return _emit_go_zero_for_type_text(ctx, ctx.node_text(type_node), type_node)
+
+
+def _emit_go_zero_for_type_text(
+    ctx: TreeSitterEmitContext, type_text: str, node
+) -> Register:
+    """Zero value for a type known only by its source text (e.g. a struct field).
+
+    A type parameter bound in the type map stands for its type argument,
+    and a named array type (``type Grid [3]int``) is zero-filled like its
+    underlying array.
+    """
+    type_name = ctx.type_map.get(type_text, type_text)
+    struct_info = ctx.symbol_table.classes.get(ClassName(type_name))
+    if struct_info is not None:
+        return _lower_go_struct_literal(ctx, struct_info, None, node)
+    declared = go_declared_type_node(node, type_name)
+    if declared is not None and declared.type == GoNodeType.ARRAY_TYPE:
+        return emit_go_zero_value(ctx, declared)
+    return _emit_go_scalar_zero(ctx, type_text, node)
+
+
+def _emit_go_scalar_zero(ctx: TreeSitterEmitContext, type_text: str, node) -> Register:
+    """Emit 0 / 0.0 / false / "" for a basic type name, nil for anything else.
+
+    Named types such as ``type Celsius float64`` take their underlying
+    type's zero.
+    """
+    canonical = go_underlying_type(ctx, type_text)
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(_GO_SCALAR_ZEROS.get(canonical, Const.null_)(reg), node=node)
+    return reg
 
 
 # -- Go: type conversion expression ----------------------------------------
 
+# []T(s) conversions that split a string, keyed by the target type's text.
+_GO_STRING_SPLIT_CONVERSIONS: dict[str, str] = {
+    "[]byte": "__go_bytes__",
+    "[]uint8": "__go_bytes__",
+    "[]rune": "__go_runes__",
+    "[]int32": "__go_runes__",
+}
+
+# Element kind of the []byte and []rune slice types, keyed by the type's text.
+_GO_BYTE_RUNE_SLICES: dict[str, str] = {
+    "[]byte": "byte",
+    "[]uint8": "byte",
+    "[]rune": "rune",
+    "[]int32": "rune",
+}
+
+# string(x) of a slice, by the element kind of x's static type.
+_GO_SLICE_STRING_BUILTINS: dict[str, str] = {
+    "byte": "__go_string_of_bytes__",
+    "rune": "__go_string_of_runes__",
+}
+
+
+def go_slice_element_kind(ctx: TreeSitterEmitContext, node) -> str:
+    """``"byte"`` or ``"rune"`` when *node* is statically a []byte or []rune.
+
+    *node* is a slice type, a conversion to, composite literal or ``make``
+    of one, a slice of such an expression, or a variable recorded in
+    ``ctx.go_slice_elements``.  Returns "" for anything else.
+    """
+    if node is None:
+        return ""
+    if node.type == GoNodeType.PARENTHESIZED_EXPRESSION:
+        inner = next((c for c in node.children if c.is_named), None)
+        return go_slice_element_kind(ctx, inner)
+    if node.type == GoNodeType.IDENTIFIER:
+        var_name = ctx.resolve_var(ctx.node_text(node))
+        return ctx.go_slice_elements.get(var_name, "")
+    if node.type == GoNodeType.SLICE_EXPRESSION:
+        return go_slice_element_kind(ctx, node.child_by_field_name("operand"))
+    type_node = node
+    if node.type in (
+        GoNodeType.TYPE_CONVERSION_EXPRESSION,
+        GoNodeType.COMPOSITE_LITERAL,
+    ):
+        type_node = node.child_by_field_name("type")
+    elif node.type == GoNodeType.CALL_EXPRESSION:
+        func_node = node.child_by_field_name("function")
+        args_node = node.child_by_field_name("arguments")
+        is_make = func_node is not None and ctx.node_text(func_node) == "make"
+        type_node = (
+            next((c for c in args_node.children if c.is_named), None)
+            if is_make and args_node is not None
+            else None
+        )
+    if type_node is None:
+        return ""
+    return _GO_BYTE_RUNE_SLICES.get(ctx.node_text(type_node).replace(" ", ""), "")
+
+
+def note_go_slice_elements(ctx: TreeSitterEmitContext, var_name: str, node) -> None:
+    """Record whether *var_name*, declared with the type or initializer *node*,
+    is a []byte or a []rune, so ``string(var_name)`` picks its builtin."""
+    kind = go_slice_element_kind(ctx, node)
+    if kind:
+        ctx.go_slice_elements[var_name] = kind
+    else:
+        ctx.go_slice_elements.pop(var_name, None)
+
 
 def lower_type_conversion(
     ctx: TreeSitterEmitContext, node: Any
@@ -275,12 +2325,31 @@ def lower_type_conversion(
 
     The node has a ``type`` field (the target type, e.g. ``[]byte``,
     ``generic_type``) and an ``operand`` field (the expression being converted).
-    We emit CALL_FUNCTION with the type text as the function name.
+    ``[]byte(s)`` and ``[]rune(s)`` call the builtins that split a string
+    into bytes or code points; other conversions emit CALL_CTOR with the
+    type text as the function name.
     """
     type_node = node.child_by_field_name("type")
     operand_node = node.child_by_field_name("operand")
     type_name = ctx.node_text(type_node) if type_node else "unknown_type"
     operand_reg = ctx.lower_expr(operand_node) if operand_node else ctx.fresh_reg()
+    split_builtin = _GO_STRING_SPLIT_CONVERSIONS.get(type_name.replace(" ", ""))
+    if split_builtin and operand_node is not None:
+        underlying, description = _go_conversion_operand(ctx, operand_node)
+        if underlying and underlying != constants.FoundationTypeName.STRING.value:
+            raise GoConversionError(
+                f"cannot convert {ctx.node_text(operand_node)} ({description}) "
+                f"to type {type_name}"
+            )
+        reg = ctx.fresh_reg()
+        ctx.emit_inst(
+            CallFunction(
+                result_reg=reg, func_name=FuncName(split_builtin), args=(operand_reg,)
+            ),
+            node=node,
+        )
+        ctx.seed_register_type(reg, array_of(scalar(constants.FoundationTypeName.INT)))
+        return reg
     reg = ctx.fresh_reg()
     ctx.emit_inst(
         CallCtorFunction(
@@ -314,24 +2383,148 @@ def lower_generic_type(
 def lower_type_assertion(
     ctx: TreeSitterEmitContext, node: Any
 ) -> Register:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower type_assertion_expression: x.(Type) -> CALL_FUNCTION('type_assert', x, Type)."""
-    named_children = [c for c in node.children if c.is_named]
-    if not named_children:
+    """Lower ``x.(T)``: yield x when its dynamic type satisfies T, else panic.
+
+    The check is ``go_expr.emit_go_type_check``; a failed assertion throws
+    ``interface conversion: ...`` the way an out-of-range index does.
+    """
+    operand_node = node.child_by_field_name("operand")
+    type_node = node.child_by_field_name("type")
+    if operand_node is None or type_node is None:
         return lower_string_literal(ctx, node, ctx.node_text(node))
-    expr_reg = ctx.lower_expr(named_children[0])
-    type_text = (
-        ctx.node_text(named_children[-1]) if len(named_children) > 1 else "interface{}"
+    val_reg = ctx.lower_expr(operand_node)
+    check_reg = emit_go_type_check(ctx, val_reg, type_node, node)
+    ok_label = ctx.fresh_label("type_assert_ok")
+    fail_label = ctx.fresh_label("type_assert_fail")
+    ctx.emit_inst(
+        BranchIf(cond_reg=check_reg, branch_targets=(ok_label, fail_label)),
+        node=node,
     )
-    reg = ctx.fresh_reg()
+    ctx.emit_inst(Label_(label=fail_label))
+    msg_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        Const.string(
+            msg_reg,
+            f"interface conversion: interface is not {ctx.node_text(type_node)}",
+        )
+    )
+    ctx.emit_inst(Throw_(value_reg=msg_reg), node=node)
+    ctx.emit_inst(Label_(label=ok_label))
+    return val_reg
+
+
+def lower_go_type_assertion_ok(
+    ctx: TreeSitterEmitContext, node
+) -> tuple[Register, Register]:
+    """Lower the comma-ok ``x.(T)`` of ``v, ok := x.(T)`` to (value, ok).
+
+    v is x when the assertion holds and the zero value of T otherwise; it
+    never panics.
+    """
+    operand_node = node.child_by_field_name("operand")
+    type_node = node.child_by_field_name("type")
+    val_reg = ctx.lower_expr(operand_node)
+    ok_reg = emit_go_type_check(ctx, val_reg, type_node, node)
+    hit_label = ctx.fresh_label("type_assert_ok")
+    miss_label = ctx.fresh_label("type_assert_miss")
+    end_label = ctx.fresh_label("type_assert_end")
+    result_var = VarName(f"__type_assert_{ctx.label_counter}")
+
+    ctx.emit_inst(BranchIf(cond_reg=ok_reg, branch_targets=(hit_label, miss_label)))
+    ctx.emit_inst(Label_(label=hit_label))
+    ctx.emit_inst(DeclVar(name=result_var, value_reg=val_reg))
+    ctx.emit_inst(Branch(label=end_label))
+    ctx.emit_inst(Label_(label=miss_label))
+    zero_reg = emit_go_zero_value(ctx, type_node)
+    ctx.emit_inst(DeclVar(name=result_var, value_reg=zero_reg))
+    ctx.emit_inst(Branch(label=end_label))
+    ctx.emit_inst(Label_(label=end_label))
+    result_reg = ctx.fresh_reg()
+    ctx.emit_inst(LoadVar(result_reg=result_reg, name=result_var))
+    return result_reg, ok_reg
+
+
+def emit_go_type_check(
+    ctx: TreeSitterEmitContext, val_reg: Register, type_node, node
+) -> Register:
+    """Emit ``CALL_FUNCTION __go_type_is__(x, name, ...)`` and return its register.
+
+    The names are the dynamic types that satisfy *type_node*: the struct or
+    basic type itself, every struct implementing an interface (structural,
+    from the symbol table) plus ``errors.errorString`` when an ``Error()``
+    method is all it needs, ``any`` for the empty interface and ``nil`` for
+    the ``case nil`` of a type switch.
+    """
+    name_regs = []
+    for name in _go_dynamic_type_names(ctx, type_node):
+        name_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.string(name_reg, name))
+        name_regs.append(name_reg)
+    check_reg = ctx.fresh_reg()
     ctx.emit_inst(
         CallFunction(
-            result_reg=reg,
-            func_name=FuncName("type_assert"),
-            args=(expr_reg, type_text),
+            result_reg=check_reg,
+            func_name=FuncName("__go_type_is__"),
+            args=(val_reg, *name_regs),
         ),
         node=node,
     )
-    return reg
+    return check_reg
+
+
+def _go_dynamic_type_names(ctx: TreeSitterEmitContext, type_node) -> list[str]:
+    """Runtime type names that satisfy a Go type in an assertion or type switch."""
+    if type_node.type == GoNodeType.POINTER_TYPE:
+        inner = next((c for c in type_node.children if c.is_named), type_node)
+        return _go_dynamic_type_names(ctx, inner)
+    if type_node.type in GO_COLLECTION_TYPES:
+        type_expr = parse_go_type(ctx, type_node)
+        if isinstance(type_expr, ParameterizedType):
+            return [type_expr.constructor]
+        return []
+    if type_node.type == GoNodeType.INTERFACE_TYPE:
+        required = {
+            FuncName(ctx.node_text(ident))
+            for elem in type_node.children
+            if elem.type == GoNodeType.METHOD_ELEM
+            for ident in elem.children
+            if ident.type == GoNodeType.FIELD_IDENTIFIER
+        }
+        if not required:
+            return ["any"]
+        return _go_implementors(ctx, required)
+    text = ctx.node_text(type_node)
+    if text == "any":
+        return ["any"]
+    if text == "nil":
+        return ["nil"]
+    if ClassName(text) in ctx.symbol_table.interfaces:
+        return _go_implementors(ctx, set(ctx.symbol_table.interfaces[ClassName(text)]))
+    return [ctx.type_map.get(text, text)]
+
+
+# Method sets of the dynamic types that builtins create (errors.New values).
+_GO_BUILTIN_METHOD_SETS: dict[str, frozenset[FuncName]] = {
+    constants.GO_ERROR_STRING_TYPE: frozenset({FuncName("Error")}),
+}
+
+
+def _go_implementors(ctx: TreeSitterEmitContext, required: set[FuncName]) -> list[str]:
+    """Dynamic type names whose method sets cover *required*.
+
+    Structs come from the symbol table, with the methods they declare or
+    promote from embedded fields, followed by builtin types such as
+    ``errors.errorString``.
+    """
+    structs = [
+        name.value
+        for name in ctx.symbol_table.classes
+        if required <= go_method_set(ctx, name)
+    ]
+    builtins = [
+        name for name, methods in _GO_BUILTIN_METHOD_SETS.items() if required <= methods
+    ]
+    return structs + builtins
 
 
 # -- Go: slice expression --------------------------------------------------
@@ -354,25 +2547,59 @@ def _make_const_null(ctx: TreeSitterEmitContext) -> Register:
 def lower_slice_expr(
     ctx: TreeSitterEmitContext, node: Any
 ) -> Register:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower slice_expression: a[low:high] -> CALL_FUNCTION('slice', a, low, high)."""
+    """Lower slice_expression: a[low:high] or a[low:high:max].
+
+    A slice or array goes through ``__go_slice__``, which returns a view of
+    the same backing array, so writes through either are seen by both.  A
+    string goes through ``__go_string_slice__`` instead, so the bounds are
+    UTF-8 byte offsets as in Go.
+    """
     operand_node = node.child_by_field_name("operand")
     obj_reg = ctx.lower_expr(operand_node) if operand_node else ctx.fresh_reg()
 
     start_node = node.child_by_field_name("start")
     end_node = node.child_by_field_name("end")
+    capacity_node = node.child_by_field_name("capacity")
 
     start_reg = ctx.lower_expr(start_node) if start_node else _make_const_zero(ctx)
     end_reg = ctx.lower_expr(end_node) if end_node else _make_const_null(ctx)
 
-    reg = ctx.fresh_reg()
+    if operand_node is not None and _is_go_string_operand(ctx, operand_node):
+        reg = _emit_go_checked_slice(
+            ctx, "__go_string_slice__", (obj_reg, start_reg, end_reg), node
+        )
+        ctx.seed_register_type(reg, scalar(constants.FoundationTypeName.STRING))
+        return reg
+    capacity_regs = (ctx.lower_expr(capacity_node),) if capacity_node else ()
+    return _emit_go_checked_slice(
+        ctx, "__go_slice__", (obj_reg, start_reg, end_reg, *capacity_regs), node
+    )
+
+
+def _emit_go_checked_slice(
+    ctx: TreeSitterEmitContext, func_name: str, args: tuple[Register, ...], node
+) -> Register:
+    """Emit a bounds-checked ``func_name(s, low, high[, max])`` call.
+
+    Bounds outside ``0 <= low <= high <= len(s)`` for a string, or
+    ``0 <= low <= high <= max <= cap(s)`` for a slice, panic with Go's
+    ``slice bounds out of range`` runtime error.
+    """
+    ok_reg = ctx.fresh_reg()
     ctx.emit_inst(
         CallFunction(
-            result_reg=reg,
-            func_name=FuncName("slice"),
-            args=(obj_reg, start_reg, end_reg),
+            result_reg=ok_reg,
+            func_name=FuncName("__go_slice_in_bounds__"),
+            args=args,
         ),
         node=node,
     )
+    emit_go_panic_unless(ctx, ok_reg, "runtime error: slice bounds out of range", node)
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(result_reg=reg, func_name=FuncName(func_name), args=args),
+        node=node,
+    )
     return reg
 
 
@@ -382,8 +2609,16 @@ def lower_slice_expr(
 def lower_func_literal(
     ctx: TreeSitterEmitContext, node: Any
 ) -> Register:  # Any: tree-sitter node — untyped at Python boundary
-    """Lower func_literal as an anonymous function."""
-    from interpreter.frontends.go.declarations import lower_go_params
+    """Lower func_literal as an anonymous function.
+
+    The literal's body is lowered inside the enclosing block scopes, so free
+    variables resolve to the enclosing declarations; the VM captures them by
+    reference in a closure environment shared with the enclosing frame.
+    """
+    from interpreter.frontends.go.declarations import (
+        lower_go_func_body,
+        lower_go_params,
+    )
 
     func_name = f"__anon_{ctx.label_counter}"
     func_label = ctx.fresh_label(f"{constants.FUNC_LABEL_PREFIX}{func_name}")
@@ -391,15 +2626,19 @@ def lower_func_literal(
 
     params_node = node.child_by_field_name(ctx.constants.func_params_field)
     body_node = node.child_by_field_name(ctx.constants.func_body_field)
+    result_node = node.child_by_field_name("result")
 
     ctx.emit_inst(Branch(label=end_label), node=node)
     ctx.emit_inst(Label_(label=func_label))
+    if result_node is not None:
+        ctx.seed_func_return_type(func_label, go_type_hint(ctx, result_node))
 
+    ctx.enter_block_scope()
     if params_node:
         lower_go_params(ctx, params_node)
 
-    if body_node:
-        ctx.lower_block(body_node)
+    lower_go_func_body(ctx, node, body_node)
+    ctx.exit_block_scope()
 
     emit_implicit_return(ctx, node)
     ctx.emit_inst(Label_(label=end_label))
@@ -412,115 +2651,474 @@ def lower_func_literal(
 # -- Go: rune and string literal lowerers ----------------------------------
 
 
-def _parse_go_rune_escape(inner: str) -> int:
-    """Parse Go rune escape sequences → Unicode code point."""
-    if len(inner) == 1:
-        return ord(inner)
-    if inner.startswith("\\"):
-        esc = inner[1:]
-        simple = {
-            "n": 10,
-            "t": 9,
-            "r": 13,
-            "\\": 92,
-            "'": 39,
-            '"': 34,
-            "a": 7,
-            "b": 8,
-            "f": 12,
-            "v": 11,
-            "0": 0,
-        }
-        if esc in simple:
-            return simple[esc]
-        if esc.startswith("x") and len(esc) == 3:
-            return int(esc[1:], 16)
-        if esc.startswith("u") and len(esc) == 5:
-            return int(esc[1:], 16)
-        if esc.startswith("U") and len(esc) == 9:
-            return int(esc[1:], 16)
-        # Octal: 3 octal digits
-        if len(esc) == 3 and all(c in "01234567" for c in esc):
-            return int(esc, 8)
-    return ord(inner[0]) if inner else 0
+class GoLiteralError(CompileError):
+    """A string or rune literal Go's lexer rejects, e.g. ``"\\q"`` or ``'ab'``."""
+
+    code = DiagnosticCode.INVALID_LITERAL
+
+    def __init__(self, literal: str, message: str):
+        super().__init__(f"{literal}: {message}")
+
+
+_GO_SIMPLE_ESCAPES: dict[str, int] = {
+    "a": 7,
+    "b": 8,
+    "f": 12,
+    "n": 10,
+    "r": 13,
+    "t": 9,
+    "v": 11,
+    "\\": 92,
+}
+
+# Escape introducer → number of hexadecimal digits that follow it
+_GO_HEX_ESCAPES: dict[str, int] = {"x": 2, "u": 4, "U": 8}
+_GO_DIGITS: dict[int, str] = {
+    2: "01",
+    8: "01234567",
+    10: "0123456789",
+    16: "0123456789abcdefABCDEF",
+}
+_GO_BASE_NAMES: dict[int, str] = {
+    2: "binary",
+    8: "octal",
+    10: "decimal",
+    16: "hexadecimal",
+}
+
+_GO_MAX_RUNE = 0x10FFFF
+
+
+def _scan_go_escape(literal: str, start: int) -> tuple[int, bool, int]:
+    """Decode the escape sequence whose backslash is at ``literal[start]``.
+
+    Returns the value, whether it is a single byte (``\\xNN`` and ``\\NNN``
+    octal escapes) rather than a code point, and the index just past the
+    sequence.  The literal's own quote may be escaped, the other may not.
+    A malformed sequence raises GoLiteralError with the Go compiler's message.
+    """
+    quote = literal[0]
+    esc = literal[start + 1]
+    if esc in _GO_SIMPLE_ESCAPES or esc == quote:
+        return _GO_SIMPLE_ESCAPES.get(esc, ord(esc)), False, start + 2
+    if esc in _GO_HEX_ESCAPES:
+        count, base, first = _GO_HEX_ESCAPES[esc], 16, start + 2
+    elif esc in _GO_DIGITS[8]:
+        count, base, first = 3, 8, start + 1
+    else:
+        raise GoLiteralError(literal, f"unknown escape sequence \\{esc}")
+    digits = literal[first : min(first + count, len(literal) - 1)]
+    for digit in digits:
+        if digit not in _GO_DIGITS[base]:
+            raise GoLiteralError(
+                literal,
+                f"invalid character {digit!r} in {_GO_BASE_NAMES[base]} escape",
+            )
+    if len(digits) < count:
+        raise GoLiteralError(literal, "escape sequence not terminated")
+    value = int(digits, base)
+    if esc == "x" or base == 8:
+        if value > 255:
+            raise GoLiteralError(literal, f"octal escape value {value} > 255")
+        return value, True, first + count
+    if value > _GO_MAX_RUNE or 0xD800 <= value < 0xE000:
+        raise GoLiteralError(
+            literal, f"escape is invalid Unicode code point U+{value:04X}"
+        )
+    return value, False, first + count
+
+
+def _go_rune_value(literal: str) -> int:
+    """Code point of a rune literal such as ``'a'``, ``'\\n'`` or ``'\\u00e9'``."""
+    if len(literal) < 3:
+        raise GoLiteralError(
+            literal, "empty rune literal or unescaped ' in rune literal"
+        )
+    if literal[1] == "\\":
+        value, _, end = _scan_go_escape(literal, 1)
+    else:
+        value, end = ord(literal[1]), 2
+    if end != len(literal) - 1:
+        raise GoLiteralError(literal, "more than one character in rune literal")
+    return value
+
+
+def _go_string_value(literal: str) -> str:
+    """Value of an interpreted ``"..."`` or raw ```...``` string literal.
+
+    Interpreted strings decode their escapes into UTF-8 bytes, so
+    ``"\\xe4\\xb8\\x96"`` is ``"世"``; bytes that do not form valid UTF-8
+    become U+FFFD.  Raw strings keep backslashes verbatim and drop carriage
+    returns, as in Go.
+    """
+    if literal.startswith("`"):
+        return literal[1:-1].replace("\r", "")
+    data = bytearray()
+    i = 1
+    while i < len(literal) - 1:
+        if literal[i] == "\\":
+            value, is_byte, i = _scan_go_escape(literal, i)
+            data.extend(bytes([value]) if is_byte else chr(value).encode("utf-8"))
+        else:
+            data.extend(literal[i].encode("utf-8"))
+            i += 1
+    return data.decode("utf-8", errors="replace")
 
 
 def lower_go_rune_literal(
     ctx: TreeSitterEmitContext, node: Any
 ) -> Register:  # Any: tree-sitter node — untyped at Python boundary
     """Rune literal 'x' → Const.int_ with Unicode code point."""
-    text = ctx.node_text(node)  # e.g. "'a'" or "'\n'" or "'\x41'"
-    # Strip surrounding single quotes
-    inner = text[1:-1]
-    cp = _parse_go_rune_escape(inner)
+    cp = _go_rune_value(ctx.node_text(node))  # e.g. "'a'" or "'\n'" or "'\x41'"
     reg = ctx.fresh_reg()
     ctx.emit_inst(Const.int_(reg, cp), node=node)
     return reg
 
 
-def _unescape_go_string(s: str) -> str:
-    """Unescape Go interpreted string escape sequences."""
-    result = []
-    i = 0
-    while i < len(s):
-        if s[i] == "\\" and i + 1 < len(s):
-            esc = s[i + 1]
-            simple = {
-                "n": "\n",
-                "t": "\t",
-                "r": "\r",
-                "\\": "\\",
-                '"': '"',
-                "'": "'",
-                "a": "\a",
-                "b": "\b",
-                "f": "\f",
-                "v": "\v",
-                "0": "\0",
-            }
-            if esc in simple:
-                result.append(simple[esc])
-                i += 2
-            elif esc == "x" and i + 3 < len(s):
-                result.append(chr(int(s[i + 2 : i + 4], 16)))
-                i += 4
-            elif esc == "u" and i + 5 < len(s):
-                result.append(chr(int(s[i + 2 : i + 6], 16)))
-                i += 6
-            elif esc == "U" and i + 9 < len(s):
-                result.append(chr(int(s[i + 2 : i + 10], 16)))
-                i += 10
-            elif esc.isdigit():  # octal
-                result.append(chr(int(s[i + 1 : i + 4], 8)))
-                i += 4
-            else:
-                result.append(s[i])
-                i += 1
-        else:
-            result.append(s[i])
-            i += 1
-    return "".join(result)
-
-
 def lower_go_interpreted_string_literal(
     ctx: TreeSitterEmitContext, node: Any
 ) -> Register:  # Any: tree-sitter node — untyped at Python boundary
     """Interpreted string literal "..." → unescape and emit Const.string."""
-    text = ctx.node_text(node)
-    # Strip surrounding double quotes
-    inner = text[1:-1]
-    value = _unescape_go_string(inner)
-    return lower_string_literal(ctx, node, value)
+    return lower_string_literal(ctx, node, _go_string_value(ctx.node_text(node)))
 
 
 def lower_go_raw_string_literal(
     ctx: TreeSitterEmitContext, node: Any
 ) -> Register:  # Any: tree-sitter node — untyped at Python boundary
-    """Raw string literal `...` → NO escape processing, just strip backticks."""
+    """Raw string literal `...` → no escape processing, carriage returns dropped."""
+    return lower_string_literal(ctx, node, _go_string_value(ctx.node_text(node)))
+
+
+# -- Go: numeric literal lowerers ------------------------------------------
+
+_GO_INT_PREFIXES: dict[str, int] = {"0b": 2, "0o": 8, "0x": 16}
+
+
+def _check_go_separators(literal: str, digits: str) -> None:
+    """Reject a ``_`` that does not sit between two *digits*.
+
+    A separator may also follow the base prefix, as in ``0x_FF``.
+    """
+    for i, ch in enumerate(literal):
+        if ch != "_":
+            continue
+        after_prefix = i == 2 and literal[:2].lower() in _GO_INT_PREFIXES
+        before_ok = after_prefix or (i > 0 and literal[i - 1] in digits)
+        after_ok = i + 1 < len(literal) and literal[i + 1] in digits
+        if not (before_ok and after_ok):
+            raise GoLiteralError(literal, "'_' must separate successive digits")
+
+
+def _go_int_value(literal: str) -> int:
+    """Value of an integer literal such as ``42``, ``0x1F``, ``0o755`` or ``1_000``.
+
+    A leading ``0`` without a letter is a legacy octal literal (``0755``).
+    Misplaced separators, missing digits and digits outside the base raise
+    GoLiteralError with the Go compiler's message.
+    """
+    prefix = literal[:2].lower()
+    if prefix in _GO_INT_PREFIXES:
+        base, digits = _GO_INT_PREFIXES[prefix], literal[2:]
+    elif len(literal) > 1 and literal[0] == "0":
+        base, digits = 8, literal[1:]
+    else:
+        base, digits = 10, literal
+    _check_go_separators(literal, _GO_DIGITS[16 if base == 16 else 10])
+    digits = digits.replace("_", "")
+    if not digits:
+        raise GoLiteralError(literal, f"{_GO_BASE_NAMES[base]} literal has no digits")
+    invalid = next((d for d in digits if d not in _GO_DIGITS[base]), None)
+    if invalid is not None:
+        raise GoLiteralError(
+            literal, f"invalid digit {invalid!r} in {_GO_BASE_NAMES[base]} literal"
+        )
+    return int(digits, base)
+
+
+def _go_float_value(literal: str) -> float:
+    """Value of a float literal such as ``1_000.5``, ``6.02e23`` or ``0x1p-2``."""
+    hexadecimal = literal[:2].lower() == "0x"
+    _check_go_separators(literal, _GO_DIGITS[16 if hexadecimal else 10])
+    text = literal.replace("_", "")
+    if not hexadecimal:
+        return float(text)
+    if "p" not in text.lower():
+        raise GoLiteralError(literal, "hexadecimal mantissa requires a 'p' exponent")
+    return float.fromhex(text)
+
+
+def lower_go_int_literal(
+    ctx: TreeSitterEmitContext, node: Any
+) -> Register:  # Any: tree-sitter node — untyped at Python boundary
+    """Integer literal in any base → Const.int_ of its value."""
+    value = _go_int_value(ctx.node_text(node))
+    return lower_int_literal(ctx, node, text=str(value))
+
+
+def lower_go_float_literal(
+    ctx: TreeSitterEmitContext, node: Any
+) -> Register:  # Any: tree-sitter node — untyped at Python boundary
+    """Float literal, decimal or hexadecimal → Const.float_ of its value."""
+    value = _go_float_value(ctx.node_text(node))
+    return lower_float_literal(ctx, node, text=repr(value))
+
+
+# -- Go: constant expressions ----------------------------------------------
+
+
+class GoConstantAssignmentError(CompileError):
+    """An assignment, ``++`` or ``--`` targets a constant.
+
+    The Go compiler rejects such programs, so lowering refuses them too.
+    """
+
+    code = DiagnosticCode.ASSIGNED_CONSTANT
+
+    def __init__(self, name: str):
+        super().__init__(f"cannot assign to {name}: {name} is a constant")
+
+
+class GoConstantOverflowError(CompileError):
+    """A constant too large for its type, which the Go compiler rejects.
+
+    ``int8(300)``, ``const b byte = 256`` and ``x := 1 << 63`` overflow the
+    type the constant is given; an untyped integer constant wider than
+    ``_GO_UNTYPED_INT_BITS`` (``1 << 600``) overflows every type.
+    """
+
+    code = DiagnosticCode.CONSTANT_OVERFLOW
+
+    def __init__(self, value: Any = None, type_name: str = ""):
+        message = f"constant {value} overflows {type_name}"
+        super().__init__(message if type_name else "constant overflow")
+
+
+# gc represents untyped integer constants exactly, up to 512 bits.
+_GO_UNTYPED_INT_BITS = 512
+
+# Bit size and signedness of each predeclared integer type.
+_GO_INT_SIZES: dict[str, tuple[int, bool]] = {
+    "int": (64, True),
+    "int8": (8, True),
+    "int16": (16, True),
+    "int32": (32, True),
+    "rune": (32, True),
+    "int64": (64, True),
+    "uint": (64, False),
+    "uint8": (8, False),
+    "byte": (8, False),
+    "uint16": (16, False),
+    "uint32": (32, False),
+    "uint64": (64, False),
+    "uintptr": (64, False),
+}
+
+
+def _check_go_int_range(
+    ctx: TreeSitterEmitContext, type_name: str, value: int
+) -> None:
+    """Raise GoConstantOverflowError unless *value* fits integer *type_name*.
+
+    A named type is checked against int, since the size of its underlying
+    integer type is not recorded.
+    """
+    name = type_name
+    if name not in _GO_INT_SIZES:
+        name = go_type_name(go_underlying_type(ctx, type_name))
+    if name not in _GO_INT_SIZES:
+        return
+    bits, signed = _GO_INT_SIZES[name]
+    low = -(1 << (bits - 1)) if signed else 0
+    high = (1 << (bits - 1 if signed else bits)) - 1
+    if not low <= value <= high:
+        raise GoConstantOverflowError(value, type_name)
+
+
+def _check_go_untyped(value: Any) -> Any:
+    """*value*, unless it is an integer wider than Go's untyped constants."""
+    if (
+        isinstance(value, int)
+        and not isinstance(value, bool)
+        and value.bit_length() > _GO_UNTYPED_INT_BITS
+    ):
+        raise GoConstantOverflowError()
+    return value
+
+
+def _go_const_shl(a: Any, b: Any) -> Any:
+    """Go ``<<``, refusing shifts that leave the untyped range before computing."""
+    if isinstance(b, int) and b > _GO_UNTYPED_INT_BITS and a:
+        raise GoConstantOverflowError()
+    return a << b
+
+
+def _go_const_div(a: Any, b: Any) -> Any:
+    """Go ``/``: integer division truncates toward zero."""
+    if isinstance(a, int) and isinstance(b, int):
+        quotient = abs(a) // abs(b)
+        return quotient if (a < 0) == (b < 0) else -quotient
+    return a / b
+
+
+def _go_const_mod(a: int, b: int) -> int:
+    """Go ``%``: the remainder takes the sign of the dividend."""
+    return a - b * _go_const_div(a, b)
+
+
+_GO_CONST_BINOPS: dict[str, Callable[[Any, Any], Any]] = {
+    "+": operator.add,
+    "-": operator.sub,
+    "*": operator.mul,
+    "/": _go_const_div,
+    "%": _go_const_mod,
+    "<<": _go_const_shl,
+    ">>": operator.rshift,
+    "&": operator.and_,
+    "|": operator.or_,
+    "^": operator.xor,
+    "&^": lambda a, b: a & ~b,
+    "==": operator.eq,
+    "!=": operator.ne,
+    "<": operator.lt,
+    "<=": operator.le,
+    ">": operator.gt,
+    ">=": operator.ge,
+    "&&": lambda a, b: a and b,
+    "||": lambda a, b: a or b,
+}
+
+_GO_CONST_UNOPS: dict[str, Callable[[Any], Any]] = {
+    "-": operator.neg,
+    "+": operator.pos,
+    "!": operator.not_,
+    "^": operator.invert,
+}
+
+
+def fold_go_const(ctx: TreeSitterEmitContext, node) -> Any:
+    """Evaluate a constant expression at compile time.
+
+    Handles literals, ``iota``, earlier constants, package constants such
+    as ``math.Pi``, parentheses, unary and binary operators with Go's
+    integer division, and numeric conversions or ``len`` of constants.
+    Returns None when *node* is not a constant expression this folder
+    understands, e.g. a division by zero.  Raises GoConstantOverflowError
+    for an integer result beyond Go's untyped constants, or a conversion
+    that does not fit its type (``int8(300)``).
+    """
+    try:
+        return _fold_go_const(ctx, node)
+    except (ArithmeticError, TypeError, ValueError):
+        return None
+
+
+def _fold_go_const(ctx: TreeSitterEmitContext, node) -> Any:
+    if node is None:
+        return None
     text = ctx.node_text(node)
-    # Strip surrounding backticks
-    value = text[1:-1]  # Do NOT unescape
-    return lower_string_literal(ctx, node, value)
+    if node.type == GoNodeType.INT_LITERAL:
+        return _go_int_value(text)
+    if node.type == GoNodeType.FLOAT_LITERAL:
+        return _go_float_value(text)
+    if node.type in (
+        GoNodeType.INTERPRETED_STRING_LITERAL,
+        GoNodeType.RAW_STRING_LITERAL,
+    ):
+        return _go_string_value(text)
+    if node.type == GoNodeType.RUNE_LITERAL:
+        return _go_rune_value(text)
+    if node.type in (GoNodeType.TRUE, GoNodeType.FALSE):
+        return node.type == GoNodeType.TRUE
+    if node.type == GoNodeType.IOTA:
+        return getattr(ctx, "_go_iota_value", 0)
+    if node.type == GoNodeType.IDENTIFIER:
+        return ctx.const_values.get(ctx.resolve_var(text))
+    if node.type == GoNodeType.SELECTOR_EXPRESSION:
+        operand_node = node.child_by_field_name("operand")
+        field_node = node.child_by_field_name("field")
+        if operand_node is None or field_node is None:
+            return None
+        return go_stdlib_const(ctx, operand_node, ctx.node_text(field_node))
+    if node.type == GoNodeType.PARENTHESIZED_EXPRESSION:
+        return _fold_go_const(ctx, next((c for c in node.children if c.is_named), None))
+    if node.type == GoNodeType.UNARY_EXPRESSION:
+        op_node = node.child_by_field_name("operator")
+        operand = _fold_go_const(ctx, node.child_by_field_name("operand"))
+        unop = _GO_CONST_UNOPS.get(op_node.type) if op_node is not None else None
+        if unop is None or operand is None:
+            return None
+        return _check_go_untyped(unop(operand))
+    if node.type == GoNodeType.BINARY_EXPRESSION:
+        op_node = node.child_by_field_name("operator")
+        binop = _GO_CONST_BINOPS.get(op_node.type) if op_node is not None else None
+        left = _fold_go_const(ctx, node.child_by_field_name("left"))
+        right = _fold_go_const(ctx, node.child_by_field_name("right"))
+        if binop is None or left is None or right is None:
+            return None
+        return _check_go_untyped(binop(left, right))
+    if node.type == GoNodeType.CALL_EXPRESSION:
+        func_node = node.child_by_field_name("function")
+        args_node = node.child_by_field_name("arguments")
+        args = [c for c in args_node.children if c.is_named] if args_node else []
+        if func_node is None or len(args) != 1:
+            return None
+        value = _fold_go_const(ctx, args[0])
+        if value is None:
+            return None
+        func_name = ctx.node_text(func_node)
+        if func_name == "len":
+            return len(value.encode("utf-8")) if isinstance(value, str) else None
+        if go_underlying_type(ctx, func_name) in _NUMERIC_CONVERSION_BUILTINS:
+            return convert_go_const(ctx, func_name, value)
+    return None
+
+
+def convert_go_const(ctx: TreeSitterEmitContext, type_name: str, value: Any) -> Any:
+    """Give a constant the numeric kind of *type_name* (``float64(1)`` is 1.0).
+
+    Non-numeric types leave *value* unchanged; a float that does not fit an
+    integer type exactly yields None, and an integer outside the range of
+    *type_name* raises GoConstantOverflowError.
+    """
+    builtin = _NUMERIC_CONVERSION_BUILTINS.get(go_underlying_type(ctx, type_name))
+    if builtin is None or isinstance(value, (bool, str)):
+        return value
+    if builtin == "float":
+        return float(value)
+    if isinstance(value, float):
+        if not value.is_integer():
+            return None
+        value = int(value)
+    _check_go_int_range(ctx, type_name, value)
+    return value
+
+
+def check_go_constant(ctx: TreeSitterEmitContext, value_node, type_name: str) -> None:
+    """Reject a constant *value_node* that overflows *type_name*.
+
+    An empty *type_name* stands for the constant's default type, so
+    ``x := 1 << 63`` overflows int.  Non-constant values are accepted.
+    """
+    value = fold_go_const(ctx, value_node)
+    if value is None:
+        return
+    convert_go_const(
+        ctx, type_name or _GO_CONSTANT_DEFAULTS.get(type(value), ""), value
+    )
+
+
+def emit_go_const_value(ctx: TreeSitterEmitContext, value: Any, node) -> Register:
+    """Emit a CONST holding a folded constant."""
+    reg = ctx.fresh_reg()
+    if isinstance(value, bool):
+        ctx.emit_inst(Const.bool_(reg, value), node=node)
+    elif isinstance(value, int):
+        ctx.emit_inst(Const.int_(reg, value), node=node)
+    elif isinstance(value, float):
+        ctx.emit_inst(Const.float_(reg, value), node=node)
+    else:
+        ctx.emit_inst(Const.string(reg, str(value)), node=node)
+    return reg
 
 
 # -- Go: expression list helpers -------------------------------------------
@@ -567,22 +3165,96 @@ def lower_expression_list(
     return [ctx.lower_expr(node)]
 
 
+# -- Go: multiple results --------------------------------------------------
+
+
+def emit_go_tuple(
+    ctx: TreeSitterEmitContext, regs: list[Register], node
+) -> Register:
+    """Pack several results into one tuple array, since RETURN carries one value."""
+    size_reg = ctx.fresh_reg()
+    ctx.emit_inst(Const.int_(size_reg, len(regs)))
+    tuple_reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        NewArray(
+            result_reg=tuple_reg, type_hint=scalar(TypeName("tuple")), size_reg=size_reg
+        ),
+        node=node,
+    )
+    for i, reg in enumerate(regs):
+        idx_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.int_(idx_reg, i))
+        ctx.emit_inst(StoreIndex(arr_reg=tuple_reg, index_reg=idx_reg, value_reg=reg))
+    return tuple_reg
+
+
+def unpack_go_tuple(
+    ctx: TreeSitterEmitContext, tuple_reg: Register, count: int, node
+) -> list[Register]:
+    """Load the *count* results packed by ``emit_go_tuple``."""
+    regs = []
+    for i in range(count):
+        idx_reg = ctx.fresh_reg()
+        ctx.emit_inst(Const.int_(idx_reg, i))
+        reg = ctx.fresh_reg()
+        ctx.emit_inst(
+            LoadIndex(result_reg=reg, arr_reg=tuple_reg, index_reg=idx_reg), node=node
+        )
+        regs.append(reg)
+    return regs
+
+
 # -- Go: store target with selector_expression -----------------------------
 
 
+def lower_go_update_target(
+    ctx: TreeSitterEmitContext,
+    target,
+    op: str,
+    rhs_reg: Register,
+    parent_node,
+    rhs_node=None,
+) -> None:
+    """Store ``target op rhs`` back into *target*: ``x op= y``, ``x++``, ``x--``.
+
+    The target is read with its ordinary lowering, so ``m[k] += 1`` on a
+    missing key starts from the map's zero value, as in Go.  *rhs_node*,
+    the expression *rhs_reg* holds, if any, types ``x /= y`` and ``x %= y``.
+    """
+    lhs_reg = ctx.lower_expr(target)
+    result_reg = emit_go_arith(
+        ctx, op, lhs_reg, rhs_reg, (target, rhs_node), parent_node
+    )
+    lower_go_store_target(ctx, target, result_reg, parent_node)
+
+
 def lower_go_store_target(
     ctx: TreeSitterEmitContext, target, val_reg: str, parent_node
 ) -> None:
     if target.type == GoNodeType.IDENTIFIER:
-        ctx.emit_inst(
-            StoreVar(name=VarName(ctx.node_text(target)), value_reg=val_reg),
-            node=parent_node,
-        )
+        name = ctx.resolve_var(ctx.node_text(target))
+        if name in ctx.const_values:
+            raise GoConstantAssignmentError(ctx.node_text(target))
+        ctx.emit_inst(StoreVar(name=VarName(name), value_reg=val_reg), node=parent_node)
     elif target.type == GoNodeType.SELECTOR_EXPRESSION:
         operand_node = target.child_by_field_name("operand")
         field_node = target.child_by_field_name("field")
-        if operand_node and field_node:
+        member = (
+            go_project_member(ctx, operand_node, ctx.node_text(field_node))
+            if operand_node and field_node
+            else ""
+        )
+        if member:
+            ctx.emit_inst(
+                StoreVar(name=VarName(member), value_reg=val_reg), node=parent_node
+            )
+        elif operand_node and field_node:
             obj_reg = ctx.lower_expr(operand_node)
+            if _is_go_pointer_operand(ctx, operand_node):
+                emit_go_nil_check(ctx, obj_reg, parent_node)
+            obj_reg = _emit_go_promotion(
+                ctx, obj_reg, operand_node, ctx.node_text(field_node), target
+            )
             ctx.emit_inst(
                 StoreField(
                     obj_reg=obj_reg,
@@ -597,10 +3269,22 @@ def lower_go_store_target(
         if operand_node and index_node:
             obj_reg = ctx.lower_expr(operand_node)
             idx_reg = ctx.lower_expr(index_node)
+            emit_go_bounds_check(ctx, operand_node, obj_reg, idx_reg, parent_node)
+            if is_go_map_index(ctx, target):
+                _emit_go_map_write_check(ctx, obj_reg, parent_node)
             ctx.emit_inst(
                 StoreIndex(arr_reg=obj_reg, index_reg=idx_reg, value_reg=val_reg),
                 node=parent_node,
             )
+    elif (
+        target.type == GoNodeType.UNARY_EXPRESSION
+        and target.child_by_field_name("operand") is not None
+    ):
+        ptr_reg = ctx.lower_expr(target.child_by_field_name("operand"))
+        emit_go_nil_check(ctx, ptr_reg, parent_node)
+        ctx.emit_inst(
+            StoreIndirect(ptr_reg=ptr_reg, value_reg=val_reg), node=parent_node
+        )
     else:
         ctx.emit_inst(
             StoreVar(name=VarName(ctx.node_text(target)), value_reg=val_reg),
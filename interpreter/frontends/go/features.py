@@ -11,54 +11,143 @@ class GoFeature(Enum):
 
     # Declarations
     SHORT_VAR_DECL = ":= short variable declaration"
-    VAR_DECLARATION = "var name Type = value declarations"
-    CONST_DECLARATION = "const name = value declarations"
-    STRUCT = "struct type declarations"
-    INTERFACE = "interface type declarations"
-    TYPE_ALIAS = "type Foo = Bar or type Foo Bar type declarations"
+    VAR_DECLARATION = (
+        "var name Type = value declarations; package vars initialized before main"
+    )
+    CONST_DECLARATION = "const declarations folded at compile time, read-only"
+    ZERO_VALUE = (
+        "var x T without an initializer: 0, \"\", false, zero-filled arrays and "
+        "structs, nil for pointers, slices, maps, channels, funcs and interfaces"
+    )
+    STRUCT = "struct type declarations, struct literals, and zero-valued fields"
+    INTERFACE = "interface type declarations, implicitly satisfied by method sets"
+    STRUCT_EMBEDDING = (
+        "embedded struct fields: promoted fields and methods, ambiguous selectors"
+    )
+    TYPE_ALIAS = (
+        "type Foo = Bar aliases and type Foo Bar named types, distinct from Bar"
+    )
+    DOC_COMMENT = (
+        "// comment blocks directly above package-level declarations, attached "
+        "as their doc comments"
+    )
 
     # Functions
     FUNCTION_DECLARATION = "func f(...) ReturnType function declarations"
+    INIT_FUNCTION = (
+        "func init() run before main, in declaration order, after package vars"
+    )
+    ENTRY_FUNCTION = "a configurable function run as the program in place of main"
     METHOD_DECLARATION = "func (r Receiver) m(...) method declarations"
-    MULTIPLE_RETURN = "functions returning multiple values"
-    VARIADIC = "variadic ...T parameters"
+    MULTIPLE_RETURN = (
+        "functions returning multiple values, unpacked by v, err := f() with "
+        "the count checked"
+    )
+    ERROR_TYPE = "predeclared error interface, err.Error(), and (T, error) results"
+    VARIADIC = "variadic ...T parameters and xs... argument spreading"
+    CLOSURE = "function literals capturing enclosing variables by reference"
+    FUNCTION_VALUE = "func(...) T types, functions stored in variables, indirect calls"
+    GENERIC_FUNCTION = (
+        "func Max[T cmp.Ordered](a, b T) T generic functions: type arguments "
+        "inferred or explicit, constraints checked, one instance per type argument"
+    )
 
     # Expressions
     ASSIGNMENT = "= and multi-variable assignment statements"
-    ARITHMETIC = "+, -, *, /, % arithmetic expressions"
+    COMPOUND_ASSIGNMENT = "+=, -=, *=, /=, %= and bitwise op= assignment statements"
+    ARITHMETIC = (
+        "+, -, *, /, % arithmetic; integer / and % truncate toward zero, and "
+        "division by zero panics"
+    )
+    LOGICAL_OPERATORS = "&& and || short-circuit: the right operand runs only if needed"
+    ORDERING = (
+        "<, <=, >, >= on integers, floats and strings (byte-wise); other types "
+        "and mismatched operands rejected"
+    )
     INC_DEC = "x++ and x-- increment/decrement statements"
     FUNCTION_CALL = "f(...) function call expressions"
     METHOD_CALL = "obj.m(...) method call expressions"
     FIELD_ACCESS = "struct field access via dot notation"
-    INDEXING = "a[i] map and slice index access"
-    COMPOSITE_LITERAL = "T{field: val} struct and collection literals"
+    INDEXING = "a[i] map, slice and string index access; s[i] of a string is a byte"
+    COMPOSITE_LITERAL = (
+        "T{field: val} struct, slice and map literals, with elided element types; "
+        "mistyped elements and unknown fields rejected"
+    )
     TYPE_ASSERTION = "x.(T) type assertion expressions"
-    TYPE_CONVERSION = "T(x) explicit type conversion expressions"
-    SLICE_EXPRESSION = "a[lo:hi] slice expressions"
+    TYPE_CONVERSION = (
+        "T(x) explicit conversions: numeric, string(x), []byte(s) and []rune(s); "
+        "invalid conversions rejected"
+    )
+    SLICE_EXPRESSION = "a[lo:hi] slice expressions; bounds-checked byte substrings"
     FUNC_LITERAL = "func(...) { } anonymous function literals"
     MAKE = "make(T, ...) built-in for slices, maps, and channels"
+    APPEND = "append(s, ...) and cap(s) built-ins for growable slices"
+    LEN = "len(x) of strings (in bytes), arrays, slices, maps, channels and nil"
     RUNE_LITERAL = "'c' rune (character) literals"
+    INT_LITERAL = (
+        "0x1F hex, 0o755 / 0755 octal and 0b1010 binary integer literals with "
+        "1_000_000 digit separators; misplaced separators and bad digits rejected"
+    )
+    STRING_LITERAL = (
+        "interpreted \"...\" strings with \\n, \\xNN, \\uNNNN escapes and raw `...` "
+        "strings; malformed escapes rejected"
+    )
+    BOOLEAN = "bool type, true / false literals, and boolean-valued comparisons"
+    FLOAT = "float32 / float64 literals, arithmetic, and numeric conversions"
     BLANK_IDENTIFIER = "_ blank identifier to discard values"
+    UNICODE_IDENTIFIER = (
+        "identifiers of Unicode letters and digits, NFC-normalized so composed "
+        "and decomposed spellings are one name"
+    )
     IOTA = "iota enumeration constant in const blocks"
     CHANNEL_TYPE = "chan T, chan<- T, and <-chan T channel types"
     SLICE_TYPE = "[]T slice type expressions"
+    ARRAY = "[N]T fixed-size arrays, array literals, and bounds-checked indexing"
+    MAP = "map[K]V literals, zero-value reads, delete, and comma-ok lookup"
+    POINTER = "*T pointer types, &x address-of, *p dereference, and nil-pointer panics"
+    NIL = (
+        "nil compared with pointers, slices, maps and interfaces; nil-map writes "
+        "panic; untyped nil and nil for basic or struct types rejected"
+    )
     GENERIC_TYPE = "generic type parameters (Go 1.18+)"
 
     # Control flow
-    IF_ELSE = "if / else if / else conditional branching"
+    IF_ELSE = "if / else if / else branching with scoped if x := f(); cond inits"
     FOR_LOOP = "for init; cond; post traditional loops"
-    FOR_RANGE = "for k, v := range collection loops"
+    FOR_RANGE = (
+        "for k, v := range collection loops; strings yield byte offsets and runes"
+    )
     SWITCH_STATEMENT = "switch / case / default statements"
     TYPE_SWITCH = "switch x.(type) type assertion switches"
-    SELECT_STATEMENT = "select / case channel multiplexing"
-    LABELED_STATEMENT = "labeled statements for targeted break/continue/goto"
+    SELECT_STATEMENT = "select over channels with default and seeded random choice"
+    LABELED_STATEMENT = (
+        "labeled statements for targeted break/continue/goto; undefined, "
+        "unused and misplaced labels rejected"
+    )
     GOTO = "goto label unconditional jumps"
     FALLTHROUGH = "fallthrough in switch cases"
     BREAK_CONTINUE = "break and continue statements"
     RETURN = "return statements (single and multiple values)"
 
+    # Standard library
+    STRINGS_PACKAGE = (
+        'import "strings": Contains, ToUpper, ToLower, Split, Index, Repeat'
+    )
+    MATH_PACKAGE = 'import "math": Sqrt, Abs, Pow, Max, Min, Pi, MaxInt, ...'
+    FMT_PACKAGE = 'import "fmt": Print, Println with output captured by the VM'
+    ERRORS_PACKAGE = 'import "errors": New, giving an error whose Error() is its text'
+    UNICODE_PACKAGE = (
+        'import "unicode" and "unicode/utf8": IsLetter, IsUpper, ToUpper, ..., '
+        "RuneCountInString, RuneLen"
+    )
+    PROJECT_PACKAGES = (
+        "multi-file programs: a directory of packages, pkg.Name references, "
+        "capitalized names exported, import cycles rejected"
+    )
+
     # Concurrency
-    DEFER = "defer statement to schedule function call on return"
-    GO_STATEMENT = "go f() goroutine launch statements"
+    DEFER = "defer: LIFO calls at function exit, arguments evaluated at defer time"
+    PANIC_RECOVER = "panic unwinding, recover() in deferred calls, uncaught panics"
+    GO_STATEMENT = "go f() goroutines on a cooperative, deterministic scheduler"
     SEND_STATEMENT = "ch <- val channel send statements"
     RECEIVE_STATEMENT = "<-ch channel receive expressions"
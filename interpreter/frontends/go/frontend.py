@@ -10,15 +10,21 @@ from interpreter.constants import Language
 from interpreter.frontend_observer import FrontendObserver, NullFrontendObserver
 from interpreter.frontends._base import BaseFrontend
 from interpreter.frontends.common import assignments as common_assign
-from interpreter.frontends.common import control_flow as common_cf
 from interpreter.frontends.common import expressions as common_expr
 from interpreter.frontends.context import GrammarConstants, TreeSitterEmitContext
 from interpreter.frontends.go import control_flow as go_cf
 from interpreter.frontends.go import declarations as go_decl
 from interpreter.frontends.go import expressions as go_expr
+from interpreter.frontends.go import generics as go_generics
+from interpreter.frontends.go.identifiers import normalize_go_identifiers
 from interpreter.frontends.go.node_types import GoNodeType
-from interpreter.frontends.go.type_alias_extractor import GoTypeAliasExtractor
+from interpreter.frontends.go.type_alias_extractor import (
+    GoNamedTypeExtractor,
+    GoTypeAliasExtractor,
+)
+from interpreter.frontends.offset_map import OffsetMap
 from interpreter.frontends.symbol_table import SymbolTable
+from interpreter.frontends.type_alias_prepass import collect_type_aliases
 from interpreter.parser import ParserFactory
 from interpreter.register import Register
 
@@ -33,9 +39,15 @@ class GoFrontend(BaseFrontend):
         parser_factory: ParserFactory,
         language: Language,
         observer: FrontendObserver = NullFrontendObserver(),
+        entry_func: str = "main",
     ) -> None:
         super().__init__(parser_factory, language, observer)
         self._type_alias_extractor = GoTypeAliasExtractor()
+        self._entry_func = entry_func
+
+    def _prepare_source(self, source: bytes) -> tuple[bytes, OffsetMap]:
+        """Put *source*'s identifiers in NFC (see ``go.identifiers``)."""
+        return normalize_go_identifiers(source)
 
     def _build_constants(self) -> GrammarConstants:
         return GrammarConstants(
@@ -50,9 +62,7 @@ class GoFrontend(BaseFrontend):
                     GoNodeType.NEWLINE,
                 }
             ),
-            block_node_types=frozenset(
-                {GoNodeType.BLOCK, GoNodeType.STATEMENT_LIST, GoNodeType.SOURCE_FILE}
-            ),
+            block_node_types=frozenset({GoNodeType.BLOCK, GoNodeType.STATEMENT_LIST}),
         )
 
     def _build_type_map(self) -> dict[str, str]:
@@ -81,8 +91,8 @@ class GoFrontend(BaseFrontend):
     ) -> dict[str, Callable[[TreeSitterEmitContext, Any], Register]]:
         return {
             GoNodeType.IDENTIFIER: common_expr.lower_identifier,
-            GoNodeType.INT_LITERAL: common_expr.lower_int_literal,
-            GoNodeType.FLOAT_LITERAL: common_expr.lower_float_literal,
+            GoNodeType.INT_LITERAL: go_expr.lower_go_int_literal,
+            GoNodeType.FLOAT_LITERAL: go_expr.lower_go_float_literal,
             GoNodeType.INTERPRETED_STRING_LITERAL: go_expr.lower_go_interpreted_string_literal,
             GoNodeType.RAW_STRING_LITERAL: go_expr.lower_go_raw_string_literal,
             GoNodeType.RUNE_LITERAL: go_expr.lower_go_rune_literal,
@@ -90,8 +100,8 @@ class GoFrontend(BaseFrontend):
             GoNodeType.TRUE: common_expr.lower_canonical_true,
             GoNodeType.FALSE: common_expr.lower_canonical_false,
             GoNodeType.NIL: common_expr.lower_canonical_none,
-            GoNodeType.BINARY_EXPRESSION: common_expr.lower_binop,
-            GoNodeType.UNARY_EXPRESSION: common_expr.lower_unop,
+            GoNodeType.BINARY_EXPRESSION: go_expr.lower_go_binop,
+            GoNodeType.UNARY_EXPRESSION: go_expr.lower_go_unary,
             GoNodeType.CALL_EXPRESSION: go_expr.lower_go_call,
             GoNodeType.SELECTOR_EXPRESSION: go_expr.lower_selector,
             GoNodeType.PARENTHESIZED_EXPRESSION: common_expr.lower_paren,
@@ -134,10 +144,10 @@ class GoFrontend(BaseFrontend):
             GoNodeType.DEC_STATEMENT: go_cf.lower_go_dec,
             GoNodeType.BLOCK: lambda ctx, node: ctx.lower_block(node),
             GoNodeType.STATEMENT_LIST: lambda ctx, node: ctx.lower_block(node),
-            GoNodeType.SOURCE_FILE: lambda ctx, node: ctx.lower_block(node),
+            GoNodeType.SOURCE_FILE: go_decl.lower_go_source_file,
             GoNodeType.VAR_DECLARATION: go_decl.lower_go_var_decl,
-            GoNodeType.BREAK_STATEMENT: common_cf.lower_break,
-            GoNodeType.CONTINUE_STATEMENT: common_cf.lower_continue,
+            GoNodeType.BREAK_STATEMENT: go_cf.lower_go_break,
+            GoNodeType.CONTINUE_STATEMENT: go_cf.lower_go_continue,
             GoNodeType.DEFER_STATEMENT: go_cf.lower_defer_stmt,
             GoNodeType.GO_STATEMENT: go_cf.lower_go_stmt,
             GoNodeType.EXPRESSION_SWITCH_STATEMENT: go_cf.lower_expression_switch,
@@ -155,3 +165,17 @@ class GoFrontend(BaseFrontend):
         from interpreter.frontends.go.declarations import extract_go_symbols
 
         return extract_go_symbols(root)
+
+    def _run_type_alias_prepass(self, root: Any, ctx: TreeSitterEmitContext) -> None:
+        """Seed aliases (``type C = float64``) and named types (``type M int``).
+
+        Function result types are collected afterwards, once the types they
+        name are known, together with the generic functions.  The entry
+        function is set here too, before anything is lowered.
+        """
+        ctx.go_entry = self._entry_func
+        super()._run_type_alias_prepass(root, ctx)
+        named_types = collect_type_aliases(root, GoNamedTypeExtractor(), ctx.type_map)
+        ctx.type_env_builder.named_types.update(named_types)
+        go_decl.collect_go_func_results(ctx, root)
+        go_generics.collect_go_generics(ctx, root)
@@ -0,0 +1,469 @@
+# pyright: standard
+"""Go generics: functions with type parameters, lowered per instantiation.
+
+``func Max[T cmp.Ordered](a, b T) T`` is recorded before lowering
+(``collect_go_generics``).  A call ``Max(x, y)`` or ``Max[int](x, y)``
+infers or takes its type arguments, checks them against the constraints
+and calls the instance ``Max[int]``.  Each instance is the generic's
+declaration lowered again after the file, with T bound to its argument in
+the type map, so the body is typed, converted and zero-initialized as if
+it had been written for int.  When an argument's type is not statically
+known, the call runs the generic declaration itself, lowered as written.
+
+Type arguments are named types: predeclared, declared or struct types.
+Constraints are ``any``, ``comparable``, the ``cmp`` and
+``golang.org/x/exp/constraints`` interfaces, unions of ``~T`` and ``T``
+terms, and interfaces made of such unions and methods.
+"""
+
+from __future__ import annotations
+
+import re
+from typing import Any
+
+from interpreter.class_name import ClassName
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.frontends.compile_error import CompileError
+from interpreter.frontends.context import GoGenericFunc, TreeSitterEmitContext
+from interpreter.frontends.go.embedding import go_method_set
+from interpreter.frontends.go.expressions import (
+    fold_go_const,
+    go_declared_type_node,
+    go_result_types,
+    go_static_type,
+    go_type_hint,
+    go_type_name,
+    go_underlying_type,
+)
+from interpreter.frontends.go.node_types import GoNodeType
+from interpreter.frontends.go.packages import go_project_type, go_qualified_name
+from interpreter.frontends.type_extraction import normalize_type_hint
+from interpreter.func_name import FuncName
+from interpreter.instructions import CallFunction
+from interpreter.register import Register
+from interpreter.types.type_expr import (
+    UNKNOWN,
+    ParameterizedType,
+    ScalarType,
+    TypeExpr,
+    array_of,
+)
+
+_GO_SIGNED = "~int | ~int8 | ~int16 | ~int32 | ~int64"
+_GO_UNSIGNED = "~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr"
+_GO_FLOAT = "~float32 | ~float64"
+_GO_ORDERED = f"{_GO_SIGNED} | {_GO_UNSIGNED} | {_GO_FLOAT} | ~string"
+
+# Import path → constraint name → the union of terms it stands for.
+_GO_STDLIB_CONSTRAINTS: dict[str, dict[str, str]] = {
+    "cmp": {"Ordered": _GO_ORDERED},
+    "golang.org/x/exp/constraints": {
+        "Ordered": _GO_ORDERED,
+        "Integer": f"{_GO_SIGNED} | {_GO_UNSIGNED}",
+        "Signed": _GO_SIGNED,
+        "Unsigned": _GO_UNSIGNED,
+        "Float": _GO_FLOAT,
+    },
+}
+
+# Kinds of untyped constants, in the order Go prefers them when they mix.
+_GO_NUMERIC_KINDS = ("int", "rune", "float")
+
+
+class GoGenericError(CompileError):
+    """A call of a generic function Go rejects.
+
+    Its type arguments cannot be inferred, the arguments disagree about
+    one, or a type argument does not satisfy its constraint.
+    """
+
+    code = DiagnosticCode.INVALID_TYPE_ARGUMENT
+
+
+def collect_go_generics(ctx: TreeSitterEmitContext, root) -> None:
+    """Record each top-level function with type parameters in ``ctx.go_generics``."""
+    for child in root.children:
+        if child.type != GoNodeType.FUNCTION_DECLARATION:
+            continue
+        name_node = child.child_by_field_name("name")
+        params_node = child.child_by_field_name("type_parameters")
+        if name_node is None or params_node is None:
+            continue
+        type_params = tuple(
+            (ctx.node_text(param), decl.child_by_field_name("type"))
+            for decl in params_node.children
+            if decl.type == GoNodeType.TYPE_PARAMETER_DECLARATION
+            for param in decl.children_by_field_name("name")
+        )
+        ctx.go_generics[ctx.node_text(name_node)] = GoGenericFunc(child, type_params)
+
+
+def go_generic_callee(ctx: TreeSitterEmitContext, func_node) -> str:
+    """Name of the generic function *func_node* calls, or "".
+
+    ``Max`` and an explicit instantiation ``Max[int]`` — which parses as an
+    index expression — both name the generic Max, unless a parameter or
+    local variable hides it.
+    """
+    if func_node is not None and func_node.type == GoNodeType.INDEX_EXPRESSION:
+        func_node = func_node.child_by_field_name("operand")
+    if func_node is None or func_node.type != GoNodeType.IDENTIFIER:
+        return ""
+    name = ctx.node_text(func_node)
+    if name not in ctx.go_generics:
+        return ""
+    return name if ctx.resolve_var(name) == go_qualified_name(ctx, name) else ""
+
+
+def lower_go_generic_call(
+    ctx: TreeSitterEmitContext, name: str, node, arg_regs: list
+) -> Register:
+    """Emit a call of the generic *name*, through the instance it needs.
+
+    The instance is registered in ``ctx.go_instances`` for lowering after
+    the file, and the result register gets the instance's result type.
+    """
+    generic = ctx.go_generics[name]
+    type_args = _go_type_args(ctx, name, generic, node)
+    func_name = ctx.resolve_var(name)
+    if type_args:
+        instance = f"{name}[{','.join(map(go_type_name, type_args))}]"
+        ctx.go_instances.setdefault(instance, (name, type_args))
+        func_name = go_qualified_name(ctx, instance)
+    reg = ctx.fresh_reg()
+    ctx.emit_inst(
+        CallFunction(
+            result_reg=reg, func_name=FuncName(func_name), args=tuple(arg_regs)
+        ),
+        node=node,
+    )
+    if type_args:
+        outer_map = ctx.type_map
+        ctx.type_map = go_instance_type_map(ctx, generic, type_args)
+        results = go_result_types(ctx, generic.node.child_by_field_name("result"))
+        ctx.type_map = outer_map
+        if len(results) == 1:
+            ctx.seed_register_type(reg, results[0])
+    return reg
+
+
+def go_instance_type_map(
+    ctx: TreeSitterEmitContext, generic: GoGenericFunc, type_args: tuple[str, ...]
+) -> dict[str, str]:
+    """The type map with each type parameter of *generic* bound to its argument."""
+    bound = {name: arg for (name, _), arg in zip(generic.type_params, type_args)}
+    return {**ctx.type_map, **bound}
+
+
+# -- Type argument inference -----------------------------------------------
+
+
+def _go_type_args(
+    ctx: TreeSitterEmitContext, name: str, generic: GoGenericFunc, node
+) -> tuple[str, ...]:
+    """Canonical type arguments of a call of *generic*, checked against its constraints.
+
+    Explicit type arguments come first; the rest are inferred from the
+    arguments.  Returns () when an argument's type is not statically known
+    or a type argument is not a named type, so the call runs the generic
+    as written.  Raises GoGenericError when Go would reject the call.
+    """
+    param_names = [param for param, _ in generic.type_params]
+    explicit = [_go_type_arg(ctx, arg) for arg in _go_explicit_type_args(node)]
+    if len(explicit) > len(param_names):
+        raise GoGenericError(
+            f"got {len(explicit)} type arguments but want {len(param_names)}"
+        )
+    if "" in explicit:
+        return ()
+    bound = dict(zip(param_names, explicit))
+    untyped: dict[str, list[tuple[str, str]]] = {}
+    unknown = False
+    for pattern, arg_node in _go_param_patterns(ctx, generic.node, node):
+        param, actual = _go_match_pattern(ctx, param_names, pattern, arg_node)
+        if not param:
+            continue
+        if not actual:
+            default, kind = _go_untyped_constant(ctx, arg_node)
+            if kind and str(pattern) == param:
+                untyped.setdefault(param, []).append((default, kind))
+            else:
+                unknown = True
+            continue
+        if param in bound and bound[param] != actual:
+            raise GoGenericError(
+                f"in call to {name}, type {go_type_name(actual)} of "
+                f"{ctx.node_text(arg_node)} does not match inferred type "
+                f"{go_type_name(bound[param])} for {param}"
+            )
+        bound[param] = actual
+    for param, constants in untyped.items():
+        if param not in bound:
+            bound[param] = _go_untyped_default(name, param, constants)
+    missing = [param for param in param_names if param not in bound]
+    if missing and unknown:
+        return ()
+    if missing:
+        raise GoGenericError(f"in call to {name}, cannot infer {missing[0]}")
+    type_args = tuple(bound[param] for param in param_names)
+    for (_, constraint), type_arg in zip(generic.type_params, type_args):
+        _check_go_constraint(ctx, type_arg, constraint)
+    return type_args
+
+
+def _go_explicit_type_args(node) -> list:
+    """Type argument nodes of ``f[int](x)``, whichever way the call parsed."""
+    type_args = node.child_by_field_name("type_arguments")
+    if type_args is not None:
+        return [c for c in type_args.children if c.is_named]
+    func_node = node.child_by_field_name("function")
+    if func_node is not None and func_node.type == GoNodeType.INDEX_EXPRESSION:
+        index = func_node.child_by_field_name("index")
+        return [index] if index is not None else []
+    return []
+
+
+def _go_type_arg(ctx: TreeSitterEmitContext, node) -> str:
+    """Canonical name of an explicit type argument, or "" unless it names a type."""
+    named = [c for c in node.children if c.is_named]
+    if node.type == GoNodeType.TYPE_ELEM and len(named) == 1:
+        node = named[0]
+    if node.type == GoNodeType.QUALIFIED_TYPE:
+        return go_project_type(ctx, node)
+    if node.type in (GoNodeType.TYPE_IDENTIFIER, GoNodeType.IDENTIFIER):
+        return str(normalize_type_hint(ctx.node_text(node), ctx.type_map))
+    return ""
+
+
+def _go_param_patterns(
+    ctx: TreeSitterEmitContext, func_node, call_node
+) -> list[tuple[TypeExpr, Any]]:  # Any: tree-sitter node — untyped at Python boundary
+    """Pair each call argument with the declared type of its parameter.
+
+    Arguments past the last parameter belong to a variadic ``...T``: each
+    is matched against T, and a spread ``xs...`` against []T.
+    """
+    args_node = call_node.child_by_field_name("arguments")
+    params_node = func_node.child_by_field_name("parameters")
+    arg_nodes = [c for c in args_node.children if c.is_named] if args_node else []
+    patterns: list[TypeExpr] = []
+    variadic: TypeExpr = UNKNOWN
+    for child in params_node.children if params_node else []:
+        type_node = child.child_by_field_name("type")
+        if child.type == GoNodeType.PARAMETER_DECLARATION:
+            count = max(len(child.children_by_field_name("name")), 1)
+            patterns.extend([go_type_hint(ctx, type_node)] * count)
+        elif child.type == GoNodeType.VARIADIC_PARAMETER_DECLARATION:
+            variadic = go_type_hint(ctx, type_node)
+    pairs = list(zip(patterns, arg_nodes))
+    for arg_node in arg_nodes[len(patterns) :] if variadic else []:
+        if arg_node.type == GoNodeType.VARIADIC_ARGUMENT:
+            inner = next(c for c in arg_node.children if c.is_named)
+            pairs.append((array_of(variadic), inner))
+        else:
+            pairs.append((variadic, arg_node))
+    return pairs
+
+
+def _go_match_pattern(
+    ctx: TreeSitterEmitContext, param_names: list[str], pattern: TypeExpr, arg_node
+) -> tuple[str, str]:
+    """The type parameter *pattern* mentions and what *arg_node* binds it to.
+
+    ``T`` matches the argument's own type and ``[]T`` its element type.
+    Returns ("", "") when *pattern* mentions no type parameter, and
+    (T, "") when the argument's type is not a known named type or T sits
+    in a pattern this inference does not take apart, such as ``func(T) U``.
+    """
+    actual = _go_arg_type(ctx, arg_node)
+    while (
+        isinstance(pattern, ParameterizedType)
+        and pattern.constructor == "Array"
+        and len(pattern.arguments) == 1
+    ):
+        pattern = pattern.arguments[0]
+        is_slice = (
+            isinstance(actual, ParameterizedType) and actual.constructor == "Array"
+        )
+        actual = actual.arguments[0] if is_slice else UNKNOWN
+    if not isinstance(pattern, ScalarType) or str(pattern) not in param_names:
+        mentioned = set(re.findall(r"\w+", str(pattern))) & set(param_names)
+        return min(mentioned, default=""), ""
+    if not isinstance(actual, ScalarType) or _is_go_unbound_param(ctx, str(actual)):
+        return str(pattern), ""
+    return str(pattern), str(actual)
+
+
+def _is_go_unbound_param(ctx: TreeSitterEmitContext, type_name: str) -> bool:
+    """True for a type parameter of the generic declaration lowered as written."""
+    return type_name not in ctx.type_map and any(
+        param == type_name
+        for generic in ctx.go_generics.values()
+        for param, _ in generic.type_params
+    )
+
+
+def _go_arg_type(ctx: TreeSitterEmitContext, node) -> TypeExpr:
+    """Static type of a call argument; a composite literal has its written type."""
+    if node.type == GoNodeType.COMPOSITE_LITERAL:
+        return go_type_hint(ctx, node.child_by_field_name("type"))
+    return go_static_type(ctx, node)
+
+
+def _go_untyped_constant(ctx: TreeSitterEmitContext, node) -> tuple[str, str]:
+    """Default type and kind (``"int"``, ``"rune"`` ...) of an untyped constant.
+
+    Returns ("", "") when *node* is not a constant expression.
+    """
+    value = fold_go_const(ctx, node)
+    if isinstance(value, bool):
+        return str(normalize_type_hint("bool", ctx.type_map)), "bool"
+    if isinstance(value, str):
+        return str(normalize_type_hint("string", ctx.type_map)), "string"
+    if isinstance(value, float):
+        return str(normalize_type_hint("float64", ctx.type_map)), "float"
+    if isinstance(value, int):
+        kind = "rune" if node.type == GoNodeType.RUNE_LITERAL else "int"
+        return str(normalize_type_hint(kind, ctx.type_map)), kind
+    return "", ""
+
+
+def _go_untyped_default(
+    name: str, param: str, constants: list[tuple[str, str]]
+) -> str:
+    """Type parameter *param* bound only by untyped constants takes their default type.
+
+    Mixed numeric kinds take the last of int, rune, float; any other mix
+    is rejected.
+    """
+    kinds = [kind for _, kind in constants]
+    if len(set(kinds)) == 1:
+        return constants[0][0]
+    if set(kinds) <= set(_GO_NUMERIC_KINDS):
+        widest = max(kinds, key=_GO_NUMERIC_KINDS.index)
+        return next(default for default, kind in constants if kind == widest)
+    other = next(kind for kind in kinds if kind != kinds[0])
+    raise GoGenericError(
+        f"in call to {name}, mismatched types untyped {kinds[0]} and "
+        f"untyped {other} (cannot infer {param})"
+    )
+
+
+# -- Constraint satisfaction -----------------------------------------------
+
+
+def _check_go_constraint(ctx: TreeSitterEmitContext, type_arg: str, constraint) -> None:
+    """Reject *type_arg* unless it satisfies the type parameter's *constraint*."""
+    reason = _go_unsatisfied(ctx, type_arg, constraint)
+    if reason:
+        raise GoGenericError(
+            f"{go_type_name(type_arg)} does not satisfy "
+            f"{ctx.node_text(constraint)} ({reason})"
+        )
+
+
+def _go_unsatisfied(ctx: TreeSitterEmitContext, type_arg: str, node) -> str:
+    """Why *type_arg* is not in the type set of constraint *node*, or "" if it is."""
+    terms = [c for c in node.children if c.is_named]
+    if node.type in (GoNodeType.TYPE_CONSTRAINT, GoNodeType.TYPE_ELEM):
+        if len(terms) == 1:
+            return _go_unsatisfied(ctx, type_arg, terms[0])
+        return _go_missing_in(ctx, type_arg, [ctx.node_text(t) for t in terms])
+    if node.type == GoNodeType.INTERFACE_TYPE:
+        return _go_interface_unsatisfied(ctx, type_arg, terms)
+    if node.type == GoNodeType.QUALIFIED_TYPE:
+        return _go_stdlib_unsatisfied(ctx, type_arg, node)
+    name = ctx.node_text(node)
+    if name in ("any", "comparable"):
+        return ""
+    interface = _go_declared_interface(node, name)
+    if interface is not None:
+        elems = [c for c in interface.children if c.is_named]
+        return _go_interface_unsatisfied(ctx, type_arg, elems)
+    required = ctx.symbol_table.interfaces.get(ClassName(name))
+    if required is not None:
+        return _go_missing_method(ctx, type_arg, required)
+    return _go_missing_in(ctx, type_arg, [name])
+
+
+def _go_interface_unsatisfied(
+    ctx: TreeSitterEmitContext, type_arg: str, elems: list
+) -> str:
+    """First reason *type_arg* fails an interface's type unions or methods."""
+    for elem in elems:
+        if elem.type == GoNodeType.METHOD_ELEM:
+            method = next(
+                c for c in elem.children if c.type == GoNodeType.FIELD_IDENTIFIER
+            )
+            reason = _go_missing_method(
+                ctx, type_arg, (FuncName(ctx.node_text(method)),)
+            )
+        else:
+            reason = _go_unsatisfied(ctx, type_arg, elem)
+        if reason:
+            return reason
+    return ""
+
+
+def _go_stdlib_unsatisfied(ctx: TreeSitterEmitContext, type_arg: str, node) -> str:
+    """Check a constraint of the standard library, such as ``cmp.Ordered``.
+
+    Constraints of other packages are not known and accept anything.
+    """
+    package_node = node.child_by_field_name("package")
+    name_node = node.child_by_field_name("name")
+    path = ctx.go_imports.get(ctx.node_text(package_node)) if package_node else ""
+    constraints = _GO_STDLIB_CONSTRAINTS.get(path or "", {})
+    terms = constraints.get(ctx.node_text(name_node)) if name_node else None
+    return _go_missing_in(ctx, type_arg, terms.split(" | ")) if terms else ""
+
+
+def _go_missing_in(ctx: TreeSitterEmitContext, type_arg: str, terms: list[str]) -> str:
+    """"T missing in ~int | string" unless *type_arg* matches one of *terms*.
+
+    ``~int`` is matched by any type whose underlying type is int, ``int``
+    only by int itself.
+    """
+    for term in terms:
+        target = str(normalize_type_hint(term.lstrip("~ "), ctx.type_map))
+        if not term.startswith("~") and type_arg == target:
+            return ""
+        if term.startswith("~") and _go_underlying(ctx, type_arg) == _go_underlying(
+            ctx, target
+        ):
+            return ""
+    return f"{go_type_name(type_arg)} missing in {' | '.join(terms)}"
+
+
+def _go_underlying(ctx: TreeSitterEmitContext, type_name: str) -> str:
+    """Underlying type of a canonical type name; predeclared types are their own."""
+    return go_underlying_type(ctx, type_name) or type_name
+
+
+def _go_missing_method(
+    ctx: TreeSitterEmitContext, type_arg: str, required: tuple[FuncName, ...]
+) -> str:
+    """"missing method M" when *type_arg* lacks one of the *required* methods.
+
+    Struct types have the methods they declare or promote from embedded
+    fields and predeclared types none; the methods of other named types
+    are not tracked, so they are assumed present.
+    """
+    if ClassName(type_arg) in ctx.symbol_table.classes:
+        methods = go_method_set(ctx, ClassName(type_arg))
+    elif _go_underlying(ctx, type_arg) == type_arg:
+        methods = set()
+    else:
+        return ""
+    missing = next((m for m in required if m not in methods), None)
+    return f"missing method {missing}" if missing is not None else ""
+
+
+def _go_declared_interface(
+    node, name: str
+) -> Any:  # Any: tree-sitter node — untyped at Python boundary
+    """The interface_type of the file's ``type name interface{...}``, or None."""
+    type_node = go_declared_type_node(node, name)
+    if type_node is not None and type_node.type == GoNodeType.INTERFACE_TYPE:
+        return type_node
+    return None
@@ -0,0 +1,82 @@
+# pyright: standard
+"""Go identifiers — Unicode letters and digits, in one canonical spelling.
+
+Go identifiers are a letter (Unicode category L, or ``_``) followed by
+letters and digits (category Nd), so ``größe`` and ``変数`` are as good as
+``x``.  Unicode spells many letters two ways, though: ``é`` is one code
+point (NFC) or ``e`` plus a combining accent (NFD), and the combining
+accent is not a letter, so an editor that writes NFD turns ``café`` into
+an identifier the lexer rejects, or into a name distinct from the NFC
+``café`` on the next line.  ``normalize_go_identifiers`` puts the code
+outside string literals, rune literals and comments into NFC before
+parsing, so each name has one spelling; literal text is left untouched,
+as Go gives it meaning byte for byte.  NFC can shorten a name, so it also
+returns an ``OffsetMap`` that carries positions in the normalized source
+back to the file.
+"""
+
+from __future__ import annotations
+
+import re
+import unicodedata
+
+from interpreter.frontends.offset_map import OffsetMap, Rewrite
+
+# String, raw string and rune literals and comments, each possibly cut off
+# by the end of the source.
+_LITERAL_OR_COMMENT = re.compile(
+    r'"(?:\\.|[^"\\\n])*"?'
+    r"|`[^`]*`?"
+    r"|'(?:\\.|[^'\\\n])*'?"
+    r"|//[^\n]*"
+    r"|/\*.*?(?:\*/|\Z)",
+    re.DOTALL,
+)
+
+# Runs of code normalized one at a time, so no rewrite spans a newline.
+# Whitespace never composes with what follows it, so this changes nothing.
+_CODE_RUN = re.compile(r"\s+|\S+")
+
+
+def _code_runs(code: str) -> list[tuple[str, str]]:
+    """Each run of *code* beside its NFC spelling."""
+    if code.isascii():
+        return [(code, code)]
+    return [
+        (run, run if run.isascii() else unicodedata.normalize("NFC", run))
+        for run in _CODE_RUN.findall(code)
+    ]
+
+
+def normalize_go_identifiers(source: bytes) -> tuple[bytes, OffsetMap]:
+    """*source* with everything but its literals and comments in NFC.
+
+    Bytes that are not UTF-8 pass through unchanged.
+    """
+    text = source.decode("utf-8", errors="surrogateescape")
+    runs: list[tuple[str, str]] = []
+    code_start = 0
+    for match in _LITERAL_OR_COMMENT.finditer(text):
+        runs.extend(_code_runs(text[code_start : match.start()]))
+        runs.append((match.group(), match.group()))
+        code_start = match.end()
+    runs.extend(_code_runs(text[code_start:]))
+
+    normalized = bytearray()
+    rewrites: list[Rewrite] = []
+    original_offset = 0
+    for original, nfc in runs:
+        original_bytes = original.encode("utf-8", errors="surrogateescape")
+        nfc_bytes = nfc.encode("utf-8", errors="surrogateescape")
+        if nfc != original:
+            rewrites.append(
+                Rewrite(
+                    start=len(normalized),
+                    end=len(normalized) + len(nfc_bytes),
+                    original_start=original_offset,
+                    original_end=original_offset + len(original_bytes),
+                )
+            )
+        normalized += nfc_bytes
+        original_offset += len(original_bytes)
+    return bytes(normalized), OffsetMap(tuple(rewrites))
@@ -0,0 +1,208 @@
+# pyright: standard
+"""Go packages of a multi-file program: pre-scan, package table, resolver.
+
+``compile_directory`` pre-scans every ``.go`` file for its package clause,
+package-level names and imports before any file is lowered.  Files are
+grouped into packages by name; the resolver hands the table to each
+file's lowering, so a file sees the names its own package declares in
+other files and the names each imported package exports.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, field
+from typing import TYPE_CHECKING, Any
+
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.frontends.compile_error import CompileError
+from interpreter.frontends.go.identifiers import normalize_go_identifiers
+from interpreter.frontends.go.node_types import GoNodeType
+from interpreter.frontends.symbol_table import SymbolTable
+from interpreter.namespace_resolver import NamespaceResolver
+from interpreter.parser import TreeSitterParserFactory
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+GO_MAIN_PACKAGE = "main"
+
+_PARSER_FACTORY = TreeSitterParserFactory()
+
+
+@dataclass
+class GoPreScanResult:
+    """Pre-scan output for a single Go source file."""
+
+    package: str = GO_MAIN_PACKAGE
+    names: list[str] = field(default_factory=list)
+    types: list[str] = field(default_factory=list)
+    imports: list[str] = field(default_factory=list)
+    declares_main: bool = False
+    root: Any = None  # Any: tree-sitter root, kept for the package's symbol table
+
+
+@dataclass(frozen=True)
+class GoPackage:
+    """One package's package-level declarations, gathered from all its files."""
+
+    name: str
+    names: frozenset[str]
+    types: frozenset[str]
+    imports: frozenset[str]  # project packages it imports, by name
+    symbol_table: SymbolTable
+
+
+class GoImportCycleError(CompileError):
+    """Project packages import each other in a cycle, which Go rejects."""
+
+    code = DiagnosticCode.IMPORT_CYCLE
+
+    def __init__(self, packages: list[str]):
+        chain = " imports ".join([*packages, packages[0]])
+        super().__init__(f"import cycle not allowed: {chain}")
+
+
+def go_pre_scan(source: bytes) -> GoPreScanResult:
+    """Fast tree-sitter extraction of package clause, declared names and imports.
+
+    Walks only top-level nodes — no expression lowering, no control flow.
+    """
+    parser = _PARSER_FACTORY.get_parser("go")
+    normalized, _ = normalize_go_identifiers(source)
+    root = parser.parse(normalized).root_node
+    result = GoPreScanResult(
+        names=go_package_level_names(root),
+        types=go_package_type_names(root),
+        root=root,
+    )
+    for child in root.children:
+        if child.type == GoNodeType.PACKAGE_CLAUSE:
+            result.package = go_package_clause_name(child)
+        elif child.type == GoNodeType.IMPORT_DECLARATION:
+            result.imports.extend(
+                spec.child_by_field_name("path").text.decode().strip('"`')
+                for spec in go_import_specs(child)
+            )
+    result.declares_main = (
+        result.package == GO_MAIN_PACKAGE and GO_MAIN_PACKAGE in result.names
+    )
+    return result
+
+
+def go_package_clause_name(clause) -> str:
+    """Package name a ``package_clause`` node declares."""
+    name_node = next((c for c in clause.children if c.is_named), None)
+    return name_node.text.decode() if name_node is not None else GO_MAIN_PACKAGE
+
+
+def go_import_specs(node) -> list:
+    """The import_spec nodes of an import_declaration, single or grouped."""
+    return [c for c in node.children if c.type == GoNodeType.IMPORT_SPEC] + [
+        spec
+        for spec_list in node.children
+        if spec_list.type == GoNodeType.IMPORT_SPEC_LIST
+        for spec in spec_list.children
+        if spec.type == GoNodeType.IMPORT_SPEC
+    ]
+
+
+def go_package_level_names(root) -> list[str]:
+    """Functions, variables and constants a source file declares at package level.
+
+    Methods belong to their receiver type; ``_`` declares nothing, nor does
+    ``init``, which no program can refer to.
+    """
+    name_nodes: list = []
+    for child in root.children:
+        if child.type == GoNodeType.FUNCTION_DECLARATION:
+            name_node = child.child_by_field_name("name")
+            if name_node is not None and name_node.text != b"init":
+                name_nodes.append(name_node)
+        elif child.type == GoNodeType.CONST_DECLARATION:
+            for spec in child.children:
+                if spec.type == GoNodeType.CONST_SPEC:
+                    name_nodes.extend(spec.children_by_field_name("name"))
+        elif child.type == GoNodeType.VAR_DECLARATION:
+            for spec in go_var_specs(child):
+                name_nodes.extend(spec.children_by_field_name("name"))
+    return [
+        node.text.decode()
+        for node in name_nodes
+        if node is not None and node.text != b"_"
+    ]
+
+
+def go_package_type_names(root) -> list[str]:
+    """Types a source file declares at package level."""
+    return [
+        spec.child_by_field_name("name").text.decode()
+        for child in root.children
+        if child.type == GoNodeType.TYPE_DECLARATION
+        for spec in child.children
+        if spec.type in (GoNodeType.TYPE_SPEC, GoNodeType.TYPE_ALIAS)
+        and spec.child_by_field_name("name") is not None
+    ]
+
+
+def go_var_specs(node) -> list:
+    """The var_spec nodes of a var_declaration, single or grouped, in order."""
+    return [c for c in node.children if c.type == GoNodeType.VAR_SPEC] + [
+        spec
+        for spec_list in node.children
+        if spec_list.type == GoNodeType.VAR_SPEC_LIST
+        for spec in spec_list.children
+        if spec.type == GoNodeType.VAR_SPEC
+    ]
+
+
+def build_go_packages(scans: dict[Path, GoPreScanResult]) -> dict[str, GoPackage]:
+    """Group pre-scanned files into packages by their package clause.
+
+    An import refers to the project package named like the last element of
+    its path (``"./utils"`` and ``"example.com/app/utils"`` both name
+    ``utils``); other imports are standard or external packages.
+    """
+    from interpreter.frontends.go.declarations import extract_go_symbols
+
+    by_package: dict[str, list[GoPreScanResult]] = {}
+    for scan in scans.values():
+        by_package.setdefault(scan.package, []).append(scan)
+    return {
+        name: GoPackage(
+            name=name,
+            names=frozenset(n for scan in files for n in scan.names),
+            types=frozenset(t for scan in files for t in scan.types),
+            imports=frozenset(
+                path.rsplit("/", 1)[-1]
+                for scan in files
+                for path in scan.imports
+                if path.rsplit("/", 1)[-1] in by_package
+            ),
+            symbol_table=extract_go_symbols(*(scan.root for scan in files)),
+        )
+        for name, files in by_package.items()
+    }
+
+
+def check_go_import_cycles(packages: dict[str, GoPackage]) -> None:
+    """Raise GoImportCycleError when project packages import each other in a cycle."""
+    done: set[str] = set()
+
+    def visit(name: str, path: list[str]) -> None:
+        if name in path:
+            raise GoImportCycleError(path[path.index(name) :])
+        if name in done:
+            return
+        for dep in sorted(packages[name].imports):
+            visit(dep, [*path, name])
+        done.add(name)
+
+    for name in sorted(packages):
+        visit(name, [])
+
+
+class GoNamespaceResolver(NamespaceResolver):
+    """Go-specific: carries the project's packages, by name, into lowering."""
+
+    def __init__(self, packages: dict[str, GoPackage]) -> None:
+        self.packages = packages
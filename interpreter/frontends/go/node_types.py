@@ -36,9 +36,18 @@ class GoNodeType:
     FUNC_LITERAL = "func_literal"
     CHANNEL_TYPE = "channel_type"
     SLICE_TYPE = "slice_type"
+    ARRAY_TYPE = "array_type"
+    IMPLICIT_LENGTH_ARRAY_TYPE = "implicit_length_array_type"
+    MAP_TYPE = "map_type"
+    POINTER_TYPE = "pointer_type"
+    FUNCTION_TYPE = "function_type"
     EXPRESSION_LIST = "expression_list"
     TYPE_CONVERSION_EXPRESSION = "type_conversion_expression"
     GENERIC_TYPE = "generic_type"
+    QUALIFIED_TYPE = "qualified_type"
+    TYPE_ARGUMENTS = "type_arguments"
+    TYPE_ELEM = "type_elem"
+    NEGATED_TYPE = "negated_type"
     LITERAL_VALUE = "literal_value"
     KEYED_ELEMENT = "keyed_element"
     LITERAL_ELEMENT = "literal_element"
@@ -51,8 +60,14 @@ class GoNodeType:
     VAR_DECLARATION = "var_declaration"
     CONST_DECLARATION = "const_declaration"
     SHORT_VAR_DECLARATION = "short_var_declaration"
+    PARAMETER_LIST = "parameter_list"
+    TYPE_PARAMETER_LIST = "type_parameter_list"
+    TYPE_PARAMETER_DECLARATION = "type_parameter_declaration"
+    TYPE_CONSTRAINT = "type_constraint"
     PARAMETER_DECLARATION = "parameter_declaration"
+    VARIADIC_PARAMETER_DECLARATION = "variadic_parameter_declaration"
     TYPE_SPEC = "type_spec"
+    TYPE_ALIAS = "type_alias"
     STRUCT_TYPE = "struct_type"
     INTERFACE_TYPE = "interface_type"
     METHOD_ELEM = "method_elem"
@@ -102,6 +117,8 @@ class GoNodeType:
     COMMENT = "comment"
     PACKAGE_CLAUSE = "package_clause"
     IMPORT_DECLARATION = "import_declaration"
+    IMPORT_SPEC = "import_spec"
+    IMPORT_SPEC_LIST = "import_spec_list"
     NEWLINE = "\n"
 
     # -- Special atoms --------------------------------------------------------
@@ -0,0 +1,285 @@
+"""Go packages: standard library builtins and the project's own packages.
+
+``import "strings"`` makes ``strings.ToUpper(s)`` a ``CALL_FUNCTION`` of the
+builtin ``strings.ToUpper``, and ``import "unicode/utf8"`` makes
+``utf8.RuneLen(r)`` one of ``unicode/utf8.RuneLen``; the tables below list
+the functions each package provides together with their result types, and
+the package constants (``math.Pi``, ``math.MaxInt``) that lower to plain
+CONSTs.
+
+A package of the program itself (``import "./utils"``) is linked into the
+same program.  Outside package main, package-level functions, variables
+and constants are declared under qualified names (``utils.Add``), which
+``utils.Add(1, 2)`` in an importing file refers to directly.
+"""
+
+from __future__ import annotations
+
+import math
+from typing import Any
+
+from interpreter import constants
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.frontends.compile_error import CompileError
+from interpreter.frontends.context import TreeSitterEmitContext
+from interpreter.frontends.go.namespace import (
+    GO_MAIN_PACKAGE,
+    GoNamespaceResolver,
+    GoPackage,
+    go_import_specs,
+    go_package_clause_name,
+    go_package_level_names,
+)
+from interpreter.frontends.go.node_types import GoNodeType
+from interpreter.type_name import TypeName
+from interpreter.types.type_expr import UNKNOWN, TypeExpr, array_of, scalar
+
+_INT = scalar(constants.FoundationTypeName.INT)
+_BOOL = scalar(constants.FoundationTypeName.BOOL)
+_STRING = scalar(constants.FoundationTypeName.STRING)
+_FLOAT = scalar(constants.FoundationTypeName.FLOAT)
+_ERROR = scalar(TypeName("error"))
+
+# Import path → member name → result type.  fmt's printers report
+# (n int, err error), which programs discard, so their result is left untyped.
+GO_STDLIB_FUNCS: dict[str, dict[str, TypeExpr]] = {
+    "fmt": {
+        "Print": UNKNOWN,
+        "Println": UNKNOWN,
+    },
+    "strings": {
+        "Contains": _BOOL,
+        "ToUpper": _STRING,
+        "ToLower": _STRING,
+        "Split": array_of(_STRING),
+        "Index": _INT,
+        "Repeat": _STRING,
+    },
+    "errors": {
+        "New": _ERROR,
+    },
+    "unicode": {
+        "IsLetter": _BOOL,
+        "IsDigit": _BOOL,
+        "IsSpace": _BOOL,
+        "IsUpper": _BOOL,
+        "IsLower": _BOOL,
+        "IsPunct": _BOOL,
+        "ToUpper": _INT,
+        "ToLower": _INT,
+    },
+    "unicode/utf8": {
+        "RuneCountInString": _INT,
+        "RuneLen": _INT,
+    },
+    "math": {
+        "Sqrt": _FLOAT,
+        "Abs": _FLOAT,
+        "Pow": _FLOAT,
+        "Max": _FLOAT,
+        "Min": _FLOAT,
+        "Floor": _FLOAT,
+        "Ceil": _FLOAT,
+        "Trunc": _FLOAT,
+    },
+}
+
+# Import path → constant name → value, folded like any Go constant.
+GO_STDLIB_CONSTS: dict[str, dict[str, Any]] = {
+    "math": {
+        "Pi": math.pi,
+        "E": math.e,
+        "Sqrt2": math.sqrt(2),
+        "MaxInt": 2**63 - 1,
+        "MinInt": -(2**63),
+        "MaxInt8": 2**7 - 1,
+        "MinInt8": -(2**7),
+        "MaxInt16": 2**15 - 1,
+        "MinInt16": -(2**15),
+        "MaxInt32": 2**31 - 1,
+        "MinInt32": -(2**31),
+        "MaxInt64": 2**63 - 1,
+        "MinInt64": -(2**63),
+        "MaxUint8": 2**8 - 1,
+        "MaxUint16": 2**16 - 1,
+        "MaxUint32": 2**32 - 1,
+    },
+}
+
+
+def record_go_imports(
+    ctx: TreeSitterEmitContext, node: Any
+) -> None:  # Any: tree-sitter node — untyped at Python boundary
+    """Record the packages an import_declaration brings into scope.
+
+    ``import "strings"`` binds ``strings``; ``import s "strings"`` binds
+    ``s``.  Blank (``_``) and dot imports bind no name.
+    """
+    for spec in go_import_specs(node):
+        path_node = spec.child_by_field_name("path")
+        if path_node is None:
+            continue
+        path = ctx.node_text(path_node).strip('"`')
+        name_node = spec.child_by_field_name("name")
+        name = ctx.node_text(name_node) if name_node else path.rsplit("/", 1)[-1]
+        if name not in ("_", "."):
+            ctx.go_imports[name] = path
+
+
+def _go_package_path(ctx: TreeSitterEmitContext, operand_node) -> str:
+    """Import path of the package *operand_node* names, or ""."""
+    if operand_node.type != GoNodeType.IDENTIFIER:
+        return ""
+    return _go_import_path(ctx, ctx.node_text(operand_node))
+
+
+def _go_import_path(ctx: TreeSitterEmitContext, name: str) -> str:
+    """Import path of the package bound to the local *name*, or "".
+
+    A variable of the same name shadows the package.
+    """
+    return "" if ctx.is_block_var(name) else ctx.go_imports.get(name, "")
+
+
+def go_stdlib_func(ctx: TreeSitterEmitContext, operand_node, member: str) -> str:
+    """Qualified builtin name for ``pkg.member``, or "" when it is not one.
+
+    *operand_node* must name an imported standard package, and *member*
+    must be a function the package provides.
+    """
+    path = _go_package_path(ctx, operand_node)
+    return f"{path}.{member}" if member in GO_STDLIB_FUNCS.get(path, {}) else ""
+
+
+def go_stdlib_const(ctx: TreeSitterEmitContext, operand_node, member: str) -> Any:
+    """Value of the package constant ``pkg.member``, or None when it is not one."""
+    path = _go_package_path(ctx, operand_node)
+    return GO_STDLIB_CONSTS.get(path, {}).get(member)
+
+
+def go_stdlib_result_type(func_name: str) -> TypeExpr:
+    """Result type of the qualified builtin *func_name* (``strings.Index``)."""
+    path, member = func_name.rsplit(".", 1)
+    return GO_STDLIB_FUNCS[path][member]
+
+
+# -- Project packages ------------------------------------------------------
+
+
+class GoPackageMemberError(CompileError):
+    """``pkg.Name`` names nothing the imported project package exports.
+
+    Only capitalized names are exported, so a lower-case member is rejected
+    even though the package declares it, as the Go compiler does.
+    """
+
+    code = DiagnosticCode.UNDEFINED_MEMBER
+
+
+def enter_go_package(ctx: TreeSitterEmitContext, root: Any) -> None:
+    """Set up the package of the file being lowered, inside its file scope.
+
+    Records the package clause in ``ctx.go_package`` and adds the struct
+    and interface types of every project package to the symbol table, so
+    literals of types declared in other files are lowered field by field
+    (the own package's entries also carry methods declared in other files).
+    Outside package main, each package-level name — of this file and,
+    through the resolver, of the package's other files — is bound to its
+    qualified name before anything refers to it.
+    """
+    clause = next(
+        (c for c in root.children if c.type == GoNodeType.PACKAGE_CLAUSE), None
+    )
+    ctx.go_package = go_package_clause_name(clause) if clause else GO_MAIN_PACKAGE
+    packages = _go_project_packages(ctx)
+    own = packages.get(ctx.go_package)
+    for package in packages.values():
+        for class_name, info in package.symbol_table.classes.items():
+            if package is own or class_name not in ctx.symbol_table.classes:
+                ctx.symbol_table.classes[class_name] = info
+        for iface_name, methods in package.symbol_table.interfaces.items():
+            ctx.symbol_table.interfaces.setdefault(iface_name, methods)
+    if ctx.go_package == GO_MAIN_PACKAGE:
+        return
+    names = set(go_package_level_names(root)) | (own.names if own else set())
+    for name in sorted(names):
+        ctx.bind_block_var(name, go_qualified_name(ctx, name))
+
+
+def go_qualified_name(ctx: TreeSitterEmitContext, name: str) -> str:
+    """Name the package-level declaration *name* gets in the linked program.
+
+    Package main keeps bare names; any other package qualifies them, so
+    packages linked into one program never collide.
+    """
+    if ctx.go_package == GO_MAIN_PACKAGE:
+        return name
+    return f"{ctx.go_package}.{name}"
+
+
+def declare_go_var(ctx: TreeSitterEmitContext, name: str) -> str:
+    """Declare a variable or constant, qualifying it at package level.
+
+    Outside any function body of a package other than main, *name* is a
+    package-level declaration, already bound to its qualified name.
+    """
+    if ctx.func_exit_stack or ctx.go_package == GO_MAIN_PACKAGE:
+        return ctx.declare_block_var(name)
+    return go_qualified_name(ctx, name)
+
+
+def go_project_member(ctx: TreeSitterEmitContext, operand_node, member: str) -> str:
+    """Qualified name of ``pkg.member`` for an imported project package, or "".
+
+    Raises GoPackageMemberError when the package does not export *member*.
+    """
+    package = _go_project_package(ctx, _go_package_path(ctx, operand_node))
+    if package is None:
+        return ""
+    _check_go_exported(package, member, package.names)
+    return f"{package.name}.{member}"
+
+
+def go_project_type(ctx: TreeSitterEmitContext, type_node) -> str:
+    """Type a ``pkg.T`` qualified_type names in a project package, or "".
+
+    Types keep their bare names in the linked program.  Raises
+    GoPackageMemberError when the package does not export T.
+    """
+    package_node = type_node.child_by_field_name("package")
+    name_node = type_node.child_by_field_name("name")
+    if package_node is None or name_node is None:
+        return ""
+    package_path = _go_import_path(ctx, ctx.node_text(package_node))
+    package = _go_project_package(ctx, package_path)
+    if package is None:
+        return ""
+    type_name = ctx.node_text(name_node)
+    _check_go_exported(package, type_name, package.types)
+    return type_name
+
+
+def _check_go_exported(
+    package: GoPackage, name: str, declared: frozenset[str]
+) -> None:
+    if name not in declared:
+        raise GoPackageMemberError(f"undefined: {package.name}.{name}")
+    if not name[:1].isupper():
+        raise GoPackageMemberError(
+            f"name {name} not exported by package {package.name}"
+        )
+
+
+def _go_project_packages(ctx: TreeSitterEmitContext) -> dict[str, GoPackage]:
+    resolver = ctx.namespace_resolver
+    return resolver.packages if isinstance(resolver, GoNamespaceResolver) else {}
+
+
+def _go_project_package(ctx: TreeSitterEmitContext, path: str) -> GoPackage | None:
+    """The project package imported from *path*, or None for other packages.
+
+    It is the package named like the last element of the path.
+    """
+    if not path:
+        return None
+    return _go_project_packages(ctx).get(path.rsplit("/", 1)[-1])
@@ -1,5 +1,5 @@
 # pyright: standard
-"""Go type alias extractor — extracts simple scalar type aliases from Go ASTs."""
+"""Go type alias extractors — extract aliases and named scalar types from Go ASTs."""
 
 from __future__ import annotations
 
@@ -12,10 +12,27 @@ from interpreter.types.type_expr import TypeExpr
 
 
 class GoTypeAliasExtractor:
-    """Matches ``type_spec`` nodes that define simple scalar type aliases.
+    """Matches ``type_alias`` nodes: ``type Celsius = float64``.
 
-    Ignores struct and interface type specs — those are class definitions,
-    not aliases.
+    An alias is another name for the same type, so it is interchangeable
+    with its target everywhere.
+    """
+
+    def matches(self, node: Any) -> bool:
+        if node.type != GoNodeType.TYPE_ALIAS:
+            return False
+        return node.child_by_field_name("type") is not None
+
+    def extract(self, node: Any, type_map: dict[str, str]) -> tuple[TypeName, TypeExpr]:
+        return _extract_name_and_type(node, type_map)
+
+
+class GoNamedTypeExtractor:
+    """Matches ``type_spec`` nodes that define named scalar types: ``type MyInt int``.
+
+    A named type is distinct from its underlying type — converting between
+    them needs an explicit ``MyInt(n)``.  Ignores struct and interface type
+    specs — those are class definitions.
     """
 
     def matches(self, node: Any) -> bool:
@@ -25,8 +42,14 @@ class GoTypeAliasExtractor:
         return type_node is not None and type_node.type == GoNodeType.TYPE_IDENTIFIER
 
     def extract(self, node: Any, type_map: dict[str, str]) -> tuple[TypeName, TypeExpr]:
-        name_node = node.child_by_field_name("name")
-        type_node = node.child_by_field_name("type")
-        alias_name = name_node.text.decode()
-        raw_type = type_node.text.decode()
-        return TypeName(alias_name), normalize_type_hint(raw_type, type_map)
+        return _extract_name_and_type(node, type_map)
+
+
+def _extract_name_and_type(
+    node: Any, type_map: dict[str, str]
+) -> tuple[TypeName, TypeExpr]:
+    name_node = node.child_by_field_name("name")
+    type_node = node.child_by_field_name("type")
+    alias_name = name_node.text.decode()
+    raw_type = type_node.text.decode()
+    return TypeName(alias_name), normalize_type_hint(raw_type, type_map)
@@ -13,8 +13,8 @@ from interpreter.frontends.common.declarations import (
     emit_synthetic_init,
 )
 from interpreter.frontends.common.expressions import (
-    lower_null_literal,
     lower_string_literal,
+    lower_unassigned,
 )
 from interpreter.frontends.context import NO_NODE, TreeSitterEmitContext
 from interpreter.frontends.java.expressions import lower_java_params
@@ -56,7 +56,7 @@ def lower_local_var_decl(
                 ctx.seed_var_type(var_name, type_hint)
             elif name_node:
                 var_name = ctx.declare_block_var(ctx.node_text(name_node))
-                val_reg = lower_null_literal(ctx, node)
+                val_reg = lower_unassigned(ctx, node)
                 ctx.emit_inst(
                     DeclVar(name=VarName(var_name), value_reg=val_reg), node=node
                 )
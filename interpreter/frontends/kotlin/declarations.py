@@ -131,7 +131,7 @@ def lower_property_decl(
         val_reg = ctx.lower_expr(value_node)
     else:
         val_reg = ctx.fresh_reg()
-        ctx.emit_inst(Const.null_(val_reg))
+        ctx.emit_inst(Const.unassigned(val_reg))
     ctx.emit_inst(DeclVar(name=VarName(var_name), value_reg=val_reg), node=node)
     ctx.seed_var_type(var_name, type_hint)
 
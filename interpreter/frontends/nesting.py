@@ -0,0 +1,80 @@
+# pyright: standard
+"""Nesting limit — refuse parse trees too deep to lower.
+
+Lowering, and the pre-passes before it, walk the tree recursively, several
+frames per level, so a tree nested deeper than Python's recursion limit
+allows (``((((...))))`` a few hundred levels deep, or a generated
+expression chain) would fail with a RecursionError part-way through.
+``check_nesting`` measures the depth up front, without recursing, and
+reports such a tree as an ordinary input error instead, positioned at the
+first node past the limit.
+
+The limit is configurable (``BaseFrontend.max_nesting_depth``); lowering
+under a limit above the default runs inside ``recursion_headroom``, which
+raises Python's recursion limit as far as that many levels need.
+"""
+
+from __future__ import annotations
+
+import sys
+from collections.abc import Iterator
+from contextlib import contextmanager
+from typing import Any
+
+from interpreter.constants import MAX_NESTING_DEPTH as MAX_NESTING_DEPTH
+from interpreter.frontends.offset_map import NO_REWRITES, OffsetMap
+from interpreter.ir import SourceLocation
+from interpreter.parser import node_location
+
+# Python frames the lowering passes take per level of nesting, at most.
+_FRAMES_PER_LEVEL = 7
+
+
+class NestingTooDeepError(ValueError):
+    """The source nests deeper than the nesting limit allows."""
+
+    def __init__(self, location: SourceLocation, max_depth: int = MAX_NESTING_DEPTH):
+        super().__init__(f"{location}: source nests deeper than {max_depth} levels")
+        self.location = location
+        self.max_depth = max_depth
+
+
+
+def check_nesting(
+    root: Any, max_depth: int = MAX_NESTING_DEPTH, offsets: OffsetMap = NO_REWRITES
+) -> None:  # Any: tree-sitter node
+    """Raise ``NestingTooDeepError`` at the first node below *max_depth* levels.
+
+    *offsets* places the error in the file when the parsed source was rewritten.
+    """
+    cursor = root.walk()
+    depth = 0
+    while True:
+        if depth > max_depth:
+            location = offsets.location(node_location(cursor.node))
+            raise NestingTooDeepError(location, max_depth)
+        if cursor.goto_first_child():
+            depth += 1
+            continue
+        while not cursor.goto_next_sibling():
+            if not cursor.goto_parent():
+                return
+            depth -= 1
+
+
+@contextmanager
+def recursion_headroom(max_depth: int) -> Iterator[None]:
+    """Raise the recursion limit, for the duration, to lower *max_depth* levels.
+
+    The limit is process-wide, so it is left alone when already high enough.
+    """
+    previous = sys.getrecursionlimit()
+    needed = max_depth * _FRAMES_PER_LEVEL
+    if needed <= previous:
+        yield
+        return
+    sys.setrecursionlimit(needed)
+    try:
+        yield
+    finally:
+        sys.setrecursionlimit(previous)
@@ -0,0 +1,70 @@
+# pyright: standard
+"""Offset maps — carry positions in rewritten source back to the file.
+
+A frontend that rewrites its source before parsing it (Go puts identifiers
+into NFC, which can make them shorter) parses bytes that no longer line up
+with the file.  An ``OffsetMap`` records each rewritten span beside the span
+of the file it replaced, and ``location`` moves a SourceLocation in the
+rewritten source back onto the file, so instructions and diagnostics point
+where the code was written.  A rewrite never spans a newline, so line
+numbers are the same in both.
+"""
+
+from __future__ import annotations
+
+from bisect import bisect_right
+from dataclasses import dataclass
+
+from interpreter.ir import SourceLocation
+
+
+@dataclass(frozen=True)
+class Rewrite:
+    """Rewritten bytes ``[start, end)`` replaced ``[original_start, original_end)``."""
+
+    start: int
+    end: int
+    original_start: int
+    original_end: int
+
+
+@dataclass(frozen=True)
+class OffsetMap:
+    """The rewrites made to a source, in source order."""
+
+    rewrites: tuple[Rewrite, ...] = ()
+
+    def offset(self, rewritten: int) -> int:
+        """Byte offset in the file of byte *rewritten* of the rewritten source.
+
+        An offset inside a rewritten span lands as far into the span it
+        replaced, or at that span's end if it is shorter.
+        """
+        index = bisect_right(self.rewrites, rewritten, key=lambda r: r.start) - 1
+        if index < 0:
+            return rewritten
+        rewrite = self.rewrites[index]
+        if rewritten >= rewrite.end:
+            return rewrite.original_end + (rewritten - rewrite.end)
+        return min(
+            rewrite.original_start + (rewritten - rewrite.start),
+            rewrite.original_end,
+        )
+
+    def location(self, location: SourceLocation) -> SourceLocation:
+        """*location*, a span of the rewritten source, as a span of the file."""
+        if not self.rewrites or location.is_unknown():
+            return location
+        start = self.offset(location.start_byte)
+        end = self.offset(location.end_byte)
+        return SourceLocation(
+            start_line=location.start_line,
+            start_col=start - self.offset(location.start_byte - location.start_col),
+            end_line=location.end_line,
+            end_col=end - self.offset(location.end_byte - location.end_col),
+            start_byte=start,
+            end_byte=end,
+        )
+
+
+NO_REWRITES = OffsetMap()
@@ -50,9 +50,18 @@ from interpreter.frontends.symbol_table import (
     SymbolTable,
 )
 from interpreter.func_name import FuncName
+from interpreter.stream_source import SourceBytes
 
 
-def _extract_python_class_parents(node) -> tuple[ClassName, ...]:
+def _text(node, source: SourceBytes) -> str:
+    """The source text of *node*, read from *source*.
+
+    Nodes parsed from a ``StreamSource`` carry no text of their own.
+    """
+    return source[node.start_byte : node.end_byte].decode()
+
+
+def _extract_python_class_parents(node, source: SourceBytes) -> tuple[ClassName, ...]:
     """Extract parent class names from a Python class_definition node."""
     arg_list = next(
         (c for c in node.children if c.type == PythonNodeType.ARGUMENT_LIST),
@@ -61,13 +70,13 @@ def _extract_python_class_parents(node) -> tuple[ClassName, ...]:
     if arg_list is None:
         return ()
     return tuple(
-        ClassName(c.text.decode())
+        ClassName(_text(c, source))
         for c in arg_list.children
         if c.type == PythonNodeType.IDENTIFIER
     )
 
 
-def _extract_python_match_args(node) -> tuple[str, ...]:
+def _extract_python_match_args(node, source: SourceBytes) -> tuple[str, ...]:
     """Extract __match_args__ tuple from a class body assignment node.
 
     Expects the right side to be a tuple containing string nodes.
@@ -79,14 +88,14 @@ def _extract_python_match_args(node) -> tuple[str, ...]:
     if rhs is None:
         return ()
     return tuple(
-        c.text.decode()
+        _text(c, source)
         for c in rhs.children
         if c.type == PythonNodeType.STRING
         for sc in c.children
         if sc.type == PythonNodeType.STRING_CONTENT
         for _ in [None]
     ) or tuple(
-        sc.text.decode()
+        _text(sc, source)
         for c in rhs.children
         if c.type == PythonNodeType.STRING
         for sc in c.children
@@ -94,7 +103,7 @@ def _extract_python_match_args(node) -> tuple[str, ...]:
     )
 
 
-def _extract_python_self_fields(init_body) -> dict[str, FieldInfo]:
+def _extract_python_self_fields(init_body, source: SourceBytes) -> dict[str, FieldInfo]:
     """Walk an __init__ body block and collect self.x = ... assignments."""
     fields: dict[str, FieldInfo] = {}
     for stmt in init_body.children:
@@ -107,22 +116,22 @@ def _extract_python_self_fields(init_body) -> dict[str, FieldInfo]:
         attr_node = lhs.child_by_field_name("attribute")
         if obj_node is None or attr_node is None:
             continue
-        if obj_node.text != b"self":
+        if _text(obj_node, source) != "self":
             continue
-        field_name = attr_node.text.decode()
+        field_name = _text(attr_node, source)
         fields[FieldName(field_name)] = FieldInfo(
             name=FieldName(field_name), type_hint="", has_initializer=True
         )
     return fields
 
 
-def _extract_python_class(node) -> tuple[str, ClassInfo] | None:
+def _extract_python_class(node, source: SourceBytes) -> tuple[str, ClassInfo] | None:
     """Extract a ClassInfo from a Python class_definition node."""
     name_node = node.child_by_field_name("name")
     if name_node is None:
         return None
-    class_name = name_node.text.decode()
-    parents = _extract_python_class_parents(node)
+    class_name = _text(name_node, source)
+    parents = _extract_python_class_parents(node, source)
 
     body = next((c for c in node.children if c.type == PythonNodeType.BLOCK), None)
     if body is None:
@@ -144,30 +153,31 @@ def _extract_python_class(node) -> tuple[str, ClassInfo] | None:
             lhs = child.children[0] if child.children else None
             if lhs is None or lhs.type != PythonNodeType.IDENTIFIER:
                 continue
-            lhs_name = lhs.text.decode()
+            lhs_name = _text(lhs, source)
             if lhs_name == "__match_args__":
-                match_args = _extract_match_args_from_assignment(child)
+                match_args = _extract_match_args_from_assignment(child, source)
             else:
                 rhs_node = child.child_by_field_name("right")
-                rhs_text = rhs_node.text.decode() if rhs_node is not None else ""
+                rhs_text = _text(rhs_node, source) if rhs_node is not None else ""
                 constants_map[lhs_name] = rhs_text
         elif child.type == PythonNodeType.FUNCTION_DEFINITION:
             mname_node = child.child_by_field_name("name")
             if mname_node is None:
                 continue
-            mname = mname_node.text.decode()
+            mname = _text(mname_node, source)
             params_node = child.child_by_field_name("parameters")
             params = (
                 tuple(
-                    _python_param_name(p)
+                    _python_param_name(p, source)
                     for p in params_node.children
-                    if p.type == PythonNodeType.IDENTIFIER and p.text != b"self"
+                    if p.type == PythonNodeType.IDENTIFIER
+                    and _text(p, source) != "self"
                 )
                 if params_node is not None
                 else ()
             )
             ret_node = child.child_by_field_name("return_type")
-            return_type = ret_node.text.decode() if ret_node else ""
+            return_type = _text(ret_node, source) if ret_node else ""
             methods[FuncName(mname)] = FunctionInfo(
                 name=FuncName(mname), params=params, return_type=return_type
             )
@@ -175,7 +185,7 @@ def _extract_python_class(node) -> tuple[str, ClassInfo] | None:
             if mname == "__init__":
                 init_body = child.child_by_field_name("body")
                 if init_body is not None:
-                    fields.update(_extract_python_self_fields(init_body))
+                    fields.update(_extract_python_self_fields(init_body, source))
 
     return class_name, ClassInfo(
         name=ClassName(class_name),
@@ -187,7 +197,7 @@ def _extract_python_class(node) -> tuple[str, ClassInfo] | None:
     )
 
 
-def _extract_match_args_from_assignment(node) -> tuple[str, ...]:
+def _extract_match_args_from_assignment(node, source: SourceBytes) -> tuple[str, ...]:
     """Extract __match_args__ tuple values from an assignment node."""
     rhs = next(
         (c for c in node.children if c.type == PythonNodeType.TUPLE),
@@ -196,7 +206,7 @@ def _extract_match_args_from_assignment(node) -> tuple[str, ...]:
     if rhs is None:
         return ()
     return tuple(
-        sc.text.decode()
+        _text(sc, source)
         for c in rhs.children
         if c.type == PythonNodeType.STRING
         for sc in c.children
@@ -204,24 +214,26 @@ def _extract_match_args_from_assignment(node) -> tuple[str, ...]:
     )
 
 
-def _python_param_name(node) -> str:
+def _python_param_name(node, source: SourceBytes) -> str:
     """Return the parameter name text."""
-    return node.text.decode()
+    return _text(node, source)
 
 
-def _collect_python_classes(node, accumulator: dict[ClassName, ClassInfo]) -> None:
+def _collect_python_classes(
+    node, source: SourceBytes, accumulator: dict[ClassName, ClassInfo]
+) -> None:
     """Recursively walk the AST and collect all class_definition nodes."""
     if node.type == PythonNodeType.CLASS_DEFINITION:
-        result = _extract_python_class(node)
+        result = _extract_python_class(node, source)
         if result is not None:
             class_name, class_info = result
             accumulator[ClassName(class_name)] = class_info
     for child in node.children:
-        _collect_python_classes(child, accumulator)
+        _collect_python_classes(child, source, accumulator)
 
 
-def extract_python_symbols(root) -> SymbolTable:
+def extract_python_symbols(root, source: SourceBytes) -> SymbolTable:
     """Walk the Python AST and return a SymbolTable of all class definitions."""
     classes: dict[ClassName, ClassInfo] = {}
-    _collect_python_classes(root, classes)
+    _collect_python_classes(root, source, classes)
     return SymbolTable(classes=classes)
@@ -22,6 +22,8 @@ from interpreter.register import Register
 class PythonFrontend(BaseFrontend):
     """Lowers a Python tree-sitter AST into flattened TAC IR."""
 
+    STREAMABLE = True
+
     def _build_constants(self) -> GrammarConstants:
         return GrammarConstants(
             attr_object_field="object",
@@ -136,4 +138,4 @@ class PythonFrontend(BaseFrontend):
     def _extract_symbols(self, root) -> SymbolTable:
         from interpreter.frontends.python.declarations import extract_python_symbols
 
-        return extract_python_symbols(root)
+        return extract_python_symbols(root, self._source)
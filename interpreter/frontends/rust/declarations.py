@@ -12,6 +12,7 @@ from interpreter.frontends.common.declarations import emit_implicit_return
 from interpreter.frontends.common.expressions import (
     lower_null_literal,
     lower_string_literal,
+    lower_unassigned,
 )
 from interpreter.frontends.context import TreeSitterEmitContext
 from interpreter.frontends.rust.node_types import RustNodeType
@@ -221,7 +222,7 @@ def lower_let_decl(
     if value_node:
         val_reg = ctx.lower_expr(value_node)
     else:
-        val_reg = lower_null_literal(ctx, node)
+        val_reg = lower_unassigned(ctx, node)
 
     if pattern_node is not None and pattern_node.type == RustNodeType.TUPLE_PATTERN:
         _lower_tuple_destructure(ctx, pattern_node, val_reg, node)
@@ -0,0 +1,249 @@
+# pyright: standard
+"""Scopes and symbols — what each name refers to, as lowering resolved it.
+
+While a frontend lowers a file it decides, for every name, which
+declaration it means: block-scoped languages mangle a shadowing
+declaration (``x`` → ``x$1``), and functions and classes open scopes of
+their own.  ``ScopeBuilder`` records those decisions as lowering makes
+them, and ``Scope`` is the finished tree, so a tool can ask what an
+identifier refers to without resolving names itself::
+
+    scopes = frontend.scopes            # after frontend.lower(source)
+    symbol = scopes.lookup_at(SourcePosition(12, 8, 0), "total")
+    symbol.location                     # where that ``total`` is declared
+    symbol.type_expr                    # its declared or inferred type
+
+A lookup starts at the innermost scope around the position and works
+outwards.  Within a scope it takes the last declaration of the name at or
+before the position, or failing that the first one after it (a function
+called before the line that defines it).  In languages without block
+scoping, the first assignment to a name in a function declares it there.
+
+A second declaration of a name in the scope that already declares it
+(``int x; int x;``) is folded into the first, and kept in the scope's
+``redeclared`` so a tool can report it.
+
+A variable's type is the one the frontend seeded for it: the declared
+type, or the type inferred from the initializer where the language infers
+one (Go's ``x := expr``).  It is UNKNOWN where the frontend seeded none.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, field, replace
+from enum import Enum
+
+from interpreter.ir import NO_SOURCE_LOCATION, SourceLocation, SourcePosition
+from interpreter.types.type_expr import UNKNOWN, TypeExpr
+
+
+class ScopeKind(str, Enum):
+    """What opened a scope."""
+
+    MODULE = "module"
+    CLASS = "class"
+    FUNCTION = "function"
+    BLOCK = "block"
+
+
+class SymbolKind(str, Enum):
+    """What a name was declared as."""
+
+    VARIABLE = "variable"
+    PARAMETER = "parameter"
+    FUNCTION = "function"
+    CLASS = "class"
+
+
+@dataclass(frozen=True)
+class Symbol:
+    """One declaration of a name.
+
+    *resolved_name* is the name the IR uses for it, which differs from
+    *name* when the declaration shadows an outer one (``x$1``).
+    """
+
+    name: str
+    kind: SymbolKind
+    location: SourceLocation
+    resolved_name: str
+    type_expr: TypeExpr = UNKNOWN
+
+    def is_present(self) -> bool:
+        return self is not NO_SYMBOL
+
+
+_VARIABLE_KINDS = frozenset({SymbolKind.VARIABLE, SymbolKind.PARAMETER})
+
+NO_SYMBOL = Symbol(
+    name="",
+    kind=SymbolKind.VARIABLE,
+    location=NO_SOURCE_LOCATION,
+    resolved_name="",
+)
+
+
+def _point(pos: SourcePosition) -> tuple[int, int]:
+    return pos.line, pos.col
+
+
+def _are_apart(a: SourceLocation, b: SourceLocation) -> bool:
+    """Are *a* and *b* known and without overlap?"""
+    if a.is_unknown() or b.is_unknown():
+        return False
+    return _point(a.end()) <= _point(b.pos()) or _point(b.end()) <= _point(a.pos())
+
+
+@dataclass(frozen=True)
+class Scope:
+    """A region of source and the names declared directly in it.
+
+    *redeclared* holds the later declarations of variables and parameters
+    that *symbols* already declares, in lowering order.
+    """
+
+    kind: ScopeKind
+    location: SourceLocation
+    symbols: tuple[Symbol, ...] = ()
+    children: tuple[Scope, ...] = ()
+    redeclared: tuple[Symbol, ...] = ()
+
+    def contains(self, pos: SourcePosition) -> bool:
+        start, end = self.location.pos(), self.location.end()
+        return _point(start) <= _point(pos) < _point(end)
+
+    def scopes_at(self, pos: SourcePosition) -> tuple[Scope, ...]:
+        """The scopes around *pos*, innermost first, ending with this one."""
+        for child in self.children:
+            if child.contains(pos):
+                return child.scopes_at(pos) + (self,)
+        return (self,)
+
+    def scope_at(self, pos: SourcePosition) -> Scope:
+        """The innermost scope around *pos*."""
+        return self.scopes_at(pos)[0]
+
+    def declared(self, name: str, pos: SourcePosition) -> Symbol:
+        """The declaration of *name* in this scope that *pos* sees."""
+        candidates = [symbol for symbol in self.symbols if symbol.name == name]
+        before = [
+            symbol
+            for symbol in candidates
+            if _point(symbol.location.pos()) <= _point(pos)
+        ]
+        if before:
+            return before[-1]
+        return candidates[0] if candidates else NO_SYMBOL
+
+    def lookup_at(self, pos: SourcePosition, name: str) -> Symbol:
+        """What *name* written at *pos* refers to; NO_SYMBOL if undeclared."""
+        for scope in self.scopes_at(pos):
+            symbol = scope.declared(name, pos)
+            if symbol.is_present():
+                return symbol
+        return NO_SYMBOL
+
+
+EMPTY_SCOPE = Scope(kind=ScopeKind.MODULE, location=NO_SOURCE_LOCATION)
+
+
+@dataclass
+class _OpenScope:
+    kind: ScopeKind
+    location: SourceLocation
+    name: str
+    symbols: list[Symbol] = field(default_factory=list)
+    children: list[Scope] = field(default_factory=list)
+    redeclared: list[Symbol] = field(default_factory=list)
+
+    def close(self) -> Scope:
+        return Scope(
+            kind=self.kind,
+            location=self.location,
+            symbols=tuple(self.symbols),
+            children=tuple(self.children),
+            redeclared=tuple(self.redeclared),
+        )
+
+
+class ScopeBuilder:
+    """Scopes opened, names declared and scopes closed, in lowering order.
+
+    The module scope is always open; ``build`` closes whatever else is.
+    """
+
+    def __init__(self, location: SourceLocation = NO_SOURCE_LOCATION) -> None:
+        self.open: list[_OpenScope] = [_OpenScope(ScopeKind.MODULE, location, "")]
+
+    def enter(self, kind: ScopeKind, location: SourceLocation, name: str = "") -> None:
+        """Open a scope inside the current one; *name* names a function's."""
+        self.open.append(_OpenScope(kind, location, name))
+
+    def exit(self, kind: ScopeKind, name: str = "") -> None:
+        """Close the innermost open *kind* scope (named *name*, if given).
+
+        Scopes opened inside it and still open are closed with it.
+        """
+        for depth in range(len(self.open) - 1, 0, -1):
+            scope = self.open[depth]
+            if scope.kind == kind and (not name or scope.name == name):
+                while len(self.open) > depth:
+                    closed = self.open.pop().close()
+                    self.open[-1].children.append(closed)
+                return
+
+    def declare(
+        self,
+        name: str,
+        kind: SymbolKind,
+        location: SourceLocation,
+        resolved_name: str = "",
+    ) -> None:
+        """Declare *name* in the current scope, unless it already is.
+
+        A variable or parameter the scope already declares by another
+        statement is recorded as redeclared; a frontend may declare one name
+        twice while lowering a single declaration.
+        """
+        resolved = resolved_name or name
+        scope = self.open[-1]
+        symbol = Symbol(name, kind, location, resolved)
+        existing = [
+            other for other in scope.symbols if other.resolved_name == resolved
+        ]
+        if not existing:
+            scope.symbols.append(symbol)
+        elif (
+            kind in _VARIABLE_KINDS
+            and existing[0].kind in _VARIABLE_KINDS
+            and _are_apart(existing[0].location, location)
+        ):
+            scope.redeclared.append(symbol)
+
+    def declare_assigned(self, name: str, location: SourceLocation) -> None:
+        """Declare variable *name*, assigned without a declaration.
+
+        It goes in the innermost function, class or module scope, unless a
+        scope out to there already declares it.
+        """
+        for scope in reversed(self.open):
+            if any(symbol.name == name for symbol in scope.symbols):
+                return
+            if scope.kind != ScopeKind.BLOCK:
+                scope.symbols.append(Symbol(name, SymbolKind.VARIABLE, location, name))
+                return
+
+    def set_type(self, resolved_name: str, type_expr: TypeExpr) -> None:
+        """Record *type_expr* on the innermost open declaration of *resolved_name*."""
+        for scope in reversed(self.open):
+            for i, symbol in enumerate(scope.symbols):
+                if symbol.resolved_name == resolved_name:
+                    scope.symbols[i] = replace(symbol, type_expr=type_expr)
+                    return
+
+    def build(self) -> Scope:
+        """The finished tree, rooted at the module scope."""
+        while len(self.open) > 1:
+            closed = self.open.pop().close()
+            self.open[-1].children.append(closed)
+        return self.open[0].close()
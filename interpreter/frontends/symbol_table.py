@@ -35,6 +35,7 @@ class FunctionInfo:
     name: FuncName
     params: tuple[str, ...]
     return_type: str
+    doc: str = ""
 
 
 @dataclass(frozen=True)
@@ -47,20 +48,45 @@ class ClassInfo:
     constants: dict[str, str]
     parents: tuple[ClassName, ...]
     match_args: tuple[str, ...] = ()
+    doc: str = ""
 
 
 @dataclass
 class SymbolTable:
-    """Symbol catalog extracted before IR lowering."""
+    """Symbol catalog extracted before IR lowering.
+
+    *docs* maps each top-level declaration to its doc comment (``""`` when
+    it has none): functions, types, variables and constants by name, and
+    methods as ``Type.method``.
+    """
 
     classes: dict[ClassName, ClassInfo] = field(default_factory=dict)
     functions: dict[FuncName, FunctionInfo] = field(default_factory=dict)
     constants: dict[str, str] = field(default_factory=dict)
+    interfaces: dict[ClassName, tuple[FuncName, ...]] = field(default_factory=dict)
+    docs: dict[str, str] = field(default_factory=dict)
 
     @classmethod
     def empty(cls) -> SymbolTable:
         return cls()
 
+    def implementors(self, interface_name: ClassName) -> tuple[ClassName, ...]:
+        """Classes whose methods cover every method the interface requires.
+
+        For structurally typed languages (Go) this is implicit satisfaction:
+        no ``implements`` clause is needed.
+        """
+        required = set(self.interfaces.get(interface_name, ()))
+        return tuple(
+            name
+            for name, info in self.classes.items()
+            if required <= set(info.methods)
+        )
+
+    def undocumented(self) -> tuple[str, ...]:
+        """Declarations without a doc comment, in declaration order."""
+        return tuple(name for name, doc in self.docs.items() if not doc)
+
     def resolve_field(self, class_name: ClassName, field_name: FieldName) -> FieldInfo:
         """Find field in class or any ancestor. Returns NULL_FIELD if not found."""
         class_info = self.classes.get(class_name)
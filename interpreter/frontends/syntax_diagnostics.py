@@ -0,0 +1,62 @@
+# pyright: standard
+"""Collect every syntax error in a tree-sitter parse tree.
+
+Tree-sitter recovers from syntax errors instead of stopping at the first:
+it wraps tokens it cannot fit into the grammar in an ERROR node, inserts a
+zero-width MISSING node for a token it had to assume, and parses the rest
+of the file normally.  The tree is therefore a complete partial AST, and
+one walk over it reports every error in the file.
+"""
+
+from __future__ import annotations
+
+from typing import Any
+
+from interpreter.frontends.offset_map import NO_REWRITES, OffsetMap
+from interpreter.parser import node_location
+from interpreter.stream_source import SourceBytes
+from interpreter.syntax_diagnostic import SyntaxDiagnostic
+
+# Longest excerpt of unexpected source quoted in a diagnostic.
+_MAX_EXCERPT = 40
+
+
+
+def _excerpt(node: Any, source: SourceBytes) -> str:  # Any: tree-sitter node
+    """The first line of *node*'s source, cut to ``_MAX_EXCERPT`` characters."""
+    text = source[node.start_byte : node.end_byte].decode("utf-8", errors="replace")
+    first_line = text.strip().split("\n", 1)[0]
+    return (
+        first_line
+        if len(first_line) <= _MAX_EXCERPT
+        else first_line[:_MAX_EXCERPT] + "..."
+    )
+
+
+def _diagnostics(
+    node: Any, source: SourceBytes, offsets: OffsetMap
+) -> list[SyntaxDiagnostic]:  # Any: tree-sitter node
+    if node.is_missing:
+        location = offsets.location(node_location(node))
+        return [SyntaxDiagnostic(location, f'missing "{node.type}"')]
+    if node.is_error:
+        excerpt = _excerpt(node, source)
+        message = f'unexpected "{excerpt}"' if excerpt else "unexpected end of input"
+        return [SyntaxDiagnostic(offsets.location(node_location(node)), message)]
+    if not node.has_error:
+        return []
+    return [
+        d for child in node.children for d in _diagnostics(child, source, offsets)
+    ]
+
+
+def collect_syntax_diagnostics(
+    root: Any, source: SourceBytes, offsets: OffsetMap = NO_REWRITES
+) -> tuple[SyntaxDiagnostic, ...]:  # Any: tree-sitter node
+    """Every syntax error under *root*, in source order.
+
+    An ERROR node is reported once, as a whole; errors nested inside it are
+    part of the same malformed region.  *offsets* places each error in the
+    file when *source* is a rewrite of it.
+    """
+    return tuple(_diagnostics(root, source, offsets))
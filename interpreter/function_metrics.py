@@ -0,0 +1,204 @@
+# pyright: standard
+"""Function metrics — cyclomatic complexity and nesting depth per function.
+
+Meant for ranking solutions by how complicated they are::
+
+    int sign(int n) {               // complexity 3, nesting 1
+        if (n > 0) {
+            return 1;
+        } else if (n < 0) {
+            return -1;
+        }
+        return 0;
+    }
+
+Cyclomatic complexity is measured on the CFG of each function's body, the
+blocks reachable from its entry label: 1, plus one for every successor of a
+block past its first.  Each ``if``, loop test, ``case`` and short-circuit
+``&&``/``||`` is a ``BRANCH_IF`` there, as is a check lowering adds (Go's
+nil-pointer check), which is a path through the program all the same.
+
+Nesting depth is measured on the concrete syntax tree: the most control
+structures (``NESTING_KINDS``) around any point of the function, not
+counting nested functions, which are measured on their own.  An ``else
+if`` continues the chain it is in rather than nesting inside it.  Code
+outside every function is reported as ``<top level>``; Go's ``main`` is
+lowered onto the top level, so its figures are there.
+"""
+
+from __future__ import annotations
+
+from collections import deque
+
+from interpreter import constants
+from interpreter.cfg import CFG, build_cfg
+from interpreter.constants import Language
+from interpreter.cst_types import CstNode
+from interpreter.function_metrics_types import TOP_LEVEL, FunctionMetrics
+from interpreter.instructions import InstructionBase, Label_
+from interpreter.ir import NO_SOURCE_LOCATION, CodeLabel, SourceLocation
+
+_IF_KINDS = frozenset({"if_statement", "if_expression", "if", "unless"})
+
+# Syntax-tree node kinds, across the supported grammars, that nest a body.
+NESTING_KINDS: frozenset[str] = _IF_KINDS | frozenset(
+    {
+        # loops
+        "for_statement",
+        "for_in_statement",
+        "for_range_loop",
+        "enhanced_for_statement",
+        "foreach_statement",
+        "for_expression",
+        "for",
+        "foreach",
+        "while_statement",
+        "while_expression",
+        "while",
+        "until",
+        "do_statement",
+        "do_while_statement",
+        "do_while_expression",
+        "repeat_statement",
+        "repeat",
+        "loop_expression",
+        # multi-way branches
+        "switch_statement",
+        "switch_expression",
+        "expression_switch_statement",
+        "type_switch_statement",
+        "select_statement",
+        "match_statement",
+        "match_expression",
+        "when_expression",
+        "case",
+        "case_match",
+        # exception handlers
+        "try_statement",
+        "try_with_resources_statement",
+        "try",
+    }
+)
+
+# Kinds that nest only in some languages: Rust's ``try_expression`` is ``?``.
+_LANGUAGE_NESTING_KINDS: dict[Language, frozenset[str]] = {
+    Language.KOTLIN: frozenset({"try_expression"}),
+    Language.SCALA: frozenset({"try_expression"}),
+}
+
+_Span = tuple[int, int, int, int]
+
+
+def _span(location: SourceLocation) -> _Span:
+    return (
+        location.start_line,
+        location.start_col,
+        location.end_line,
+        location.end_col,
+    )
+
+
+def _contains(outer: _Span, inner: _Span) -> bool:
+    return outer[:2] <= inner[:2] and inner[2:] <= outer[2:]
+
+
+def _overlaps(a: _Span, b: _Span) -> bool:
+    return a[:2] < b[2:] and b[:2] < a[2:]
+
+
+def _functions(
+    instructions: list[InstructionBase],
+) -> list[tuple[str, CodeLabel, SourceLocation]]:
+    """Each function's name, entry label and location, in IR order.
+
+    A function is located at the instruction just before its entry label,
+    the ``BRANCH`` around its body.  Functions lowering made up
+    (``__resolve_default__``) are left out.
+    """
+    functions: list[tuple[str, CodeLabel, SourceLocation]] = []
+    for previous, inst in zip([None, *instructions], instructions):
+        if not isinstance(inst, Label_) or not inst.label.is_function():
+            continue
+        name = inst.label.extract_name(constants.FUNC_LABEL_PREFIX)
+        if name.startswith("__") and name.endswith("__"):
+            continue
+        location = previous.source_location if previous else NO_SOURCE_LOCATION
+        functions.append((name, inst.label, location))
+    return functions
+
+
+def _complexity(cfg: CFG, entry: CodeLabel) -> int:
+    """1 plus the extra successors of the blocks reachable from *entry*."""
+    seen = {entry}
+    queue: deque[CodeLabel] = deque([entry])
+    complexity = 1
+    while queue:
+        block = cfg.blocks[queue.popleft()]
+        complexity += max(len(block.successors) - 1, 0)
+        for successor in block.successors:
+            if successor not in seen:
+                seen.add(successor)
+                queue.append(successor)
+    return complexity
+
+
+def _nests(node: CstNode, parent: CstNode, kinds: frozenset[str]) -> bool:
+    """Does *node* open a level of nesting?  Not an ``else if``."""
+    if node.kind not in kinds:
+        return False
+    return node.kind not in _IF_KINDS or not (
+        parent.kind in _IF_KINDS or "else" in parent.kind
+    )
+
+
+def _nesting(
+    node: CstNode, span: _Span, skipped: set[_Span], kinds: frozenset[str]
+) -> int:
+    """The deepest nesting under *node*, within *span* and outside *skipped*."""
+    deepest = 0
+    for child in node.children:
+        if not isinstance(child, CstNode):
+            continue
+        child_span = _span(child.location)
+        if child_span in skipped or not _overlaps(span, child_span):
+            continue
+        level = int(_contains(span, child_span) and _nests(child, node, kinds))
+        deepest = max(deepest, level + _nesting(child, span, skipped, kinds))
+    return deepest
+
+
+def compute_function_metrics(
+    instructions: list[InstructionBase], cst: CstNode, language: Language
+) -> tuple[FunctionMetrics, ...]:
+    """The complexity and nesting of every function, and of the top level.
+
+    *instructions* and *cst* come from the same source.  The top level
+    comes first, then the functions in source order.
+    """
+    cfg = build_cfg(instructions)
+    kinds = NESTING_KINDS | _LANGUAGE_NESTING_KINDS.get(language, frozenset())
+    functions = _functions(instructions)
+    spans = {_span(location) for _, _, location in functions}
+    root = _span(cst.location)
+    metrics = [
+        FunctionMetrics(
+            location=cst.location,
+            function=TOP_LEVEL,
+            complexity=_complexity(cfg, cfg.entry) if cfg.blocks else 1,
+            nesting=_nesting(cst, root, spans, kinds),
+        )
+    ]
+    for name, entry, location in functions:
+        span = _span(location)
+        inner = {other for other in spans if other != span and _contains(span, other)}
+        metrics.append(
+            FunctionMetrics(
+                location=location,
+                function=name,
+                complexity=_complexity(cfg, entry),
+                nesting=_nesting(cst, span, inner, kinds),
+            )
+        )
+    return (metrics[0],) + tuple(
+        sorted(metrics[1:], key=lambda m: (m.location.start_line, m.location.start_col))
+    )
@@ -0,0 +1,32 @@
+# pyright: standard
+"""FunctionMetrics — how complex one function is."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter.ir import SourceLocation
+
+# The name the code outside every function is reported under.
+TOP_LEVEL = "<top level>"
+
+
+@dataclass(frozen=True)
+class FunctionMetrics:
+    """*function*, at *location*, with its cyclomatic complexity and nesting.
+
+    *complexity* is 1 for straight-line code, plus one for each extra way
+    out of a branch; *nesting* is the most control structures (``if``,
+    loops, ``switch``, ``try``) around any point of the body, 0 for none.
+    """
+
+    location: SourceLocation
+    function: str
+    complexity: int
+    nesting: int
+
+    def __str__(self) -> str:
+        return (
+            f"{self.location}: {self.function}: "
+            f"complexity {self.complexity}, nesting {self.nesting}"
+        )
@@ -0,0 +1,112 @@
+# pyright: standard
+"""Parser fuzzing — feed the frontends mutated source and catch crashes.
+
+``fuzz_parse`` is the fuzz target: it lowers arbitrary bytes with a
+deterministic frontend, and the only failure it accepts is the frontend
+refusing a tree nested too deep to lower.  Anything else it raises is a
+bug.  ``run_fuzz`` drives it from a seed corpus, mutating the seeds the
+way a fuzzer would (flipped bytes, dropped and repeated spans, stray and
+unbalanced brackets, truncation, splices of two seeds) with a seeded
+random generator, so every failure it finds is reproducible.
+"""
+
+from __future__ import annotations
+
+import random
+from collections import defaultdict
+from dataclasses import dataclass
+from pathlib import Path
+
+from interpreter.constants import Language
+from interpreter.frontends import (
+    SUPPORTED_DETERMINISTIC_LANGUAGES,
+    get_deterministic_frontend,
+)
+from interpreter.frontends.nesting import NestingTooDeepError
+from interpreter.project.language_detection import detect_language
+
+_BRACKETS = (b"(", b")", b"[", b"]", b"{", b"}", b"<", b">")
+_FRAGMENTS = (b"\x00", b"\xff\xfe", b"\\", b'"', b"'", b"/*", b"\n", b";", b"end")
+
+
+def fuzz_parse(data: bytes, language: Language) -> None:
+    """Lower *data* as *language* source, ignoring the IR.
+
+    Raises whatever the frontend raises other than ``NestingTooDeepError``.
+    """
+    try:
+        get_deterministic_frontend(language).lower(data)
+    except NestingTooDeepError:
+        pass
+
+
+def seed_corpus(paths: list[Path]) -> dict[Language, tuple[bytes, ...]]:
+    """The files at *paths* grouped by detected language.
+
+    Files in a language without a deterministic frontend are left out.
+    """
+    corpus: dict[Language, list[bytes]] = defaultdict(list)
+    for path in sorted(paths):
+        language = detect_language(path)
+        if language in SUPPORTED_DETERMINISTIC_LANGUAGES:
+            corpus[language].append(path.read_bytes())
+    return {language: tuple(seeds) for language, seeds in corpus.items()}
+
+
+def _span(data: bytes, rng: random.Random) -> tuple[int, int]:
+    start = rng.randrange(len(data) + 1)
+    return start, rng.randint(start, min(len(data), start + 64))
+
+
+def mutate(data: bytes, other: bytes, rng: random.Random) -> bytes:
+    """One random mutation of *data*; *other* is a second seed to splice in."""
+    start, end = _span(data, rng)
+    choice = rng.randrange(7)
+    if choice == 0 and data:
+        i = rng.randrange(len(data))
+        return data[:i] + bytes([data[i] ^ (1 << rng.randrange(8))]) + data[i + 1 :]
+    if choice == 1:
+        return data[:start] + data[end:]
+    if choice == 2:
+        return data[:end] + data[start:end] * rng.randint(2, 32) + data[end:]
+    if choice == 3:
+        return data[:start] + rng.choice(_BRACKETS) * rng.randint(1, 500) + data[start:]
+    if choice == 4:
+        return data[:start] + rng.choice(_FRAGMENTS) + data[start:]
+    if choice == 5:
+        return data[:start]
+    other_start, other_end = _span(other, rng)
+    return data[:start] + other[other_start:other_end] + data[end:]
+
+
+@dataclass(frozen=True)
+class FuzzFailure:
+    """An input the frontend for *language* crashed on."""
+
+    language: Language
+    data: bytes
+    error: Exception
+
+
+def run_fuzz(
+    corpus: dict[Language, tuple[bytes, ...]],
+    iterations: int,
+    seed: int = 0,
+) -> tuple[FuzzFailure, ...]:
+    """Fuzz each language in *corpus* for *iterations* inputs from its seeds.
+
+    Each input is a seed under one to four stacked mutations.  The same
+    *corpus*, *iterations* and *seed* always try the same inputs.
+    """
+    rng = random.Random(seed)
+    failures: list[FuzzFailure] = []
+    for language, seeds in corpus.items():
+        for _ in range(iterations):
+            data = rng.choice(seeds)
+            for _ in range(rng.randint(1, 4)):
+                data = mutate(data, rng.choice(seeds), rng)
+            try:
+                fuzz_parse(data, language)
+            except Exception as error:  # every other exception is a finding
+                failures.append(FuzzFailure(language, data, error))
+    return tuple(failures)
@@ -0,0 +1,295 @@
+# pyright: standard
+"""Grammar export — the syntax a deterministic frontend lowers, as EBNF.
+
+The grammar is read off the frontend's own dispatch tables, so it always
+describes exactly the subset lowered today, in terms of tree-sitter node
+kinds::
+
+    program = { statement } ;
+    statement = "block" | "if_statement" | ... | skipped | expression ;
+    expression = "binary_operator" | "call" | ... ;
+    skipped = "comment" | ... ;
+
+A statement kind the frontend has no handler for is lowered as an
+expression, which is why ``expression`` is the last alternative of
+``statement``; an expression kind without one becomes a symbolic
+placeholder and is not part of the grammar.  Kinds the tree-sitter
+grammar cannot produce (handlers kept for another grammar version, noise
+such as ``"\\n"``) are left out.
+
+Given the tree-sitter grammar itself — its ``src/grammar.json``, which the
+compiled parsers do not carry — each lowered kind also gets the production
+tree-sitter builds it by, and is referred to by name rather than quoted.
+So does each hidden or supertype rule those reach (``_suite``), as none
+is a node of its own::
+
+    if_statement = "if" expression ":" _suite { elif_clause } [ else_clause ] ;
+
+Any other kind, and any token, stays a quoted terminal, so the grammar
+stops where lowering does.  A rule named like one of the four productions
+above, such as Python's ``expression`` supertype, is taken to be it.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import Any
+
+from interpreter.constants import Language
+from interpreter.frontends import get_deterministic_frontend
+from interpreter.grammar_types import (
+    Choice,
+    Grammar,
+    GrammarExpr,
+    NonTerminal,
+    Option,
+    Production,
+    Repeat,
+    Sequence,
+    Terminal,
+)
+
+_WIDTH = 80
+
+# The productions every implemented grammar starts with.
+_PRODUCTIONS = ("program", "statement", "expression", "skipped")
+
+
+def _parser_kinds(language: Language) -> frozenset[str]:
+    """Every node kind, named or anonymous, the parser for *language* produces."""
+    import tree_sitter_language_pack as tslp
+
+    ts_language = tslp.get_language(language)  # type: ignore[arg-type]  # Language enum values are valid SupportedLanguage strings
+    names = (
+        ts_language.node_kind_for_id(kind_id)
+        for kind_id in range(ts_language.node_kind_count)
+    )
+    return frozenset(name for name in names if name is not None)
+
+
+def _choice(
+    kinds: frozenset[str], expanded: frozenset[str], *rest: GrammarExpr
+) -> Choice:
+    return Choice(
+        tuple(
+            NonTerminal(kind) if kind in expanded else Terminal(kind)
+            for kind in sorted(kinds)
+        )
+        + rest
+    )
+
+
+def implemented_grammar(
+    language: Language, tree_sitter_grammar: dict[str, Any] | None = None
+) -> Grammar:
+    """The node kinds the deterministic frontend for *language* lowers.
+
+    *tree_sitter_grammar*, the parsed ``grammar.json`` of the language's
+    tree-sitter grammar, adds the production of each lowered kind.
+
+    Raises ``ValueError`` if *language* has no deterministic frontend.
+    """
+    frontend = get_deterministic_frontend(language)
+    kinds = _parser_kinds(language)
+    skipped = frontend.skipped_node_types() & kinds
+    statements = frontend.statement_node_types() & kinds
+    expressions = frontend.expression_node_types() & kinds
+    structure = (
+        tree_sitter_productions(
+            tree_sitter_grammar, statements | expressions, frozenset(_PRODUCTIONS)
+        )
+        if tree_sitter_grammar
+        else ()
+    )
+    expanded = frozenset(p.name for p in structure)
+    expression = NonTerminal("expression")
+    productions = (
+        Production("program", Repeat(NonTerminal("statement"))),
+        Production(
+            "statement",
+            _choice(
+                statements,
+                expanded,
+                *((NonTerminal("skipped"),) if skipped else ()),
+                expression,
+            ),
+        ),
+        Production("expression", _choice(expressions, expanded)),
+    )
+    if skipped:
+        productions += (Production("skipped", _choice(skipped, frozenset())),)
+    return Grammar(language=language, productions=productions + structure)
+
+
+def tree_sitter_productions(
+    tree_sitter_grammar: dict[str, Any],
+    kinds: frozenset[str],
+    defined: frozenset[str] = frozenset(),
+) -> tuple[Production, ...]:
+    """The rules of *tree_sitter_grammar* that build *kinds*, as productions.
+
+    A kind gets a production when its rule is built from other rules; one
+    that is a single token (an identifier, a literal) stays a terminal.
+    The hidden and supertype rules those productions reach get one too, in
+    the order they are first reached.  A rule named in *defined* is left to
+    the production of that name the caller already has.
+    """
+    rules: dict[str, Any] = tree_sitter_grammar["rules"]
+    supertypes = {rule["name"] for rule in tree_sitter_grammar.get("supertypes", ())}
+    expandable = frozenset(
+        name
+        for name, rule in rules.items()
+        if (name in kinds or name.startswith("_") or name in supertypes)
+        and name not in defined
+        and _has_symbol(rule)
+    )
+    order = [kind for kind in sorted(kinds) if kind in expandable]
+    productions: list[Production] = []
+    for name in order:  # grows as hidden rules are reached
+        body = _rule_expr(rules[name], expandable | defined)
+        productions.append(Production(name, body))
+        order += [
+            reached
+            for reached in dict.fromkeys(_references(body))
+            if reached in expandable and reached not in order
+        ]
+    return tuple(productions)
+
+
+def _has_symbol(rule: dict[str, Any]) -> bool:
+    """Whether *rule* refers to another rule outside a token."""
+    if rule["type"] == "SYMBOL":
+        return True
+    if rule["type"] in ("TOKEN", "IMMEDIATE_TOKEN"):
+        return False
+    children = list(rule.get("members", []))
+    if "content" in rule:
+        children.append(rule["content"])
+    return any(_has_symbol(child) for child in children)
+
+
+def _references(expr: GrammarExpr) -> list[str]:
+    """The productions *expr* refers to, in order, repeats included."""
+    if isinstance(expr, NonTerminal):
+        return [expr.name]
+    if isinstance(expr, (Option, Repeat)):
+        return _references(expr.item)
+    if isinstance(expr, (Sequence, Choice)):
+        parts = expr.items if isinstance(expr, Sequence) else expr.alternatives
+        return [name for part in parts for name in _references(part)]
+    return []
+
+
+def _rule_expr(rule: dict[str, Any], expandable: frozenset[str]) -> GrammarExpr:
+    """A tree-sitter grammar rule as a grammar expression.
+
+    Fields and precedences only annotate what they wrap, so they are
+    dropped; an alias is the node kind or token it renames to.
+    """
+    kind = rule["type"]
+    if kind == "SYMBOL":
+        name = rule["name"]
+        return NonTerminal(name) if name in expandable else Terminal(name)
+    if kind in ("STRING", "ALIAS"):
+        return Terminal(rule["value"])
+    if kind == "PATTERN":
+        return Terminal(f"/{rule['value']}/")
+    if kind == "BLANK":
+        return Sequence(())
+    if kind == "SEQ":
+        return _sequence([_rule_expr(m, expandable) for m in rule["members"]])
+    if kind == "CHOICE":
+        return _alternatives([_rule_expr(m, expandable) for m in rule["members"]])
+    item = _rule_expr(rule["content"], expandable)
+    if kind == "REPEAT":
+        return Repeat(item)
+    if kind == "REPEAT1":
+        return _sequence([item, Repeat(item)])
+    return item
+
+
+def _sequence(items: list[GrammarExpr]) -> GrammarExpr:
+    """*items* in order, nested sequences spliced in; a lone item is itself."""
+    flat = tuple(
+        part
+        for item in items
+        for part in (item.items if isinstance(item, Sequence) else (item,))
+    )
+    return flat[0] if len(flat) == 1 else Sequence(flat)
+
+
+def _alternatives(alternatives: list[GrammarExpr]) -> GrammarExpr:
+    """A choice of *alternatives*; one that can be empty makes the rest optional."""
+    flat = tuple(
+        dict.fromkeys(
+            part
+            for alt in alternatives
+            for part in (alt.alternatives if isinstance(alt, Choice) else (alt,))
+        )
+    )
+    present = tuple(alt for alt in flat if alt != Sequence(()))
+    body = present[0] if len(present) == 1 else Choice(present)
+    return Option(body) if len(present) < len(flat) else body
+
+
+def _quote(text: str) -> str:
+    if text.isprintable() and '"' not in text:
+        return f'"{text}"'
+    if text.isprintable() and "'" not in text:
+        return f"'{text}'"
+    return json.dumps(text)
+
+
+def expr_to_ebnf(expr: GrammarExpr) -> str:
+    """*expr* in EBNF, with a choice inside another expression parenthesized."""
+    if isinstance(expr, Terminal):
+        return _quote(expr.text)
+    if isinstance(expr, NonTerminal):
+        return expr.name
+    if isinstance(expr, (Option, Repeat)):
+        item = expr.item
+        inner = (
+            " | ".join(expr_to_ebnf(alt) for alt in item.alternatives)
+            if isinstance(item, Choice)
+            else expr_to_ebnf(item)
+        )
+        return f"[ {inner} ]" if isinstance(expr, Option) else f"{{ {inner} }}"
+    if isinstance(expr, Sequence):
+        if not expr.items:
+            return '""'
+        return " ".join(_parenthesized(item) for item in expr.items)
+    return " | ".join(_parenthesized(alt) for alt in expr.alternatives)
+
+
+def _parenthesized(expr: GrammarExpr) -> str:
+    text = expr_to_ebnf(expr)
+    return f"( {text} )" if isinstance(expr, Choice) else text
+
+
+def production_to_ebnf(production: Production) -> str:
+    """``name = body ;`` on one line, or one alternative per line if too long."""
+    line = f"{production.name} = {expr_to_ebnf(production.body)} ;"
+    body = production.body
+    if len(line) <= _WIDTH or not isinstance(body, Choice):
+        return line
+    alternatives = [expr_to_ebnf(alt) for alt in body.alternatives]
+    indent = " " * len(production.name)
+    return "\n".join(
+        [f"{production.name} = {alternatives[0]}"]
+        + [f"{indent} | {alt}" for alt in alternatives[1:]]
+        + [f"{indent} ;"]
+    )
+
+
+def to_ebnf(grammar: Grammar) -> str:
+    """*grammar* as ISO-style EBNF, one production per paragraph."""
+    header = (
+        f"(* {grammar.language.value}: the tree-sitter node kinds "
+        "the deterministic frontend lowers *)"
+    )
+    return (
+        "\n\n".join(
+            [header] + [production_to_ebnf(p) for p in grammar.productions]
+        )
+        + "\n"
+    )
@@ -0,0 +1,76 @@
+# pyright: standard
+"""Grammar data types (pure data, no business logic).
+
+A ``Grammar`` is a list of productions, each naming a rule and giving its
+body: a terminal (a tree-sitter node kind or a token), a reference to
+another rule, a sequence, a choice between alternatives, an optional part,
+or zero or more repetitions.  It is the shape both the EBNF export and the
+railroad diagrams are drawn from.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter.constants import Language
+
+
+@dataclass(frozen=True)
+class Terminal:
+    """A tree-sitter node kind, written quoted."""
+
+    text: str
+
+
+@dataclass(frozen=True)
+class NonTerminal:
+    """A reference to another production."""
+
+    name: str
+
+
+@dataclass(frozen=True)
+class Sequence:
+    """Each of the items, in order."""
+
+    items: tuple[GrammarExpr, ...]
+
+
+@dataclass(frozen=True)
+class Choice:
+    """Exactly one of the alternatives."""
+
+    alternatives: tuple[GrammarExpr, ...]
+
+
+@dataclass(frozen=True)
+class Option:
+    """The item, or nothing."""
+
+    item: GrammarExpr
+
+
+@dataclass(frozen=True)
+class Repeat:
+    """Zero or more of the item."""
+
+    item: GrammarExpr
+
+
+GrammarExpr = Terminal | NonTerminal | Sequence | Choice | Option | Repeat
+
+
+@dataclass(frozen=True)
+class Production:
+    """``name = body ;``"""
+
+    name: str
+    body: GrammarExpr
+
+
+@dataclass(frozen=True)
+class Grammar:
+    """The productions of one language's grammar, start rule first."""
+
+    language: Language
+    productions: tuple[Production, ...]
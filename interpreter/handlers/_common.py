@@ -9,16 +9,30 @@ from typing import TYPE_CHECKING, Any
 if TYPE_CHECKING:
     from interpreter.vm.executor import HandlerContext
 
+from interpreter.address import Address
 from interpreter.field_name import FieldKind, FieldName
 from interpreter.ir import SpreadArguments
 from interpreter.type_name import TypeName
 from interpreter.types.type_expr import UNKNOWN, TypeExpr, scalar
 from interpreter.types.typed_value import TypedValue
 from interpreter.var_name import VarName
-from interpreter.vm.vm import SymbolicValue, VMState, _heap_addr
+from interpreter.vm.vm import SymbolicValue, VMState, _heap_addr, _heap_slot
 from interpreter.vm.vm_types import StackFrame
 
 
+def _spread_elements(vm: VMState, addr: Address) -> list[TypedValue]:
+    """The elements of the array at *addr*, up to its length when it has one."""
+    fields = vm.heap_get(addr).fields
+    length = fields.get(FieldName("length", FieldKind.SPECIAL))
+    count = length.value if length and isinstance(length.value, int) else len(fields)
+    elements: list[TypedValue] = []
+    for i in range(count):
+        base, slot = _heap_slot(vm, addr, FieldName(str(i), FieldKind.INDEX))
+        if slot in vm.heap_get(base).fields:
+            elements.append(vm.heap_get(base).fields[slot])
+    return elements
+
+
 def _resolve_call_args(vm: VMState, arg_operands: list) -> list[TypedValue]:
     """Resolve call arguments, expanding SpreadArguments from the heap."""
     from interpreter.vm.vm import _resolve_reg
@@ -29,12 +43,7 @@ def _resolve_call_args(vm: VMState, arg_operands: list) -> list[TypedValue]:
             tv = _resolve_reg(vm, op.register)
             addr = _heap_addr(tv.value)
             if addr and vm.heap_contains(addr):
-                fields = vm.heap_get(addr).fields
-                args.extend(
-                    fields[FieldName(str(i), FieldKind.INDEX)]
-                    for i in range(len(fields))
-                    if FieldName(str(i), FieldKind.INDEX) in fields
-                )
+                args.extend(_spread_elements(vm, addr))
             else:
                 args.append(tv)
         else:
@@ -13,6 +13,7 @@ from interpreter.instructions import Binop, InstructionBase, Unop
 from interpreter.refs.func_ref import BoundFuncRef
 from interpreter.types.type_expr import UNKNOWN, scalar
 from interpreter.types.typed_value import typed, typed_from_runtime
+from interpreter.vm.integer_overflow import apply_integer_overflow
 from interpreter.vm.vm import (
     ExecutionResult,
     Operators,
@@ -128,6 +129,12 @@ def _handle_binop(
                 reasoning=f"binop {lhs_raw!r} {oper} {rhs_raw!r} → uncomputable, symbolic {sym.name}",
             )
         )
+    result = apply_integer_overflow(
+        ctx.integer_overflow,
+        result,
+        f"{lhs_raw!r} {oper} {rhs_raw!r}",
+        t.source_location,
+    )
     return ExecutionResult.success(
         StateUpdate(
             register_writes={
@@ -186,6 +193,9 @@ def _handle_unop(
                 reasoning=f"unop {oper}{raw!r} → uncomputable, symbolic {sym.name}",
             )
         )
+    result = apply_integer_overflow(
+        ctx.integer_overflow, result, f"{oper}{raw!r}", t.source_location
+    )
     return ExecutionResult.success(
         StateUpdate(
             register_writes={
@@ -125,6 +125,7 @@ def _try_builtin_call(
             },
             new_objects=result.new_objects,
             heap_writes=result.heap_writes,
+            heap_deletes=result.heap_deletes,
             reasoning=(
                 f"builtin {func_name}"
                 f"({', '.join(repr(a.value) for a in args)}) = {result.value!r}"
@@ -257,8 +258,13 @@ def _try_user_function_call(
         logger.debug("Injecting closure vars for %s: %s", fname, list(captured.keys()))
 
     closure_env_id = func_val.closure_id if closure_env else NO_CLOSURE_ID
+    # Parameters shadow captured names; binding them must not write the env.
     captured_var_names = (
-        [VarName(k) if isinstance(k, str) else k for k in captured.keys()]
+        [
+            name
+            for name in (VarName(k) if isinstance(k, str) else k for k in captured)
+            if name not in param_vars
+        ]
         if closure_env
         else []
     )
@@ -510,6 +516,7 @@ def _handle_call_method(
                     },
                     new_objects=result.new_objects,
                     heap_writes=result.heap_writes,
+                    heap_deletes=result.heap_deletes,
                     reasoning=f"method builtin {method_name}({obj_val.value!r}, {[a.value for a in args]}) = {result.value!r}",
                 )
             )
@@ -31,6 +31,7 @@ from interpreter.vm.vm import (
     ExceptionHandler,
     ExecutionResult,
     StateUpdate,
+    ThrownException,
     VMState,
     _is_symbolic,
     _resolve_reg,
@@ -113,9 +114,29 @@ def _handle_throw(
 ) -> ExecutionResult:
     t = inst
     assert isinstance(t, Throw_)
-    val = _resolve_reg(vm, t.value_reg).value if t.value_reg is not None else None
+    thrown = (
+        _resolve_reg(vm, t.value_reg)
+        if t.value_reg is not None
+        else typed(None, scalar(constants.FoundationTypeName.VOID))
+    )
+    val = thrown.value
+    # Re-throwing the in-flight value keeps the stack trace of the original throw.
+    in_flight = vm.current_exception
+    if in_flight is None or in_flight.value != thrown:
+        vm.current_exception = ThrownException(
+            value=thrown,
+            stack_trace=tuple(str(f.function_name) for f in reversed(vm.call_stack)),
+            location=t.source_location,
+        )
+    depth = len(vm.call_stack)
+    # Handlers pushed by frames that have since returned no longer apply.
+    while vm.exception_stack and vm.exception_stack[-1].frame_depth > depth:
+        vm.exception_stack.pop()
     if vm.exception_stack:
         handler = vm.exception_stack.pop()
+        # Unwind the frames above the one that pushed the handler.
+        if 0 < handler.frame_depth < depth:
+            del vm.call_stack[handler.frame_depth :]
         # Redirect to the first catch label (or finally if no catch)
         target = (
             handler.catch_labels[0]
@@ -143,6 +164,7 @@ def _handle_try_push(
             catch_labels=list(catch_labels),
             finally_label=finally_label if finally_label.is_present() else None,
             end_label=end_label if end_label.is_present() else None,
+            frame_depth=len(vm.call_stack),
         )
     )
     return ExecutionResult.success(
@@ -41,6 +41,7 @@ from interpreter.vm.vm import (
     StateUpdate,
     VMState,
     _heap_addr,
+    _heap_slot,
     _resolve_reg,
 )
 from interpreter.vm.vm_types import HeapWrite
@@ -542,16 +543,12 @@ def _handle_store_index(
                 reasoning=f"store {arr_desc}[{idx_val}] = {tv.value!r} (array not on heap, no-op)",
             )
         )
-    idx_kind = _infer_index_kind(idx_val)
+    slot_addr, slot = _heap_slot(
+        vm, addr, FieldName(str(idx_val), _infer_index_kind(idx_val))
+    )
     return ExecutionResult.success(
         StateUpdate(
-            heap_writes=[
-                HeapWrite(
-                    obj_addr=addr,
-                    field=FieldName(str(idx_val), idx_kind),
-                    value=tv,
-                )
-            ],
+            heap_writes=[HeapWrite(obj_addr=slot_addr, field=slot, value=tv)],
             reasoning=f"store {addr}[{idx_val}] = {tv.value!r}",
         )
     )
@@ -598,9 +595,10 @@ def _handle_load_index(
                 reasoning=f"load {arr_desc}[{idx_val}] (not on heap) → {sym.name}",
             )
         )
-    heap_obj = vm.heap_get(addr)
-    idx_kind = _infer_index_kind(idx_val)
-    key = FieldName(str(idx_val), idx_kind)
+    slot_addr, key = _heap_slot(
+        vm, addr, FieldName(str(idx_val), _infer_index_kind(idx_val))
+    )
+    heap_obj = vm.heap_get(slot_addr)
     if key in heap_obj.fields:
         tv = heap_obj.fields[key]
         return ExecutionResult.success(
@@ -67,9 +67,12 @@ def _handle_const(
             if env_id:
                 # Reuse existing environment; sync any new local vars into it
                 env = vm.closures[env_id]
-                for k, v in enclosing.local_vars.items():
-                    if k not in env.bindings:
-                        env.bindings[k] = v
+                new_names = [k for k in enclosing.local_vars if k not in env.bindings]
+                for k in new_names:
+                    env.bindings[k] = enclosing.local_vars[k]
+                enclosing.captured_var_names = enclosing.captured_var_names | frozenset(
+                    new_names
+                )
             else:
                 env_id = ClosureId(f"{constants.ENV_ID_PREFIX}{vm.symbolic_counter}")
                 vm.symbolic_counter += 1
@@ -119,6 +122,17 @@ def _handle_load_var(
                     reasoning=f"load {name} = {tv!r} (via heap alias {alias_ptr.base})",
                 )
             )
+        # Captured variables are shared with closures through the environment,
+        # which holds the latest write from either side.
+        env = vm.closures.get(f.closure_env_id) if f.closure_env_id else None
+        if env is not None and name in f.captured_var_names and name in env.bindings:
+            stored = env.bindings[name]
+            return ExecutionResult.success(
+                StateUpdate(
+                    register_writes={t.result_reg: stored},
+                    reasoning=f"load {name} = {stored.value!r} (captured)",
+                )
+            )
         if name in f.local_vars:
             stored = f.local_vars[name]
             return ExecutionResult.success(
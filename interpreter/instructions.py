@@ -212,8 +212,8 @@ class Const(InstructionBase):
 
     ``type_expr`` is required (keyword-only).  Use the typed factory classmethods
     (``Const.int_``, ``Const.float_``, ``Const.string``, ``Const.bool_``,
-    ``Const.null_``, ``Const.func_ref``, ``Const.class_ref``) rather than
-    constructing ``Const`` directly.
+    ``Const.null_``, ``Const.unassigned``, ``Const.func_ref``,
+    ``Const.class_ref``) rather than constructing ``Const`` directly.
     """
 
     result_reg: Register = NO_REGISTER
@@ -286,6 +286,21 @@ class Const(InstructionBase):
             **kw,
         )
 
+    @classmethod
+    def unassigned(cls, result_reg: Register, **kw: Any) -> Const:
+        """Create the null a variable declared without an initializer holds.
+
+        It has no operand, so definite-assignment analysis can tell it from
+        an explicit ``null``; the VM loads it as ``None`` all the same.
+        """
+        return cls(
+            result_reg=result_reg,
+            value=None,
+            has_value=False,
+            type_expr=NULL,
+            **kw,
+        )
+
     @classmethod
     def func_ref(
         cls,
@@ -1,10 +1,21 @@
 # pyright: standard
-"""Call graph construction with Class Hierarchy Analysis (CHA) for method dispatch."""
+"""Call graph construction with Class Hierarchy Analysis (CHA) for method dispatch.
+
+Calls through function values are resolved too.  ``f = add; f(1)``, a
+callback passed as an argument and a function returned by another are
+followed by a flow-insensitive pass over the function references
+(``Const.func_ref``) stored in registers and variables: a call of a
+variable, or of a register loaded from one, may reach every function ever
+stored there.  Variables are told apart by name alone, and function values
+kept in fields or collections are not followed.
+"""
 
 from __future__ import annotations
 
 import logging
+from collections import defaultdict, deque
 
+from interpreter.cfg import escape_mermaid, node_id
 from interpreter.cfg_types import CFG
 from interpreter.func_name import FuncName
 from interpreter.instructions import (
@@ -12,14 +23,22 @@ from interpreter.instructions import (
     CallFunction,
     CallMethod,
     CallUnknown,
+    Const,
+    DeclVar,
+    InstructionBase,
+    LoadVar,
+    Return_,
+    StoreVar,
 )
 from interpreter.interprocedural.types import (
+    ROOT_FUNCTION,
     CallGraph,
     CallSite,
     FunctionEntry,
     InstructionLocation,
 )
 from interpreter.ir import CodeLabel
+from interpreter.register import Register
 from interpreter.registry import FunctionRegistry
 
 logger = logging.getLogger(__name__)
@@ -40,18 +59,34 @@ def _build_block_to_function(
 ) -> dict[CodeLabel, FunctionEntry]:
     """Map each block label to the FunctionEntry that owns it.
 
-    Strategy: walk blocks in order. When we hit a block whose label is a known
-    function entry, set current_function. All subsequent blocks belong to that
-    function until we hit the next function entry.
+    A function owns the blocks reachable from its entry without entering
+    another function; the blocks reachable from the CFG entry outside every
+    function belong to ROOT_FUNCTION.  A block no function reaches (dead
+    code, an exception handler) belongs to the function entered last
+    before it in block order.
     """
     block_to_func: dict[CodeLabel, FunctionEntry] = {}
-    current_func: FunctionEntry | None = None
+    owners = list(function_entries.items())
+    if cfg.entry in cfg.blocks and cfg.entry not in function_entries:
+        owners.insert(0, (cfg.entry, ROOT_FUNCTION))
+
+    for entry, func in owners:
+        pending = deque([entry])
+        while pending:
+            label = pending.popleft()
+            if label in block_to_func or label not in cfg.blocks:
+                continue
+            block_to_func[label] = func
+            pending.extend(
+                succ
+                for succ in cfg.blocks[label].successors
+                if succ not in function_entries
+            )
 
+    current_func = ROOT_FUNCTION
     for label in cfg.blocks:
-        if label in function_entries:
-            current_func = function_entries[label]
-        if current_func is not None:
-            block_to_func[label] = current_func
+        current_func = function_entries.get(label, current_func)
+        block_to_func.setdefault(label, current_func)
 
     return block_to_func
 
@@ -94,59 +129,199 @@ def _resolve_call_method_callees_cha(
     return frozenset(callees)
 
 
+_CALLS = (CallFunction, CallCtorFunction, CallMethod, CallUnknown)
+_Call = CallFunction | CallCtorFunction | CallMethod | CallUnknown
+
+# A copy of the functions one register or variable holds into another.
+_Copy = tuple[str, str]
+
+
+def _function_references(
+    cfg: CFG, function_entries: dict[CodeLabel, FunctionEntry]
+) -> tuple[dict[str, set[FunctionEntry]], set[_Copy]]:
+    """The function each function reference loads into its register, and
+    the copies ``LOAD_VAR``, ``STORE_VAR`` and ``DECL_VAR`` make."""
+    by_label = {str(label): entry for label, entry in function_entries.items()}
+    held: dict[str, set[FunctionEntry]] = defaultdict(set)
+    copies: set[_Copy] = set()
+    for block in cfg.blocks.values():
+        for inst in block.instructions:
+            if isinstance(inst, Const) and str(inst.value) in by_label:
+                held[str(inst.result_reg)].add(by_label[str(inst.value)])
+            elif isinstance(inst, LoadVar):
+                copies.add((str(inst.name), str(inst.result_reg)))
+            elif isinstance(inst, (StoreVar, DeclVar)):
+                copies.add((str(inst.value_reg), str(inst.name)))
+    return held, copies
+
+
+def _returned_registers(
+    cfg: CFG, block_to_func: dict[CodeLabel, FunctionEntry]
+) -> dict[FunctionEntry, set[str]]:
+    """The registers each function returns."""
+    returns: dict[FunctionEntry, set[str]] = defaultdict(set)
+    for label, block in cfg.blocks.items():
+        returns[block_to_func[label]].update(
+            str(inst.value_reg)
+            for inst in block.instructions
+            if isinstance(inst, Return_) and inst.value_reg is not None
+        )
+    return returns
+
+
+def _propagate(held: dict[str, set[FunctionEntry]], copies: set[_Copy]) -> None:
+    """Flow the functions in *held* along *copies* until nothing changes."""
+    targets: dict[str, set[str]] = defaultdict(set)
+    for source, destination in copies:
+        targets[source].add(destination)
+    pending = deque(held)
+    while pending:
+        source = pending.popleft()
+        for destination in targets.get(source, ()):
+            if not held[source] <= held[destination]:
+                held[destination] |= held[source]
+                pending.append(destination)
+
+
+def _resolve_callees(
+    inst: InstructionBase,
+    held: dict[str, set[FunctionEntry]],
+    function_entries: dict[CodeLabel, FunctionEntry],
+    registry: FunctionRegistry,
+) -> frozenset[FunctionEntry]:
+    """The functions a CALL_* may reach.
+
+    A CALL_FUNCTION no function is declared for calls the functions its
+    name, as a variable, holds; a CALL_UNKNOWN those its target register
+    holds.
+    """
+    if isinstance(inst, (CallFunction, CallCtorFunction)):
+        callees = _resolve_call_function_callees(
+            str(inst.func_name), function_entries, registry
+        )
+        if callees or isinstance(inst, CallCtorFunction):
+            return callees
+        return frozenset(held.get(str(inst.func_name), ()))
+    if isinstance(inst, CallMethod):
+        return _resolve_call_method_callees_cha(
+            str(inst.method_name), registry, function_entries
+        )
+    if isinstance(inst, CallUnknown):
+        return frozenset(held.get(str(inst.target_reg), ()))
+    return frozenset()
+
+
+def _call_copies(
+    inst: InstructionBase,
+    callees: frozenset[FunctionEntry],
+    returns: dict[FunctionEntry, set[str]],
+) -> set[_Copy]:
+    """The copies a function call makes: each argument into its callee's
+    parameter, and each register the callee returns into the result."""
+    if not isinstance(inst, (CallFunction, CallUnknown)):
+        return set()
+    copies: set[_Copy] = set()
+    for callee in callees:
+        copies.update(
+            (str(arg), param)
+            for arg, param in zip(inst.args, callee.params)
+            if isinstance(arg, Register)
+        )
+        copies.update((reg, str(inst.result_reg)) for reg in returns[callee])
+    return copies
+
+
 def build_call_graph(cfg: CFG, registry: FunctionRegistry) -> CallGraph:
     """Scan CFG for CALL_* instructions and build the call graph."""
     function_entries = build_function_entries(cfg, registry)
     block_to_func = _build_block_to_function(cfg, function_entries)
     functions = frozenset(function_entries.values())
 
+    calls: list[tuple[InstructionLocation, _Call]] = [
+        (InstructionLocation(block_label=label, instruction_index=idx), inst)
+        for label, block in cfg.blocks.items()
+        for idx, inst in enumerate(block.instructions)
+        if isinstance(inst, _CALLS)
+    ]
+
+    # Resolving calls of function values can pass more of them on, as
+    # arguments or results, so resolve until no call makes a new copy.
+    held, copies = _function_references(cfg, function_entries)
+    returns = _returned_registers(cfg, block_to_func)
+    while True:
+        _propagate(held, copies)
+        callees = {
+            location: _resolve_callees(inst, held, function_entries, registry)
+            for location, inst in calls
+        }
+        made = copies.union(
+            *(_call_copies(inst, callees[loc], returns) for loc, inst in calls)
+        )
+        if made == copies:
+            break
+        copies = made
+
     call_sites: list[CallSite] = []
 
-    for label, block in cfg.blocks.items():
-        caller = block_to_func.get(label)
-        if caller is None:
-            continue
-
-        for idx, inst in enumerate(block.instructions):
-            t = inst
-            if not isinstance(
-                t, (CallFunction, CallCtorFunction, CallMethod, CallUnknown)
-            ):
-                continue
+    for location, t in calls:
+        if isinstance(t, CallUnknown):
+            arg_operands = (str(t.target_reg),) + tuple(str(a) for a in t.args)
+        else:
+            # CALL_METHOD operands: [object_reg, method_name, arg1, arg2, ...]
+            arg_operands = tuple(str(a) for a in t.args)
 
-            location = InstructionLocation(block_label=label, instruction_index=idx)
-
-            if isinstance(t, (CallFunction, CallCtorFunction)):
-                target_label = str(t.func_name)
-                callees = _resolve_call_function_callees(
-                    target_label, function_entries, registry
-                )
-                arg_operands = tuple(str(a) for a in t.args)
-
-            elif isinstance(t, CallMethod):
-                # operands: [object_reg, method_name, arg1, arg2, ...]
-                method_name = str(t.method_name)
-                callees = _resolve_call_method_callees_cha(
-                    method_name, registry, function_entries
-                )
-                arg_operands = tuple(str(a) for a in t.args)
-
-            else:  # CallUnknown
-                callees = frozenset()
-                arg_operands = (str(t.target_reg),) + tuple(str(a) for a in t.args)
-
-            site = CallSite(
-                caller=caller,
-                location=location,
-                callees=callees,
-                arg_operands=arg_operands,
-            )
-            call_sites.append(site)
-            logger.debug(
-                "CallSite: %s calls %s at %s",
-                caller.label,
-                [c.label for c in callees],
-                location,
-            )
+        site = CallSite(
+            caller=block_to_func[location.block_label],
+            location=location,
+            callees=callees[location],
+            arg_operands=arg_operands,
+        )
+        call_sites.append(site)
+        logger.debug(
+            "CallSite: %s calls %s at %s",
+            site.caller.label,
+            [c.label for c in site.callees],
+            location,
+        )
 
     return CallGraph(functions=functions, call_sites=frozenset(call_sites))
+
+
+def _mermaid_name(function: FunctionEntry) -> str:
+    return "top level" if function == ROOT_FUNCTION else str(function.label)
+
+
+def call_graph_to_mermaid(call_graph: CallGraph) -> str:
+    """Convert a call graph to a Mermaid flowchart LR diagram.
+
+    One node per function, and one for the top level when it calls any;
+    one edge per caller and callee.  An edge is dashed when every call
+    along it may also reach another function (a virtual call, or a call of
+    a function value).
+    """
+    lines: list[str] = ["flowchart LR"]
+    callers = {site.caller for site in call_graph.call_sites}
+    nodes = sorted(
+        call_graph.functions | (callers & {ROOT_FUNCTION}),
+        key=lambda f: str(f.label),
+    )
+    for function in nodes:
+        name = escape_mermaid(_mermaid_name(function))
+        lines.append(f'    {node_id(function.label)}["{name}"]')
+
+    # caller, callee -> whether some call along the edge has no other callee
+    certain: dict[tuple[FunctionEntry, FunctionEntry], bool] = {}
+    for site in call_graph.call_sites:
+        for callee in site.callees:
+            edge = (site.caller, callee)
+            certain[edge] = certain.get(edge, False) or len(site.callees) == 1
+    edges = sorted(certain, key=lambda e: (str(e[0].label), str(e[1].label)))
+    for caller, callee in edges:
+        arrow = "-->" if certain[(caller, callee)] else "-.->"
+        lines.append(f"    {node_id(caller.label)} {arrow} {node_id(callee.label)}")
+
+    if ROOT_FUNCTION in nodes:
+        root = node_id(ROOT_FUNCTION.label)
+        lines.append(f"    style {root} fill:#28a745,color:#fff")
+
+    return "\n".join(lines)
@@ -3,6 +3,7 @@
 
 from __future__ import annotations
 
+from collections.abc import Iterable
 from dataclasses import dataclass
 from typing import Union
 
@@ -47,6 +48,10 @@ class FunctionEntry:
         return cfg.blocks[self.label]
 
 
+# The caller of calls made by code outside every function.
+ROOT_FUNCTION = FunctionEntry(label=CodeLabel("__root__"), params=())
+
+
 # ---------------------------------------------------------------------------
 # 3. FlowEndpoints — what flows where
 # ---------------------------------------------------------------------------
@@ -153,7 +158,7 @@ class CallContext:
 
 ROOT_CONTEXT = CallContext(
     site=CallSite(
-        caller=FunctionEntry(label=CodeLabel("__root__"), params=()),
+        caller=ROOT_FUNCTION,
         location=NO_INSTRUCTION_LOC,
         callees=frozenset(),
         arg_operands=(),
@@ -195,11 +200,47 @@ class SummaryKey:
 
 @dataclass(frozen=True)
 class CallGraph:
-    """The program's call graph: functions and edges (call sites)."""
+    """The program's call graph: functions and edges (call sites).
+
+    Calls made outside every function have ROOT_FUNCTION as their caller;
+    it is not one of *functions*.
+    """
 
     functions: frozenset[FunctionEntry]
     call_sites: frozenset[CallSite]
 
+    def callees_of(self, function: FunctionEntry) -> frozenset[FunctionEntry]:
+        """The functions *function* may call directly."""
+        return frozenset(
+            callee
+            for site in self.call_sites
+            if site.caller == function
+            for callee in site.callees
+        )
+
+    def callers_of(self, function: FunctionEntry) -> frozenset[FunctionEntry]:
+        """The functions, and possibly ROOT_FUNCTION, that may call *function*."""
+        return frozenset(
+            site.caller for site in self.call_sites if function in site.callees
+        )
+
+    def reachable_from(
+        self, roots: Iterable[FunctionEntry] = (ROOT_FUNCTION,)
+    ) -> frozenset[FunctionEntry]:
+        """*roots* and every function a chain of calls from them may reach.
+
+        The functions not reachable from ROOT_FUNCTION, or from the entry
+        points a language adds (Java's ``main``), are dead.
+        """
+        reached = set(roots)
+        pending = list(reached)
+        while pending:
+            for callee in self.callees_of(pending.pop()):
+                if callee not in reached:
+                    reached.add(callee)
+                    pending.append(callee)
+        return frozenset(reached)
+
 
 # ---------------------------------------------------------------------------
 # 9. InterproceduralResult — the complete analysis output
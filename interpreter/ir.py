@@ -78,13 +78,37 @@ VAR_DEFINITION_OPCODES: frozenset[Opcode] = frozenset(
 )
 
 
+@dataclass(frozen=True)
+class SourcePosition:
+    """One point in source: 1-based line, 0-based column and byte offset."""
+
+    line: int
+    col: int
+    byte: int
+
+
 class SourceLocation(BaseModel):
-    """Structured source span from tree-sitter AST nodes."""
+    """Structured source span from tree-sitter AST nodes.
+
+    Lines are 1-based; columns and byte offsets are 0-based, and the end is
+    exclusive, as in tree-sitter.  Byte offsets default to 0 for locations
+    built without a source buffer (e.g. by the LLM frontends).
+    """
 
     start_line: int
     start_col: int
     end_line: int
     end_col: int
+    start_byte: int = 0
+    end_byte: int = 0
+
+    def pos(self) -> SourcePosition:
+        """Where the span starts."""
+        return SourcePosition(self.start_line, self.start_col, self.start_byte)
+
+    def end(self) -> SourcePosition:
+        """Just past where the span ends."""
+        return SourcePosition(self.end_line, self.end_col, self.end_byte)
 
     def is_unknown(self) -> bool:
         return (
@@ -0,0 +1,106 @@
+# pyright: standard
+"""IR verifier — checks the invariants every frontend's output must satisfy.
+
+The instruction list a frontend returns from ``lower()`` is the one
+language-neutral representation the rest of the pipeline sees: the CFG
+builder, registry, type inference, dataflow and the VM never inspect source
+syntax.  Those stages assume the structural invariants below without
+checking them (``build_cfg`` silently drops an edge to an undefined label,
+and a duplicated label replaces the earlier block), so a frontend bug
+surfaces far from its cause.  ``verify_ir`` states the invariants in one
+place and reports every violation with the offending instruction:
+
+- every ``LABEL`` defines a distinct label;
+- every label a ``BRANCH``, ``BRANCH_IF`` or ``TRY_PUSH`` targets is defined;
+- every register an instruction reads is written by some instruction.
+"""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from dataclasses import dataclass
+from enum import StrEnum
+
+from interpreter.instructions import Branch, BranchIf, InstructionBase, Label_, TryPush
+from interpreter.ir import CodeLabel
+from interpreter.register import Register
+
+
+class IRViolationKind(StrEnum):
+    DUPLICATE_LABEL = "duplicate label"
+    UNDEFINED_LABEL = "undefined label"
+    UNDEFINED_REGISTER = "undefined register"
+
+
+@dataclass(frozen=True)
+class IRViolation:
+    """One broken invariant, at instruction *index* of the verified list."""
+
+    kind: IRViolationKind
+    index: int
+    instruction: InstructionBase
+    subject: str
+
+    def __str__(self) -> str:
+        return f"{self.index}: {self.kind} {self.subject} in `{self.instruction}`"
+
+
+class IRVerificationError(Exception):
+    """Raised by ``check_ir`` when the IR breaks an invariant."""
+
+    def __init__(self, violations: Sequence[IRViolation]):
+        self.violations = tuple(violations)
+        super().__init__(
+            "IR verification failed:\n" + "\n".join(str(v) for v in self.violations)
+        )
+
+
+def _branch_targets(inst: InstructionBase) -> tuple[CodeLabel, ...]:
+    """Labels control may transfer to from *inst*."""
+    if isinstance(inst, Branch):
+        return (inst.label,)
+    if isinstance(inst, BranchIf):
+        return inst.branch_targets
+    if isinstance(inst, TryPush):
+        return (*inst.catch_labels, inst.finally_label, inst.end_label)
+    return ()
+
+
+def verify_ir(instructions: Sequence[InstructionBase]) -> tuple[IRViolation, ...]:
+    """Every invariant violation in *instructions*, in instruction order."""
+    defined_labels: set[CodeLabel] = set()
+    violations: list[IRViolation] = []
+    for index, inst in enumerate(instructions):
+        if isinstance(inst, Label_):
+            if inst.label in defined_labels:
+                violations.append(
+                    IRViolation(
+                        IRViolationKind.DUPLICATE_LABEL, index, inst, str(inst.label)
+                    )
+                )
+            defined_labels.add(inst.label)
+
+    written = {
+        storage
+        for storage in (inst.writes() for inst in instructions)
+        if isinstance(storage, Register)
+    }
+    for index, inst in enumerate(instructions):
+        violations.extend(
+            IRViolation(IRViolationKind.UNDEFINED_LABEL, index, inst, str(target))
+            for target in _branch_targets(inst)
+            if target.is_present() and target not in defined_labels
+        )
+        violations.extend(
+            IRViolation(IRViolationKind.UNDEFINED_REGISTER, index, inst, str(reg))
+            for reg in inst.reads()
+            if isinstance(reg, Register) and reg.is_present() and reg not in written
+        )
+    return tuple(sorted(violations, key=lambda v: v.index))
+
+
+def check_ir(instructions: Sequence[InstructionBase]) -> None:
+    """Raise ``IRVerificationError`` if *instructions* break any invariant."""
+    violations = verify_ir(instructions)
+    if violations:
+        raise IRVerificationError(violations)
@@ -0,0 +1,59 @@
+# pyright: standard
+"""Liveness — the variables whose current value may still be read.
+
+A variable is live at a point when some path from there reads it before
+assigning it again::
+
+    x = 1           # x is dead here: the next statement overwrites it
+    x = 2           # x is live here
+    print(x)
+
+The analysis runs backward over the CFG.  ``LOAD_VAR`` and ``ADDRESS_OF``
+(through which a callee may read the variable) make a variable live;
+``STORE_VAR`` and ``DECL_VAR`` end its liveness.  ``LIVENESS`` is the
+problem ``solve_dataflow`` iterates.
+"""
+
+from __future__ import annotations
+
+from interpreter.cfg import CFG
+from interpreter.dataflow_solver import solve_dataflow
+from interpreter.dataflow_solver_types import DataflowProblem, Direction
+from interpreter.instructions import (
+    AddressOf,
+    DeclVar,
+    InstructionBase,
+    LoadVar,
+    StoreVar,
+)
+from interpreter.ir import CodeLabel
+
+
+def live_before(inst: InstructionBase, live: frozenset[str]) -> frozenset[str]:
+    """The variables live just before *inst*, given those live after it."""
+    if isinstance(inst, (StoreVar, DeclVar)):
+        return live - {str(inst.name)}
+    if isinstance(inst, LoadVar):
+        return live | {str(inst.name)}
+    if isinstance(inst, AddressOf):
+        return live | {str(inst.var_name)}
+    return live
+
+
+LIVENESS: DataflowProblem[frozenset[str]] = DataflowProblem(
+    direction=Direction.BACKWARD,
+    transfer=live_before,
+    merge=frozenset.union,
+    initial=frozenset(),
+    boundary=frozenset(),
+)
+
+
+def live_in(cfg: CFG) -> dict[CodeLabel, frozenset[str]]:
+    """The variables live at the start of each block of *cfg*."""
+    return solve_dataflow(cfg, LIVENESS).at_entry
+
+
+def live_out(cfg: CFG) -> dict[CodeLabel, frozenset[str]]:
+    """The variables live at the end of each block of *cfg*."""
+    return solve_dataflow(cfg, LIVENESS).at_exit
@@ -0,0 +1,41 @@
+# pyright: standard
+"""MissingReturn — a function with a result that can end without returning."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, Severity
+from interpreter.ir import CodeLabel, SourceLocation
+
+
+@dataclass(frozen=True)
+class MissingReturn:
+    """*function*, declared at *location*, can reach the end of its body.
+
+    *path* is the blocks control passes through after the function's entry
+    block to get there, e.g. ``(if_false_2, if_end_3)``; it is empty when
+    the entry block itself falls off the end.
+    """
+
+    location: SourceLocation
+    function: str
+    path: tuple[CodeLabel, ...] = ()
+
+    @property
+    def message(self) -> str:
+        message = f"missing return statement in {self.function}"
+        if not self.path:
+            return message
+        return f"{message} (via {' -> '.join(str(label) for label in self.path)})"
+
+    def as_diagnostic(self) -> Diagnostic:
+        return Diagnostic(
+            code=DiagnosticCode.MISSING_RETURN,
+            severity=Severity.ERROR,
+            location=self.location,
+            message=self.message,
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: {self.message}"
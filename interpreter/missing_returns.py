@@ -0,0 +1,165 @@
+# pyright: standard
+"""Missing returns — functions with a result that can end without returning.
+
+Every function body is lowered with a synthetic ``RETURN`` at its end
+(``implicit=True``) that returns the language's default, so a function
+declared to return a value that misses a ``return`` on some path quietly
+returns ``None`` and fails later, far from the cause::
+
+    int sign(int n) {
+        if (n > 0) {
+            return 1;
+        } else if (n < 0) {
+            return -1;
+        }
+    }   // missing return statement in sign (via if_false_3 -> if_end_7 -> if_end_4)
+
+This walks the CFG of each function whose declared return type is not
+``void``/``Unit`` and reports it if the implicit return is reachable, with
+the shortest path there.  A path ends at a ``RETURN``, at a ``THROW`` (Go's
+``panic`` lowers to one), or at a call that never returns (Rust's
+``panic!``, Kotlin's ``error``); a loop on a literal ``true`` is only left
+through a ``break``.
+"""
+
+from __future__ import annotations
+
+from collections import deque
+from collections.abc import Mapping
+
+from interpreter import constants
+from interpreter.cfg import CFG, BasicBlock, build_cfg
+from interpreter.constants import FoundationTypeName, Language
+from interpreter.instructions import (
+    BranchIf,
+    CallFunction,
+    Const,
+    InstructionBase,
+    Return_,
+)
+from interpreter.ir import CodeLabel
+from interpreter.missing_return_types import MissingReturn
+from interpreter.types.type_expr import TypeExpr, scalar
+
+# Languages that reject a value-returning function that can fall off its
+# end.  Pascal returns its ``Result`` variable; the rest return a default.
+RETURN_REQUIRED_LANGUAGES: frozenset[Language] = frozenset(
+    {
+        Language.C,
+        Language.CPP,
+        Language.CSHARP,
+        Language.GO,
+        Language.JAVA,
+        Language.KOTLIN,
+        Language.RUST,
+        Language.SCALA,
+        Language.TYPESCRIPT,
+    }
+)
+
+# Calls that never return.
+_DIVERGING = frozenset(
+    {"panic!", "unreachable!", "todo!", "unimplemented!", "TODO", "error"}
+)
+
+# C and C++'s main returns 0 when it falls off the end.
+_EXEMPT = frozenset({"main"})
+
+# ``void``, ``Unit`` and ``never`` all normalize to Any.
+_NO_RESULT = scalar(FoundationTypeName.ANY)
+
+
+def _literal_conditions(instructions: list[InstructionBase]) -> dict[str, bool]:
+    """The registers holding a ``true`` or ``false`` literal."""
+    return {
+        str(inst.result_reg): inst.value
+        for inst in instructions
+        if isinstance(inst, Const) and isinstance(inst.value, bool)
+    }
+
+
+def _successors(block: BasicBlock, conditions: dict[str, bool]) -> list[CodeLabel]:
+    """Where control can go from *block*; a branch on a literal goes one way."""
+    last = block.instructions[-1] if block.instructions else None
+    if (
+        isinstance(last, BranchIf)
+        and str(last.cond_reg) in conditions
+        and len(last.branch_targets) == 2
+    ):
+        return [last.branch_targets[0 if conditions[str(last.cond_reg)] else 1]]
+    return block.successors
+
+
+def _diverges(block: BasicBlock) -> bool:
+    return any(
+        isinstance(inst, CallFunction) and str(inst.func_name) in _DIVERGING
+        for inst in block.instructions
+    )
+
+
+def _falls_off(block: BasicBlock) -> bool:
+    """Does *block* end in the implicit return, with nothing stopping it?"""
+    last = block.instructions[-1] if block.instructions else None
+    return isinstance(last, Return_) and last.implicit and not _diverges(block)
+
+
+def _path_to_end(
+    cfg: CFG, entry: CodeLabel, conditions: dict[str, bool]
+) -> tuple[CodeLabel, ...]:
+    """The shortest path from *entry* to the implicit return, or () if none.
+
+    The path starts with *entry* and ends with the block that falls off.
+    """
+    parents: dict[CodeLabel, CodeLabel] = {entry: entry}
+    queue: deque[CodeLabel] = deque([entry])
+    while queue:
+        label = queue.popleft()
+        block = cfg.blocks[label]
+        if _falls_off(block):
+            path = [label]
+            while path[-1] != entry:
+                path.append(parents[path[-1]])
+            return tuple(reversed(path))
+        if _diverges(block):
+            continue
+        for successor in _successors(block, conditions):
+            if successor not in parents:
+                parents[successor] = label
+                queue.append(successor)
+    return ()
+
+
+def find_missing_returns(
+    instructions: list[InstructionBase],
+    return_types: Mapping[str, TypeExpr],
+    language: Language,
+) -> tuple[MissingReturn, ...]:
+    """The functions in *instructions* that can end without returning a value.
+
+    *return_types* maps each function's entry label to its declared return
+    type, as the frontend seeded it; functions without one are not checked,
+    nor are languages outside ``RETURN_REQUIRED_LANGUAGES``.  Results are in
+    source order.
+    """
+    if language not in RETURN_REQUIRED_LANGUAGES:
+        return ()
+    cfg = build_cfg(instructions)
+    conditions = _literal_conditions(instructions)
+    missing: list[MissingReturn] = []
+    for label, return_type in return_types.items():
+        entry = CodeLabel(label)
+        name = entry.extract_name(constants.FUNC_LABEL_PREFIX)
+        if (
+            entry not in cfg.blocks
+            or not return_type
+            or return_type == _NO_RESULT
+            or name in _EXEMPT
+        ):
+            continue
+        path = _path_to_end(cfg, entry, conditions)
+        if path:
+            end = cfg.blocks[path[-1]].instructions[-1]
+            missing.append(MissingReturn(end.source_location, name, path[1:]))
+    return tuple(
+        sorted(missing, key=lambda m: (m.location.start_line, m.location.start_col))
+    )
@@ -7,6 +7,21 @@ from abc import ABC, abstractmethod
 from typing import Any
 
 from interpreter.constants import Language
+from interpreter.ir import SourceLocation
+from interpreter.stream_source import StreamSource
+
+
+def node_location(node: Any) -> SourceLocation:  # Any: tree-sitter node or Range
+    """Where *node* spans in the source, with 1-based lines."""
+    s, e = node.start_point, node.end_point
+    return SourceLocation(
+        start_line=s[0] + 1,
+        start_col=s[1],
+        end_line=e[0] + 1,
+        end_col=e[1],
+        start_byte=node.start_byte,
+        end_byte=node.end_byte,
+    )
 
 
 class ParserFactory(ABC):
@@ -35,3 +50,12 @@ class Parser:
         parser = self._factory.get_parser(language)
         tree = parser.parse(source.encode("utf-8"))
         return tree
+
+    def parse_stream(self, source: StreamSource, language: Language) -> Any:
+        """Parse UTF-8 source pulled from *source* without holding all of it.
+
+        Nodes of the returned tree carry positions but not text; slice
+        *source* for the text of a node.
+        """
+        parser = self._factory.get_parser(language)
+        return parser.parse(source.read)
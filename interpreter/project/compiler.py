@@ -2,14 +2,20 @@
 """Per-module compiler and directory compilation orchestrator.
 
 compile_module(): file → ModuleUnit
-compile_directory(): directory → LinkedProgram (scan → compile → link)
+compile_directory(): directory → LinkedProgram (scan → compile → link),
+    scanning and compiling the files on a pool of worker processes
 build_export_table(): IR + symbol tables → ExportTable
 """
 
 from __future__ import annotations
 
 import dataclasses
+import os
+from collections.abc import Callable
+from concurrent.futures import ProcessPoolExecutor
+from functools import partial
 from pathlib import Path
+from typing import TYPE_CHECKING, TypeVar
 
 from interpreter import constants
 from interpreter.class_name import ClassName
@@ -41,6 +47,12 @@ from interpreter.refs.func_ref import FuncRef
 from interpreter.register import Register
 from interpreter.var_name import VarName
 
+if TYPE_CHECKING:
+    from interpreter.frontends.go.namespace import GoPreScanResult
+    from interpreter.frontends.java.namespace import JavaPreScanResult
+
+_Result = TypeVar("_Result")
+
 # ── Resolved imports mapping ─────────────────────────────────────
 
 
@@ -209,12 +221,13 @@ def compile_module(
         exports=exports,
         imports=imports,
         symbol_table=frontend.symbol_table,
+        diagnostics=frontend.diagnostics,
     )
 
 
 # ── File extension mapping ───────────────────────────────────────
 
-_LANGUAGE_EXTENSIONS: dict[Language, tuple[str, ...]] = {
+LANGUAGE_EXTENSIONS: dict[Language, tuple[str, ...]] = {
     Language.PYTHON: (".py",),
     Language.JAVASCRIPT: (".js", ".mjs", ".cjs"),
     Language.TYPESCRIPT: (".ts", ".tsx"),
@@ -241,32 +254,81 @@ _COPYBOOK_EXTENSIONS: dict[Language, frozenset[str]] = {
 }
 
 
+def default_max_workers() -> int:
+    """Worker processes for compiling a directory: the CPUs this process may use."""
+    if hasattr(os, "sched_getaffinity"):
+        return len(os.sched_getaffinity(0))
+    return os.cpu_count() or 1
+
+
+def _map_files(
+    work: Callable[[Path], _Result], paths: list[Path], max_workers: int
+) -> dict[Path, _Result]:
+    """*work* applied to each of *paths* in worker processes, keyed in *paths* order.
+
+    Files are independent until linking, so any worker may take any file;
+    the results come back in the order of *paths* whatever order the
+    workers finish in, keeping everything downstream deterministic.
+
+    Lowering is pure Python, so threads would take turns on the GIL, and
+    would share what a frontend assumes it has to itself: module-level
+    caches, and the recursion limit ``recursion_headroom`` raises and
+    restores.  Each worker process has its own.  *work* and its results
+    cross into and out of the workers, so both must pickle — *work* is a
+    module-level function or a ``partial`` of one.  With one worker, or
+    one file, the files are done in turn in this process.
+    """
+    if max_workers <= 1 or len(paths) <= 1:
+        return {path: work(path) for path in paths}
+    with ProcessPoolExecutor(max_workers=min(max_workers, len(paths))) as pool:
+        return dict(zip(paths, pool.map(work, paths)))
+
+
+def _java_pre_scan_file(path: Path) -> JavaPreScanResult:
+    from interpreter.frontends.java.namespace import java_pre_scan
+
+    return java_pre_scan(path.read_bytes())
+
+
+def _go_pre_scan_file(path: Path) -> GoPreScanResult:
+    from interpreter.frontends.go.namespace import go_pre_scan
+
+    return go_pre_scan(path.read_bytes())
+
+
 def compile_directory(
     directory: Path,
     language: Language,
+    max_workers: int = 0,
 ) -> LinkedProgram:
     """Compile all source files in a directory tree.
 
     Compiles every file matching the language's extensions — catches
     orphaned modules, test files, and files not reachable via imports.
+    The files are pre-scanned and compiled concurrently; the result is the
+    same as compiling them one after another.
 
     Args:
         directory: Root directory to scan recursively.
         language: Source language — determines which file extensions to include.
+        max_workers: Files scanned or compiled at once; 0 (the default) for
+            one per CPU (``default_max_workers()``), 1 to compile sequentially.
 
     Returns:
         A LinkedProgram with all files compiled and linked.
 
     Raises:
         FileNotFoundError: If the directory does not exist.
+        GoImportCycleError: If Go packages import each other in a cycle.
     """
     directory = directory.resolve()
     if not directory.is_dir():
         raise FileNotFoundError(f"Directory not found: {directory}")
 
     copybook_dirs = _collect_copybook_dirs(directory)
+    workers = max_workers or default_max_workers()
 
-    extensions = _LANGUAGE_EXTENSIONS.get(language, ())
+    extensions = LANGUAGE_EXTENSIONS.get(language, ())
     copybook_exts = _COPYBOOK_EXTENSIONS.get(language, frozenset())
     source_files = sorted(
         f.resolve()
@@ -283,23 +345,36 @@ def compile_directory(
         from interpreter.frontends.java.namespace import (
             JavaNamespaceResolver,
             build_java_namespace_tree,
-            java_pre_scan,
         )
 
-        scan_results = {path: java_pre_scan(path.read_bytes()) for path in source_files}
+        scan_results = _map_files(_java_pre_scan_file, source_files, workers)
         tree = build_java_namespace_tree(scan_results, STDLIB_REGISTRY)
         namespace_resolver = JavaNamespaceResolver(tree)
 
+    # --- Go packages: pre-scan, group files by package, reject import cycles ---
+    if language == Language.GO:
+        from interpreter.frontends.go.namespace import (
+            GoNamespaceResolver,
+            build_go_packages,
+            check_go_import_cycles,
+        )
+
+        go_scans = _map_files(_go_pre_scan_file, source_files, workers)
+        go_packages = build_go_packages(go_scans)
+        check_go_import_cycles(go_packages)
+        namespace_resolver = GoNamespaceResolver(go_packages)
+
     # --- PASS 1: Compile each module (with namespace resolver if available) ---
-    modules = {
-        path: compile_module(
-            path,
-            language,
+    modules = _map_files(
+        partial(
+            compile_module,
+            language=language,
             namespace_resolver=namespace_resolver,
             copybook_dirs=copybook_dirs,
-        )
-        for path in source_files
-    }
+        ),
+        source_files,
+        workers,
+    )
 
     # --- Java stdlib: inject pre-built IR modules for system imports ---
     stdlib_edges: dict[Path, list[Path]] = {}  # user_path → [stdlib_path, ...]
@@ -381,6 +456,22 @@ def compile_directory(
             for path, deps in import_graph.items()
         }
 
+    # --- Go: the file declaring func main depends on the rest of its package ---
+    # Its hoisted main body runs last, after the package's other files have
+    # declared their functions and variables.
+    if language == Language.GO:
+        for main_path, scan in go_scans.items():
+            if not scan.declares_main:
+                continue
+            import_graph[main_path].extend(
+                path
+                for path, other in go_scans.items()
+                if path != main_path
+                and path.parent == main_path.parent
+                and other.package == scan.package
+                and path not in import_graph[main_path]
+            )
+
     # --- PASS 2: Patch IMPORT_MODULE instructions with resolved paths ---
     resolved_imports_map = _build_resolved_imports_map(
         modules, import_graph, resolver, directory
@@ -0,0 +1,124 @@
+# pyright: standard
+"""Source-language detection for files and directories.
+
+A file's language is decided by its extension.  When no language claims
+the extension, or more than one does (``.h`` is both C and C++), the first
+lines of the file decide instead: a ``#!`` interpreter line, then a
+marker only one language's sources carry (``<?php``, ``package main``,
+``IDENTIFICATION DIVISION``, ...).
+"""
+
+from __future__ import annotations
+
+import re
+from pathlib import Path
+
+from interpreter.constants import Language
+from interpreter.project.compiler import LANGUAGE_EXTENSIONS
+
+# Bytes read from the head of a file for shebang and content heuristics.
+_HEAD_BYTES = 4096
+
+_SHEBANG_INTERPRETERS: dict[str, Language] = {
+    "python": Language.PYTHON,
+    "node": Language.JAVASCRIPT,
+    "deno": Language.TYPESCRIPT,
+    "ts-node": Language.TYPESCRIPT,
+    "ruby": Language.RUBY,
+    "php": Language.PHP,
+    "lua": Language.LUA,
+    "scala": Language.SCALA,
+    "kotlin": Language.KOTLIN,
+}
+
+# Checked in order; a C++ marker is only meaningful once the others fail.
+_CONTENT_MARKERS: tuple[tuple[Language, re.Pattern[str]], ...] = (
+    (Language.PHP, re.compile(r"<\?php")),
+    (Language.COBOL, re.compile(r"(?im)^[\d ]*identification\s+division\s*\.")),
+    (Language.GO, re.compile(r"(?m)^package\s+\w+\s*$")),
+    (Language.PASCAL, re.compile(r"(?im)^\s*program\s+\w+\s*;")),
+    (
+        Language.CPP,
+        re.compile(
+            r"(?m)^\s*(#include\s*<\w+>\s*$|namespace\s+\w+|template\s*<"
+            r"|class\s+\w+\s*[:{])"
+        ),
+    ),
+)
+
+
+class LanguageDetectionError(ValueError):
+    """No supported language could be recognized for a source file."""
+
+    def __init__(self, path: Path):
+        super().__init__(f"Cannot detect the source language of {path}")
+
+
+def _claimants(suffix: str) -> tuple[Language, ...]:
+    """Languages whose sources use *suffix*, in LANGUAGE_EXTENSIONS order."""
+    return tuple(
+        language
+        for language, extensions in LANGUAGE_EXTENSIONS.items()
+        if suffix.lower() in extensions
+    )
+
+
+def _shebang_language(first_line: str) -> tuple[Language, ...]:
+    """The language a ``#!`` line runs, as a 0- or 1-tuple."""
+    if not first_line.startswith("#!"):
+        return ()
+    words = first_line[2:].split()
+    # "#!/usr/bin/env python3" names the interpreter after env.
+    program = (words[1] if words[0].endswith("/env") else words[0]) if words else ""
+    name = Path(program).name.rstrip("0123456789.")
+    return (_SHEBANG_INTERPRETERS[name],) if name in _SHEBANG_INTERPRETERS else ()
+
+
+def _content_language(
+    head: str, candidates: tuple[Language, ...]
+) -> tuple[Language, ...]:
+    """The language of source starting with *head*, among *candidates*."""
+    shebang = [
+        language
+        for language in _shebang_language(head.split("\n", 1)[0])
+        if language in candidates
+    ]
+    markers = [
+        language
+        for language, pattern in _CONTENT_MARKERS
+        if language in candidates and pattern.search(head)
+    ]
+    return tuple(shebang + markers)[:1]
+
+
+def detect_language(path: Path) -> Language:
+    """The source language of the file at *path*.
+
+    Raises ``LanguageDetectionError`` if neither the extension nor the
+    file's contents identify one.
+    """
+    claimants = _claimants(path.suffix)
+    if len(claimants) == 1:
+        return claimants[0]
+    with path.open("rb") as source:
+        head = source.read(_HEAD_BYTES).decode("utf-8", errors="replace")
+    detected = _content_language(head, claimants or tuple(Language))
+    if detected:
+        return detected[0]
+    if claimants:
+        return claimants[0]
+    raise LanguageDetectionError(path)
+
+
+def detect_directory_languages(directory: Path) -> tuple[Language, ...]:
+    """Every language with a source file under *directory*, in Language order.
+
+    Only files with a language's extension count, so READMEs, build files
+    and data files never make a directory ambiguous.
+    """
+    present = {
+        detect_language(path)
+        for path in directory.rglob("*")
+        if path.is_file() and _claimants(path.suffix)
+    }
+    return tuple(language for language in Language if language in present)
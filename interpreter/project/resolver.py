@@ -241,23 +241,44 @@ class JavaImportResolver(ImportResolver):
 
 
 class GoImportResolver(ImportResolver):
-    """Resolve Go imports to local packages."""
+    """Resolve Go imports to the files of local packages.
+
+    A package is a directory, so an import resolves to every ``.go`` file
+    in it.  Relative paths (``./utils``) start at the importing file's
+    directory; paths under the module that the project's ``go.mod``
+    declares (``example.com/app/utils``) start at the project root.
+    """
 
     def resolve(self, ref: ImportRef, project_root: Path) -> list[ResolvedImport]:
         if ref.is_system:
             return [ResolvedImport(ref=ref, is_external=True)]
         if ref.is_relative:
-            base = ref.source_file.parent
-            target = (base / ref.module_path).resolve()
-            # Go packages are directories — look for .go files in them
-            if target.is_dir():
-                go_files = list(target.glob("*.go"))
-                if go_files:
-                    return [ResolvedImport(ref=ref, resolved_path=go_files[0])]
+            target = (ref.source_file.parent / ref.module_path).resolve()
         else:
-            # External package (github.com/...) — skip
-            return [ResolvedImport(ref=ref, is_external=True)]
-        return [ResolvedImport(ref=ref)]
+            module = _go_module_path(project_root)
+            if not module or not ref.module_path.startswith(f"{module}/"):
+                # External package (github.com/...) — skip
+                return [ResolvedImport(ref=ref, is_external=True)]
+            target = project_root / ref.module_path.removeprefix(f"{module}/")
+        go_files = sorted(target.glob("*.go")) if target.is_dir() else []
+        if not go_files:
+            return [ResolvedImport(ref=ref)]
+        return [ResolvedImport(ref=ref, resolved_path=f) for f in go_files]
+
+
+def _go_module_path(project_root: Path) -> str:
+    """Module path declared by ``project_root/go.mod``, or "" without one."""
+    go_mod = project_root / "go.mod"
+    if not go_mod.is_file():
+        return ""
+    return next(
+        (
+            line.split()[1]
+            for line in go_mod.read_text().splitlines()
+            if line.startswith("module ") and len(line.split()) > 1
+        ),
+        "",
+    )
 
 
 # ── Rust resolver ────────────────────────────────────────────────
@@ -28,6 +28,7 @@ from interpreter.refs.class_ref import ClassRef
 from interpreter.refs.func_ref import FuncRef
 from interpreter.register import Register
 from interpreter.registry import FunctionRegistry
+from interpreter.syntax_diagnostic import SyntaxDiagnostic
 from interpreter.types.type_environment_builder import TypeEnvironmentBuilder
 from interpreter.var_name import VarName
 
@@ -98,6 +99,7 @@ class ModuleUnit:
     exports: ExportTable
     imports: tuple[ImportRef, ...]
     symbol_table: SymbolTable = field(default_factory=SymbolTable.empty)
+    diagnostics: tuple[SyntaxDiagnostic, ...] = ()
 
 
 NO_MODULE_UNIT = ModuleUnit(
@@ -155,3 +157,16 @@ class LinkedProgram:
         Default predicate returns all functions.
         """
         return [ref for ref in self.func_symbol_table.values() if predicate(ref)]
+
+    @property
+    def diagnostics(self) -> tuple[tuple[Path, SyntaxDiagnostic], ...]:
+        """Every module's syntax diagnostics, ordered by path, then position.
+
+        The order depends only on the files, not on the order in which
+        they happened to be compiled.
+        """
+        return tuple(
+            (path, diagnostic)
+            for path, module in sorted(self.modules.items())
+            for diagnostic in module.diagnostics
+        )
@@ -0,0 +1,310 @@
+# pyright: standard
+"""Purity — functions that only compute a result from their arguments.
+
+A pure function can be folded when its arguments are constants, memoized,
+or required by an exercise::
+
+    def area(w, h):             # area is pure
+        return w * h
+
+    def report(w, h):           # report is impure: it performs I/O (print)
+        print(area(w, h))
+
+A function is impure when its body has an effect, or it calls an impure
+function.  The effects are:
+
+- I/O, nondeterminism (random numbers, the clock) and Go's goroutines and
+  channels, found by the name of the library function or method called;
+- reading or assigning a variable declared outside the function — a
+  global, or a variable a closure captures — which the frontend's scopes
+  tell apart from the function's own;
+- storing into an object, array or pointer the function did not allocate,
+  directly or through a library method that mutates its receiver
+  (``xs.append(x)``); storing into ``this`` is told apart, so a
+  constructor, whose caller owns the new object, stays pure to its callers;
+- calling a function value the call graph cannot resolve.
+
+Library calls are judged by name only, so a library function not known to
+perform I/O or be nondeterministic counts as pure.
+"""
+
+from __future__ import annotations
+
+from interpreter.cfg import build_cfg, function_regions
+from interpreter.dead_stores import accessed_variable
+from interpreter.frontends.scopes import (
+    NO_SYMBOL,
+    Scope,
+    ScopeKind,
+    Symbol,
+    SymbolKind,
+)
+from interpreter.function_metrics import _functions
+from interpreter.instructions import (
+    AddressOf,
+    CallCtorFunction,
+    CallFunction,
+    CallMethod,
+    CallUnknown,
+    DeclVar,
+    InstructionBase,
+    LoadField,
+    LoadVar,
+    NewArray,
+    NewObject,
+    StoreField,
+    StoreIndex,
+    StoreIndirect,
+    StoreVar,
+)
+from interpreter.interprocedural.types import (
+    CallGraph,
+    FunctionEntry,
+    InstructionLocation,
+)
+from interpreter.ir import SourceLocation
+from interpreter.purity_types import Effect, Impurity, Purity
+from interpreter.register import Register
+
+_RECEIVERS = frozenset({"this", "self", "$this"})
+_VARIABLES = frozenset({SymbolKind.VARIABLE, SymbolKind.PARAMETER})
+
+# Library functions and methods, by the last part of their name.
+_IO_FUNCTIONS = frozenset(
+    {"print", "println", "printf", "puts", "input", "open", "exit", "__go_exit__"}
+    | {"Print", "Println", "Printf", "Scan", "Scanln", "Scanf", "write", "read"}
+)
+_IO_METHODS = frozenset(
+    {"print", "println", "printf", "log", "write", "writeln", "flush"}
+    | {"readLine", "readline", "nextLine", "nextInt", "nextDouble"}
+    | {"Write", "WriteLine", "ReadLine"}
+)
+_NONDETERMINISTIC = frozenset(
+    {"random", "rand", "randint", "randrange", "choice", "shuffle", "uuid4"}
+    | {"Intn", "Float64", "Now", "time", "nanoTime", "currentTimeMillis"}
+)
+_CONCURRENCY = frozenset(
+    {
+        "__go_spawn__",
+        "__go_chan_send__",
+        "__go_chan_recv__",
+        "__go_chan_recv_ok__",
+        "__go_chan_close__",
+        "__go_select__",
+    }
+)
+# Library functions that mutate their first argument, and methods that
+# mutate their receiver.
+_MUTATING_FUNCTIONS = frozenset({"list_append", "__go_map_delete__"})
+_MUTATING_METHODS = frozenset(
+    {"append", "add", "addAll", "push", "pop", "remove", "insert", "put", "set"}
+    | {"clear", "sort", "reverse", "extend", "update", "delete", "setdefault"}
+    | {"shift", "unshift", "splice", "discard", "removeAt"}
+)
+
+_FRESH, _OWN, _OTHER = "fresh", "own", "other"
+
+
+def _nonlocal(scopes: Scope, location: SourceLocation, name: str) -> Symbol:
+    """The declaration of variable *name* if it is outside the function at
+    *location*; NO_SYMBOL if the function declares it, or it is no variable."""
+    outside = False
+    for scope in scopes.scopes_at(location.pos()):
+        for symbol in scope.symbols:
+            if symbol.resolved_name == name:
+                return symbol if outside and symbol.kind in _VARIABLES else NO_SYMBOL
+        outside = outside or scope.kind == ScopeKind.FUNCTION
+    return NO_SYMBOL
+
+
+class _Registers:
+    """What each register holds, as far as purity cares.
+
+    A register is fresh when it holds memory its function allocated: a new
+    object or array, or a local variable only ever assigned fresh memory.
+    """
+
+    def __init__(self, placed: list[tuple[str, InstructionBase]]):
+        self.allocated: set[Register] = set()
+        self.loaded: dict[Register, str] = {}
+        self.loaded_from: dict[Register, tuple[str, str]] = {}
+        stores: dict[tuple[str, str], list[Register]] = {}
+        for function, inst in placed:
+            if isinstance(inst, (NewObject, NewArray, CallCtorFunction)):
+                self.allocated.add(inst.result_reg)
+            elif isinstance(inst, LoadVar):
+                self.loaded[inst.result_reg] = str(inst.name)
+                self.loaded_from[inst.result_reg] = (function, str(inst.name))
+            elif isinstance(inst, LoadField) and inst.obj_reg in self.loaded:
+                field = f"{self.loaded[inst.obj_reg]}.{inst.field_name}"
+                self.loaded[inst.result_reg] = field
+            elif isinstance(inst, (DeclVar, StoreVar)):
+                key = (function, str(inst.name))
+                stores.setdefault(key, []).append(inst.value_reg)
+        self.fresh_vars = set(stores)
+        while True:
+            fresh = {
+                key
+                for key in self.fresh_vars
+                if all(self.is_fresh(reg) for reg in stores[key])
+            }
+            if fresh == self.fresh_vars:
+                break
+            self.fresh_vars = fresh
+
+    def is_fresh(self, reg: Register) -> bool:
+        return reg in self.allocated or self.loaded_from.get(reg) in self.fresh_vars
+
+    def owner(self, reg: Register) -> str:
+        """Whose memory *reg* points at: fresh, the receiver's, or another's."""
+        if self.is_fresh(reg):
+            return _FRESH
+        if self.loaded.get(reg) in _RECEIVERS:
+            return _OWN
+        return _OTHER
+
+    def store_effect(self, location: SourceLocation, reg: Register) -> list[Effect]:
+        owner = self.owner(reg)
+        if owner == _FRESH:
+            return []
+        impurity = Impurity.RECEIVER if owner == _OWN else Impurity.MUTATION
+        return [Effect(location, impurity, self.loaded.get(reg, ""))]
+
+
+def _library_effects(inst: InstructionBase, registers: _Registers) -> list[Effect]:
+    """The effects of a call no function of the program answers."""
+    location = inst.source_location
+    if isinstance(inst, CallUnknown):
+        return [Effect(location, Impurity.UNKNOWN_CALL)]
+    if isinstance(inst, CallFunction):
+        name = str(inst.func_name)
+        io, mutating, target = _IO_FUNCTIONS, _MUTATING_FUNCTIONS, inst.args[:1]
+    elif isinstance(inst, CallMethod):
+        name = str(inst.method_name)
+        io, mutating, target = _IO_METHODS, _MUTATING_METHODS, (inst.obj_reg,)
+    else:
+        return []
+    short = name.rsplit(".", 1)[-1]
+    if name in _CONCURRENCY:
+        return [Effect(location, Impurity.CONCURRENCY, name)]
+    if short in io:
+        return [Effect(location, Impurity.IO, name)]
+    if short in _NONDETERMINISTIC:
+        return [Effect(location, Impurity.NONDETERMINISM, name)]
+    if short in mutating and target and isinstance(target[0], Register):
+        return registers.store_effect(location, target[0])
+    return []
+
+
+def _call_owner(inst: InstructionBase, registers: _Registers) -> str:
+    """Whose object a call lets the callee mutate as its receiver.
+
+    A call with no receiver that reaches a function mutating its receiver
+    is a constructor call, and the new object is fresh.
+    """
+    if isinstance(inst, CallMethod):
+        return registers.owner(inst.obj_reg)
+    return _FRESH
+
+
+def find_purity(
+    instructions: list[InstructionBase], call_graph: CallGraph, scopes: Scope
+) -> tuple[Purity, ...]:
+    """Whether each function in *instructions* is pure, in source order.
+
+    *call_graph* was built from *instructions*, and *scopes* are those the
+    frontend built while lowering them.
+    """
+    cfg = build_cfg(instructions)
+    region = function_regions(cfg, instructions)
+    callees = {site.location: site.callees for site in call_graph.call_sites}
+    placed = [
+        (region.get(label, ""), inst)
+        for label, block in cfg.blocks.items()
+        for inst in block.instructions
+    ]
+    registers = _Registers(placed)
+
+    effects: dict[str, list[Effect]] = {}
+    calls: list[tuple[str, InstructionBase, frozenset[FunctionEntry], str]] = []
+    for label, block in cfg.blocks.items():
+        function = region.get(label, "")
+        if not function:
+            continue
+        found = effects.setdefault(function, [])
+        for index, inst in enumerate(block.instructions):
+            location = inst.source_location
+            if isinstance(inst, (LoadVar, StoreVar, AddressOf)):
+                symbol = (
+                    NO_SYMBOL
+                    if location.is_unknown()
+                    else _nonlocal(scopes, location, accessed_variable(inst))
+                )
+                if symbol.is_present():
+                    found.append(Effect(location, Impurity.NONLOCAL, symbol.name))
+            elif isinstance(inst, StoreField):
+                found.extend(registers.store_effect(location, inst.obj_reg))
+            elif isinstance(inst, StoreIndex):
+                found.extend(registers.store_effect(location, inst.arr_reg))
+            elif isinstance(inst, StoreIndirect):
+                found.extend(registers.store_effect(location, inst.ptr_reg))
+            elif isinstance(
+                inst, (CallFunction, CallMethod, CallUnknown, CallCtorFunction)
+            ):
+                reached = callees.get(InstructionLocation(label, index), frozenset())
+                if reached:
+                    owner = _call_owner(inst, registers)
+                    calls.append((function, inst, reached, owner))
+                else:
+                    found.extend(_library_effects(inst, registers))
+
+    # A function only mutating its receiver is pure to a caller that owns
+    # the receiver, and mutates its caller's own receiver otherwise.
+    impure = {f for f, found in effects.items() if found}
+    strong = {
+        f
+        for f, found in effects.items()
+        if any(e.impurity != Impurity.RECEIVER for e in found)
+    }
+    changed = True
+    while changed:
+        changed = False
+        for function, _, reached, owner in calls:
+            for callee in reached:
+                label = str(callee.label)
+                if label in strong or (label in impure and owner == _OTHER):
+                    changed |= function not in strong
+                    strong.add(function)
+                    impure.add(function)
+                elif label in impure and owner == _OWN:
+                    changed |= function not in impure
+                    impure.add(function)
+
+    declared = _functions(instructions)
+    names = {str(label): name for name, label, _ in declared}
+    for function, inst, reached, owner in calls:
+        for callee in reached:
+            label = str(callee.label)
+            name = names.get(label, label)
+            if label in strong:
+                impurity = Impurity.IMPURE_CALL
+            elif label in impure and owner != _FRESH:
+                impurity = Impurity.RECEIVER if owner == _OWN else Impurity.MUTATION
+            else:
+                continue
+            effects[function].append(Effect(inst.source_location, impurity, name))
+
+    def unique(found: list[Effect]) -> tuple[Effect, ...]:
+        first: dict[tuple[Impurity, str], Effect] = {}
+        for effect in sorted(
+            found, key=lambda e: (e.location.start_line, e.location.start_col)
+        ):
+            first.setdefault((effect.impurity, effect.detail), effect)
+        return tuple(first.values())
+
+    return tuple(
+        Purity(location, name, unique(effects.get(str(label), [])))
+        for name, label, location in sorted(
+            declared, key=lambda d: (d[2].start_line, d[2].start_col)
+        )
+    )
@@ -0,0 +1,83 @@
+# pyright: standard
+"""Purity — whether a function only computes its result from its arguments."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from enum import Enum
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, SecondarySpan, Severity
+from interpreter.ir import SourceLocation
+
+
+class Impurity(str, Enum):
+    """What an effect that makes a function impure does."""
+
+    IO = "performs I/O"
+    NONDETERMINISM = "is nondeterministic"
+    CONCURRENCY = "communicates with other goroutines"
+    NONLOCAL = "uses a variable it does not own"
+    MUTATION = "mutates memory it did not allocate"
+    RECEIVER = "mutates its receiver"
+    UNKNOWN_CALL = "calls a function value it cannot resolve"
+    IMPURE_CALL = "calls an impure function"
+
+
+@dataclass(frozen=True)
+class Effect:
+    """One thing at *location* that makes a function impure.
+
+    *detail* names what it touches or calls, e.g. ``print`` or ``counter``.
+    """
+
+    location: SourceLocation
+    impurity: Impurity
+    detail: str = ""
+
+    def __str__(self) -> str:
+        if not self.detail:
+            return self.impurity.value
+        return f"{self.impurity.value} ({self.detail})"
+
+
+@dataclass(frozen=True)
+class Purity:
+    """Whether *function*, declared at *location*, is pure.
+
+    A function is pure when it has no *effects*: it performs no I/O, reads
+    or writes no variable outside it, is deterministic, mutates nothing it
+    did not allocate, and calls only pure functions.  A call of a pure
+    function can be folded or memoized.
+    """
+
+    location: SourceLocation
+    function: str
+    effects: tuple[Effect, ...] = ()
+
+    @property
+    def is_pure(self) -> bool:
+        return not self.effects
+
+    @property
+    def message(self) -> str:
+        if self.is_pure:
+            return f"{self.function} is pure"
+        more = len(self.effects) - 1
+        also = f" (and {more} more)" if more else ""
+        return f"{self.function} is impure: it {self.effects[0]}{also}"
+
+    def as_diagnostic(self) -> Diagnostic:
+        """The error for a function an exercise requires to be pure."""
+        return Diagnostic(
+            code=DiagnosticCode.IMPURE_FUNCTION,
+            severity=Severity.ERROR,
+            location=self.location,
+            message=f"{self.function} must be pure",
+            secondary=tuple(
+                SecondarySpan(effect.location, f"{self.function} {effect}")
+                for effect in self.effects
+            ),
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: {self.message}"
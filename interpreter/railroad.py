@@ -0,0 +1,195 @@
+# pyright: standard
+"""Railroad diagrams — a grammar production drawn as SVG.
+
+Each production becomes one self-contained SVG: the rule name, then a
+track running left to right through its body.  A terminal is a rounded
+box holding the quoted node kind, a reference to another production a
+square box (a link to ``<name>.svg``, so a directory of diagrams can be
+browsed), a sequence its items one after another along the track, a
+choice a fan of branches stacked below the first, an optional part a
+choice between it and a bare track, and a repetition a track that either
+skips its item or loops back through it.
+
+Layout is computed bottom-up: every element knows its width and how far
+it reaches above and below the track, and draws itself with its left end
+at a given point on the track.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from html import escape
+
+from interpreter.grammar_export import expr_to_ebnf
+from interpreter.grammar_types import (
+    Choice,
+    GrammarExpr,
+    NonTerminal,
+    Option,
+    Production,
+    Repeat,
+    Sequence,
+    Terminal,
+)
+
+_CHAR_WIDTH = 7  # monospace 12px
+_BOX_HEIGHT = 22
+_BOX_PADDING = 10
+_ARC = 10  # radius of every bend
+_GAP = 8  # between stacked branches
+_MARGIN = 20
+_TITLE_HEIGHT = 24
+_STUB = 10
+
+_STYLE = (
+    "path{fill:none;stroke:#333;stroke-width:1.5}"
+    "rect{fill:#f5f5dc;stroke:#333;stroke-width:1.5}"
+    "text{font:12px monospace;text-anchor:middle;dominant-baseline:central}"
+    "text.title{font-weight:bold;text-anchor:start}"
+)
+
+
+@dataclass(frozen=True)
+class _Box:
+    """Extent of a drawn element relative to the left end of its track."""
+
+    width: int
+    up: int
+    down: int
+
+
+def _skippable(option: Option) -> Choice:
+    """*option* as the choice it is drawn as: its item, or a bare track."""
+    return Choice((option.item, Sequence(())))
+
+
+def _extent(expr: GrammarExpr) -> _Box:
+    if isinstance(expr, (Terminal, NonTerminal)):
+        label = expr_to_ebnf(expr)
+        half = _BOX_HEIGHT // 2
+        return _Box(len(label) * _CHAR_WIDTH + 2 * _BOX_PADDING, half, half)
+    if isinstance(expr, Option):
+        return _extent(_skippable(expr))
+    if isinstance(expr, Sequence):
+        if not expr.items:
+            return _Box(2 * _ARC, 0, 0)
+        boxes = [_extent(item) for item in expr.items]
+        return _Box(
+            sum(box.width for box in boxes) + _STUB * (len(boxes) - 1),
+            max(box.up for box in boxes),
+            max(box.down for box in boxes),
+        )
+    if isinstance(expr, Repeat):
+        item = _extent(expr.item)
+        return _Box(item.width + 4 * _ARC, 0, 3 * _ARC + item.up + item.down)
+    boxes = [_extent(alt) for alt in expr.alternatives]
+    width = max(box.width for box in boxes) + 4 * _ARC
+    return _Box(width, boxes[0].up, _branch_rows(expr, 0)[-1] + boxes[-1].down)
+
+
+def _branch_rows(choice: Choice, y: int) -> list[int]:
+    """The track height of each alternative of *choice* on a track at *y*.
+
+    Branches are at least two bends apart, so each has room to turn.
+    """
+    boxes = [_extent(alt) for alt in choice.alternatives]
+    rows = [y]
+    for above, below in zip(boxes, boxes[1:]):
+        rows.append(rows[-1] + max(above.down + _GAP + below.up, 2 * _ARC))
+    return rows
+
+
+def _draw(expr: GrammarExpr, x: int, y: int) -> list[str]:
+    """SVG elements for *expr* with the left end of its track at (*x*, *y*)."""
+    box = _extent(expr)
+    if isinstance(expr, (Terminal, NonTerminal)):
+        top = y - box.up
+        corner = _BOX_HEIGHT // 2 if isinstance(expr, Terminal) else 0
+        shape = (
+            f'<rect x="{x}" y="{top}" width="{box.width}" height="{_BOX_HEIGHT}" '
+            f'rx="{corner}"/>'
+            f'<text x="{x + box.width // 2}" y="{y}">'
+            f"{escape(expr_to_ebnf(expr))}</text>"
+        )
+        if isinstance(expr, NonTerminal):
+            shape = f'<a href="{escape(expr.name)}.svg">{shape}</a>'
+        return [shape]
+    right = x + box.width
+    if isinstance(expr, Option):
+        return _draw(_skippable(expr), x, y)
+    if isinstance(expr, Sequence):
+        if not expr.items:
+            return [f'<path d="M{x} {y}H{right}"/>']
+        drawn: list[str] = []
+        at = x
+        for item in expr.items:
+            if drawn:
+                drawn.append(f'<path d="M{at} {y}h{_STUB}"/>')
+                at += _STUB
+            drawn += _draw(item, at, y)
+            at += _extent(item).width
+        return drawn
+    if isinstance(expr, Repeat):
+        item = _extent(expr.item)
+        row = y + 2 * _ARC + item.up
+        bottom = row + item.down + _ARC
+        return [
+            f'<path d="M{x} {y}H{right}"/>',
+            _down_branch(x, y, row),
+            _up_branch(right, y, row),
+            f'<path d="M{right - 2 * _ARC} {row}'
+            f"q{_ARC} 0 {_ARC} {_ARC}V{bottom - _ARC}q0 {_ARC} {-_ARC} {_ARC}"
+            f"H{x + 2 * _ARC}q{-_ARC} 0 {-_ARC} {-_ARC}V{row + _ARC}"
+            f'q0 {-_ARC} {_ARC} {-_ARC}"/>',
+        ] + _draw(expr.item, x + 2 * _ARC, row)
+    elements: list[str] = []
+    for alt, row in zip(expr.alternatives, _branch_rows(expr, y)):
+        end = x + 2 * _ARC + _extent(alt).width
+        if row == y:
+            elements.append(f'<path d="M{x} {y}h{2 * _ARC}"/>')
+        else:
+            elements += [_down_branch(x, y, row), _up_branch(right, y, row)]
+        elements.append(f'<path d="M{end} {row}H{right - 2 * _ARC}"/>')
+        if row == y:
+            elements.append(f'<path d="M{right - 2 * _ARC} {y}H{right}"/>')
+        elements += _draw(alt, x + 2 * _ARC, row)
+    return elements
+
+
+def _down_branch(x: int, y: int, row: int) -> str:
+    """From the track at (*x*, *y*) down to the start of a branch at *row*."""
+    return (
+        f'<path d="M{x} {y}q{_ARC} 0 {_ARC} {_ARC}V{row - _ARC}'
+        f'q0 {_ARC} {_ARC} {_ARC}"/>'
+    )
+
+
+def _up_branch(right: int, y: int, row: int) -> str:
+    """From the end of a branch at *row* up to the track at (*right*, *y*)."""
+    return (
+        f'<path d="M{right - 2 * _ARC} {row}q{_ARC} 0 {_ARC} {-_ARC}'
+        f'V{y + _ARC}q0 {-_ARC} {_ARC} {-_ARC}"/>'
+    )
+
+
+def railroad_svg(production: Production) -> str:
+    """*production* as a standalone SVG railroad diagram."""
+    body = _extent(production.body)
+    width = body.width + 2 * _STUB + 2 * _MARGIN
+    y = _MARGIN + _TITLE_HEIGHT + max(body.up, _STUB)
+    height = y + max(body.down, _STUB) + _MARGIN
+    start, end = _MARGIN, _MARGIN + _STUB + body.width
+    elements = [
+        f"<style>{_STYLE}</style>",
+        f'<text class="title" x="{_MARGIN}" y="{_MARGIN + _TITLE_HEIGHT // 2}">'
+        f"{escape(production.name)}</text>",
+        f'<path d="M{start} {y - _STUB}v{2 * _STUB}M{start} {y}h{_STUB}"/>',
+        f'<path d="M{end} {y}h{_STUB}m0 {-_STUB}v{2 * _STUB}"/>',
+        *_draw(production.body, start + _STUB, y),
+    ]
+    return (
+        f'<svg xmlns="http://www.w3.org/2000/svg" width="{width}" '
+        f'height="{height}" viewBox="0 0 {width} {height}">\n'
+        + "\n".join(elements)
+        + "\n</svg>\n"
+    )
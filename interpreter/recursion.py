@@ -0,0 +1,59 @@
+# pyright: standard
+"""Recursion — functions that call themselves, directly or mutually.
+
+Teaching dialects often rule recursion out::
+
+    def even(n):                # even is mutually recursive with odd
+        return n == 0 or odd(n - 1)
+
+    def odd(n):                 # odd is mutually recursive with even
+        return n != 0 and even(n - 1)
+
+A function is recursive when it lies on a cycle of the call graph: a
+strongly connected component of more than one function, or a function with
+a call to itself.  The call graph is an over-approximation (a method call
+may reach every class's method of that name, a call of a function value
+every function stored where it came from), so a function is reported when
+it *may* call itself.
+"""
+
+from __future__ import annotations
+
+from interpreter.function_metrics import _functions
+from interpreter.instructions import InstructionBase
+from interpreter.interprocedural.propagation import compute_sccs
+from interpreter.interprocedural.types import CallGraph
+from interpreter.recursion_types import Recursion
+
+
+def find_recursion(
+    instructions: list[InstructionBase], call_graph: CallGraph
+) -> tuple[Recursion, ...]:
+    """Every recursive function of *call_graph*, in source order.
+
+    *instructions* are those the call graph was built from; they give each
+    function its name and location.
+    """
+    declared = {
+        label: (name, location) for name, label, location in _functions(instructions)
+    }
+    recursions: list[Recursion] = []
+    for scc in compute_sccs(call_graph):
+        (first, *rest) = scc
+        if not rest and first not in call_graph.callees_of(first):
+            continue
+        cycle = sorted(
+            (declared[f.label] for f in scc if f.label in declared),
+            key=lambda d: (d[1].start_line, d[1].start_col),
+        )
+        recursions.extend(
+            Recursion(
+                location=location,
+                function=name,
+                others=tuple(other for j, (other, _) in enumerate(cycle) if j != i),
+            )
+            for i, (name, location) in enumerate(cycle)
+        )
+    return tuple(
+        sorted(recursions, key=lambda r: (r.location.start_line, r.location.start_col))
+    )
@@ -0,0 +1,57 @@
+# pyright: standard
+"""Recursion — a function that can call itself."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, Severity
+from interpreter.ir import SourceLocation
+
+# What a dialect without recursion reports for each recursive function.
+RECURSION_NOT_ALLOWED = "recursion not allowed in this dialect"
+
+
+def _names(names: tuple[str, ...]) -> str:
+    if len(names) == 1:
+        return names[0]
+    return f"{', '.join(names[:-1])} and {names[-1]}"
+
+
+@dataclass(frozen=True)
+class Recursion:
+    """*function*, declared at *location*, can call itself.
+
+    *others* are the other functions of its cycle, in source order, when
+    the recursion is mutual (f calls g, which calls f); it is empty when
+    *function* only calls itself directly.
+    """
+
+    location: SourceLocation
+    function: str
+    others: tuple[str, ...] = ()
+
+    @property
+    def is_mutual(self) -> bool:
+        return bool(self.others)
+
+    @property
+    def message(self) -> str:
+        if not self.others:
+            return f"{self.function} calls itself"
+        return f"{self.function} is mutually recursive with {_names(self.others)}"
+
+    def as_error(self) -> str:
+        """The lint for a dialect that does not allow recursion."""
+        return f"{self.location}: error: {RECURSION_NOT_ALLOWED} ({self.message})"
+
+    def as_diagnostic(self) -> Diagnostic:
+        return Diagnostic(
+            code=DiagnosticCode.RECURSION,
+            severity=Severity.ERROR,
+            location=self.location,
+            message=f"{RECURSION_NOT_ALLOWED} ({self.message})",
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: {self.message}"
@@ -140,12 +140,12 @@ def _scan_classes(
             is_class_start = inst.label.is_class()
             is_class_end = inst.label.is_end_class()
             if is_class_start:
-                for cname, clabel in classes.items():
-                    if inst.label == clabel:
-                        in_class = cname
-                        if cname not in class_methods:
-                            class_methods[cname] = {}
-                        break
+                # A class may own several blocks (Rust impls, Go methods
+                # declared per receiver), so match any label registered for it.
+                cref = class_symbol_table.get(inst.label)
+                if cref is not None:
+                    in_class = cref.name
+                    class_methods.setdefault(cref.name, {})
             elif is_class_end:
                 # Keep in_class set — hoisted methods may follow end_class
                 pass
@@ -43,6 +43,7 @@ from interpreter.register import Register
 from interpreter.registry import FunctionRegistry, build_registry
 from interpreter.run_types import (
     ExecutionStats,
+    IntegerOverflowMode,
     PipelineStats,
     UnresolvedCallStrategy,
     VMConfig,
@@ -67,11 +68,13 @@ from interpreter.types.coercion.unop_coercion import (
 from interpreter.types.type_environment import TypeEnvironment
 from interpreter.types.type_expr import UNKNOWN, scalar
 from interpreter.types.type_graph import DEFAULT_TYPE_NODES, TypeGraph
+from interpreter.types.type_check import IllTypedProgramError, check_types
 from interpreter.types.type_inference import infer_types
 from interpreter.types.type_node import TypeNode
 from interpreter.types.type_resolver import TypeResolver
 from interpreter.types.typed_value import TypedValue, typed, unwrap
 from interpreter.var_name import VarName
+from interpreter.vm.call_depth import check_call_depth
 from interpreter.vm.executor import HandlerContext, _try_execute_locally
 from interpreter.vm.field_fallback import (
     FieldFallbackStrategy,
@@ -83,12 +86,14 @@ from interpreter.vm.function_scoping import (
     GlobalLeakFunctionScopingStrategy,
     LocalFunctionScopingStrategy,
 )
+from interpreter.vm.scheduler import switch_goroutine
 from interpreter.vm.unresolved_call import (
     LLMPlausibleResolver,
     SymbolicResolver,
     UnresolvedCallResolver,
 )
 from interpreter.vm.vm import (
+    SchedulerRequest,
     StackFrame,
     StateUpdate,
     SymbolicValue,
@@ -97,6 +102,7 @@ from interpreter.vm.vm import (
     coerce_local_update,
     materialize_raw_update,
 )
+from interpreter.vm.vm_types import Deadlock
 
 logger = logging.getLogger(__name__)
 
@@ -344,6 +350,7 @@ def _make_base_ctx(
     registry: FunctionRegistry,
     call_resolver: Any,
     strategies: ExecutionStrategies,
+    integer_overflow: IntegerOverflowMode,
 ) -> HandlerContext:
     """Build the per-run HandlerContext shared by every step in a loop slice."""
     return HandlerContext(
@@ -361,6 +368,7 @@ def _make_base_ctx(
         field_fallback=strategies.field_fallback,
         function_scoping=strategies.function_scoping,
         symbol_table=strategies.symbol_table,
+        integer_overflow=integer_overflow,
     )
 
 
@@ -444,6 +452,7 @@ def _run_loop(
         if config.verbose:
             _log_update(step, current_label, ip, instruction, update, used_llm)
 
+        step_label, step_ip = current_label, ip
         is_return = isinstance(instruction, Return_)
         is_throw = isinstance(instruction, Throw_)
         is_caught_throw = is_throw and update.next_label is not None
@@ -457,6 +466,12 @@ def _run_loop(
             update.call_push is not None and update.next_label is not None
         )
         if is_call_dispatch:
+            check_call_depth(
+                len(vm.call_stack),
+                config.max_call_depth,
+                str(update.call_push.function_name),
+                instruction.source_location,
+            )
             _handle_call_dispatch_setup(
                 vm,
                 instruction,
@@ -490,10 +505,19 @@ def _run_loop(
         else:
             ip += 1
 
+        if vm.scheduler.request is not SchedulerRequest.NONE:
+            switched = switch_goroutine(
+                vm, step_label, step_ip, instruction.source_location
+            )
+            if isinstance(switched, Deadlock):
+                logger.error("[step %d] %s", step, switched)
+                break
+            current_label, ip = switched.label, switched.ip
+
     return _LoopResult(suspended=False, steps=step + 1, llm_calls=llm_calls)
 
 
-def initial_vm_state(io_provider: Any = None) -> VMState:
+def initial_vm_state(io_provider: Any = None, scheduler_seed: int = 0) -> VMState:
     """Build a fresh VMState seeded with the ``<main>`` call frame.
 
     ``execute_cfg``/``run_resumable``/``execute_cfg_traced``/``run_linked``/
@@ -501,10 +525,12 @@ def initial_vm_state(io_provider: Any = None) -> VMState:
     now (no ``None``-defaulted fallback). This is the equivalent of what those
     functions used to build internally when the argument was omitted — callers
     that don't need to continue an existing VM should call this to get one.
+    *scheduler_seed* seeds the choice among ready Go ``select`` cases.
     """
     vm = VMState()
     vm.call_stack.append(StackFrame(function_name=FuncName(constants.MAIN_FRAME_NAME)))
     vm.io_provider = io_provider
+    vm.scheduler.rng.seed(scheduler_seed)
     return vm
 
 
@@ -538,7 +564,9 @@ def execute_cfg(
     logger.debug("execute_cfg: entry=%s max_steps=%d", entry, config.max_steps)
 
     call_resolver = _create_resolver(config)
-    base_ctx = _make_base_ctx(cfg, registry, call_resolver, strategies)
+    base_ctx = _make_base_ctx(
+        cfg, registry, call_resolver, strategies, config.integer_overflow
+    )
 
     loop = _run_loop(
         cfg,
@@ -649,7 +677,9 @@ def run_resumable(
     raising as ``execute_cfg`` does), or ``Completed`` when it terminates."""
     entry = _find_entry_point(cfg, entry_point)
     call_resolver = _create_resolver(config)
-    base_ctx = _make_base_ctx(cfg, registry, call_resolver, strategies)
+    base_ctx = _make_base_ctx(
+        cfg, registry, call_resolver, strategies, config.integer_overflow
+    )
     loop = _run_loop(
         cfg,
         vm,
@@ -684,7 +714,9 @@ def resume(
     if state.resume_reg is not None and state.resume_reg.is_present():
         vm.current_frame.registers[state.resume_reg] = typed(value, UNKNOWN)
     call_resolver = _create_resolver(config)
-    base_ctx = _make_base_ctx(cfg, registry, call_resolver, strategies)
+    base_ctx = _make_base_ctx(
+        cfg, registry, call_resolver, strategies, config.integer_overflow
+    )
     loop = _run_loop(
         cfg,
         vm,
@@ -753,6 +785,7 @@ def execute_cfg_traced(
         field_fallback=strategies.field_fallback,
         function_scoping=strategies.function_scoping,
         symbol_table=strategies.symbol_table,
+        integer_overflow=config.integer_overflow,
     )
 
     for step in range(config.max_steps):
@@ -796,6 +829,7 @@ def execute_cfg_traced(
         if config.verbose:
             _log_update(step, current_label, ip, instruction, update, used_llm)
 
+        step_label, step_ip = current_label, ip
         is_return = isinstance(instruction, Return_)
         is_throw = isinstance(instruction, Throw_)
         return_frame = vm.current_frame if (is_return or is_throw) else None
@@ -804,6 +838,12 @@ def execute_cfg_traced(
             update.call_push is not None and update.next_label is not None
         )
         if is_call_dispatch:
+            check_call_depth(
+                len(vm.call_stack),
+                config.max_call_depth,
+                str(update.call_push.function_name),
+                instruction.source_location,
+            )
             _handle_call_dispatch_setup(
                 vm,
                 instruction,
@@ -850,6 +890,15 @@ def execute_cfg_traced(
         else:
             ip += 1
 
+        if vm.scheduler.request is not SchedulerRequest.NONE:
+            switched = switch_goroutine(
+                vm, step_label, step_ip, instruction.source_location
+            )
+            if isinstance(switched, Deadlock):
+                logger.error("[step %d] %s", step, switched)
+                break
+            current_label, ip = switched.label, switched.ip
+
     stats = ExecutionStats(
         steps=step + 1,
         llm_calls=llm_calls + call_resolver.llm_call_count,
@@ -913,6 +962,22 @@ def build_execution_strategies(
     )
 
 
+def _reject_ill_typed(
+    frontend: Frontend, instructions: list[InstructionBase], lang: Language
+) -> None:
+    """Raise IllTypedProgramError if *instructions* fail type checking."""
+    type_env = infer_types(
+        instructions,
+        TypeResolver(DefaultTypeConversionRules()),
+        type_env_builder=frontend.type_env_builder,
+        func_symbol_table=frontend.func_symbol_table,
+        class_symbol_table=frontend.class_symbol_table,
+    )
+    result = check_types(instructions, type_env, lang)
+    if not result.ok:
+        raise IllTypedProgramError(result.errors)
+
+
 def _build_strategies_from_linked(linked: LinkedProgram) -> ExecutionStrategies:
     """Build ExecutionStrategies from a LinkedProgram's data."""
     conversion_rules = DefaultTypeConversionRules()
@@ -956,6 +1021,8 @@ def run_linked(
     backend: str = LLMProvider.CLAUDE,
     unresolved_call_strategy: UnresolvedCallStrategy = UnresolvedCallStrategy.SYMBOLIC,
     io_provider: Any = None,  # Any: CobolIOProvider — optional COBOL I/O injection
+    integer_overflow: IntegerOverflowMode = IntegerOverflowMode.BIG,
+    max_call_depth: int = constants.MAX_CALL_DEPTH,
     *,
     initial_vm: VMState,
 ) -> VMState:
@@ -969,6 +1036,8 @@ def run_linked(
         backend: LLM backend for interpreter fallback.
         unresolved_call_strategy: Resolution strategy for unknown calls.
         io_provider: Optional COBOL I/O provider (e.g. StubIOProvider for testing).
+        integer_overflow: Exact, wrapping or trapping int64 arithmetic.
+        max_call_depth: Most nested calls before CallDepthExceededError.
         initial_vm: VM state to execute against. Use ``initial_vm_state()`` for a
             fresh one.
     """
@@ -980,6 +1049,8 @@ def run_linked(
         verbose=verbose,
         source_language=linked.language,
         unresolved_call_strategy=unresolved_call_strategy,
+        integer_overflow=integer_overflow,
+        max_call_depth=max_call_depth,
         io_provider=io_provider,
     )
 
@@ -1246,6 +1317,12 @@ def run(
     unresolved_call_strategy: UnresolvedCallStrategy = UnresolvedCallStrategy.SYMBOLIC,
     io_provider: Any = None,  # Any: CobolIOProvider — optional COBOL I/O injection
     copybook_dirs: list[Path] = [],
+    scheduler_seed: int = 0,
+    integer_overflow: IntegerOverflowMode = IntegerOverflowMode.BIG,
+    go_entry_func: str = "main",
+    max_nesting_depth: int = constants.MAX_NESTING_DEPTH,
+    max_call_depth: int = constants.MAX_CALL_DEPTH,
+    type_check: bool = False,
 ) -> VMState:
     """End-to-end: parse → lower → build LinkedProgram → run_linked.
 
@@ -1262,6 +1339,18 @@ def run(
         frontend_type: "deterministic" (tree-sitter) or "llm".
         llm_client: Pre-built LLMClient for DI/testing (used by LLM frontend).
         unresolved_call_strategy: Resolution strategy for unknown calls.
+        scheduler_seed: Seed for choosing among ready Go ``select`` cases.
+        integer_overflow: What arithmetic does past the int64 range: keep the
+            exact value (default), wrap, or raise IntegerOverflowError.
+        go_entry_func: Go function to run in place of ``main``, after the
+            package's variables and ``init`` functions are initialized.
+        max_nesting_depth: Deepest syntax tree to lower; deeper source
+            raises NestingTooDeepError at the first node past the limit.
+        max_call_depth: Most nested calls before the run stops with a
+            CallDepthExceededError at the call site.
+        type_check: Type-check the program before running it, raising
+            IllTypedProgramError if a statically typed language would
+            reject it.
     """
     lang = Language(language)
     pipeline_start = time.perf_counter()
@@ -1308,6 +1397,8 @@ def run(
             llm_client=llm_client,
             observer=observer,
             copybook_dirs=copybook_dirs,
+            go_entry_func=go_entry_func,
+            max_nesting_depth=max_nesting_depth,
         )
         instructions = frontend.lower(source.encode("utf-8"))
 
@@ -1346,6 +1437,9 @@ def run(
         stats.registry_functions = len(registry.func_params)
         stats.registry_classes = len(registry.classes)
 
+        if type_check:
+            _reject_ill_typed(frontend, instructions, lang)
+
         # 4. Build single-module LinkedProgram
         linked = LinkedProgram(
             modules={},
@@ -1393,7 +1487,11 @@ def run(
         backend=backend,
         unresolved_call_strategy=unresolved_call_strategy,
         io_provider=io_provider,
-        initial_vm=initial_vm_state(io_provider=io_provider),
+        integer_overflow=integer_overflow,
+        max_call_depth=max_call_depth,
+        initial_vm=initial_vm_state(
+            io_provider=io_provider, scheduler_seed=scheduler_seed
+        ),
     )
     stats.execution_time = time.perf_counter() - exec_start
     stats.total_time = time.perf_counter() - pipeline_start
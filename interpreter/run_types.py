@@ -7,7 +7,7 @@ from dataclasses import dataclass
 from enum import Enum
 from typing import Any
 
-from interpreter.constants import LLMProvider
+from interpreter.constants import MAX_CALL_DEPTH, LLMProvider
 
 
 class UnresolvedCallStrategy(Enum):
@@ -17,6 +17,19 @@ class UnresolvedCallStrategy(Enum):
     LLM = "llm"
 
 
+class IntegerOverflowMode(Enum):
+    """What integer arithmetic does with a result outside the signed 64-bit range.
+
+    BIG keeps the exact (arbitrary-precision) result, WRAP wraps it to
+    two's complement the way Go's ``int64`` does, and TRAP stops the run
+    with an IntegerOverflowError naming the offending source span.
+    """
+
+    BIG = "big"
+    WRAP = "wrap"
+    TRAP = "trap"
+
+
 @dataclass(frozen=True)
 class VMConfig:
     """Groups VM execution configuration."""
@@ -26,6 +39,8 @@ class VMConfig:
     verbose: bool = False
     unresolved_call_strategy: UnresolvedCallStrategy = UnresolvedCallStrategy.SYMBOLIC
     source_language: str = ""
+    integer_overflow: IntegerOverflowMode = IntegerOverflowMode.BIG
+    max_call_depth: int = MAX_CALL_DEPTH
     io_provider: Any = (
         None  # Any: COBOL isolation boundary — CobolIOProvider avoided in core VM
     )
@@ -0,0 +1,270 @@
+# pyright: standard
+"""Token stream — the tokens of a source file, for tools that want no AST.
+
+Syntax highlighters and quick linters want tokens, not trees.  Tree-sitter
+has no stand-alone lexer (it lexes on demand, by parse state), so the
+tokens come from a parse, but callers never see it: ``tokenize`` returns
+them all and ``Scanner`` hands them out one at a time, walking the tree
+lazily.  Whitespace is never a token, even where the grammar makes a
+newline one.
+
+Malformed source still tokenizes, and the scanner never stops early: a
+character no rule accepts, each run of text the parser skipped while
+recovering, and a string or character literal left unterminated each
+become a token of category ``ERROR``, positioned like any other, and the
+tokens after them follow as usual.
+
+Each token gets a ``TokenCategory`` from its grammar kind: anonymous
+(literal) tokens are keywords when they read like words, punctuation when
+they are brackets or separators, and operators otherwise; named tokens are
+classed by the markers in their kind (``identifier``, ``_type``,
+``integer``, ...).
+
+Scanning allocates as little per token as it can: a token is a span of
+the shared source bytes, categorised from its kind alone, and its text
+and location are built only when read (see ``Token``).
+
+A ``Scanner`` also takes a ``StreamSource``.  It then parses through the
+stream's window and reads every span it looks at by slicing the stream,
+so tokens come from a file too large to hold as one string.  The text
+of a token read after its bytes have left the window is read again from
+the stream, which must then be seekable.
+"""
+
+from __future__ import annotations
+
+import re
+import sys
+from collections.abc import Iterator
+from typing import Any
+
+from interpreter.constants import Language
+from interpreter.parser import Parser, ParserFactory, TreeSitterParserFactory
+from interpreter.stream_source import SourceBytes, StreamSource
+from interpreter.token_types import END_TOKEN, Token, TokenCategory
+
+# Kinds, as substrings of the lower-cased kind, emitted as one token.
+_STRING_MARKERS: tuple[str, ...] = ("string", "heredoc", "char", "rune", "regex")
+
+_PUNCTUATION_CHARS = frozenset("()[]{},;:.")
+
+_QUOTES = frozenset({'"', "'", "`", '"""', "'''"})
+
+_NON_SPACE = re.compile(rb"\S")
+_WORD = re.compile(rb"\S+")
+
+_KEYWORD_KINDS = frozenset(
+    {
+        "true",
+        "false",
+        "null",
+        "nil",
+        "none",
+        "undefined",
+        "null_literal",
+        "nullptr",
+        "self",
+        "this",
+        "super",
+    }
+)
+
+# Checked in order against the lower-cased kind of a named token.
+_NAMED_MARKERS: tuple[tuple[str, TokenCategory], ...] = (
+    ("identifier", TokenCategory.IDENTIFIER),
+    ("_type", TokenCategory.TYPE),
+    ("integer", TokenCategory.NUMBER),
+    ("int_literal", TokenCategory.NUMBER),
+    ("float", TokenCategory.NUMBER),
+    ("number", TokenCategory.NUMBER),
+    ("decimal", TokenCategory.NUMBER),
+    ("real", TokenCategory.NUMBER),
+    ("imaginary", TokenCategory.NUMBER),
+    ("name", TokenCategory.IDENTIFIER),
+    ("constant", TokenCategory.IDENTIFIER),
+)
+
+
+def _is_comment(node: Any) -> bool:  # Any: tree-sitter node
+    return node.is_named and "comment" in node.type.lower()
+
+
+def _is_string(node: Any) -> bool:  # Any: tree-sitter node
+    # Named only: anonymous "char" and "string" are Java and TypeScript keywords.
+    kind = node.type.lower()
+    return node.is_named and any(marker in kind for marker in _STRING_MARKERS)
+
+
+def _is_token(node: Any) -> bool:  # Any: tree-sitter node
+    return node.child_count == 0 or _is_comment(node) or _is_string(node)
+
+
+def _category(node: Any) -> TokenCategory:  # Any: tree-sitter node
+    kind = node.type.lower()
+    if _is_comment(node):
+        return TokenCategory.COMMENT
+    if node.type == "ERROR":
+        return TokenCategory.ERROR
+    if _is_string(node):
+        # A literal with an error inside lacks its closing delimiter.
+        return TokenCategory.ERROR if node.has_error else TokenCategory.STRING
+    if kind in _KEYWORD_KINDS:
+        return TokenCategory.KEYWORD
+    if not node.is_named:
+        # An anonymous token's kind is its text.
+        if kind[:1].isalpha() or kind[:1] in "_@#":
+            return TokenCategory.KEYWORD
+        if all(char in _PUNCTUATION_CHARS for char in kind):
+            return TokenCategory.PUNCTUATION
+        return TokenCategory.OPERATOR
+    return next(
+        (category for marker, category in _NAMED_MARKERS if marker in kind),
+        TokenCategory.OTHER,
+    )
+
+
+def _advance(point: tuple[int, int], text: bytes, offset: int) -> tuple[int, int]:
+    """The point of byte *offset* of *text*, which starts at *point*."""
+    newlines = text.count(b"\n", 0, offset)
+    if newlines == 0:
+        return point[0], point[1] + offset
+    return point[0] + newlines, offset - (text.rfind(b"\n", 0, offset) + 1)
+
+
+def _point(source: bytes, offset: int) -> tuple[int, int]:
+    """Tree-sitter's (row, byte column) of byte *offset* in *source*."""
+    return _advance((0, 0), source, offset)
+
+
+def _token(
+    node: Any, source: SourceBytes, category: TokenCategory
+) -> Token:  # Any: tree-sitter node
+    return Token(
+        kind=sys.intern(node.type),
+        category=category,
+        source=source,
+        start_byte=node.start_byte,
+        end_byte=node.end_byte,
+        start_point=node.start_point,
+        end_point=node.end_point,
+    )
+
+
+def _is_blank(node: Any, source: SourceBytes) -> bool:  # Any: tree-sitter node
+    """Whether *node* spans only whitespace; only a blank-led span is sliced."""
+    start, end = node.start_byte, node.end_byte
+    if start == end:
+        return True
+    if not source[start : start + 1].isspace():
+        return False
+    return _NON_SPACE.search(source[start:end]) is None
+
+
+def _skipped(
+    source: SourceBytes, start: int, end: int, start_point: tuple[int, int]
+) -> Iterator[Token]:
+    """ERROR tokens for the text in ``source[start:end]``, one per word.
+
+    *start_point* is the point of *start*.
+    """
+    text = source[start:end]
+    for match in _WORD.finditer(text):
+        yield Token(
+            kind="ERROR",
+            category=TokenCategory.ERROR,
+            source=source,
+            start_byte=start + match.start(),
+            end_byte=start + match.end(),
+            start_point=_advance(start_point, text, match.start()),
+            end_point=_advance(start_point, text, match.end()),
+        )
+
+
+def _error_tokens(
+    node: Any, source: SourceBytes
+) -> Iterator[Token]:  # Any: tree-sitter node
+    """The tokens of an ERROR node, including the text its children skip.
+
+    A quote the parser could not fit is an unterminated literal's opening,
+    so it is an ERROR token too.
+    """
+    offset, point = node.start_byte, node.start_point
+    for child in node.children:
+        yield from _skipped(source, offset, child.start_byte, point)
+        if not child.is_named and child.type in _QUOTES:
+            yield _token(child, source, TokenCategory.ERROR)
+        else:
+            yield from _tokens(child, source)
+        if child.end_byte > offset:
+            offset, point = child.end_byte, child.end_point
+    yield from _skipped(source, offset, node.end_byte, point)
+
+
+def _tokens(root: Any, source: SourceBytes) -> Iterator[Token]:  # Any: tree-sitter node
+    """The tokens under *root* in source order, whitespace-only ones skipped.
+
+    The cursor walks only *root*'s subtree: it has no siblings or parent.
+    """
+    cursor = root.walk()
+    while True:
+        node = cursor.node
+        if node.type == "ERROR" and node.child_count > 0:
+            yield from _error_tokens(node, source)
+        elif _is_token(node):
+            # Whitespace-only: a newline statement terminator, as in Go.
+            if not _is_blank(node, source):
+                yield _token(node, source, _category(node))
+        elif cursor.goto_first_child():
+            continue
+        while not cursor.goto_next_sibling():
+            if not cursor.goto_parent():
+                return
+
+
+class Scanner:
+    """Pull-based access to the tokens of *source*, in source order.
+
+    *source* is a string, or a ``StreamSource`` to scan without holding
+    the whole file.  ``next_token()`` returns ``END_TOKEN`` once the tokens
+    run out; the scanner is also an iterator over the tokens.
+    """
+
+    def __init__(
+        self,
+        source: str | StreamSource,
+        language: Language,
+        parser_factory: ParserFactory = TreeSitterParserFactory(),
+    ):
+        if language == Language.COBOL:
+            raise ValueError("COBOL source cannot be tokenized by tree-sitter")
+        if isinstance(source, StreamSource):
+            tree = Parser(parser_factory).parse_stream(source, language)
+            self._tokens = _tokens(tree.root_node, source)
+            return
+        encoded = source.encode("utf-8")
+        tree = parser_factory.get_parser(language).parse(encoded)
+        self._tokens = _tokens(tree.root_node, encoded)
+
+    def next_token(self) -> Token:
+        return next(self._tokens, END_TOKEN)
+
+    def __iter__(self) -> Iterator[Token]:
+        return self
+
+    def __next__(self) -> Token:
+        token = self.next_token()
+        if token.is_end():
+            raise StopIteration
+        return token
+
+
+def tokenize(
+    source: str,
+    language: Language,
+    parser_factory: ParserFactory = TreeSitterParserFactory(),
+) -> tuple[Token, ...]:
+    """Every token of *source*, comments included, in source order.
+
+    Raises ``ValueError`` for COBOL, which tree-sitter does not parse.
+    """
+    return tuple(Scanner(source, language, parser_factory))
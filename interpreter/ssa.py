@@ -0,0 +1,322 @@
+# pyright: standard
+"""SSA form — each assignment of a local variable makes a new version.
+
+``to_ssa`` renames every assignment of a local variable to a fresh version,
+and places a phi where versions arriving along different paths meet::
+
+    x = 0                   x#1 = 0
+    if c:                   if c:
+        x = 1                   x#2 = 1
+    print(x)                x#3 = phi(x#1, x#2); print(x#3)
+
+Phis go at the iterated dominance frontier of each assignment: the first
+blocks where a path from the assignment meets a path that avoids it.  Each
+function is converted on its own, from its entry, over its dominator tree
+(``dominators.py``).  ``SSAMode`` picks how many of the possible phis are
+placed.
+
+Only a variable or parameter the scopes declare, accessed from one function
+and never address-taken, is renamed: a global, a variable a closure
+captures, or a field assigned through an implicit ``this`` may be read or
+written where the function cannot see.  Every assignment of a renamed
+variable becomes a ``DECL_VAR`` of its version.
+
+``from_ssa`` turns the phis back into copies at the end of each
+predecessor, moving the copies for an edge out of a conditional branch into
+a block of their own, so the program runs again.
+"""
+
+from __future__ import annotations
+
+from collections.abc import Iterator
+from dataclasses import replace
+from itertools import count
+
+from interpreter.cfg import (
+    CFG,
+    BasicBlock,
+    build_cfg,
+    build_function_cfgs,
+    function_regions,
+)
+from interpreter.dead_stores import accessed_variable
+from interpreter.dominator_types import DominatorTree
+from interpreter.dominators import build_dominator_tree
+from interpreter.frontends.scopes import Scope, SymbolKind
+from interpreter.instructions import (
+    AddressOf,
+    Branch,
+    BranchIf,
+    DeclVar,
+    InstructionBase,
+    Label_,
+    LoadVar,
+    StoreVar,
+)
+from interpreter.ir import CodeLabel
+from interpreter.liveness import live_in
+from interpreter.register import Register
+from interpreter.ssa_types import Phi, SSAForm, SSAMode
+from interpreter.var_name import VarName
+
+_VERSION_SEPARATOR = "#"
+
+
+def _variables(scope: Scope) -> set[str]:
+    """The IR name of every variable and parameter declared in *scope*."""
+    names = {
+        symbol.resolved_name
+        for symbol in scope.symbols
+        if symbol.kind in (SymbolKind.VARIABLE, SymbolKind.PARAMETER)
+    }
+    for child in scope.children:
+        names |= _variables(child)
+    return names
+
+
+def _renamable(
+    cfg: CFG, region: dict[CodeLabel, str], variables: set[str]
+) -> dict[str, str]:
+    """Each of *variables* local to one function, by the function's entry
+    label."""
+    functions: dict[str, set[str | None]] = {}
+    address_taken: set[str] = set()
+    for label, block in cfg.blocks.items():
+        for inst in block.instructions:
+            name = accessed_variable(inst)
+            if not name:
+                continue
+            # A block no function reaches, such as a class body, is
+            # nobody's: a variable accessed there is never renamed.
+            functions.setdefault(name, set()).add(region.get(label))
+            if isinstance(inst, AddressOf):
+                address_taken.add(name)
+    return {
+        name: next(iter(owners))
+        for name, owners in functions.items()
+        if len(owners) == 1
+        and None not in owners
+        and name in variables
+        and name not in address_taken
+    }
+
+
+def _current(stacks: dict[str, list[str]], name: str) -> str:
+    """The version of *name* last assigned on the walk; *name* if none is."""
+    return stacks[name][-1] if stacks[name] else name
+
+
+def _upward_exposed(block: BasicBlock) -> set[str]:
+    """The variables *block* reads before assigning them."""
+    assigned: set[str] = set()
+    exposed: set[str] = set()
+    for inst in block.instructions:
+        if isinstance(inst, LoadVar) and str(inst.name) not in assigned:
+            exposed.add(str(inst.name))
+        elif isinstance(inst, (DeclVar, StoreVar)):
+            assigned.add(str(inst.name))
+    return exposed
+
+
+def _phi_candidates(
+    cfg: CFG, mode: SSAMode, reachable: list[CodeLabel]
+) -> dict[CodeLabel, frozenset[str]] | None:
+    """The variables that may get a phi at each block; None if any may."""
+    if mode == SSAMode.MINIMAL:
+        return None
+    if mode == SSAMode.SEMI_PRUNED:
+        nonlocal_names = frozenset().union(
+            *(_upward_exposed(cfg.blocks[label]) for label in reachable)
+        )
+        return {label: nonlocal_names for label in reachable}
+    return live_in(cfg)
+
+
+class _Builder:
+    """The renamed blocks and the phis of the functions converted so far."""
+
+    def __init__(self, mode: SSAMode):
+        self.mode = mode
+        self.counters: dict[str, int] = {}
+        self.blocks: dict[CodeLabel, list[InstructionBase]] = {}
+        self.phis: dict[CodeLabel, tuple[Phi, ...]] = {}
+
+    def _new_version(self, name: str) -> str:
+        self.counters[name] = self.counters.get(name, 0) + 1
+        return f"{name}{_VERSION_SEPARATOR}{self.counters[name]}"
+
+    def convert(self, cfg: CFG, names: frozenset[str]) -> None:
+        """Rename *names* throughout the function *cfg*."""
+        tree = build_dominator_tree(cfg)
+        placed = self._place(cfg, names, tree)
+        self._rename(cfg, names, tree, placed)
+
+    def _place(
+        self, cfg: CFG, names: frozenset[str], tree: DominatorTree
+    ) -> dict[CodeLabel, list[str]]:
+        """The variables needing a phi at each block, in name order."""
+        reachable = list(tree.order)
+        candidates = _phi_candidates(cfg, self.mode, reachable)
+        assigned_in: dict[str, set[CodeLabel]] = {}
+        for label in reachable:
+            for inst in cfg.blocks[label].instructions:
+                if isinstance(inst, (DeclVar, StoreVar)) and str(inst.name) in names:
+                    assigned_in.setdefault(str(inst.name), set()).add(label)
+        placed: dict[CodeLabel, list[str]] = {}
+        for name in sorted(assigned_in):
+            has_phi: set[CodeLabel] = set()
+            pending = list(assigned_in[name])
+            while pending:
+                for join in tree.frontiers[pending.pop()]:
+                    if join in has_phi:
+                        continue
+                    if candidates is not None and name not in candidates[join]:
+                        continue
+                    has_phi.add(join)
+                    placed.setdefault(join, []).append(name)
+                    if join not in assigned_in[name]:
+                        pending.append(join)
+        return placed
+
+    def _rename(
+        self,
+        cfg: CFG,
+        names: frozenset[str],
+        tree: DominatorTree,
+        placed: dict[CodeLabel, list[str]],
+    ) -> None:
+        """Give each assignment a new version and each read the version
+        reaching it, walking the dominator tree from the entry."""
+        stacks: dict[str, list[str]] = {name: [] for name in names}
+        targets: dict[tuple[CodeLabel, str], str] = {}
+        sources: dict[tuple[CodeLabel, str], dict[CodeLabel, str]] = {}
+
+        work: list[tuple[CodeLabel, list[str] | None]] = (
+            [(cfg.entry, None)] if tree.order else []
+        )
+        while work:
+            label, pushed = work.pop()
+            if pushed is not None:
+                for name in pushed:
+                    stacks[name].pop()
+                continue
+            pushed = []
+            for name in placed.get(label, []):
+                targets[(label, name)] = self._new_version(name)
+                stacks[name].append(targets[(label, name)])
+                pushed.append(name)
+            renamed: list[InstructionBase] = []
+            for inst in cfg.blocks[label].instructions:
+                name = accessed_variable(inst)
+                if name not in names:
+                    renamed.append(inst)
+                elif isinstance(inst, LoadVar):
+                    version = _current(stacks, name)
+                    renamed.append(replace(inst, name=VarName(version)))
+                else:
+                    version = self._new_version(name)
+                    stacks[name].append(version)
+                    pushed.append(name)
+                    renamed.append(
+                        DeclVar(
+                            name=VarName(version),
+                            value_reg=inst.value_reg,
+                            source_location=inst.source_location,
+                        )
+                    )
+            self.blocks[label] = renamed
+            for successor in cfg.blocks[label].successors:
+                for name in placed.get(successor, []):
+                    reaching = _current(stacks, name)
+                    sources.setdefault((successor, name), {})[label] = reaching
+            work.append((label, pushed))
+            work.extend((child, None) for child in reversed(tree.children(label)))
+
+        for label, block_names in placed.items():
+            preds = [p for p in cfg.blocks[label].predecessors if p in tree]
+            self.phis[label] = tuple(
+                Phi(
+                    name,
+                    targets[(label, name)],
+                    tuple(
+                        (pred, sources.get((label, name), {}).get(pred, name))
+                        for pred in preds
+                    ),
+                )
+                for name in block_names
+            )
+
+
+def to_ssa(
+    scopes: Scope, instructions: list[InstructionBase], mode: SSAMode = SSAMode.PRUNED
+) -> SSAForm:
+    """*instructions* in SSA form, with phis placed as *mode* says.
+
+    *scopes* and *instructions* come from lowering the same source.
+    """
+    cfg = build_cfg(instructions)
+    region = function_regions(cfg, instructions)
+    local = _renamable(cfg, region, _variables(scopes))
+    builder = _Builder(mode)
+    for function, function_cfg in build_function_cfgs(instructions).items():
+        names = frozenset(name for name, owner in local.items() if owner == function)
+        builder.convert(function_cfg, names)
+    blocks = {
+        label: BasicBlock(
+            label=label,
+            instructions=builder.blocks.get(label, block.instructions),
+            successors=list(block.successors),
+            predecessors=list(block.predecessors),
+        )
+        for label, block in cfg.blocks.items()
+    }
+    return SSAForm(CFG(blocks=blocks, entry=cfg.entry), builder.phis)
+
+
+def _copies(
+    pred: CodeLabel, phis: tuple[Phi, ...], registers: Iterator[int]
+) -> list[InstructionBase]:
+    """The copies the edge from *pred* makes for *phis*, all read before any
+    is written, as the phis of one block take their values at once."""
+    moves = [
+        (phi.target, version, Register(f"%ssa_{next(registers)}"))
+        for phi in phis
+        for source, version in phi.sources
+        if source == pred and version != phi.name
+    ]
+    loads: list[InstructionBase] = [
+        LoadVar(result_reg=reg, name=VarName(version)) for _, version, reg in moves
+    ]
+    stores: list[InstructionBase] = [
+        DeclVar(name=VarName(target), value_reg=reg) for target, _, reg in moves
+    ]
+    return loads + stores
+
+
+def from_ssa(ssa: SSAForm) -> list[InstructionBase]:
+    """The instructions of *ssa* with each phi replaced by copies along the
+    edges into its block."""
+    registers = count()
+    edges = count()
+    instructions: list[InstructionBase] = []
+    for label, block in ssa.cfg.blocks.items():
+        body = list(block.instructions)
+        last = body[-1] if body else None
+        joins = [s for s in block.successors if ssa.phis.get(s)]
+        split: list[InstructionBase] = []
+        if isinstance(last, BranchIf) and joins:
+            targets = list(last.branch_targets)
+            for join in joins:
+                edge = CodeLabel(f"ssa_edge_{next(edges)}")
+                targets = [edge if t == join else t for t in targets]
+                split.append(Label_(label=edge))
+                split.extend(_copies(label, ssa.phis[join], registers))
+                split.append(Branch(label=join))
+            body[-1] = replace(last, branch_targets=tuple(targets))
+        elif joins:
+            at = len(body) - 1 if isinstance(last, Branch) else len(body)
+            body[at:at] = _copies(label, ssa.phis[joins[0]], registers)
+        instructions.append(Label_(label=label))
+        instructions.extend(body)
+        instructions.extend(split)
+    return instructions
@@ -0,0 +1,60 @@
+# pyright: standard
+"""SSA form — a program in which each variable is assigned exactly once."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, field
+from enum import Enum
+
+from interpreter.cfg_types import CFG
+from interpreter.ir import CodeLabel
+
+
+class SSAMode(str, Enum):
+    """Which join points get a phi for a variable assigned on the way there.
+
+    ``MINIMAL`` places one at every join in the dominance frontier of an
+    assignment.  ``SEMI_PRUNED`` does so only for variables read in some
+    block before that block assigns them; ``PRUNED`` only where the
+    variable is live, so no phi is ever dead.
+    """
+
+    MINIMAL = "minimal"
+    SEMI_PRUNED = "semi-pruned"
+    PRUNED = "pruned"
+
+
+@dataclass(frozen=True)
+class Phi:
+    """``target = phi(...)``: the version of variable *name* holding at the
+    start of a block, chosen by the predecessor control came from.
+
+    *sources* pairs each predecessor with the version reaching the block
+    from it; a source that is *name* itself means no assignment reaches.
+    """
+
+    name: str
+    target: str
+    sources: tuple[tuple[CodeLabel, str], ...]
+
+    def __str__(self) -> str:
+        sources = ", ".join(f"{label}: {version}" for label, version in self.sources)
+        return f"{self.target} = phi({sources})"
+
+
+@dataclass
+class SSAForm:
+    """A program's CFG with its local variables renamed to versions, each
+    assigned by one ``DECL_VAR`` or one phi."""
+
+    cfg: CFG
+    phis: dict[CodeLabel, tuple[Phi, ...]] = field(default_factory=dict)
+
+    def __str__(self) -> str:
+        lines = []
+        for label, block in self.cfg.blocks.items():
+            lines.append(f"[{label}]")
+            lines.extend(f"  {phi}" for phi in self.phis.get(label, ()))
+            lines.extend(f"  {inst}" for inst in block.instructions)
+            lines.append("")
+        return "\n".join(lines)
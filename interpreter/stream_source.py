@@ -0,0 +1,105 @@
+# pyright: standard
+"""Stream Source — feed tree-sitter from a binary stream, a chunk at a time.
+
+Tree-sitter lexes as it parses and pulls its input through a callback,
+asking for the bytes at some offset.  ``StreamSource`` answers those
+requests from any binary stream (an open file, a socket's ``makefile``,
+a pipe) while holding at most a bounded window of recent bytes, so a
+generated program of hundreds of megabytes is never loaded, decoded and
+re-encoded in full.
+
+The lexer moves forward almost always; it only steps back to re-lex a
+token or two after an error.  Slicing a ``StreamSource`` reads the bytes
+of a span the same way, so the scanner and the frontends read token text
+through the window rather than from one string of the whole file.  Bytes
+that have already left the window are read again from a seekable stream;
+from any other stream they raise ``StreamWindowError``, and a larger
+window fixes it.
+"""
+
+from __future__ import annotations
+
+from typing import Any, BinaryIO, Protocol
+
+DEFAULT_CHUNK_SIZE = 64 * 1024
+DEFAULT_WINDOW_SIZE = 1024 * 1024
+
+
+class StreamWindowError(ValueError):
+    """The parser asked for bytes the stream window no longer holds."""
+
+    def __init__(self, offset: int, window_start: int):
+        super().__init__(
+            f"Byte {offset} has left the stream window (which starts at byte "
+            f"{window_start}); use a larger window"
+        )
+
+
+class SourceBytes(Protocol):
+    """Source read by span: the ``bytes`` of a file, or a ``StreamSource``."""
+
+    def __getitem__(self, span: slice, /) -> bytes: ...
+
+
+class StreamSource:
+    """Bytes of a stream, read on demand and kept in a bounded window."""
+
+    def __init__(
+        self,
+        stream: BinaryIO,
+        chunk_size: int = DEFAULT_CHUNK_SIZE,
+        window_size: int = DEFAULT_WINDOW_SIZE,
+    ):
+        if window_size < chunk_size:
+            raise ValueError("The window must hold at least one chunk")
+        self._stream = stream
+        # Where the source starts in the stream, to seek back to.
+        self._origin = stream.tell() if stream.seekable() else 0
+        self._chunk_size = chunk_size
+        self._window_size = window_size
+        self._window = bytearray()
+        self._window_start = 0
+        self._exhausted = False
+
+    @property
+    def bytes_read(self) -> int:
+        """How far into the stream reading has got."""
+        return self._window_start + len(self._window)
+
+    def _rewind(self, offset: int) -> None:
+        """Start the window again at *offset*, which has left it."""
+        if not self._stream.seekable():
+            raise StreamWindowError(offset, self._window_start)
+        self._stream.seek(self._origin + offset)
+        self._window = bytearray()
+        self._window_start = offset
+        self._exhausted = False
+
+    def _fill(self, offset: int, end: int) -> None:
+        """Read until bytes *offset* to *end* are in the window or the stream ends."""
+        if offset < self._window_start:
+            self._rewind(offset)
+        while end > self.bytes_read and not self._exhausted:
+            chunk = self._stream.read(self._chunk_size)
+            self._exhausted = not chunk
+            self._window += chunk
+            # Drop the oldest bytes past the window size, but never *offset*.
+            excess = len(self._window) - self._window_size
+            drop = min(excess, offset - self._window_start)
+            if drop > 0:
+                del self._window[:drop]
+                self._window_start += drop
+
+    def read(self, offset: int, _point: Any = None) -> bytes:
+        """Up to one chunk of bytes from *offset*; empty at the end.
+
+        Its signature is tree-sitter's read callback, so the bound method
+        can be passed straight to ``parser.parse``.
+        """
+        return self[offset : offset + self._chunk_size]
+
+    def __getitem__(self, span: slice) -> bytes:
+        """The bytes of *span*, a slice of the source with a start and a stop."""
+        self._fill(span.start, span.stop)
+        start = span.start - self._window_start
+        return bytes(self._window[start : span.stop - self._window_start])
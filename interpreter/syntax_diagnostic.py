@@ -0,0 +1,32 @@
+# pyright: standard
+"""SyntaxDiagnostic — one syntax error a frontend recovered from."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, Severity
+from interpreter.ir import SourceLocation
+
+
+@dataclass(frozen=True)
+class SyntaxDiagnostic:
+    """A syntax error at *location*, e.g. ``missing ")"`` or ``unexpected "=>"``.
+
+    Lowering continues past it: the frontend skips the malformed region and
+    lowers the rest of the file.
+    """
+
+    location: SourceLocation
+    message: str
+
+    def as_diagnostic(self) -> Diagnostic:
+        return Diagnostic(
+            code=DiagnosticCode.SYNTAX_ERROR,
+            severity=Severity.ERROR,
+            location=self.location,
+            message=self.message,
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: {self.message}"
@@ -0,0 +1,277 @@
+# pyright: standard
+"""Taint analysis — untrusted values reaching calls that must not get them.
+
+A ``TaintPolicy`` names three kinds of call: sources return an untrusted
+value, sinks must not be passed one, and sanitizers return a trusted value
+whatever they are passed::
+
+    name = input()              # name is tainted
+    n = int(input())            # n is not: int sanitizes
+    eval("print(" + name + ")") # untrusted value from input reaches eval
+
+A value is tainted when it comes from a source, or is computed from a
+tainted value: an operator, a field or element of a tainted object, and a
+library call not in the policy all pass taint on.  Assigning a variable an
+untainted value cleans it; storing a tainted value into an object, or
+passing one to a library method (``xs.append(name)``), taints the variable
+holding the object.
+
+Taint crosses calls of the program's functions: a tainted argument taints
+the parameter, and a function that can return a tainted value taints the
+result of every call of it.  Each function is analysed once for all its
+callers, so a parameter tainted at one call is tainted at all of them.  A
+variable accessed from more than one function — a global, or a variable a
+closure captures — stays tainted from its first tainted assignment on.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter import constants
+from interpreter.cfg import BasicBlock, build_cfg, function_regions
+from interpreter.dataflow_solver import solve_dataflow
+from interpreter.dataflow_solver_types import DataflowProblem, Direction
+from interpreter.dead_stores import accessed_variable
+from interpreter.instructions import (
+    CallCtorFunction,
+    CallFunction,
+    CallMethod,
+    CallUnknown,
+    DeclVar,
+    InstructionBase,
+    LoadVar,
+    Return_,
+    StoreField,
+    StoreIndex,
+    StoreVar,
+    Symbolic,
+)
+from interpreter.interprocedural.types import (
+    CallGraph,
+    FunctionEntry,
+    InstructionLocation,
+)
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.register import Register
+from interpreter.taint_types import TaintFlow, TaintPolicy
+
+_RECEIVERS = frozenset({"this", "self", "$this"})
+
+_CALLS = (CallFunction, CallMethod, CallUnknown, CallCtorFunction)
+
+
+@dataclass(frozen=True)
+class _Source:
+    """Where a tainted value came from: the source called, and the call."""
+
+    name: str
+    location: SourceLocation
+
+
+def _call_name(inst: InstructionBase) -> str:
+    if isinstance(inst, (CallFunction, CallCtorFunction)):
+        return str(inst.func_name)
+    if isinstance(inst, CallMethod):
+        return str(inst.method_name)
+    return ""
+
+
+class _Taint:
+    """The taint of every register, parameter and function result.
+
+    Registers, parameters and results only ever become tainted, so passes
+    over the program repeat until one taints nothing new.  Variables local
+    to one function are tracked per program point, within a pass.
+    """
+
+    def __init__(
+        self,
+        instructions: list[InstructionBase],
+        call_graph: CallGraph,
+        policy: TaintPolicy,
+    ):
+        self.cfg = build_cfg(instructions)
+        self.region = function_regions(self.cfg, instructions)
+        self.callees = {site.location: site.callees for site in call_graph.call_sites}
+        self.policy = policy
+        accessed_from: dict[str, set[str]] = {}
+        for label, block in self.cfg.blocks.items():
+            for inst in block.instructions:
+                name = accessed_variable(inst)
+                if name:
+                    regions = accessed_from.setdefault(name, set())
+                    regions.add(self.region.get(label, ""))
+        self.shared_names = {n for n, r in accessed_from.items() if len(r) > 1}
+
+        self.registers: dict[Register, _Source] = {}
+        self.shared: dict[str, _Source] = {}
+        self.params: dict[tuple[str, str], _Source] = {}
+        self.returns: dict[str, _Source] = {}
+        self.loaded: dict[Register, str] = {}
+        self.flows: dict[tuple[CodeLabel, int], TaintFlow] = {}
+
+    def run(self) -> tuple[TaintFlow, ...]:
+        while True:
+            size = self._size()
+            self._pass()
+            if self._size() == size:
+                break
+        return tuple(
+            sorted(
+                self.flows.values(),
+                key=lambda f: (f.location.start_line, f.location.start_col),
+            )
+        )
+
+    def _size(self) -> int:
+        return (
+            len(self.registers)
+            + len(self.shared)
+            + len(self.params)
+            + len(self.returns)
+            + len(self.flows)
+        )
+
+    def _pass(self) -> None:
+        problem: DataflowProblem[dict[str, _Source]] = DataflowProblem(
+            direction=Direction.FORWARD,
+            block_transfer=self._across,
+            merge=lambda state, other: {**other, **state},
+            initial={},
+            boundary={},
+        )
+        solve_dataflow(self.cfg, problem)
+
+    def _across(
+        self, block: BasicBlock, entry: dict[str, _Source]
+    ) -> dict[str, _Source]:
+        state = dict(entry)
+        for index, inst in enumerate(block.instructions):
+            self._step(block.label, index, inst, state)
+        return state
+
+    def _read(self, inst: InstructionBase) -> _Source | None:
+        """The source of the first tainted register *inst* reads, if any."""
+        return next(
+            (
+                self.registers[reg]
+                for reg in inst.reads()
+                if isinstance(reg, Register) and reg in self.registers
+            ),
+            None,
+        )
+
+    def _taint(self, reg: Register, source: _Source | None) -> None:
+        if source and reg.is_present():
+            self.registers.setdefault(reg, source)
+
+    def _taint_variable(
+        self, name: str, source: _Source | None, state: dict[str, _Source]
+    ) -> None:
+        """Taint *name* without cleaning it: it keeps any taint it had."""
+        if not source or not name:
+            return
+        if name in self.shared_names:
+            self.shared.setdefault(name, source)
+        else:
+            state.setdefault(name, source)
+
+    def _step(
+        self,
+        label: CodeLabel,
+        index: int,
+        inst: InstructionBase,
+        state: dict[str, _Source],
+    ) -> None:
+        function = self.region.get(label, "")
+        if isinstance(inst, Symbolic):
+            hint = str(inst.hint)
+            if hint.startswith(constants.PARAM_PREFIX):
+                param = hint[len(constants.PARAM_PREFIX) :]
+                self._taint(inst.result_reg, self.params.get((function, param)))
+        elif isinstance(inst, LoadVar):
+            name = str(inst.name)
+            self.loaded[inst.result_reg] = name
+            self._taint(inst.result_reg, state.get(name) or self.shared.get(name))
+        elif isinstance(inst, (DeclVar, StoreVar)):
+            name = str(inst.name)
+            source = self.registers.get(inst.value_reg)
+            if name in self.shared_names:
+                self._taint_variable(name, source, state)
+            elif source:
+                state[name] = source
+            else:
+                state.pop(name, None)
+        elif isinstance(inst, (StoreField, StoreIndex)):
+            obj = inst.obj_reg if isinstance(inst, StoreField) else inst.arr_reg
+            source = self._read(inst)
+            self._taint(obj, source)
+            self._taint_variable(self.loaded.get(obj, ""), source, state)
+        elif isinstance(inst, Return_):
+            if function and inst.value_reg in self.registers:
+                self.returns.setdefault(function, self.registers[inst.value_reg])
+        elif isinstance(inst, _CALLS):
+            self._call(label, index, inst, state)
+        elif isinstance(inst.writes(), Register):
+            self._taint(inst.result_reg, self._read(inst))
+
+    def _call(
+        self,
+        label: CodeLabel,
+        index: int,
+        inst: InstructionBase,
+        state: dict[str, _Source],
+    ) -> None:
+        name = _call_name(inst)
+        tainted = self._read(inst)
+        if name and self.policy.is_sink(name):
+            argument = next(
+                (
+                    self.registers[arg]
+                    for arg in inst.args
+                    if isinstance(arg, Register) and arg in self.registers
+                ),
+                None,
+            )
+            if argument:
+                self.flows[(label, index)] = TaintFlow(
+                    inst.source_location, name, argument.name, argument.location
+                )
+        if name and self.policy.is_source(name):
+            self._taint(inst.result_reg, _Source(name, inst.source_location))
+            return
+        if name and self.policy.is_sanitizer(name):
+            return
+        reached = self.callees.get(InstructionLocation(label, index), frozenset())
+        if not reached:
+            self._taint(inst.result_reg, tainted)
+            if isinstance(inst, CallMethod) and tainted:
+                receiver = self.loaded.get(inst.obj_reg, "")
+                self._taint_variable(receiver, tainted, state)
+            return
+        for callee in reached:
+            self._enter(callee, inst)
+            self._taint(inst.result_reg, self.returns.get(str(callee.label)))
+
+    def _enter(self, callee: FunctionEntry, inst: InstructionBase) -> None:
+        """Taint the parameters of *callee* its call *inst* passes taint to."""
+        operands = list(inst.args)
+        params = list(callee.params)
+        if isinstance(inst, CallMethod) and params and params[0] in _RECEIVERS:
+            operands.insert(0, inst.obj_reg)
+        for operand, param in zip(operands, params):
+            if isinstance(operand, Register) and operand in self.registers:
+                key = (str(callee.label), param)
+                self.params.setdefault(key, self.registers[operand])
+
+
+def find_taint_flows(
+    instructions: list[InstructionBase], call_graph: CallGraph, policy: TaintPolicy
+) -> tuple[TaintFlow, ...]:
+    """Every call of a sink of *policy* that may be passed an untrusted value.
+
+    *call_graph* was built from *instructions*.  Flows are in source order,
+    one per sink call, each with the first source found reaching it.
+    """
+    return _Taint(instructions, call_graph, policy).run()
@@ -0,0 +1,95 @@
+# pyright: standard
+"""Taint — untrusted values, and the calls they must not reach unsanitized."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, replace
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, SecondarySpan, Severity
+from interpreter.ir import SourceLocation
+
+
+def _matches(names: frozenset[str], name: str) -> bool:
+    """Whether *names* has *name*, or the last part of a dotted *name*."""
+    return name in names or name.rsplit(".", 1)[-1] in names
+
+
+@dataclass(frozen=True)
+class TaintPolicy:
+    """Which calls taint a value, which must not receive one, and which
+    clean one.
+
+    A *source* returns an untrusted value, e.g. ``input``; a *sink* must not
+    be passed one, e.g. ``eval``; a *sanitizer* returns a trusted value
+    whatever it is passed, e.g. ``int``.  A name matches a call by its
+    whole name (``os.system``) or its last part (``system``).
+    """
+
+    sources: frozenset[str] = frozenset()
+    sinks: frozenset[str] = frozenset()
+    sanitizers: frozenset[str] = frozenset()
+
+    def with_sources(self, *names: str) -> TaintPolicy:
+        return replace(self, sources=self.sources | set(names))
+
+    def with_sinks(self, *names: str) -> TaintPolicy:
+        return replace(self, sinks=self.sinks | set(names))
+
+    def with_sanitizers(self, *names: str) -> TaintPolicy:
+        return replace(self, sanitizers=self.sanitizers | set(names))
+
+    def is_source(self, name: str) -> bool:
+        return _matches(self.sources, name)
+
+    def is_sink(self, name: str) -> bool:
+        return _matches(self.sinks, name)
+
+    def is_sanitizer(self, name: str) -> bool:
+        return _matches(self.sanitizers, name)
+
+
+# User input and the environment, flowing into code evaluation, shell
+# commands and database queries, unless parsed as a number or escaped.
+DEFAULT_POLICY = TaintPolicy(
+    sources=frozenset(
+        {"input", "raw_input", "gets", "getenv", "Getenv"}
+        | {"readLine", "readline", "nextLine", "Scan", "Scanln", "Scanf"}
+    ),
+    sinks=frozenset(
+        {"eval", "exec", "system", "popen", "Command"}
+        | {"execute", "executeQuery", "executeUpdate", "query", "Query", "Exec"}
+    ),
+    sanitizers=frozenset(
+        {"int", "float", "parseInt", "parseDouble", "Atoi", "ParseInt"}
+        | {"escape", "quote", "escapeHtml", "sanitize"}
+    ),
+)
+
+
+@dataclass(frozen=True)
+class TaintFlow:
+    """An untrusted value from *source*, called at *source_location*,
+    reaching a call of *sink* at *location* without being sanitized."""
+
+    location: SourceLocation
+    sink: str
+    source: str
+    source_location: SourceLocation
+
+    @property
+    def message(self) -> str:
+        return f"untrusted value from {self.source} reaches {self.sink}"
+
+    def as_diagnostic(self) -> Diagnostic:
+        return Diagnostic(
+            code=DiagnosticCode.TAINTED_FLOW,
+            severity=Severity.WARNING,
+            location=self.location,
+            message=self.message,
+            secondary=(
+                SecondarySpan(self.source_location, f"{self.source} called here"),
+            ),
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: warning: {self.message}"
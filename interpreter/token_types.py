@@ -0,0 +1,85 @@
+# pyright: standard
+"""Token data types (pure data, no business logic)."""
+
+from __future__ import annotations
+
+import sys
+from dataclasses import dataclass, field
+from enum import Enum
+from functools import cached_property
+
+from interpreter.ir import SourceLocation
+from interpreter.stream_source import SourceBytes
+
+
+class TokenCategory(str, Enum):
+    """What a token is, across languages — a syntax highlighter's classes."""
+
+    KEYWORD = "keyword"
+    IDENTIFIER = "identifier"
+    TYPE = "type"
+    NUMBER = "number"
+    STRING = "string"
+    COMMENT = "comment"
+    OPERATOR = "operator"
+    PUNCTUATION = "punctuation"
+    ERROR = "error"
+    OTHER = "other"
+    END = "end"
+
+
+@dataclass(frozen=True)
+class Token:
+    """One token of the source.
+
+    *kind* is the grammar's name for it (``"identifier"``, ``"+="``).
+    Strings and comments are one token each, quotes and interpolations
+    included.
+
+    A token is a span of the shared *source* (the file's bytes, or the
+    ``StreamSource`` it was scanned from): its byte offsets and its
+    tree-sitter (row, byte column) points.  Its ``text`` and ``location``
+    are built the first time they are asked for, so scanning a file costs
+    no string or location per token, and a tool that reads only kinds and
+    categories never pays for them.  Texts are interned, so the many
+    occurrences of a name or keyword share one string.
+    """
+
+    kind: str
+    category: TokenCategory
+    source: SourceBytes = field(repr=False)
+    start_byte: int
+    end_byte: int
+    start_point: tuple[int, int]
+    end_point: tuple[int, int]
+
+    @cached_property
+    def text(self) -> str:
+        raw = self.source[self.start_byte : self.end_byte]
+        return sys.intern(raw.decode("utf-8", errors="replace"))
+
+    @cached_property
+    def location(self) -> SourceLocation:
+        return SourceLocation(
+            start_line=self.start_point[0] + 1,
+            start_col=self.start_point[1],
+            end_line=self.end_point[0] + 1,
+            end_col=self.end_point[1],
+            start_byte=self.start_byte,
+            end_byte=self.end_byte,
+        )
+
+    def is_end(self) -> bool:
+        return self.category == TokenCategory.END
+
+
+# Row -1 puts END_TOKEN's location at line 0: NO_SOURCE_LOCATION.
+END_TOKEN = Token(
+    kind="",
+    category=TokenCategory.END,
+    source=b"",
+    start_byte=0,
+    end_byte=0,
+    start_point=(-1, 0),
+    end_point=(-1, 0),
+)
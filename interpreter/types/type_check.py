@@ -0,0 +1,450 @@
+# pyright: standard
+"""Static type checking — reject ill-typed programs before they run.
+
+Inference (``infer_types``) is permissive by design: it types what it can
+and leaves the rest UNKNOWN, and the VM then runs whatever it is given.
+This pass builds on its results, assigns a type to every expression (every
+instruction that produces a value), and reports the operations a
+statically typed language would refuse to compile.  For example, in::
+
+    if (dna[i] == "G") { ... }          // Java, Kotlin, C#, C, ...
+
+``dna[i]`` on a String is a character, so comparing it with a string
+literal is an error, as is indexing a String at all in Java or Rust.
+Calls are checked against the function they name: the number of
+arguments, the type of each, and that the name is a function at all.
+
+Only languages that check types at compile time are checked, and only
+operations on operands of a known scalar type (``Int``, ``Float``,
+``String``, ``Bool``) are judged, so an expression the inference could not
+type never causes an error.  Character literals lower to their code point,
+so characters check as ``Int``.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from types import MappingProxyType
+
+from interpreter import constants
+from interpreter.cfg import CFG, build_cfg
+from interpreter.constants import FoundationTypeName, Language
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, SecondarySpan, Severity
+from interpreter.instructions import (
+    Binop,
+    CallFunction,
+    CallUnknown,
+    Const,
+    DeclVar,
+    InstructionBase,
+    Label_,
+    LoadIndex,
+    LoadVar,
+    Symbolic,
+    Unop,
+)
+from interpreter.ir import (
+    NO_SOURCE_LOCATION,
+    CodeLabel,
+    SourceLocation,
+    SpreadArguments,
+)
+from interpreter.operator_kind import BinopKind, UnopKind
+from interpreter.register import Register
+from interpreter.types.type_environment import TypeEnvironment
+from interpreter.types.type_expr import (
+    FunctionType,
+    ParameterizedType,
+    TypeExpr,
+    scalar,
+)
+from interpreter.var_name import VarName
+
+_ANY = scalar(FoundationTypeName.ANY)
+_BOOL = scalar(FoundationTypeName.BOOL)
+_INT = scalar(FoundationTypeName.INT)
+_STRING = scalar(FoundationTypeName.STRING)
+
+STATICALLY_TYPED_LANGUAGES: frozenset[Language] = frozenset(
+    {
+        Language.C,
+        Language.CPP,
+        Language.CSHARP,
+        Language.GO,
+        Language.JAVA,
+        Language.KOTLIN,
+        Language.PASCAL,
+        Language.RUST,
+        Language.SCALA,
+        Language.TYPESCRIPT,
+    }
+)
+
+# What ``s[i]`` on a String gives; a language missing here cannot index one.
+_STRING_ELEMENT: dict[Language, TypeExpr] = {
+    Language.C: _INT,
+    Language.CPP: _INT,
+    Language.CSHARP: _INT,
+    Language.GO: _INT,  # a byte
+    Language.KOTLIN: _INT,
+    Language.PASCAL: _STRING,  # Pascal's char is a one-character string
+    Language.SCALA: _INT,
+    Language.TYPESCRIPT: _STRING,
+}
+
+# C and C++ treat a Bool as an integer and a string literal as a pointer.
+_BOOL_IS_NUMERIC = frozenset({Language.C, Language.CPP})
+_POINTER_STRINGS = frozenset({Language.C, Language.CPP})
+
+_COMPARISONS = frozenset(
+    {
+        BinopKind.EQ,
+        BinopKind.NE,
+        BinopKind.LT,
+        BinopKind.GT,
+        BinopKind.LE,
+        BinopKind.GE,
+        BinopKind.STRICT_EQ,
+    }
+)
+_NUMERIC_ONLY = frozenset({BinopKind.SUB, BinopKind.DIV, BinopKind.MOD})
+
+_INDEXABLE = (FoundationTypeName.ARRAY.value, FoundationTypeName.POINTER.value)
+
+_NUMERIC = "number"
+
+# The receiver of a method, which no call site passes as an argument.
+_RECEIVERS = frozenset({"this", "$this"})
+
+
+@dataclass(frozen=True)
+class TypeNote:
+    """Where something an error refers to is, e.g. ``f declared here``."""
+
+    location: SourceLocation
+    message: str
+
+    def __str__(self) -> str:
+        return f"{self.location}: note: {self.message}"
+
+
+@dataclass(frozen=True)
+class TypeDiagnostic:
+    """A type error at *location*, e.g. ``cannot compare Int with String``.
+
+    *code* tells the kind of error apart from its wording.  *notes* point at
+    related code, such as the declaration of a function called with the
+    wrong arguments.
+    """
+
+    location: SourceLocation
+    code: DiagnosticCode
+    message: str
+    notes: tuple[TypeNote, ...] = ()
+
+    def as_diagnostic(self) -> Diagnostic:
+        return Diagnostic(
+            code=self.code,
+            severity=Severity.ERROR,
+            location=self.location,
+            message=self.message,
+            secondary=tuple(
+                SecondarySpan(note.location, note.message) for note in self.notes
+            ),
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: {self.message}"
+
+
+@dataclass(frozen=True)
+class _Function:
+    """A declared function as its call sites see it.
+
+    *params* are the registers its parameters arrive in, in order.  A
+    *flexible* function reads ``arguments`` itself — it is variadic or has
+    default parameters — so any number of arguments may be passed.
+    """
+
+    name: str
+    params: tuple[Register, ...]
+    flexible: bool
+    location: SourceLocation
+
+    def accepts(self, count: int) -> bool:
+        return self.flexible or count == len(self.params)
+
+    def notes(self) -> tuple[TypeNote, ...]:
+        if self.location.is_unknown():
+            return ()
+        return (TypeNote(self.location, f"{self.name} declared here"),)
+
+
+def _body(cfg: CFG, entry: CodeLabel) -> list[InstructionBase]:
+    """The instructions of the function entered at *entry*, in order.
+
+    Functions declared inside it are branched around, so they are not part
+    of its body.
+    """
+    reached: set[CodeLabel] = set()
+    pending = [entry]
+    while pending:
+        label = pending.pop()
+        if label not in reached and label in cfg.blocks:
+            reached.add(label)
+            pending.extend(cfg.blocks[label].successors)
+    return [
+        inst
+        for label, block in cfg.blocks.items()
+        if label in reached
+        for inst in block.instructions
+    ]
+
+
+def _functions(instructions: list[InstructionBase]) -> dict[str, list[_Function]]:
+    """Every named function in *instructions*, by the name calls use.
+
+    A function is named by the ``DECL_VAR`` that binds its reference; a
+    lambda bound to a variable is not a declaration and is left out.  A
+    function is located at the instruction just before its entry label,
+    the ``BRANCH`` around its body.
+    """
+    cfg = build_cfg(instructions)
+    refs: dict[Register, CodeLabel] = {}
+    locations: dict[CodeLabel, SourceLocation] = {}
+    functions: dict[str, list[_Function]] = {}
+    for previous, inst in zip([None, *instructions], instructions):
+        if isinstance(inst, Label_) and previous is not None:
+            locations[inst.label] = previous.source_location
+        elif isinstance(inst, Const) and isinstance(inst.type_expr, FunctionType):
+            refs[inst.result_reg] = CodeLabel(str(inst.value))
+        elif isinstance(inst, DeclVar) and inst.value_reg in refs:
+            label = refs[inst.value_reg]
+            name = str(inst.name)
+            declared = label.extract_name(constants.FUNC_LABEL_PREFIX)
+            if label not in cfg.blocks or name.rsplit(".", 1)[-1] != declared:
+                continue
+            body = _body(cfg, label)
+            params = tuple(
+                i.result_reg
+                for i in body
+                if isinstance(i, Symbolic)
+                and str(i.hint).startswith(constants.PARAM_PREFIX)
+                and str(i.hint)[len(constants.PARAM_PREFIX) :] not in _RECEIVERS
+            )
+            flexible = any(
+                isinstance(i, LoadVar) and str(i.name) == "arguments" for i in body
+            )
+            functions.setdefault(name, []).append(
+                _Function(
+                    name, params, flexible, locations.get(label, NO_SOURCE_LOCATION)
+                )
+            )
+    return functions
+
+
+@dataclass(frozen=True)
+class TypeCheckResult:
+    """The type of every expression, and the type errors found.
+
+    *expression_types* maps every register an instruction writes to its
+    type — ``Any`` where nothing more specific is known.
+    """
+
+    expression_types: MappingProxyType[Register, TypeExpr]
+    errors: tuple[TypeDiagnostic, ...]
+
+    @property
+    def ok(self) -> bool:
+        return not self.errors
+
+
+class IllTypedProgramError(Exception):
+    """A program failed type checking; carries every error found."""
+
+    def __init__(self, errors: tuple[TypeDiagnostic, ...]):
+        self.errors = errors
+        first = errors[0]
+        more = f" (and {len(errors) - 1} more)" if len(errors) > 1 else ""
+        super().__init__(f"type error at {first.location}: {first.message}{more}")
+
+
+def _category(type_expr: TypeExpr, language: Language) -> str:
+    """The kind of value *type_expr* holds, or "" if it can't be judged."""
+    if type_expr in (_INT, scalar(FoundationTypeName.FLOAT)):
+        return _NUMERIC
+    if type_expr == _BOOL:
+        return _NUMERIC if language in _BOOL_IS_NUMERIC else str(_BOOL)
+    if type_expr == _STRING:
+        return str(_STRING)
+    return ""
+
+
+class _Checker:
+    def __init__(
+        self,
+        type_env: TypeEnvironment,
+        language: Language,
+        functions: dict[str, list[_Function]],
+    ):
+        self.language = language
+        self.types: dict[Register, TypeExpr] = dict(type_env.register_types)
+        self.var_types = type_env.var_types
+        self.functions = functions
+        self.errors: list[TypeDiagnostic] = []
+
+    def type_of(self, reg: Register) -> TypeExpr:
+        return self.types.get(reg, _ANY) or _ANY
+
+    def refine(self, reg: Register, type_expr: TypeExpr) -> None:
+        """Type *reg* as *type_expr*, unless inference already typed it."""
+        if not self.types.get(reg):
+            self.types[reg] = type_expr
+
+    def error(
+        self,
+        inst: InstructionBase,
+        code: DiagnosticCode,
+        message: str,
+        notes: tuple[TypeNote, ...] = (),
+    ) -> None:
+        self.errors.append(TypeDiagnostic(inst.source_location, code, message, notes))
+
+    def check(self, inst: InstructionBase) -> None:
+        if isinstance(inst, Binop):
+            self.check_binop(inst)
+        elif isinstance(inst, Unop):
+            self.check_unop(inst)
+        elif isinstance(inst, LoadIndex):
+            self.check_load_index(inst)
+        elif isinstance(inst, CallFunction):
+            self.check_call(inst)
+        elif isinstance(inst, CallUnknown):
+            self.check_callable(inst, "", self.type_of(inst.target_reg))
+        if inst.result_reg.is_present():
+            self.types[inst.result_reg] = self.type_of(inst.result_reg)
+
+    def check_binop(self, inst: Binop) -> None:
+        left, right = self.type_of(inst.left), self.type_of(inst.right)
+        left_kind = _category(left, self.language)
+        right_kind = _category(right, self.language)
+        if inst.operator in _COMPARISONS:
+            self.refine(inst.result_reg, _BOOL)
+            if left_kind and right_kind and left_kind != right_kind:
+                self.error(
+                    inst,
+                    DiagnosticCode.INCOMPARABLE_TYPES,
+                    f"cannot compare {left} with {right}",
+                )
+            return
+        strings = self.language not in _POINTER_STRINGS and str(_STRING) in (
+            left_kind,
+            right_kind,
+        )
+        if inst.operator in _NUMERIC_ONLY and strings:
+            self.error(
+                inst,
+                DiagnosticCode.UNDEFINED_OPERATOR,
+                f"operator {inst.operator.value} is not defined on String",
+            )
+
+    def check_unop(self, inst: Unop) -> None:
+        operand = self.type_of(inst.operand)
+        if inst.operator == UnopKind.NEG and operand == _STRING:
+            self.error(
+                inst, DiagnosticCode.UNDEFINED_OPERATOR, "cannot negate a String"
+            )
+
+    def check_load_index(self, inst: LoadIndex) -> None:
+        container = self.type_of(inst.arr_reg)
+        if container == _STRING:
+            if self.language not in _STRING_ELEMENT:
+                self.error(
+                    inst, DiagnosticCode.NOT_INDEXABLE, "cannot index a String"
+                )
+                return
+            self.refine(inst.result_reg, _STRING_ELEMENT[self.language])
+        elif (
+            isinstance(container, ParameterizedType)
+            and container.constructor in _INDEXABLE
+            and container.arguments
+        ):
+            self.refine(inst.result_reg, container.arguments[0])
+
+    def check_call(self, inst: CallFunction) -> None:
+        """Check a call against the function it names.
+
+        An overloaded name only needs one overload that takes as many
+        arguments; a spread argument (``f(xs...)``) hides how many there are.
+        """
+        name = str(inst.func_name)
+        candidates = self.functions.get(name, [])
+        if not candidates:
+            self.check_callable(inst, name, self.var_types.get(VarName(name), _ANY))
+            return
+        if any(isinstance(arg, SpreadArguments) for arg in inst.args):
+            return
+        count = len(inst.args)
+        if len(candidates) > 1:
+            if not any(function.accepts(count) for function in candidates):
+                notes = tuple(note for f in candidates for note in f.notes())
+                message = f"no overload of {name} takes {count} arguments"
+                self.error(inst, DiagnosticCode.WRONG_ARGUMENT_COUNT, message, notes)
+            return
+        function = candidates[0]
+        if not function.accepts(count):
+            want = len(function.params)
+            amount = "not enough" if count < want else "too many"
+            self.error(
+                inst,
+                DiagnosticCode.WRONG_ARGUMENT_COUNT,
+                f"{amount} arguments in call to {name} (have {count}, want {want})",
+                function.notes(),
+            )
+            return
+        for position, (arg, param) in enumerate(zip(inst.args, function.params), 1):
+            arg_type, param_type = self.type_of(arg), self.type_of(param)
+            arg_kind = _category(arg_type, self.language)
+            param_kind = _category(param_type, self.language)
+            if arg_kind and param_kind and arg_kind != param_kind:
+                self.error(
+                    inst,
+                    DiagnosticCode.ARGUMENT_TYPE_MISMATCH,
+                    f"cannot use {arg_type} as {param_type} "
+                    f"in argument {position} to {name}",
+                    function.notes(),
+                )
+
+    def check_callable(
+        self, inst: InstructionBase, name: str, callee: TypeExpr
+    ) -> None:
+        """Reject calling a number, a String or a Bool."""
+        if not _category(callee, self.language):
+            return
+        what = f"non-function {name}" if name else "a value"
+        self.error(
+            inst, DiagnosticCode.NOT_CALLABLE, f"cannot call {what} of type {callee}"
+        )
+
+
+def check_types(
+    instructions: list[InstructionBase],
+    type_env: TypeEnvironment,
+    language: Language,
+) -> TypeCheckResult:
+    """Type every expression in *instructions* and collect the type errors.
+
+    *type_env* is the result of ``infer_types`` over the same instructions.
+    Languages outside ``STATICALLY_TYPED_LANGUAGES`` are typed but never
+    report errors.
+    """
+    checker = _Checker(type_env, language, _functions(instructions))
+    for inst in instructions:
+        checker.check(inst)
+    errors = (
+        tuple(checker.errors) if language in STATICALLY_TYPED_LANGUAGES else ()
+    )
+    return TypeCheckResult(
+        expression_types=MappingProxyType(checker.types),
+        errors=errors,
+    )
@@ -33,6 +33,9 @@ class TypeEnvironment:
     method_signatures: TypeExpr → {"name" → [FunctionSignature(...), ...]}
         Unified container for all signatures. Class methods keyed by class
         TypeExpr (e.g. ScalarType("Dog")), standalone functions keyed by UNKNOWN.
+    type_aliases:   "Celsius" → ScalarType("Float") for ``type Celsius = float64``
+    named_types:    "MyInt" → ScalarType("Int") for ``type MyInt int`` — a
+        distinct type whose values use its underlying type's representation.
     """
 
     register_types: MappingProxyType[Register, TypeExpr]
@@ -41,6 +44,7 @@ class TypeEnvironment:
         TypeExpr, MappingProxyType[FuncName, list[FunctionSignature]]
     ] = MappingProxyType({})
     type_aliases: MappingProxyType[TypeName, TypeExpr] = MappingProxyType({})
+    named_types: MappingProxyType[TypeName, TypeExpr] = MappingProxyType({})
     interface_implementations: MappingProxyType[str, tuple[str, ...]] = (
         MappingProxyType({})
     )
@@ -40,6 +40,7 @@ class TypeEnvironmentBuilder:
         default_factory=dict
     )
     type_aliases: dict[TypeName, TypeExpr] = field(default_factory=dict)
+    named_types: dict[TypeName, TypeExpr] = field(default_factory=dict)
     interface_implementations: dict[str, list[str]] = field(default_factory=dict)
     var_scope_metadata: dict[str, VarScopeInfo] = field(default_factory=dict)
 
@@ -58,6 +59,7 @@ class TypeEnvironmentBuilder:
             ),
             method_signatures=MappingProxyType(unified),
             type_aliases=MappingProxyType(dict(self.type_aliases)),
+            named_types=MappingProxyType(dict(self.named_types)),
         )
 
 
@@ -391,7 +391,9 @@ def infer_types(
 
     Pure function — no mutation of the input instructions.
     """
-    aliases = type_env_builder.type_aliases
+    # Named types share their underlying type's representation and operators
+    # at runtime, so seeded types resolve through them as through aliases.
+    aliases = {**type_env_builder.named_types, **type_env_builder.type_aliases}
     ctx = _InferenceContext(
         register_types=_resolve_aliases_in_dict(
             type_env_builder.register_types, aliases
@@ -474,7 +476,8 @@ def infer_types(
         register_types=MappingProxyType(ctx.register_types),
         var_types=MappingProxyType(flat_vars),
         method_signatures=MappingProxyType(unified_sigs),
-        type_aliases=MappingProxyType(dict(aliases)),
+        type_aliases=MappingProxyType(dict(type_env_builder.type_aliases)),
+        named_types=MappingProxyType(dict(type_env_builder.named_types)),
         interface_implementations=MappingProxyType(
             {k: tuple(v) for k, v in type_env_builder.interface_implementations.items()}
         ),
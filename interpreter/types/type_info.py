@@ -0,0 +1,157 @@
+# pyright: standard
+"""TypeInfo — what checking found out about each piece of source.
+
+Modelled on go/types' ``Info``: once a program is lowered and checked,
+``TypeInfo`` records, by source span,
+
+- ``types``: the type of every expression,
+- ``defs``: the declaration (``Symbol``) every declaring name introduces,
+- ``uses``: the declaration every variable read or assignment refers to,
+- ``values``: the value of every constant expression, folded at compile
+  time where the frontend folds (Go's ``1 << iota``, ``KB * 1024``),
+
+so an editor can answer a hover, a go-to-definition or a find-references
+without lowering or inferring again::
+
+    info = api.type_info(source, "go")
+    info.type_at(SourcePosition(4, 9, 0))      # Int
+    info.object_at(SourcePosition(4, 9, 0))    # the Symbol of that name
+
+Lowering may emit several instructions for one node; an expression's type
+and value are those of the last of them, which computes the node's value.
+A span is ``(start_line, start_col, end_line, end_col)``.  A use of a
+named constant is in ``uses``; its value is in ``values`` at the
+expression it was declared with.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from types import MappingProxyType
+from typing import Any
+
+from interpreter.constants import FoundationTypeName
+from interpreter.frontends.scopes import NO_SYMBOL, Scope, Symbol
+from interpreter.instructions import Const, InstructionBase, LoadVar, StoreVar
+from interpreter.ir import SourceLocation, SourcePosition
+from interpreter.types.type_check import TypeCheckResult
+from interpreter.types.type_expr import UNKNOWN, TypeExpr, scalar
+
+Span = tuple[int, int, int, int]
+
+# The types of the constants whose value is kept.
+_CONSTANT_TYPES = frozenset(
+    scalar(name)
+    for name in (
+        FoundationTypeName.INT,
+        FoundationTypeName.FLOAT,
+        FoundationTypeName.STRING,
+        FoundationTypeName.BOOL,
+    )
+)
+
+
+def span_of(location: SourceLocation) -> Span:
+    """The key *location* has in a TypeInfo."""
+    return (
+        location.start_line,
+        location.start_col,
+        location.end_line,
+        location.end_col,
+    )
+
+
+def _innermost(spans: MappingProxyType[Span, Any], pos: SourcePosition) -> Span | None:
+    """The smallest span of *spans* around *pos*, or None if none is."""
+    point = (pos.line, pos.col)
+    around = [span for span in spans if span[:2] <= point < span[2:]]
+    return min(around, key=lambda s: (s[2] - s[0], s[3] - s[1]), default=None)
+
+
+@dataclass(frozen=True)
+class TypeInfo:
+    """The types, declarations and constant values of a checked program."""
+
+    types: MappingProxyType[Span, TypeExpr]
+    defs: MappingProxyType[Span, Symbol]
+    uses: MappingProxyType[Span, Symbol]
+    values: MappingProxyType[Span, Any]
+
+    def type_of(self, location: SourceLocation) -> TypeExpr:
+        """The type of the expression at *location*; UNKNOWN if none is."""
+        return self.types.get(span_of(location), UNKNOWN)
+
+    def object_of(self, location: SourceLocation) -> Symbol:
+        """The declaration the name at *location* introduces or refers to."""
+        span = span_of(location)
+        return self.defs.get(span) or self.uses.get(span) or NO_SYMBOL
+
+    def type_at(self, pos: SourcePosition) -> TypeExpr:
+        """The type of the innermost expression around *pos*."""
+        span = _innermost(self.types, pos)
+        return UNKNOWN if span is None else self.types[span]
+
+    def object_at(self, pos: SourcePosition) -> Symbol:
+        """The declaration of the innermost name around *pos*."""
+        names = MappingProxyType({**self.defs, **self.uses})
+        span = _innermost(names, pos)
+        return NO_SYMBOL if span is None else names[span]
+
+
+def _symbol(scopes: Scope, location: SourceLocation, resolved_name: str) -> Symbol:
+    """The declaration the IR name *resolved_name* at *location* refers to."""
+    for scope in scopes.scopes_at(location.pos()):
+        for symbol in scope.symbols:
+            if symbol.resolved_name == resolved_name:
+                return symbol
+    return NO_SYMBOL
+
+
+def _declarations(scope: Scope) -> dict[Span, Symbol]:
+    defs = {
+        span_of(symbol.location): symbol
+        for symbol in scope.symbols
+        if not symbol.location.is_unknown()
+    }
+    for child in scope.children:
+        defs.update(_declarations(child))
+    return defs
+
+
+def build_type_info(
+    instructions: list[InstructionBase], checked: TypeCheckResult, scopes: Scope
+) -> TypeInfo:
+    """The TypeInfo of *instructions*, checked into *checked*.
+
+    *scopes* are the scopes the frontend built while lowering them.
+    """
+    last: dict[Span, InstructionBase] = {}
+    uses: dict[Span, Symbol] = {}
+    for inst in instructions:
+        location = inst.source_location
+        if location.is_unknown():
+            continue
+        span = span_of(location)
+        if inst.result_reg.is_present():
+            last[span] = inst
+        if isinstance(inst, (LoadVar, StoreVar)):
+            symbol = _symbol(scopes, location, str(inst.name))
+            if symbol.is_present():
+                uses[span] = symbol
+
+    types = {
+        span: checked.expression_types[inst.result_reg]
+        for span, inst in last.items()
+        if inst.result_reg in checked.expression_types
+    }
+    values = {
+        span: inst.value
+        for span, inst in last.items()
+        if isinstance(inst, Const) and inst.type_expr in _CONSTANT_TYPES
+    }
+    return TypeInfo(
+        types=MappingProxyType(types),
+        defs=MappingProxyType(_declarations(scopes)),
+        uses=MappingProxyType(uses),
+        values=MappingProxyType(values),
+    )
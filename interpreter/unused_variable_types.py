@@ -0,0 +1,40 @@
+# pyright: standard
+"""UnusedVariable — a local or parameter that is declared but never read."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, Severity
+from interpreter.ir import SourceLocation
+
+
+@dataclass(frozen=True)
+class UnusedVariable:
+    """*name*, declared at *location*, is never read.
+
+    The message follows Go's: ``declared and not used: x``, or
+    ``parameter declared and not used: x`` for a parameter.
+    """
+
+    location: SourceLocation
+    name: str
+    is_parameter: bool
+    severity: Severity = Severity.WARNING
+
+    @property
+    def message(self) -> str:
+        what = "parameter declared" if self.is_parameter else "declared"
+        return f"{what} and not used: {self.name}"
+
+    def as_diagnostic(self) -> Diagnostic:
+        return Diagnostic(
+            code=DiagnosticCode.UNUSED_VARIABLE,
+            severity=self.severity,
+            location=self.location,
+            message=self.message,
+            notes=(f"rename it to _{self.name} if it is unused on purpose",),
+        )
+
+    def __str__(self) -> str:
+        return f"{self.location}: {self.severity.value}: {self.message}"
@@ -0,0 +1,97 @@
+# pyright: standard
+"""Unused variables — locals and parameters declared but never read.
+
+Go refuses to compile a function with a local it never uses; this reports
+the same thing for every frontend, parameters included::
+
+    func area(w, h int) int {
+        scale := 2            // declared and not used: scale
+        return w * w          // parameter declared and not used: h
+    }
+
+A variable is used if the IR reads it (``LOAD_VAR`` or ``ADDRESS_OF``)
+anywhere in the scope that declares it, nested functions included.  Only
+assigning to it is not a use.  Names starting with ``_`` (``_``, ``_unused``)
+are never reported, so a parameter a function must accept but does not need
+can say so, and neither is a method's ``self`` or ``this``.  Only variables
+declared in a function, or a block inside one, are checked: globals and
+fields may be used from anywhere.
+"""
+
+from __future__ import annotations
+
+from interpreter.diagnostic import Severity
+from interpreter.frontends.scopes import Scope, ScopeKind, SymbolKind
+from interpreter.instructions import AddressOf, InstructionBase, LoadVar
+from interpreter.ir import SourceLocation
+from interpreter.unused_variable_types import UnusedVariable
+
+# The receiver a method is called on, which a method need not use.
+_RECEIVERS = frozenset({"self", "this"})
+
+_CHECKED = frozenset({SymbolKind.VARIABLE, SymbolKind.PARAMETER})
+
+
+def _reads(instructions: list[InstructionBase]) -> dict[str, list[SourceLocation]]:
+    """Where each variable is read, by IR name."""
+    reads: dict[str, list[SourceLocation]] = {}
+    for inst in instructions:
+        if isinstance(inst, LoadVar):
+            reads.setdefault(str(inst.name), []).append(inst.source_location)
+        elif isinstance(inst, AddressOf):
+            reads.setdefault(str(inst.var_name), []).append(inst.source_location)
+    return reads
+
+
+def _is_read_in(scope: Scope, locations: list[SourceLocation]) -> bool:
+    return any(
+        scope.location.is_unknown()
+        or location.is_unknown()
+        or scope.contains(location.pos())
+        for location in locations
+    )
+
+
+def is_exempt(name: str) -> bool:
+    """``_``-prefixed names, receivers, and names lowering made up."""
+    return name.startswith("_") or name in _RECEIVERS or not name.isidentifier()
+
+
+def find_unused_variables(
+    scopes: Scope,
+    instructions: list[InstructionBase],
+    strict: bool = False,
+) -> tuple[UnusedVariable, ...]:
+    """The locals and parameters in *scopes* that *instructions* never read.
+
+    *scopes* and *instructions* come from lowering the same source.  Each is
+    reported as a warning, or as an error when *strict*; in source order.
+    """
+    reads = _reads(instructions)
+    severity = Severity.ERROR if strict else Severity.WARNING
+    unused: list[UnusedVariable] = []
+
+    def visit(scope: Scope, in_function: bool) -> None:
+        in_function = scope.kind == ScopeKind.FUNCTION or (
+            in_function and scope.kind == ScopeKind.BLOCK
+        )
+        if in_function:
+            unused.extend(
+                UnusedVariable(
+                    location=symbol.location,
+                    name=symbol.name,
+                    is_parameter=symbol.kind == SymbolKind.PARAMETER,
+                    severity=severity,
+                )
+                for symbol in scope.symbols
+                if symbol.kind in _CHECKED
+                and not is_exempt(symbol.name)
+                and not _is_read_in(scope, reads.get(symbol.resolved_name, []))
+            )
+        for child in scope.children:
+            visit(child, in_function)
+
+    visit(scopes, False)
+    return tuple(
+        sorted(unused, key=lambda u: (u.location.start_line, u.location.start_col))
+    )
@@ -0,0 +1,135 @@
+# pyright: standard
+"""Value ranges — intervals of numbers, and what they prove about operations."""
+
+from __future__ import annotations
+
+import math
+from dataclasses import dataclass
+from enum import Enum
+
+from interpreter.diagnostic import Diagnostic, DiagnosticCode, Severity
+from interpreter.ir import SourceLocation
+
+
+def _bound(value: float) -> str:
+    if math.isinf(value):
+        return "-inf" if value < 0 else "inf"
+    return str(int(value)) if value == int(value) else str(value)
+
+
+@dataclass(frozen=True)
+class Interval:
+    """The numbers from *lo* to *hi*, inclusive; either end may be infinite.
+
+    An interval with ``lo > hi`` is empty: no value reaches it.
+    """
+
+    lo: float = -math.inf
+    hi: float = math.inf
+
+    @classmethod
+    def of(cls, value: float) -> Interval:
+        return cls(value, value)
+
+    def is_empty(self) -> bool:
+        return self.lo > self.hi
+
+    def is_bounded(self) -> bool:
+        return not math.isinf(self.lo) and not math.isinf(self.hi)
+
+    def contains(self, value: float) -> bool:
+        return self.lo <= value <= self.hi
+
+    def join(self, other: Interval) -> Interval:
+        return Interval(min(self.lo, other.lo), max(self.hi, other.hi))
+
+    def meet(self, other: Interval) -> Interval:
+        return Interval(max(self.lo, other.lo), min(self.hi, other.hi))
+
+    def widen(self, other: Interval) -> Interval:
+        """*self* joined with *other*, with every end that grew made infinite,
+        so a loop's ranges stop growing."""
+        return Interval(
+            self.lo if other.lo >= self.lo else -math.inf,
+            self.hi if other.hi <= self.hi else math.inf,
+        )
+
+    def __add__(self, other: Interval) -> Interval:
+        return Interval(self.lo + other.lo, self.hi + other.hi)
+
+    def __sub__(self, other: Interval) -> Interval:
+        return Interval(self.lo - other.hi, self.hi - other.lo)
+
+    def __neg__(self) -> Interval:
+        return Interval(-self.hi, -self.lo)
+
+    def __mul__(self, other: Interval) -> Interval:
+        # 0 * inf is 0: a zero factor bounds the product whatever the other.
+        products = [
+            0.0 if 0 in (a, b) else a * b
+            for a in (self.lo, self.hi)
+            for b in (other.lo, other.hi)
+        ]
+        return Interval(min(products), max(products))
+
+    def __str__(self) -> str:
+        if self.lo == self.hi:
+            return _bound(self.lo)
+        return f"{_bound(self.lo)}..{_bound(self.hi)}"
+
+
+TOP = Interval()
+
+
+class Operation(str, Enum):
+    """An operation that fails on some values of its operands."""
+
+    INDEX = "index"
+    DIVISION = "division"
+
+
+class Verdict(str, Enum):
+    """What the value ranges prove about an operation."""
+
+    SAFE = "safe"  # never fails
+    UNSAFE = "unsafe"  # always fails
+    POSSIBLE = "possible"  # fails for some values the ranges allow
+    UNKNOWN = "unknown"  # the ranges do not bound the operands
+
+
+_CODES = {
+    Operation.INDEX: DiagnosticCode.INDEX_OUT_OF_BOUNDS,
+    Operation.DIVISION: DiagnosticCode.DIVISION_BY_ZERO,
+}
+
+
+@dataclass(frozen=True)
+class RangeCheck:
+    """What the value ranges prove about the *operation* at *location*."""
+
+    location: SourceLocation
+    operation: Operation
+    verdict: Verdict
+    message: str
+
+    @property
+    def is_problem(self) -> bool:
+        return self.verdict in (Verdict.UNSAFE, Verdict.POSSIBLE)
+
+    @property
+    def severity(self) -> Severity:
+        return Severity.ERROR if self.verdict == Verdict.UNSAFE else Severity.WARNING
+
+    def as_diagnostic(self) -> Diagnostic:
+        """An error for an operation that always fails, else a warning."""
+        return Diagnostic(
+            code=_CODES[self.operation],
+            severity=self.severity,
+            location=self.location,
+            message=self.message,
+        )
+
+    def __str__(self) -> str:
+        if self.is_problem:
+            return f"{self.location}: {self.severity.value}: {self.message}"
+        return f"{self.location}: {self.message}"
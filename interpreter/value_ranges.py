@@ -0,0 +1,584 @@
+# pyright: standard
+"""Value ranges — an interval analysis proving indexing and division safe.
+
+Each number is tracked as an interval, narrowed by the branches that guard
+it and widened at loops so the analysis ends::
+
+    func hammingDistance(s1 string, s2 string) int {
+        i := 0
+        for i < len(s1) {           // i is 0..inf, and less than len(s1)
+            if s1[i] != s2[i] {     // s1[i] is safe; s2[i] may not be:
+                ...                 //   i is bounded by the length of s1
+            }
+            i = i + 1
+        }
+
+Intervals alone cannot prove ``s1[i]`` safe when the length of ``s1`` is
+unknown, so a value also records the variables it was compared to as
+smaller — ``i < n`` — and the arrays whose length it is less than —
+``i < len(s1)``.  An index is safe when it is non-negative and less than
+its array's length, by a guard or by the intervals of both; an index
+bounded by something else, as when ``hammingDistance`` trusts a caller's
+``n`` against both strings, may be out of bounds.  A divisor is safe when
+its interval excludes zero.
+
+Lengths are known for string literals and arrays allocated with a constant
+size, and are read with ``len(s)``, ``#s``, ``s.length``, ``s.length()``,
+``s.size()`` and the like.  Indexing is checked in the languages where an
+index past the end is an error and indexes start at zero; the negative
+indexes of Python count from the end, so only their upper bound is checked.
+"""
+
+from __future__ import annotations
+
+import math
+from dataclasses import dataclass, replace
+from functools import reduce
+
+from interpreter.cfg import BasicBlock, build_cfg
+from interpreter.constants import Language
+from interpreter.dataflow_solver import solve_dataflow
+from interpreter.dataflow_solver_types import DataflowProblem, Direction
+from interpreter.definite_assignment import source_names
+from interpreter.frontends.scopes import Scope
+from interpreter.instructions import (
+    Binop,
+    BranchIf,
+    CallFunction,
+    CallMethod,
+    Const,
+    DeclVar,
+    InstructionBase,
+    LoadField,
+    LoadIndex,
+    LoadVar,
+    NewArray,
+    StoreIndex,
+    StoreVar,
+    Unop,
+)
+from interpreter.ir import CodeLabel
+from interpreter.operator_kind import BinopKind, UnopKind
+from interpreter.register import Register
+from interpreter.value_range_types import (
+    TOP,
+    Interval,
+    Operation,
+    RangeCheck,
+    Verdict,
+)
+
+# Languages where indexing past the end of an array or string is an error.
+BOUNDS_CHECKED_LANGUAGES: frozenset[Language] = frozenset(
+    {
+        Language.PYTHON,
+        Language.JAVA,
+        Language.GO,
+        Language.CSHARP,
+        Language.C,
+        Language.CPP,
+        Language.RUST,
+        Language.KOTLIN,
+        Language.SCALA,
+    }
+)
+# Of those, the languages where a negative index counts from the end.
+_NEGATIVE_INDEX_LANGUAGES = frozenset({Language.PYTHON})
+
+_LENGTH_FUNCTIONS = frozenset({"len", "__go_len__", "strlen", "count", "sizeof"})
+_LENGTH_MEMBERS = frozenset({"length", "Length", "size", "len", "Count", "count"})
+# Calls a frontend emits to check ``a[i]`` before reading the element; the
+# guard is checked in place of the read it guards.
+_INDEX_GUARDS = frozenset({"__go_in_bounds__"})
+_GROWING_METHODS = frozenset({"append", "add", "push", "insert", "extend", "put"})
+_SHRINKING_METHODS = frozenset(
+    {"pop", "remove", "removeAt", "clear", "delete", "shift", "splice", "truncate"}
+)
+_DIVISIONS = frozenset(
+    {BinopKind.DIV, BinopKind.FLOOR_DIV, BinopKind.MOD, BinopKind.MOD_WORD}
+)
+# Each comparison operator, as one of the six the analysis narrows by.
+_COMPARISONS = {
+    BinopKind.LT: BinopKind.LT,
+    BinopKind.LE: BinopKind.LE,
+    BinopKind.GT: BinopKind.GT,
+    BinopKind.GE: BinopKind.GE,
+    BinopKind.EQ: BinopKind.EQ,
+    BinopKind.STRICT_EQ: BinopKind.EQ,
+    BinopKind.NE: BinopKind.NE,
+    BinopKind.NE_LUA: BinopKind.NE,
+}
+# Each comparison, as it is when its operands swap sides.
+_MIRRORED = {
+    BinopKind.LT: BinopKind.GT,
+    BinopKind.LE: BinopKind.GE,
+    BinopKind.GT: BinopKind.LT,
+    BinopKind.GE: BinopKind.LE,
+    BinopKind.EQ: BinopKind.EQ,
+    BinopKind.NE: BinopKind.NE,
+}
+_NEGATED = {
+    BinopKind.LT: BinopKind.GE,
+    BinopKind.LE: BinopKind.GT,
+    BinopKind.GT: BinopKind.LE,
+    BinopKind.GE: BinopKind.LT,
+    BinopKind.EQ: BinopKind.NE,
+    BinopKind.NE: BinopKind.EQ,
+}
+# Visits of a block after which its entry ranges are widened.
+_WIDEN_AFTER = 2
+
+
+@dataclass(frozen=True)
+class _Value:
+    """What is known of one variable or register.
+
+    *below* holds the names of the variables it is less than, and
+    ``#name`` for the arrays whose length it is less than; *size* is its
+    length, when it is an array or string; *length_of* names the arrays
+    whose length it is.
+    """
+
+    interval: Interval = TOP
+    below: frozenset[str] = frozenset()
+    size: Interval = TOP
+    length_of: frozenset[str] = frozenset()
+
+    def join(self, other: _Value) -> _Value:
+        return _Value(
+            self.interval.join(other.interval),
+            self.below & other.below,
+            self.size.join(other.size),
+            self.length_of & other.length_of,
+        )
+
+    def widen(self, other: _Value) -> _Value:
+        return _Value(
+            self.interval.widen(other.interval),
+            self.below & other.below,
+            self.size.widen(other.size),
+            self.length_of & other.length_of,
+        )
+
+    def without(self, names: frozenset[str]) -> _Value:
+        """This value, forgetting its relations to *names*."""
+        return replace(self, below=self.below - names, length_of=self.length_of - names)
+
+
+_UNKNOWN = _Value()
+
+_State = dict[str, _Value]
+
+
+def _join(state: _State, other: _State) -> _State:
+    """What holds on both *state* and *other*; a name missing is unknown."""
+    return {
+        name: value.join(other[name])
+        for name, value in state.items()
+        if name in other
+    }
+
+
+def _merge(state: _State | None, other: _State | None) -> _State | None:
+    """*state* joined with *other*, where None is a state not yet reached."""
+    if state is None:
+        return other
+    if other is None:
+        return state
+    return _join(state, other)
+
+
+def _widen(previous: _State | None, merged: _State | None) -> _State | None:
+    """*merged*, with each value widened from what it was in *previous*."""
+    if previous is None or merged is None:
+        return merged
+    return {
+        name: value.widen(merged[name])
+        for name, value in previous.items()
+        if name in merged
+    }
+
+
+@dataclass(frozen=True)
+class _Comparison:
+    operator: BinopKind
+    left: Register
+    right: Register
+
+
+class _Ranges:
+    """The value ranges at each point of one program, and the checks they
+    decide."""
+
+    def __init__(
+        self,
+        instructions: list[InstructionBase],
+        names: dict[str, str],
+        language: Language,
+    ):
+        self.cfg = build_cfg(instructions)
+        self.names = names
+        self.bounds_checked = language in BOUNDS_CHECKED_LANGUAGES
+        self.negative_checked = language not in _NEGATIVE_INDEX_LANGUAGES
+        self.loaded: dict[Register, str] = {}
+        self.comparisons: dict[Register, _Comparison] = {}
+        self.guarded: set[tuple[Register, Register]] = set()
+        self.related: set[str] = set()
+        self.checks: dict[tuple[CodeLabel, int], RangeCheck] = {}
+
+    def run(self) -> tuple[RangeCheck, ...]:
+        problem: DataflowProblem[_State | None] = DataflowProblem(
+            direction=Direction.FORWARD,
+            block_transfer=self._across,
+            edge=self._along,
+            merge=_merge,
+            initial=None,
+            boundary={},
+            widen=_widen,
+            widen_after=_WIDEN_AFTER,
+        )
+        solve_dataflow(self.cfg, problem)
+        return tuple(
+            sorted(
+                self.checks.values(),
+                key=lambda c: (c.location.start_line, c.location.start_col),
+            )
+        )
+
+    def _across(self, block: BasicBlock, entry: _State | None) -> _State | None:
+        """The state at the end of *block*; checks are recorded on the way,
+        each visit replacing what the last one found."""
+        if entry is None:
+            return None
+        state = dict(entry)
+        for index, inst in enumerate(block.instructions):
+            self._step(block.label, index, inst, state)
+        return state
+
+    # ── transfer ────────────────────────────────────────────────
+
+    def _define(self, state: _State, name: str, value: _Value) -> None:
+        """Give *name* *value*, forgetting what other values said of it."""
+        if name in self.related:
+            gone = frozenset({name, f"#{name}"})
+            for other, known in list(state.items()):
+                if known.below & gone or name in known.length_of:
+                    state[other] = known.without(gone)
+            value = value.without(gone)
+        state[name] = value
+
+    def _value(self, state: _State, reg: Register) -> _Value:
+        return state.get(str(reg), _UNKNOWN)
+
+    def _name(self, reg: Register) -> str:
+        """The variable *reg* was loaded from, or *reg* itself."""
+        return self.loaded.get(reg, str(reg))
+
+    def _bounds(self, reg: Register, value: _Value) -> frozenset[str]:
+        """What a value less than *reg*, which holds *value*, is less than."""
+        bounds = {f"#{name}" for name in value.length_of}
+        if reg in self.loaded:
+            bounds.add(self.loaded[reg])
+        self.related.update(b.removeprefix("#") for b in bounds)
+        return frozenset(bounds)
+
+    def _length(self, state: _State, reg: Register, obj: Register) -> None:
+        """*reg* is the length of the array or string in *obj*."""
+        name = self._name(obj)
+        self.related.add(name)
+        size = self._value(state, obj).size.meet(Interval(0, math.inf))
+        self._define(state, str(reg), _Value(size, length_of=frozenset({name})))
+
+    def _step(
+        self, label: CodeLabel, index: int, inst: InstructionBase, state: _State
+    ) -> None:
+        if isinstance(inst, Const):
+            self._define(state, str(inst.result_reg), _constant(inst.value))
+        elif isinstance(inst, LoadVar):
+            self.loaded[inst.result_reg] = str(inst.name)
+            value = state.get(str(inst.name), _UNKNOWN)
+            self._define(state, str(inst.result_reg), value)
+        elif isinstance(inst, (DeclVar, StoreVar)):
+            self._define(state, str(inst.name), self._value(state, inst.value_reg))
+        elif isinstance(inst, NewArray):
+            size = self._value(state, inst.size_reg).interval
+            self._define(state, str(inst.result_reg), _Value(size=size))
+        elif isinstance(inst, Binop):
+            self._binop(label, index, inst, state)
+        elif isinstance(inst, Unop):
+            self._unop(inst, state)
+        elif isinstance(inst, (LoadIndex, StoreIndex)):
+            if (inst.arr_reg, inst.index_reg) not in self.guarded:
+                self._check_index(
+                    label, index, inst, inst.arr_reg, inst.index_reg, state
+                )
+            if isinstance(inst, LoadIndex):
+                self._define(state, str(inst.result_reg), _UNKNOWN)
+        elif isinstance(inst, LoadField) and str(inst.field_name) in _LENGTH_MEMBERS:
+            self._length(state, inst.result_reg, inst.obj_reg)
+        elif isinstance(inst, CallMethod):
+            self._method(inst, state)
+        elif (
+            isinstance(inst, CallFunction)
+            and str(inst.func_name) in _INDEX_GUARDS
+            and len(inst.args) == 2
+            and all(isinstance(arg, Register) for arg in inst.args)
+        ):
+            arr, idx = inst.args
+            self.guarded.add((arr, idx))
+            self._check_index(label, index, inst, arr, idx, state)
+            self._define(state, str(inst.result_reg), _UNKNOWN)
+        elif (
+            isinstance(inst, CallFunction)
+            and str(inst.func_name) in _LENGTH_FUNCTIONS
+            and len(inst.args) == 1
+            and isinstance(inst.args[0], Register)
+        ):
+            self._length(state, inst.result_reg, inst.args[0])
+        elif isinstance(inst.writes(), Register):
+            self._define(state, str(inst.writes()), _UNKNOWN)
+
+    def _method(self, inst: CallMethod, state: _State) -> None:
+        method = str(inst.method_name)
+        if method in _LENGTH_MEMBERS and not inst.args:
+            self._length(state, inst.result_reg, inst.obj_reg)
+            return
+        self._define(state, str(inst.result_reg), _UNKNOWN)
+        name = self._name(inst.obj_reg)
+        if name not in state:
+            return
+        known = state[name]
+        if method in _GROWING_METHODS:
+            size = Interval(known.size.lo, math.inf)
+            self._define(state, name, replace(known, size=size))
+        elif method in _SHRINKING_METHODS:
+            size = Interval(0, known.size.hi)
+            self._define(state, name, replace(known, size=size))
+
+    def _unop(self, inst: Unop, state: _State) -> None:
+        operand = self._value(state, inst.operand)
+        if inst.operator == UnopKind.NEG:
+            self._define(state, str(inst.result_reg), _Value(-operand.interval))
+        elif inst.operator == UnopKind.LEN:
+            self._length(state, inst.result_reg, inst.operand)
+        else:
+            if inst.operator in (UnopKind.NOT, UnopKind.BANG):
+                comparison = self.comparisons.get(inst.operand)
+                if comparison:
+                    self.comparisons[inst.result_reg] = replace(
+                        comparison, operator=_NEGATED[comparison.operator]
+                    )
+            self._define(state, str(inst.result_reg), _UNKNOWN)
+
+    def _binop(self, label: CodeLabel, index: int, inst: Binop, state: _State) -> None:
+        left = self._value(state, inst.left)
+        right = self._value(state, inst.right)
+        operator = inst.operator
+        result = _UNKNOWN
+        if operator in _COMPARISONS:
+            self.comparisons[inst.result_reg] = _Comparison(
+                _COMPARISONS[operator], inst.left, inst.right
+            )
+        elif operator == BinopKind.ADD:
+            # Adding at most zero keeps a value below what it was below.
+            keeps = right.interval.hi <= 0
+            result = _Value(
+                left.interval + right.interval, left.below if keeps else frozenset()
+            )
+        elif operator == BinopKind.SUB:
+            keeps = right.interval.lo >= 0
+            result = _Value(
+                left.interval - right.interval, left.below if keeps else frozenset()
+            )
+        elif operator == BinopKind.MUL:
+            result = _Value(left.interval * right.interval)
+        elif operator in _DIVISIONS:
+            self._check_division(label, index, inst, right)
+            is_mod = operator in (BinopKind.MOD, BinopKind.MOD_WORD)
+            if is_mod and left.interval.lo >= 0 and right.interval.lo >= 1:
+                # 0 <= a % n < n
+                result = _Value(
+                    Interval(0, right.interval.hi - 1),
+                    self._bounds(inst.right, right),
+                )
+        self._define(state, str(inst.result_reg), result)
+
+    # ── branches ────────────────────────────────────────────────
+
+    def _along(
+        self, block: BasicBlock, successor: CodeLabel, state: _State | None
+    ) -> _State | None:
+        """The state along the edge from *block* to *successor*; None if no
+        value can take it."""
+        if state is None:
+            return None
+        last = block.instructions[-1] if block.instructions else None
+        if not isinstance(last, BranchIf) or last.cond_reg not in self.comparisons:
+            return state
+        comparison = self.comparisons[last.cond_reg]
+        refined = [
+            self._refine(
+                state,
+                comparison.operator if holds else _NEGATED[comparison.operator],
+                comparison,
+            )
+            for target, holds in zip(last.branch_targets, (True, False))
+            if target == successor
+        ]
+        return reduce(_merge, refined, None)
+
+    def _refine(
+        self, state: _State, operator: BinopKind, comparison: _Comparison
+    ) -> _State | None:
+        """*state* where ``left operator right`` holds; None if it never does."""
+        refined = dict(state)
+        sides = [
+            (comparison.left, operator, comparison.right),
+            (comparison.right, _MIRRORED[operator], comparison.left),
+        ]
+        for reg, op, other in sides:
+            value = self._value(state, reg)
+            bound = self._value(state, other)
+            interval = _narrow(value.interval, op, bound.interval)
+            if interval.is_empty():
+                return None
+            below = self._bounds(other, bound) if op == BinopKind.LT else frozenset()
+            narrowed = replace(value, interval=interval, below=value.below | below)
+            refined[str(reg)] = narrowed
+            name = self.loaded.get(reg)
+            if name is not None and state.get(name, _UNKNOWN) == value:
+                refined[name] = narrowed
+        return refined
+
+    # ── checks ──────────────────────────────────────────────────
+
+    def _describe(self, reg: Register, value: _Value) -> str:
+        """The variable *reg* was loaded from, or else its range."""
+        return self.names.get(self.loaded.get(reg, ""), "") or str(value.interval)
+
+    def _record(
+        self,
+        label: CodeLabel,
+        index: int,
+        inst: InstructionBase,
+        operation: Operation,
+        verdict: Verdict,
+        message: str,
+    ) -> None:
+        if inst.source_location.is_unknown():
+            return
+        self.checks[(label, index)] = RangeCheck(
+            inst.source_location, operation, verdict, message
+        )
+
+    def _check_index(
+        self,
+        label: CodeLabel,
+        index: int,
+        inst: InstructionBase,
+        arr: Register,
+        idx: Register,
+        state: _State,
+    ) -> None:
+        if not self.bounds_checked:
+            return
+        value = self._value(state, idx)
+        interval = value.interval
+        size = self._value(state, arr).size
+        array = self.names.get(self.loaded.get(arr, ""), "") or "the array"
+        named = self._describe(idx, value)
+        described = named if named == str(interval) else f"{named} ({interval})"
+        non_negative = interval.lo >= 0 or not self.negative_checked
+        if size.is_bounded() and interval.lo >= size.hi:
+            verdict = Verdict.UNSAFE
+            message = f"index {described} is out of bounds of {array}, of length {size}"
+        elif self.negative_checked and interval.hi < 0:
+            verdict = Verdict.UNSAFE
+            message = f"index {described} of {array} is negative"
+        elif non_negative and (
+            f"#{self._name(arr)}" in value.below or interval.hi < size.lo
+        ):
+            verdict = Verdict.SAFE
+            message = f"index {named} of {array} is in bounds"
+        elif value.below:
+            verdict = Verdict.POSSIBLE
+            bounds = " and ".join(sorted(self._bound_name(b) for b in value.below))
+            message = (
+                f"index {named} of {array} is bounded by {bounds}, "
+                f"not by the length of {array}"
+            )
+        elif size.is_bounded() and not math.isinf(interval.hi):
+            verdict = Verdict.POSSIBLE
+            message = (
+                f"index {described} may be out of bounds of {array}, of length {size}"
+            )
+        else:
+            verdict = Verdict.UNKNOWN
+            message = f"index {named} of {array} cannot be bounded"
+        self._record(label, index, inst, Operation.INDEX, verdict, message)
+
+    def _bound_name(self, bound: str) -> str:
+        name = self.names.get(bound.removeprefix("#"), "") or "a value"
+        return f"the length of {name}" if bound.startswith("#") else name
+
+    def _check_division(
+        self, label: CodeLabel, index: int, inst: Binop, divisor: _Value
+    ) -> None:
+        interval = divisor.interval
+        named = self._describe(inst.right, divisor)
+        divisor_text = "divisor" if named == str(interval) else f"divisor {named}"
+        if interval == Interval.of(0):
+            verdict = Verdict.UNSAFE
+            message = f"division by zero: {divisor_text} is 0"
+        elif not interval.contains(0):
+            verdict = Verdict.SAFE
+            message = f"{divisor_text} is never zero ({interval})"
+        elif interval.is_bounded() or 0 in (interval.lo, interval.hi):
+            verdict = Verdict.POSSIBLE
+            message = f"{divisor_text} may be zero ({interval})"
+        else:
+            verdict = Verdict.UNKNOWN
+            message = f"{divisor_text} cannot be bounded"
+        self._record(label, index, inst, Operation.DIVISION, verdict, message)
+
+
+def _constant(value: object) -> _Value:
+    if isinstance(value, bool):
+        return _UNKNOWN
+    if isinstance(value, (int, float)):
+        return _Value(Interval.of(value))
+    if isinstance(value, str):
+        return _Value(size=Interval.of(len(value)))
+    return _UNKNOWN
+
+
+def _narrow(interval: Interval, operator: BinopKind, bound: Interval) -> Interval:
+    """*interval* where ``value operator bound`` holds, for integers."""
+    if operator == BinopKind.LT:
+        return interval.meet(Interval(-math.inf, bound.hi - 1))
+    if operator == BinopKind.LE:
+        return interval.meet(Interval(-math.inf, bound.hi))
+    if operator == BinopKind.GT:
+        return interval.meet(Interval(bound.lo + 1, math.inf))
+    if operator == BinopKind.GE:
+        return interval.meet(Interval(bound.lo, math.inf))
+    if operator == BinopKind.EQ:
+        return interval.meet(bound)
+    if bound.lo == bound.hi:
+        # Excluding a value narrows an interval only at its ends.
+        lo = interval.lo + 1 if interval.lo == bound.lo else interval.lo
+        hi = interval.hi - 1 if interval.hi == bound.hi else interval.hi
+        return Interval(lo, hi)
+    return interval
+
+
+def find_range_checks(
+    scopes: Scope, instructions: list[InstructionBase], language: Language
+) -> tuple[RangeCheck, ...]:
+    """What the value ranges prove about each index and division, in
+    source order.
+
+    *scopes* and *instructions* come from lowering the same source in
+    *language*; indexing is checked only in ``BOUNDS_CHECKED_LANGUAGES``.
+    """
+    return _Ranges(instructions, source_names(scopes), language).run()
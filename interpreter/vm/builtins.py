@@ -4,23 +4,43 @@
 from __future__ import annotations
 
 import logging
+import math
+import unicodedata
+from functools import lru_cache
 from typing import Any
 
-from interpreter.address import Address
+from interpreter.address import NO_ADDRESS, Address
 from interpreter.cobol.byte_builtins import BYTE_BUILTINS
-from interpreter.constants import ARR_ADDR_PREFIX, FoundationTypeName
+from interpreter.constants import (
+    ARR_ADDR_PREFIX,
+    GO_ERROR_STRING_TYPE,
+    GOROUTINE_FRAME_NAME,
+    MAIN_FRAME_NAME,
+    OBJ_ADDR_PREFIX,
+    FoundationTypeName,
+)
 from interpreter.field_name import FieldKind, FieldName
 from interpreter.func_name import FuncName
+from interpreter.ir import CodeLabel
 from interpreter.type_name import TypeName
-from interpreter.types.type_expr import pointer, scalar
+from interpreter.types.type_expr import UNKNOWN, ParameterizedType, pointer, scalar
 from interpreter.types.typed_value import TypedValue, typed, typed_from_runtime
-from interpreter.vm.vm import Operators, VMState, _heap_addr, _is_symbolic
+from interpreter.vm.scheduler import spawn_goroutine, wake_blocked
+from interpreter.vm.vm import (
+    Operators,
+    VMState,
+    _heap_addr,
+    _heap_slot,
+    _is_symbolic,
+)
 from interpreter.vm.vm_types import (
     BuiltinResult,
+    HeapDelete,
     HeapObject,
     HeapWrite,
     NewObject,
     Pointer,
+    SchedulerRequest,
 )
 
 _UNCOMPUTABLE = Operators.UNCOMPUTABLE
@@ -60,17 +80,23 @@ def _builtin_range(args: list[TypedValue], vm: VMState) -> BuiltinResult:
     return BuiltinResult(value=_UNCOMPUTABLE)
 
 
+def _write_stdout(vm: VMState, text: str) -> None:
+    """Print *text* and capture it in ``vm.stdout`` so callers can inspect it."""
+    vm.stdout.append(text)
+    print(text, end="")
+
+
 def _builtin_print(args: list[TypedValue], vm: VMState) -> BuiltinResult:
     msg = " ".join(str(a.value) for a in args)
     logger.info("[VM print] %s", msg)
-    print(msg, end="")
+    _write_stdout(vm, msg)
     return BuiltinResult(value=None)
 
 
 def _builtin_println(args: list[TypedValue], vm: VMState) -> BuiltinResult:
     """println(msg) — print msg to stdout with a newline. Used by java.io.PrintStream stub."""
     msg = " ".join(str(a.value) for a in args)
-    print(msg)
+    _write_stdout(vm, msg + "\n")
     return BuiltinResult(value=None)
 
 
@@ -408,6 +434,739 @@ def _builtin_py_contains(args: list[TypedValue], vm: VMState) -> BuiltinResult:
     return BuiltinResult(value=_UNCOMPUTABLE)
 
 
+def _builtin_go_range_keys(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_range_keys__(collection) — keys visited by a Go ``for ... range`` loop.
+
+    Integers yield 0..n-1, strings the byte offset at which each rune starts,
+    heap-backed slices/arrays their indices, and heap-backed maps their keys in
+    insertion order; a nil slice or map yields no keys.  Numeric (INDEX) keys
+    are returned as ints so the loop variable supports arithmetic.
+    """
+    if not args or _is_symbolic(args[0].value):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    val = args[0].value
+    if val is None:
+        return _builtin_array_of([], vm)
+    addr = _heap_addr(val)
+    if addr and vm.heap_contains(addr):
+        fields = vm.heap_get(addr).fields
+        length_key = FieldName("length", FieldKind.SPECIAL)
+        if length_key in fields:
+            return _builtin_array_of(list(range(int(fields[length_key].value))), vm)
+        keys = [
+            int(k.value) if k.kind == FieldKind.INDEX else k.value
+            for k in fields
+            if k.kind != FieldKind.SPECIAL
+        ]
+        return _builtin_array_of(keys, vm)
+    if isinstance(val, bool):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    if isinstance(val, int):
+        return _builtin_array_of(list(range(val)), vm)
+    if isinstance(val, str):
+        return _builtin_array_of(_go_rune_offsets(val), vm)
+    if isinstance(val, (list, tuple)):
+        return _builtin_array_of(list(range(len(val))), vm)
+    return BuiltinResult(value=_UNCOMPUTABLE)
+
+
+def _go_rune_offsets(s: str) -> list[int]:
+    """Byte offset in s's UTF-8 encoding at which each of its runes starts."""
+    offsets: list[int] = []
+    position = 0
+    for ch in s:
+        offsets.append(position)
+        position += len(ch.encode("utf-8"))
+    return offsets
+
+
+@lru_cache(maxsize=8)
+def _utf8(s: str) -> bytes:
+    """s's UTF-8 encoding, kept across the iterations of a range over s."""
+    return s.encode("utf-8")
+
+
+def _go_rune_at(s: str, offset: int) -> int:
+    """The rune of s whose UTF-8 encoding starts at byte *offset*."""
+    encoded = _utf8(s)
+    lead = encoded[offset]
+    width = 1 if lead < 0x80 else 2 if lead < 0xE0 else 3 if lead < 0xF0 else 4
+    return ord(encoded[offset : offset + width].decode("utf-8"))
+
+
+def _builtin_go_range_value(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_range_value__(collection, key) — value bound by ``for k, v := range``.
+
+    Strings yield the rune (code point) starting at the byte offset rather
+    than a one-character string; slices, arrays and maps yield the stored
+    element.
+    """
+    if len(args) < 2 or any(_is_symbolic(a.value) for a in args):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    val, key = args[0].value, args[1].value
+    addr = _heap_addr(val)
+    if addr and vm.heap_contains(addr):
+        for kind in (FieldKind.INDEX, FieldKind.PROPERTY):
+            slot_addr, field = _heap_slot(vm, addr, FieldName(str(key), kind))
+            fields = vm.heap_get(slot_addr).fields
+            if field in fields:
+                return BuiltinResult(value=fields[field])
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    if isinstance(val, str):
+        return BuiltinResult(value=_go_rune_at(val, int(key)))
+    if isinstance(val, (list, tuple)):
+        return BuiltinResult(value=val[int(key)])
+    return BuiltinResult(value=_UNCOMPUTABLE)
+
+
+def _builtin_go_array_fill(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_array_fill__(arr, zero) — store *zero* into every slot of a Go array.
+
+    Used to give freshly allocated ``[N]T`` arrays and ``make([]T, n, c)``
+    slices their element zero values; the slot count is the capacity, so
+    reslicing past the length reads zeros too.
+    """
+    if len(args) < 2:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    header = _go_slice_header(args[0].value, vm)
+    if header is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    addr, _, _, capacity = header
+    return BuiltinResult(
+        value=None,
+        heap_writes=[
+            HeapWrite(
+                obj_addr=addr, field=FieldName(str(i), FieldKind.INDEX), value=args[1]
+            )
+            for i in range(capacity)
+        ],
+    )
+
+
+def _builtin_go_in_bounds(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_in_bounds__(arr, index) — whether 0 <= index < len(arr).
+
+    Arrays without a concrete length (and symbolic indices) are assumed to be
+    in bounds so the index check never blocks symbolic execution.
+    """
+    if len(args) < 2:
+        return BuiltinResult(value=True)
+    index = args[1].value
+    if isinstance(index, bool) or not isinstance(index, int):
+        return BuiltinResult(value=True)
+    length = _builtin_go_len([args[0]], vm).value
+    if not isinstance(length, int):
+        return BuiltinResult(value=True)
+    return BuiltinResult(value=0 <= index < length)
+
+
+def _go_int_operands(args: list[TypedValue]) -> tuple[int, int] | None:
+    """The two operands of a Go ``/`` or ``%`` when both are concrete integers."""
+    values = _concrete_values(args, int, int)
+    return (values[0], values[1]) if values is not None else None
+
+
+def _builtin_go_divisor_ok(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_divisor_ok__(a, b) — false only for an integer division by zero.
+
+    Float operands divide by zero to an infinity or NaN, and symbolic ones
+    are assumed to be fine, so only concrete integers fail the check.
+    """
+    ints = _go_int_operands(args)
+    return BuiltinResult(value=ints is None or ints[1] != 0)
+
+
+def _builtin_go_quo(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_quo__(a, b) — Go's a / b: integers truncate toward zero.
+
+    The quotient of two integers is exact, however large they are.  Any
+    other numbers divide as floats, giving ±Inf or NaN for a zero divisor.
+    """
+    ints = _go_int_operands(args)
+    if ints is not None:
+        a, b = ints
+        if b == 0:
+            return BuiltinResult(value=_UNCOMPUTABLE)
+        quotient = abs(a) // abs(b)
+        return BuiltinResult(value=quotient if (a < 0) == (b < 0) else -quotient)
+    floats = _float_args(args, 2)
+    if floats is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    a, b = floats
+    if b != 0:
+        return BuiltinResult(value=a / b)
+    if a == 0 or math.isnan(a):
+        return BuiltinResult(value=math.nan)
+    return BuiltinResult(value=math.copysign(math.inf, a) * math.copysign(1.0, b))
+
+
+def _builtin_go_rem(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_rem__(a, b) — Go's a % b: the remainder takes the sign of a."""
+    ints = _go_int_operands(args)
+    if ints is None or ints[1] == 0:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    a, b = ints
+    remainder = abs(a) % abs(b)
+    return BuiltinResult(value=remainder if a >= 0 else -remainder)
+
+
+def _builtin_go_append(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_append__(slice, *elems) — Go append(): old elements followed by *elems*.
+
+    When *elems* fit in the slice's capacity they are written into its
+    backing array, past its length, and the result is a longer view of that
+    array — so it aliases the original, as in Go.  Otherwise the result is
+    a fresh array whose capacity is doubled (or raised to the new length),
+    as the Go runtime does.  A nil slice appends like an empty one.
+    """
+    if not args or _is_symbolic(args[0].value):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    base = args[0].value
+    added = list(args[1:])
+    if base is None:
+        return _go_fresh_slice(added, len(added), vm)
+    header = _go_slice_header(base, vm)
+    existing = _go_slice_elements(base, vm)
+    if header is None or existing is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    backing, offset, length, capacity = header
+    if length + len(added) > capacity:
+        elements = [
+            e if e is not None else typed_from_runtime(None) for e in existing
+        ] + added
+        return _go_fresh_slice(elements, max(len(elements), 2 * capacity), vm)
+    source = vm.heap_get(_heap_addr(base))
+    view = _go_slice_view(source, backing, offset, length + len(added), capacity, vm)
+    writes = [
+        HeapWrite(
+            obj_addr=backing,
+            field=FieldName(str(offset + length + i), FieldKind.INDEX),
+            value=value,
+        )
+        for i, value in enumerate(added)
+    ]
+    return BuiltinResult(
+        value=view.value,
+        new_objects=view.new_objects,
+        heap_writes=[*writes, *view.heap_writes],
+    )
+
+
+def _go_fresh_slice(
+    elements: list[TypedValue], capacity: int, vm: VMState
+) -> BuiltinResult:
+    """A new heap array holding *elements*, with room for *capacity*."""
+    result = _builtin_array_of(elements, vm)
+    capacity_write = HeapWrite(
+        obj_addr=_heap_addr(result.value.value),
+        field=FieldName("capacity", FieldKind.SPECIAL),
+        value=typed(capacity, scalar(FoundationTypeName.INT)),
+    )
+    return BuiltinResult(
+        value=result.value,
+        new_objects=result.new_objects,
+        heap_writes=[*result.heap_writes, capacity_write],
+    )
+
+
+def _go_slice_header(value: Any, vm: VMState) -> tuple[Address, int, int, int] | None:
+    """(backing array, offset, len, cap) of a heap slice or array, else None.
+
+    An array is its own backing array, at offset 0; without a capacity field
+    its capacity is its length.
+    """
+    addr = _heap_addr(value)
+    if not addr or not vm.heap_contains(addr):
+        return None
+    fields = vm.heap_get(addr).fields
+    length_tv = fields.get(FieldName("length", FieldKind.SPECIAL))
+    if length_tv is None or type(length_tv.value) is not int:
+        return None
+    capacity_tv = fields.get(FieldName("capacity", FieldKind.SPECIAL), length_tv)
+    if type(capacity_tv.value) is not int:
+        return None
+    backing = fields.get(FieldName("backing", FieldKind.SPECIAL))
+    if backing is None:
+        return addr, 0, length_tv.value, capacity_tv.value
+    offset = fields[FieldName("offset", FieldKind.SPECIAL)].value
+    return _heap_addr(backing.value), offset, length_tv.value, capacity_tv.value
+
+
+def _go_slice_elements(value: Any, vm: VMState) -> list[TypedValue | None] | None:
+    """Elements 0..len-1 of a heap slice or array (None where unset), else None."""
+    addr = _heap_addr(value)
+    header = _go_slice_header(value, vm)
+    if header is None:
+        return None
+    slots = [
+        _heap_slot(vm, addr, FieldName(str(i), FieldKind.INDEX))
+        for i in range(header[2])
+    ]
+    return [vm.heap_get(base).fields.get(slot) for base, slot in slots]
+
+
+def _go_slice_view(
+    source: HeapObject,
+    backing: Address,
+    offset: int,
+    length: int,
+    capacity: int,
+    vm: VMState,
+) -> BuiltinResult:
+    """A new slice header over *backing*, typed like the *source* it came from."""
+    addr = Address(f"{ARR_ADDR_PREFIX}{vm.symbolic_counter}")
+    vm.symbolic_counter += 1
+    int_type = scalar(FoundationTypeName.INT)
+    fields = {
+        "backing": typed(
+            Pointer(base=backing, offset=0), pointer(scalar(TypeName("Array")))
+        ),
+        "offset": typed(offset, int_type),
+        "length": typed(length, int_type),
+        "capacity": typed(capacity, int_type),
+    }
+    return BuiltinResult(
+        value=typed(Pointer(base=addr, offset=0), pointer(scalar(TypeName("Array")))),
+        new_objects=[NewObject(addr=addr, type_hint=source.type_hint or UNKNOWN)],
+        heap_writes=[
+            HeapWrite(
+                obj_addr=addr, field=FieldName(name, FieldKind.SPECIAL), value=value
+            )
+            for name, value in fields.items()
+        ],
+    )
+
+
+def _builtin_go_len(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_len__(x) — Go len(): a string's UTF-8 byte count, 0 for nil.
+
+    Arrays, slices, maps and channels report their element count as len().
+    """
+    if not args:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    val = args[0].value
+    if val is None:
+        return BuiltinResult(value=0)
+    if isinstance(val, str):
+        return BuiltinResult(value=len(val.encode("utf-8")))
+    return _builtin_len(args, vm)
+
+
+def _builtin_go_string_byte(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_string_byte__(s, i) — Go s[i]: byte i of the UTF-8 encoding of *s*."""
+    if len(args) < 2 or any(_is_symbolic(a.value) for a in args):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    val, index = args[0].value, args[1].value
+    if not isinstance(val, str) or type(index) is not int:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    return BuiltinResult(value=val.encode("utf-8")[index])
+
+
+def _go_string_slice_bounds(args: list[TypedValue]) -> tuple[bytes, int, int] | None:
+    """UTF-8 bytes and concrete (low, high) of ``s[low:high]``, else None."""
+    if len(args) < 3 or any(_is_symbolic(a.value) for a in args):
+        return None
+    val = args[0].value
+    if not isinstance(val, str):
+        return None
+    data = val.encode("utf-8")
+    low, high = _parse_slice_int(args[1].value), _parse_slice_int(args[2].value)
+    return data, 0 if low is None else low, len(data) if high is None else high
+
+
+def _builtin_go_slice_in_bounds(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_slice_in_bounds__(s, low, high[, max]) — whether s[low:high:max] is valid.
+
+    A string needs 0 <= low <= high <= len(s); a slice or array needs
+    0 <= low <= high <= max <= cap(s).  Symbolic operands are assumed to be
+    in bounds.
+    """
+    bounds = _go_string_slice_bounds(args)
+    if bounds is not None:
+        data, low, high = bounds
+        return BuiltinResult(value=0 <= low <= high <= len(data))
+    slice_bounds = _go_slice_bounds(args, vm)
+    if slice_bounds is None:
+        return BuiltinResult(value=True)
+    (_, _, _, capacity), low, high, limit = slice_bounds
+    return BuiltinResult(value=0 <= low <= high <= limit <= capacity)
+
+
+def _builtin_go_string_slice(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_string_slice__(s, low, high) — Go s[low:high] over UTF-8 byte offsets."""
+    bounds = _go_string_slice_bounds(args)
+    if bounds is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    data, low, high = bounds
+    return BuiltinResult(value=data[low:high].decode("utf-8", errors="replace"))
+
+
+def _go_slice_bounds(
+    args: list[TypedValue], vm: VMState
+) -> tuple[tuple[Address, int, int, int], int, int, int] | None:
+    """Header and concrete (low, high, max) of ``s[low:high:max]``, else None.
+
+    A nil slice has no backing array and a capacity of 0.
+    """
+    if len(args) < 3 or any(_is_symbolic(a.value) for a in args):
+        return None
+    header = (
+        (NO_ADDRESS, 0, 0, 0)
+        if args[0].value is None
+        else _go_slice_header(args[0].value, vm)
+    )
+    if header is None:
+        return None
+    _, _, length, capacity = header
+    low, high = _parse_slice_int(args[1].value), _parse_slice_int(args[2].value)
+    limit = _parse_slice_int(_arg_or_none_value(args, 3))
+    return (
+        header,
+        0 if low is None else low,
+        length if high is None else high,
+        capacity if limit is None else limit,
+    )
+
+
+def _builtin_go_slice(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_slice__(s, low, high[, max]) — Go s[low:high:max] of a slice or array.
+
+    The result is a view of the same backing array, starting *low* elements
+    further in, with length high - low and capacity max - low, so writes
+    through either are seen by both.  Slicing a nil slice gives nil.
+    """
+    bounds = _go_slice_bounds(args, vm)
+    if bounds is None:
+        return _builtin_slice(args[:3], vm)
+    (backing, offset, _, _), low, high, limit = bounds
+    if args[0].value is None:
+        return BuiltinResult(value=None)
+    return _go_slice_view(
+        vm.heap_get(_heap_addr(args[0].value)),
+        backing,
+        offset + low,
+        high - low,
+        limit - low,
+        vm,
+    )
+
+
+def _is_go_rune(code: int) -> bool:
+    """Whether *code* is a Unicode scalar value (not a surrogate, in range)."""
+    return 0 <= code <= 0x10FFFF and not 0xD800 <= code <= 0xDFFF
+
+
+def _go_rune_text(code: int) -> str:
+    """UTF-8 text of a code point; invalid ones become U+FFFD, as in Go."""
+    return chr(code) if _is_go_rune(code) else "\ufffd"
+
+
+def _go_int_elements(value: Any, vm: VMState) -> list[int] | None:
+    """Elements of a heap slice when every one is a concrete integer."""
+    elements = _go_slice_elements(value, vm)
+    if elements is None:
+        return None
+    values = [e.value if e is not None else None for e in elements]
+    if any(type(v) is not int for v in values):
+        return None
+    return values
+
+
+def _builtin_go_string(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_string__(x) — Go string(x) of a string or an integer.
+
+    An integer is a code point, so ``string(65)`` is "A".  The frontend
+    sends a []byte or []rune it can type to ``__go_string_of_bytes__`` or
+    ``__go_string_of_runes__``; any other slice is taken to be a []byte.
+    """
+    if args and type(args[0].value) is int:
+        return BuiltinResult(value=_go_rune_text(args[0].value))
+    if args and isinstance(args[0].value, str):
+        return BuiltinResult(value=args[0].value)
+    return _builtin_go_string_of_bytes(args, vm)
+
+
+def _go_slice_codes(args: list[TypedValue], vm: VMState) -> list[int] | None:
+    """Integer elements of the slice argument, [] for a nil slice, else None."""
+    if not args or _is_symbolic(args[0].value):
+        return None
+    if args[0].value is None:
+        return []
+    return _go_int_elements(args[0].value, vm)
+
+
+def _builtin_go_string_of_bytes(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_string_of_bytes__(b) — Go string(b) of a []byte.
+
+    The bytes are decoded as UTF-8, and each byte that is not part of a
+    valid encoding becomes U+FFFD, as in Go.
+    """
+    codes = _go_slice_codes(args, vm)
+    if codes is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    data = bytes(c & 0xFF for c in codes)
+    return BuiltinResult(value=data.decode("utf-8", errors="replace"))
+
+
+def _builtin_go_string_of_runes(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_string_of_runes__(r) — Go string(r) of a []rune: the UTF-8 text
+    of its code points, each invalid one becoming U+FFFD."""
+    codes = _go_slice_codes(args, vm)
+    if codes is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    return BuiltinResult(value="".join(_go_rune_text(c) for c in codes))
+
+
+def _builtin_go_bytes(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_bytes__(s) — Go []byte(s): a fresh slice of s's UTF-8 bytes."""
+    values = _concrete_values(args, str)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    return _builtin_array_of(list(values[0].encode("utf-8")), vm)
+
+
+def _builtin_go_runes(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_runes__(s) — Go []rune(s): a fresh slice of s's code points."""
+    values = _concrete_values(args, str)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    return _builtin_array_of([ord(c) for c in values[0]], vm)
+
+
+def _builtin_go_cap(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_cap__(slice) — Go cap(): the capacity field, else the length."""
+    if not args:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    if args[0].value is None:
+        return BuiltinResult(value=0)
+    addr = _heap_addr(args[0].value)
+    if addr and vm.heap_contains(addr):
+        capacity_tv = vm.heap_get(addr).fields.get(
+            FieldName("capacity", FieldKind.SPECIAL)
+        )
+        if capacity_tv is not None:
+            return BuiltinResult(value=capacity_tv.value)
+    return _builtin_len(args, vm)
+
+
+def _go_map_key_field(key: Any) -> FieldName:
+    """Heap field for a Go map key — numeric keys are INDEX, the rest PROPERTY.
+
+    Mirrors the key classification LOAD_INDEX / STORE_INDEX use so builtins
+    see the same fields as ``m[k]`` reads and writes.
+    """
+    key_str = str(key)
+    if isinstance(key, int):
+        return FieldName(key_str, FieldKind.INDEX)
+    try:
+        int(key_str)
+        return FieldName(key_str, FieldKind.INDEX)
+    except ValueError:
+        return FieldName(key_str, FieldKind.PROPERTY)
+
+
+def _builtin_go_map_get(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_map_get__(m, key, zero) — Go ``m[key]``: the entry, or *zero* if absent.
+
+    Reading from a nil map also yields the zero value.
+    """
+    if len(args) < 3 or any(_is_symbolic(a.value) for a in args[:2]):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    if args[0].value is None:
+        return BuiltinResult(value=args[2])
+    addr = _heap_addr(args[0].value)
+    if not addr or not vm.heap_contains(addr):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    fields = vm.heap_get(addr).fields
+    return BuiltinResult(value=fields.get(_go_map_key_field(args[1].value), args[2]))
+
+
+def _builtin_go_map_has(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_map_has__(m, key) — the ``ok`` of ``v, ok := m[key]``."""
+    if len(args) < 2 or any(_is_symbolic(a.value) for a in args):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    if args[0].value is None:
+        return BuiltinResult(value=False)
+    addr = _heap_addr(args[0].value)
+    if not addr or not vm.heap_contains(addr):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    fields = vm.heap_get(addr).fields
+    return BuiltinResult(value=_go_map_key_field(args[1].value) in fields)
+
+
+def _builtin_go_map_delete(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_map_delete__(m, key) — Go ``delete(m, key)``; a no-op if absent."""
+    if len(args) < 2 or any(_is_symbolic(a.value) for a in args):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    if args[0].value is None:
+        return BuiltinResult(value=None)
+    addr = _heap_addr(args[0].value)
+    if not addr or not vm.heap_contains(addr):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    return BuiltinResult(
+        value=None,
+        heap_deletes=[
+            HeapDelete(obj_addr=addr, field=_go_map_key_field(args[1].value))
+        ],
+    )
+
+
+def _go_dynamic_type_name(val: Any, vm: VMState) -> str:
+    """Name of a runtime value's dynamic type, as matched by ``__go_type_is__``."""
+    if val is None:
+        return "nil"
+    if isinstance(val, bool):
+        return FoundationTypeName.BOOL.value
+    if isinstance(val, int):
+        return FoundationTypeName.INT.value
+    if isinstance(val, float):
+        return FoundationTypeName.FLOAT.value
+    if isinstance(val, str):
+        return FoundationTypeName.STRING.value
+    addr = _heap_addr(val)
+    if not addr or not vm.heap_contains(addr):
+        return ""
+    hint = vm.heap_get(addr).type_hint
+    return hint.constructor if isinstance(hint, ParameterizedType) else str(hint)
+
+
+def _builtin_go_type_is(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_type_is__(x, name, ...) — whether x's dynamic type is one of *names*.
+
+    The frontend expands interfaces to the structs implementing them, so this
+    is a plain name match; ``any`` matches every non-nil value.
+    """
+    if not args or _is_symbolic(args[0].value):
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    names = {str(a.value) for a in args[1:]}
+    dynamic = _go_dynamic_type_name(args[0].value, vm)
+    return BuiltinResult(
+        value=dynamic in names or ("any" in names and dynamic != "nil")
+    )
+
+
+def _builtin_go_recover(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_recover__() — Go recover(): stop the in-flight panic and return its value.
+
+    Returns nil when no panic is in flight.
+    """
+    in_flight = vm.current_exception
+    vm.current_exception = None
+    return BuiltinResult(value=in_flight.value if in_flight is not None else None)
+
+
+def _builtin_go_panicking(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_panicking__() — whether a panic is in flight (thrown, not recovered)."""
+    return BuiltinResult(value=vm.current_exception is not None)
+
+
+def _builtin_go_panic_value(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_panic_value__() — the in-flight panic value, to panic again with."""
+    in_flight = vm.current_exception
+    return BuiltinResult(value=in_flight.value if in_flight is not None else None)
+
+
+def _go_panic_text(value: Any) -> str:
+    """Render a panic value the way Go's runtime prints it after ``panic: ``."""
+    if isinstance(value, bool):
+        return "true" if value else "false"
+    return "nil" if value is None else str(value)
+
+
+def _builtin_go_runtime_error(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_runtime_error__() — the in-flight panic as a ``runtime.Error`` object.
+
+    Its fields are ``message`` (``"panic: <value>"``), ``value``, ``stack``
+    (an array of the functions active at the panic, innermost first, ending
+    at ``main`` or at the function a goroutine was started with) and
+    ``location`` (the panic's source span).
+    """
+    in_flight = vm.current_exception
+    if in_flight is None:
+        return BuiltinResult(value=None)
+    names = list(in_flight.stack_trace)
+    if GOROUTINE_FRAME_NAME in names:
+        names = names[: names.index(GOROUTINE_FRAME_NAME)]
+    stack = _builtin_array_of(
+        ["main" if name == MAIN_FRAME_NAME else name for name in names], vm
+    )
+    addr = Address(f"{OBJ_ADDR_PREFIX}{vm.symbolic_counter}")
+    vm.symbolic_counter += 1
+    error_type = scalar(TypeName("runtime.Error"))
+    fields = {
+        "message": typed(
+            f"panic: {_go_panic_text(in_flight.value.value)}",
+            scalar(FoundationTypeName.STRING),
+        ),
+        "value": in_flight.value,
+        "stack": stack.value,
+        "location": typed(str(in_flight.location), scalar(FoundationTypeName.STRING)),
+    }
+    return BuiltinResult(
+        value=typed(Pointer(base=addr, offset=0), pointer(error_type)),
+        new_objects=[*stack.new_objects, NewObject(addr=addr, type_hint=error_type)],
+        heap_writes=[
+            *stack.heap_writes,
+            *(
+                HeapWrite(obj_addr=addr, field=FieldName(name), value=value)
+                for name, value in fields.items()
+            ),
+        ],
+    )
+
+
+# errors.New values are GO_ERROR_STRING_TYPE objects whose one field is the text.
+_GO_ERROR_TEXT_FIELD = FieldName("s")
+
+
+def _go_error_text(value: Any, vm: VMState) -> str | None:
+    """The message of an errors.New value, or None for anything else."""
+    addr = _heap_addr(value)
+    if not addr or not vm.heap_contains(addr):
+        return None
+    obj = vm.heap_get(addr)
+    if obj.type_hint != scalar(TypeName(GO_ERROR_STRING_TYPE)):
+        return None
+    text = obj.fields.get(_GO_ERROR_TEXT_FIELD)
+    return text.value if text is not None else ""
+
+
+def _builtin_errors_new(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """errors.New(text) — a fresh ``*errors.errorString`` whose Error() is *text*.
+
+    Every call allocates, so two errors with the same text are not ==.
+    """
+    values = _concrete_values(args, str)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    addr = Address(f"{OBJ_ADDR_PREFIX}{vm.symbolic_counter}")
+    vm.symbolic_counter += 1
+    error_type = scalar(TypeName(GO_ERROR_STRING_TYPE))
+    return BuiltinResult(
+        value=typed(Pointer(base=addr, offset=0), pointer(error_type)),
+        new_objects=[NewObject(addr=addr, type_hint=error_type)],
+        heap_writes=[
+            HeapWrite(
+                obj_addr=addr,
+                field=_GO_ERROR_TEXT_FIELD,
+                value=typed(values[0], scalar(FoundationTypeName.STRING)),
+            )
+        ],
+    )
+
+
+def _method_go_error(
+    obj: TypedValue, args: list[TypedValue], vm: VMState
+) -> BuiltinResult:
+    """Method builtin: err.Error() on an errors.New value → its text.
+
+    Other receivers are uncomputable here, so a user type's own Error()
+    method is dispatched as usual.
+    """
+    text = _go_error_text(obj.value, vm)
+    return BuiltinResult(value=_UNCOMPUTABLE if text is None else text)
+
+
 def _builtin_str_upper(args: list[TypedValue], vm: VMState) -> BuiltinResult:
     # Precondition: args[0].value must be a raw Python str.
     # The caller (String stub IR) extracts the raw value via LoadField before calling.
@@ -490,6 +1249,470 @@ def _builtin_isinstance(args: list[TypedValue], vm: VMState) -> BuiltinResult:
     return BuiltinResult(value=typed(False, scalar(TypeName("Boolean"))))
 
 
+def _builtin_go_spawn(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_spawn__(label, entry) — Go ``go f(x)``: queue a goroutine at *label*.
+
+    *entry* is stored in the goroutine's frame for the code at *label* to
+    call.  Returns the goroutine's id.
+    """
+    if len(args) < 2:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    return BuiltinResult(
+        value=spawn_goroutine(vm, CodeLabel(str(args[0].value)), args[1])
+    )
+
+
+def _builtin_go_exit(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_exit__() — the running goroutine has finished; switch to another."""
+    vm.scheduler.request = SchedulerRequest.EXIT
+    return BuiltinResult(value=None)
+
+
+def _go_chan_fields(ch: TypedValue, vm: VMState) -> dict[FieldName, TypedValue]:
+    """Heap fields of a channel; empty for a nil channel."""
+    addr = _heap_addr(ch.value) if ch.value is not None else None
+    if not addr or not vm.heap_contains(addr):
+        return {}
+    return vm.heap_get(addr).fields
+
+
+def _go_chan_count(fields: dict[FieldName, TypedValue], name: str) -> int:
+    """An integer bookkeeping field of a channel (0 when unset)."""
+    tv = fields.get(FieldName(name, FieldKind.SPECIAL))
+    return tv.value if tv is not None and isinstance(tv.value, int) else 0
+
+
+def _go_chan_closed(fields: dict[FieldName, TypedValue]) -> bool:
+    tv = fields.get(FieldName("closed", FieldKind.SPECIAL))
+    return tv is not None and tv.value is True
+
+
+def _go_chan_write(addr: Address, name: str, value: Any) -> HeapWrite:
+    return HeapWrite(
+        obj_addr=addr,
+        field=FieldName(name, FieldKind.SPECIAL),
+        value=typed_from_runtime(value),
+    )
+
+
+def _builtin_go_chan_send(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_chan_send__(ch, v) — Go ``ch <- v``.
+
+    Blocks while the buffer is full (an unbuffered channel holds one value)
+    and, on an unbuffered channel, until a receiver has taken the value.
+    Sending on a nil channel blocks forever.  Returns False without sending
+    when the channel is closed, so the caller can panic.
+    """
+    if len(args) < 2:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    scheduler = vm.scheduler
+    fields = _go_chan_fields(args[0], vm)
+    if not fields:
+        scheduler.request = SchedulerRequest.BLOCK
+        return BuiltinResult(value=True)
+    addr = _heap_addr(args[0].value)
+    capacity = _go_chan_count(fields, "capacity")
+    received = _go_chan_count(fields, "received")
+    length = _go_chan_count(fields, "length")
+    heap_writes: list[HeapWrite] = []
+    ticket = scheduler.pending_sends.pop(scheduler.running_id, None)
+    if ticket is None:
+        if _go_chan_closed(fields):
+            return BuiltinResult(value=False)
+        if length >= max(capacity, 1):
+            scheduler.request = SchedulerRequest.BLOCK
+            return BuiltinResult(value=True)
+        ticket = received + length
+        slot = FieldName(str(length), FieldKind.INDEX)
+        heap_writes = [
+            HeapWrite(obj_addr=addr, field=slot, value=args[1]),
+            _go_chan_write(addr, "length", length + 1),
+        ]
+        wake_blocked(vm)
+    if ticket >= received + capacity:
+        scheduler.pending_sends[scheduler.running_id] = ticket
+        scheduler.request = SchedulerRequest.BLOCK
+    return BuiltinResult(value=True, heap_writes=heap_writes)
+
+
+def _go_wait_to_receive(vm: VMState, channels: tuple[Address, ...]) -> None:
+    """Record that the running goroutine blocks receiving on *channels*.
+
+    A new receiver can make a blocked ``select`` send ready, so the other
+    goroutines are woken when the set changes.
+    """
+    scheduler = vm.scheduler
+    if scheduler.waiting_receivers.get(scheduler.running_id, ()) == channels:
+        return
+    scheduler.waiting_receivers[scheduler.running_id] = channels
+    wake_blocked(vm)
+
+
+def _go_chan_take(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """Receive from a channel; the result's value is the pair ``(v, ok)``."""
+    fields = _go_chan_fields(args[0], vm) if args else {}
+    length = _go_chan_count(fields, "length")
+    scheduler = vm.scheduler
+    if not length:
+        if not _go_chan_closed(fields):
+            if fields:
+                _go_wait_to_receive(vm, (_heap_addr(args[0].value),))
+            scheduler.request = SchedulerRequest.BLOCK
+            return BuiltinResult(value=(None, False))
+        scheduler.waiting_receivers.pop(scheduler.running_id, None)
+        zero = fields.get(
+            FieldName("zero", FieldKind.SPECIAL), typed_from_runtime(None)
+        )
+        return BuiltinResult(value=(zero, False))
+    addr = _heap_addr(args[0].value)
+    slots = [FieldName(str(i), FieldKind.INDEX) for i in range(length)]
+    items = [fields[slot] for slot in slots]
+    scheduler.waiting_receivers.pop(scheduler.running_id, None)
+    wake_blocked(vm)
+    return BuiltinResult(
+        value=(items[0], True),
+        heap_writes=[
+            *(
+                HeapWrite(obj_addr=addr, field=slot, value=item)
+                for slot, item in zip(slots, items[1:])
+            ),
+            _go_chan_write(addr, "length", length - 1),
+            _go_chan_write(addr, "received", _go_chan_count(fields, "received") + 1),
+        ],
+        heap_deletes=[HeapDelete(obj_addr=addr, field=slots[-1])],
+    )
+
+
+def _builtin_go_chan_recv(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_chan_recv__(ch) — Go ``<-ch``.
+
+    Blocks until a value is available; a closed, drained channel yields the
+    element type's zero value and a nil channel blocks forever.
+    """
+    taken = _go_chan_take(args, vm)
+    value, _ = taken.value
+    return BuiltinResult(
+        value=value, heap_writes=taken.heap_writes, heap_deletes=taken.heap_deletes
+    )
+
+
+def _builtin_go_chan_recv_ok(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_chan_recv_ok__(ch) — Go ``v, ok := <-ch`` as the array ``[v, ok]``.
+
+    *ok* is false once the channel is closed and drained.
+    """
+    taken = _go_chan_take(args, vm)
+    value, ok = taken.value
+    pair = _builtin_array_of([value, ok], vm)
+    return BuiltinResult(
+        value=pair.value,
+        new_objects=pair.new_objects,
+        heap_writes=[*taken.heap_writes, *pair.heap_writes],
+        heap_deletes=taken.heap_deletes,
+    )
+
+
+def _builtin_go_chan_close(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_chan_close__(ch) — Go close(ch).
+
+    Wakes goroutines blocked on the channel.  Returns False for a nil or
+    already closed channel, so the caller can panic.
+    """
+    fields = _go_chan_fields(args[0], vm) if args else {}
+    if not fields or _go_chan_closed(fields):
+        return BuiltinResult(value=False)
+    wake_blocked(vm)
+    return BuiltinResult(
+        value=True,
+        heap_writes=[_go_chan_write(_heap_addr(args[0].value), "closed", True)],
+    )
+
+
+def _go_select_ready(ch: TypedValue, is_send: bool, vm: VMState) -> bool:
+    """Whether a select case on *ch* can proceed without blocking.
+
+    A receive is ready when a value is buffered or the channel is closed.
+    A send is ready when the buffer has room or, on an unbuffered channel,
+    when another goroutine waits to receive; sending on a closed channel
+    is ready too, so the case can panic.  A nil channel is never ready.
+    """
+    fields = _go_chan_fields(ch, vm)
+    if not fields:
+        return False
+    length = _go_chan_count(fields, "length")
+    if _go_chan_closed(fields):
+        return True
+    if not is_send:
+        return length > 0
+    capacity = _go_chan_count(fields, "capacity")
+    if capacity:
+        return length < capacity
+    addr = _heap_addr(ch.value)
+    return not length and any(
+        addr in channels
+        for goroutine_id, channels in vm.scheduler.waiting_receivers.items()
+        if goroutine_id != vm.scheduler.running_id
+    )
+
+
+def _builtin_go_select(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """__go_select__(has_default, ch0, is_send0, ch1, is_send1, ...) — Go select.
+
+    Returns the index of a ready case, picked uniformly at random with the
+    scheduler's seeded generator, or -1 to run ``default`` when no case is
+    ready.  Without a default clause it blocks until some case is ready.
+    The chosen case performs its own send or receive afterwards.
+    """
+    if not args:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    scheduler = vm.scheduler
+    cases = list(zip(args[1::2], args[2::2]))
+    ready = [
+        index
+        for index, (ch, is_send) in enumerate(cases)
+        if _go_select_ready(ch, is_send.value is True, vm)
+    ]
+    if ready:
+        scheduler.waiting_receivers.pop(scheduler.running_id, None)
+        return BuiltinResult(value=scheduler.rng.choice(ready))
+    if args[0].value is True:
+        return BuiltinResult(value=-1)
+    receiving = tuple(
+        _heap_addr(ch.value)
+        for ch, is_send in cases
+        if is_send.value is not True and _go_chan_fields(ch, vm)
+    )
+    _go_wait_to_receive(vm, receiving)
+    scheduler.request = SchedulerRequest.BLOCK
+    return BuiltinResult(value=-1)
+
+
+def _concrete_values(args: list[TypedValue], *kinds: type) -> list[Any] | None:
+    """Raw values of *args* when each is concrete and of the matching kind."""
+    if len(args) < len(kinds):
+        return None
+    values = [a.value for a in args[: len(kinds)]]
+    if any(
+        _is_symbolic(v) or isinstance(v, bool) or not isinstance(v, kind)
+        for v, kind in zip(values, kinds)
+    ):
+        return None
+    return values
+
+
+def _builtin_strings_contains(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """strings.Contains(s, substr) — whether substr occurs within s."""
+    values = _concrete_values(args, str, str)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    s, substr = values
+    return BuiltinResult(value=substr in s)
+
+
+def _builtin_strings_to_upper(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """strings.ToUpper(s) — s with every letter mapped to upper case."""
+    values = _concrete_values(args, str)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    return BuiltinResult(value=values[0].upper())
+
+
+def _builtin_strings_to_lower(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """strings.ToLower(s) — s with every letter mapped to lower case."""
+    values = _concrete_values(args, str)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    return BuiltinResult(value=values[0].lower())
+
+
+def _builtin_strings_split(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """strings.Split(s, sep) — a fresh []string of the substrings between seps.
+
+    An empty sep splits s into its individual characters, as in Go.
+    """
+    values = _concrete_values(args, str, str)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    s, sep = values
+    return _builtin_array_of(list(s) if sep == "" else s.split(sep), vm)
+
+
+def _builtin_strings_index(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """strings.Index(s, substr) — byte offset of the first substr in s, or -1."""
+    values = _concrete_values(args, str, str)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    s, substr = values
+    position = s.find(substr)
+    if position < 0:
+        return BuiltinResult(value=-1)
+    return BuiltinResult(value=len(s[:position].encode("utf-8")))
+
+
+def _builtin_strings_repeat(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """strings.Repeat(s, count) — count copies of s; a negative count is unknown."""
+    values = _concrete_values(args, str, int)
+    if values is None or values[1] < 0:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    s, count = values
+    return BuiltinResult(value=s * count)
+
+
+def _go_rune_func(func: Any) -> Any:
+    """Wrap a one-character str function as a unicode.X builtin taking a rune.
+
+    A string result maps back to a code point, so ``unicode.ToUpper('é')``
+    is ``'É'``; a case mapping Go does not make (``'ß'`` to ``"SS"``) keeps
+    the rune unchanged.
+    """
+
+    def builtin(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+        values = _concrete_values(args, int)
+        if values is None:
+            return BuiltinResult(value=_UNCOMPUTABLE)
+        result = func(_go_rune_text(values[0]))
+        if isinstance(result, str):
+            return BuiltinResult(value=ord(result) if len(result) == 1 else values[0])
+        return BuiltinResult(value=result)
+
+    return builtin
+
+
+def _builtin_utf8_rune_count(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """utf8.RuneCountInString(s) — the number of runes (code points) in s."""
+    values = _concrete_values(args, str)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    return BuiltinResult(value=len(values[0]))
+
+
+def _builtin_utf8_rune_len(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """utf8.RuneLen(r) — bytes needed to encode r in UTF-8, or -1 if invalid."""
+    values = _concrete_values(args, int)
+    if values is None:
+        return BuiltinResult(value=_UNCOMPUTABLE)
+    code = values[0]
+    if not _is_go_rune(code):
+        return BuiltinResult(value=-1)
+    return BuiltinResult(value=len(chr(code).encode("utf-8")))
+
+
+def _float_args(args: list[TypedValue], count: int) -> list[float] | None:
+    """The first *count* args as floats when each is a concrete number."""
+    values = [a.value for a in args[:count]]
+    if len(values) < count or any(
+        isinstance(v, bool) or not isinstance(v, (int, float)) for v in values
+    ):
+        return None
+    return [float(v) for v in values]
+
+
+def _go_math_func(func: Any, arity: int) -> Any:
+    """Wrap a float function as a math.X builtin taking *arity* float64 args.
+
+    Integer arguments convert to float64 as untyped constants do in Go.  A
+    domain error (``math.Sqrt(-1)``) gives NaN and an overflow +Inf, where
+    Go returns those values rather than failing.
+    """
+
+    def builtin(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+        values = _float_args(args, arity)
+        if values is None:
+            return BuiltinResult(value=_UNCOMPUTABLE)
+        try:
+            return BuiltinResult(value=float(func(*values)))
+        except ValueError:
+            return BuiltinResult(value=math.nan)
+        except OverflowError:
+            return BuiltinResult(value=math.inf)
+
+    return builtin
+
+
+def _go_format_float(x: float) -> str:
+    """Format a float64 as Go's %v does.
+
+    Uses the shortest digits that round-trip, in exponent form (``1e+06``)
+    below 1e-4 and from 1e6 upwards, as Go's %g does.
+    """
+    if math.isnan(x):
+        return "NaN"
+    if math.isinf(x):
+        return "+Inf" if x > 0 else "-Inf"
+    mantissa = next(m for p in range(17) if float(m := f"{x:.{p}e}") == x)
+    digits, exponent = mantissa.split("e")
+    if int(exponent) < -4 or int(exponent) >= 6:
+        return mantissa
+    significant = len(digits.lstrip("-").replace(".", ""))
+    return f"{x:.{max(significant - 1 - int(exponent), 0)}f}"
+
+
+def _go_map_key_order(field: FieldName) -> tuple[bool, int, str]:
+    """Sort key putting numeric map keys first, in numeric order, as fmt does."""
+    numeric = field.kind == FieldKind.INDEX
+    return (not numeric, int(field.value) if numeric else 0, field.value)
+
+
+def _go_format_value(value: Any, vm: VMState) -> str:
+    """Format *value* as Go's %v does.
+
+    Slices print as ``[1 2 3]``, maps as ``map[a:1 b:2]`` with sorted
+    keys and structs as ``{1 2}``, formatting their elements in turn; an
+    errors.New value prints its text.
+    """
+    if isinstance(value, TypedValue):
+        return _go_format_value(value.value, vm)
+    if value is None:
+        return "<nil>"
+    if isinstance(value, bool):
+        return "true" if value else "false"
+    if isinstance(value, float):
+        return _go_format_float(value)
+    if isinstance(value, str):
+        return value
+    addr = _heap_addr(value)
+    if not addr or not vm.heap_contains(addr):
+        return str(value)
+    error_text = _go_error_text(value, vm)
+    if error_text is not None:
+        return error_text
+    obj = vm.heap_get(addr)
+    fields = obj.fields
+    items = _go_slice_elements(value, vm)
+    if items is not None:
+        return "[" + " ".join(_go_format_value(v, vm) for v in items) + "]"
+    if isinstance(obj.type_hint, ParameterizedType) and (
+        obj.type_hint.constructor == "Map"
+    ):
+        entries = " ".join(
+            f"{k.value}:{_go_format_value(fields[k], vm)}"
+            for k in sorted(fields, key=_go_map_key_order)
+        )
+        return f"map[{entries}]"
+    return "{" + " ".join(_go_format_value(v, vm) for v in fields.values()) + "}"
+
+
+def _builtin_fmt_print(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """fmt.Print(a...) — print operands, spacing those where neither is a string."""
+    text = ""
+    for i, arg in enumerate(args):
+        if (
+            i
+            and not isinstance(arg.value, str)
+            and not isinstance(args[i - 1].value, str)
+        ):
+            text += " "
+        text += _go_format_value(arg, vm)
+    _write_stdout(vm, text)
+    return BuiltinResult(value=None)
+
+
+def _builtin_fmt_println(args: list[TypedValue], vm: VMState) -> BuiltinResult:
+    """fmt.Println(a...) — print operands separated by spaces, then a newline."""
+    _write_stdout(vm, " ".join(_go_format_value(a, vm) for a in args) + "\n")
+    return BuiltinResult(value=None)
+
+
 class Builtins:
     """Table of built-in function implementations."""
 
@@ -523,6 +1746,69 @@ class Builtins:
             FuncName("list_append"): _builtin_list_append,
             FuncName("dict_contains_key"): _builtin_dict_contains_key,
             FuncName("__py_contains__"): _builtin_py_contains,
+            FuncName("__go_range_keys__"): _builtin_go_range_keys,
+            FuncName("__go_range_value__"): _builtin_go_range_value,
+            FuncName("__go_array_fill__"): _builtin_go_array_fill,
+            FuncName("__go_in_bounds__"): _builtin_go_in_bounds,
+            FuncName("__go_divisor_ok__"): _builtin_go_divisor_ok,
+            FuncName("__go_quo__"): _builtin_go_quo,
+            FuncName("__go_rem__"): _builtin_go_rem,
+            FuncName("__go_append__"): _builtin_go_append,
+            FuncName("__go_slice__"): _builtin_go_slice,
+            FuncName("__go_len__"): _builtin_go_len,
+            FuncName("__go_string_byte__"): _builtin_go_string_byte,
+            FuncName("__go_string_slice__"): _builtin_go_string_slice,
+            FuncName("__go_string__"): _builtin_go_string,
+            FuncName("__go_string_of_bytes__"): _builtin_go_string_of_bytes,
+            FuncName("__go_string_of_runes__"): _builtin_go_string_of_runes,
+            FuncName("__go_bytes__"): _builtin_go_bytes,
+            FuncName("__go_runes__"): _builtin_go_runes,
+            FuncName("__go_slice_in_bounds__"): _builtin_go_slice_in_bounds,
+            FuncName("__go_cap__"): _builtin_go_cap,
+            FuncName("__go_map_get__"): _builtin_go_map_get,
+            FuncName("__go_map_has__"): _builtin_go_map_has,
+            FuncName("__go_map_delete__"): _builtin_go_map_delete,
+            FuncName("__go_type_is__"): _builtin_go_type_is,
+            FuncName("__go_recover__"): _builtin_go_recover,
+            FuncName("__go_panicking__"): _builtin_go_panicking,
+            FuncName("__go_panic_value__"): _builtin_go_panic_value,
+            FuncName("__go_runtime_error__"): _builtin_go_runtime_error,
+            FuncName("__go_spawn__"): _builtin_go_spawn,
+            FuncName("__go_exit__"): _builtin_go_exit,
+            FuncName("__go_chan_send__"): _builtin_go_chan_send,
+            FuncName("__go_chan_recv__"): _builtin_go_chan_recv,
+            FuncName("__go_chan_recv_ok__"): _builtin_go_chan_recv_ok,
+            FuncName("__go_chan_close__"): _builtin_go_chan_close,
+            FuncName("__go_select__"): _builtin_go_select,
+            FuncName("strings.Contains"): _builtin_strings_contains,
+            FuncName("strings.ToUpper"): _builtin_strings_to_upper,
+            FuncName("strings.ToLower"): _builtin_strings_to_lower,
+            FuncName("strings.Split"): _builtin_strings_split,
+            FuncName("strings.Index"): _builtin_strings_index,
+            FuncName("strings.Repeat"): _builtin_strings_repeat,
+            FuncName("unicode.IsLetter"): _go_rune_func(str.isalpha),
+            FuncName("unicode.IsDigit"): _go_rune_func(str.isdecimal),
+            FuncName("unicode.IsSpace"): _go_rune_func(str.isspace),
+            FuncName("unicode.IsUpper"): _go_rune_func(str.isupper),
+            FuncName("unicode.IsLower"): _go_rune_func(str.islower),
+            FuncName("unicode.IsPunct"): _go_rune_func(
+                lambda ch: unicodedata.category(ch).startswith("P")
+            ),
+            FuncName("unicode.ToUpper"): _go_rune_func(str.upper),
+            FuncName("unicode.ToLower"): _go_rune_func(str.lower),
+            FuncName("unicode/utf8.RuneCountInString"): _builtin_utf8_rune_count,
+            FuncName("unicode/utf8.RuneLen"): _builtin_utf8_rune_len,
+            FuncName("math.Sqrt"): _go_math_func(math.sqrt, 1),
+            FuncName("math.Abs"): _go_math_func(abs, 1),
+            FuncName("math.Pow"): _go_math_func(math.pow, 2),
+            FuncName("math.Max"): _go_math_func(max, 2),
+            FuncName("math.Min"): _go_math_func(min, 2),
+            FuncName("math.Floor"): _go_math_func(math.floor, 1),
+            FuncName("math.Ceil"): _go_math_func(math.ceil, 1),
+            FuncName("math.Trunc"): _go_math_func(math.trunc, 1),
+            FuncName("fmt.Print"): _builtin_fmt_print,
+            FuncName("fmt.Println"): _builtin_fmt_println,
+            FuncName("errors.New"): _builtin_errors_new,
             **BYTE_BUILTINS,
         }
     )
@@ -547,5 +1833,6 @@ class Builtins:
             FuncName("length"): _method_length,
             FuncName("size"): _method_length,
             FuncName("Length"): _method_length,
+            FuncName("Error"): _method_go_error,
         }
     )
@@ -0,0 +1,34 @@
+# pyright: standard
+"""Call-depth limit — stop runaway recursion where it recurses.
+
+The VM keeps its call stack as data, so unbounded recursion never crashes
+the interpreter: it just pushes frames until ``max_steps`` runs out, with
+nothing to say why.  ``VMConfig.max_call_depth`` bounds the stack instead;
+a call that would go deeper stops the run with a CallDepthExceededError
+naming the function and the call site.
+"""
+
+from __future__ import annotations
+
+from interpreter.ir import SourceLocation
+
+
+class CallDepthExceededError(Exception):
+    """A call nested deeper than ``VMConfig.max_call_depth``."""
+
+    def __init__(self, function_name: str, max_depth: int, location: SourceLocation):
+        self.function_name = function_name
+        self.max_depth = max_depth
+        self.location = location
+        super().__init__(
+            f"runtime error: call depth limit exceeded at {location}: "
+            f"calling {function_name} nests more than {max_depth} calls deep"
+        )
+
+
+def check_call_depth(
+    depth: int, max_depth: int, function_name: str, location: SourceLocation
+) -> None:
+    """Raise CallDepthExceededError if a call *depth* calls deep is too deep."""
+    if depth > max_depth:
+        raise CallDepthExceededError(function_name, max_depth, location)
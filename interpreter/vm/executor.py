@@ -23,6 +23,7 @@ from interpreter.overload.overload_resolver import (
 from interpreter.refs.class_ref import ClassRef
 from interpreter.refs.func_ref import FuncRef
 from interpreter.registry import FunctionRegistry
+from interpreter.run_types import IntegerOverflowMode
 from interpreter.types.coercion.binop_coercion import (
     BinopCoercionStrategy,
     DefaultBinopCoercion,
@@ -77,6 +78,7 @@ class HandlerContext:
     field_fallback: FieldFallbackStrategy
     function_scoping: FunctionScopingStrategy
     symbol_table: SymbolTable
+    integer_overflow: IntegerOverflowMode = IntegerOverflowMode.BIG
 
 
 def _default_handler_context() -> HandlerContext:
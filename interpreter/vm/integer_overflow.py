@@ -0,0 +1,53 @@
+# pyright: standard
+"""Signed 64-bit overflow handling for integer BINOP / UNOP results.
+
+Python integers never overflow, so by default (IntegerOverflowMode.BIG)
+arithmetic is exact and ``grains.total()`` yields 2**64 - 1.  A run can
+instead wrap results to ``int64`` or trap on the first result that does
+not fit; the mode is chosen per run through ``VMConfig.integer_overflow``.
+"""
+
+from __future__ import annotations
+
+from typing import Any
+
+from interpreter.ir import SourceLocation
+from interpreter.run_types import IntegerOverflowMode
+
+INT64_MIN = -(1 << 63)
+INT64_MAX = (1 << 63) - 1
+
+
+class IntegerOverflowError(Exception):
+    """An integer result outside the int64 range under IntegerOverflowMode.TRAP."""
+
+    def __init__(self, expression: str, result: int, location: SourceLocation):
+        self.expression = expression
+        self.result = result
+        self.location = location
+        super().__init__(
+            f"runtime error: integer overflow at {location}: "
+            f"{expression} = {result} does not fit in int64"
+        )
+
+
+def apply_integer_overflow(
+    mode: IntegerOverflowMode,
+    result: Any,  # Any: raw BINOP/UNOP result — only ints are affected
+    expression: str,
+    location: SourceLocation,
+) -> Any:
+    """Return *result* as *mode* sees it; non-integers pass through unchanged.
+
+    Raises IntegerOverflowError under TRAP when *result* does not fit in
+    int64; *expression* and *location* describe the operation for the error.
+    """
+    if (
+        mode is IntegerOverflowMode.BIG
+        or type(result) is not int
+        or INT64_MIN <= result <= INT64_MAX
+    ):
+        return result
+    if mode is IntegerOverflowMode.WRAP:
+        return (result - INT64_MIN) % (1 << 64) + INT64_MIN
+    raise IntegerOverflowError(expression, result, location)
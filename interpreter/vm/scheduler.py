@@ -0,0 +1,102 @@
+"""Cooperative goroutine scheduler.
+
+Goroutines run one at a time on the shared VM.  The running goroutine's
+call stack, exception handlers and in-flight panic live in the VM itself;
+the others wait in ``vm.scheduler.ready`` together with the label and
+instruction index to resume at.  Builtins never switch goroutines
+directly: they set ``vm.scheduler.request`` and the run loop calls
+``switch_goroutine`` once the step is applied, so scheduling is fully
+deterministic.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import GOROUTINE_ENTRY_VAR, GOROUTINE_FRAME_NAME
+from interpreter.func_name import FuncName
+from interpreter.ir import NO_SOURCE_LOCATION, CodeLabel, SourceLocation
+from interpreter.types.typed_value import TypedValue
+from interpreter.var_name import VarName
+from interpreter.vm.vm_types import (
+    Deadlock,
+    Goroutine,
+    Resume,
+    SchedulerRequest,
+    StackFrame,
+    VMState,
+)
+
+
+def spawn_goroutine(vm: VMState, label: CodeLabel, entry: TypedValue) -> int:
+    """Queue a goroutine that starts at *label* with *entry* in its frame.
+
+    Returns the new goroutine's id.  It runs once every goroutine queued
+    before it has blocked or finished.
+    """
+    scheduler = vm.scheduler
+    goroutine_id = scheduler.next_id
+    scheduler.next_id += 1
+    frame = StackFrame(
+        function_name=FuncName(GOROUTINE_FRAME_NAME),
+        local_vars={VarName(GOROUTINE_ENTRY_VAR): entry},
+    )
+    scheduler.ready.append(
+        Goroutine(
+            goroutine_id=goroutine_id,
+            call_stack=[vm.call_stack[0], frame],
+            label=label,
+        )
+    )
+    return goroutine_id
+
+
+def wake_blocked(vm: VMState) -> None:
+    """A channel changed state: let every blocked goroutine retry."""
+    for goroutine in vm.scheduler.ready:
+        goroutine.blocked = False
+
+
+def switch_goroutine(
+    vm: VMState,
+    label: CodeLabel,
+    ip: int,
+    location: SourceLocation = NO_SOURCE_LOCATION,
+) -> Resume | Deadlock:
+    """Act on the pending scheduler request; return where execution continues.
+
+    *label* and *ip* locate the instruction that made the request, and
+    *location* its source.  A blocked goroutine resumes there so the
+    blocking operation is retried; a finished one is dropped.  Returns the
+    ``Deadlock``, with where each goroutine waits, when no goroutine can
+    make progress; it is also recorded on the scheduler.
+    """
+    scheduler = vm.scheduler
+    request = scheduler.request
+    scheduler.request = SchedulerRequest.NONE
+    if request is SchedulerRequest.BLOCK:
+        scheduler.ready.append(
+            Goroutine(
+                goroutine_id=scheduler.running_id,
+                call_stack=list(vm.call_stack),
+                label=label,
+                ip=ip,
+                exception_stack=list(vm.exception_stack),
+                current_exception=vm.current_exception,
+                blocked=True,
+                blocked_at=location,
+            )
+        )
+    runnable = next((g for g in scheduler.ready if not g.blocked), None)
+    if runnable is None:
+        scheduler.deadlock = Deadlock(
+            blocked=tuple(
+                (g.goroutine_id, g.blocked_at)
+                for g in sorted(scheduler.ready, key=lambda g: g.goroutine_id)
+            )
+        )
+        return scheduler.deadlock
+    scheduler.ready.remove(runnable)
+    scheduler.running_id = runnable.goroutine_id
+    vm.call_stack[:] = runnable.call_stack
+    vm.exception_stack[:] = runnable.exception_stack
+    vm.current_exception = runnable.current_exception
+    return Resume(runnable.label, runnable.ip)
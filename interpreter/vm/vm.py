@@ -20,15 +20,19 @@ from interpreter.vm.vm_types import (  # noqa: F401 — re-exported for backward
     ClosureEnvironment,
     ExceptionHandler,
     ExecutionResult,
+    Goroutine,
     HeapObject,
     HeapWrite,
     NewObject,
     Pointer,
     RegionWrite,
+    Scheduler,
+    SchedulerRequest,
     StackFrame,
     StackFramePush,
     StateUpdate,
     SymbolicValue,
+    ThrownException,
     VMState,
     _serialize_value,
 )
@@ -254,6 +258,9 @@ def apply_update(
     for hw in update.heap_writes:
         obj = vm.heap_ensure(hw.obj_addr)
         obj.fields[hw.field] = hw.value
+    for hd in update.heap_deletes:
+        if vm.heap_contains(hd.obj_addr):
+            vm.heap_get(hd.obj_addr).fields.pop(hd.field, None)
 
     # Path condition
     if update.path_condition:
@@ -346,6 +353,24 @@ def _heap_addr(val: Any) -> Address:
     return NO_ADDRESS
 
 
+def _heap_slot(
+    vm: VMState, addr: Address, field: FieldName
+) -> tuple[Address, FieldName]:
+    """The heap object and field that hold *field* of the object at *addr*.
+
+    A Go slice is a view of a backing array: its ``backing`` and ``offset``
+    SPECIAL fields say where its element 0 lives, so its INDEX fields are
+    read and written there.  Any other object holds its own fields.
+    """
+    fields = vm.heap_get(addr).fields
+    backing = fields.get(FieldName("backing", FieldKind.SPECIAL))
+    if backing is None or field.kind != FieldKind.INDEX:
+        return addr, field
+    offset = fields[FieldName("offset", FieldKind.SPECIAL)].value
+    index = offset + int(field.value)
+    return _heap_addr(backing.value), FieldName(str(index), FieldKind.INDEX)
+
+
 def _resolve_reg(vm: VMState, operand: str | Register) -> TypedValue:
     """Resolve a register name to its TypedValue.
 
@@ -3,8 +3,10 @@
 
 from __future__ import annotations
 
+import random
 from collections.abc import ItemsView, KeysView, ValuesView
 from dataclasses import dataclass, field
+from enum import Enum
 from typing import Any
 
 from pydantic import BaseModel, ConfigDict
@@ -15,7 +17,7 @@ from interpreter.constants import FoundationTypeName
 from interpreter.continuation_name import NO_CONTINUATION_NAME, ContinuationName
 from interpreter.field_name import FieldName
 from interpreter.func_name import FuncName
-from interpreter.ir import CodeLabel
+from interpreter.ir import NO_SOURCE_LOCATION, CodeLabel, SourceLocation
 from interpreter.register import NO_REGISTER, Register
 from interpreter.types.type_expr import UNKNOWN, TypeExpr, scalar
 from interpreter.types.typed_value import TypedValue, typed
@@ -90,11 +92,29 @@ class ClosureEnvironment:
 
 @dataclass
 class ExceptionHandler:
-    """Exception handler pushed by TRY_PUSH, popped by TRY_POP or THROW."""
+    """Exception handler pushed by TRY_PUSH, popped by TRY_POP or THROW.
+
+    *frame_depth* is the call-stack depth of the frame that pushed it; a THROW
+    from a deeper frame unwinds back to that frame before jumping to the catch.
+    """
 
     catch_labels: list[CodeLabel] = field(default_factory=list)
     finally_label: CodeLabel | None = None
     end_label: CodeLabel | None = None
+    frame_depth: int = 0
+
+
+@dataclass(frozen=True)
+class ThrownException:
+    """The value of the latest THROW and the call stack it was thrown from.
+
+    *stack_trace* names the active functions innermost first; *location* is
+    the source span of the THROW.
+    """
+
+    value: TypedValue
+    stack_trace: tuple[str, ...] = ()
+    location: SourceLocation = NO_SOURCE_LOCATION
 
 
 @dataclass
@@ -142,6 +162,87 @@ def _serialize_value(v: Any) -> Any:
     return v
 
 
+class SchedulerRequest(Enum):
+    """What the running goroutine asked the scheduler for during a step."""
+
+    NONE = "none"
+    BLOCK = "block"  # retry the current instruction once another goroutine ran
+    EXIT = "exit"  # the goroutine finished
+
+
+@dataclass
+class Goroutine:
+    """A goroutine that is not running: its stack, handlers and resume point.
+
+    Goroutines share the heap; each call stack starts with the shared
+    top-level frame so closures created in ``main`` still reach its locals.
+    """
+
+    goroutine_id: int
+    call_stack: list[StackFrame]
+    label: CodeLabel
+    ip: int = 0
+    exception_stack: list[ExceptionHandler] = field(default_factory=list)
+    current_exception: ThrownException | None = None
+    blocked: bool = False
+    blocked_at: SourceLocation = NO_SOURCE_LOCATION
+
+
+@dataclass(frozen=True)
+class Resume:
+    """Where execution continues after a goroutine switch."""
+
+    label: CodeLabel
+    ip: int
+
+
+@dataclass(frozen=True)
+class Deadlock:
+    """Every goroutine blocked at once — Go's fatal "all goroutines are asleep".
+
+    *blocked* pairs each goroutine's id with the source of the channel
+    operation it waits on, main (id 0) first.
+    """
+
+    blocked: tuple[tuple[int, SourceLocation], ...]
+
+    def __str__(self) -> str:
+        """Go's report, numbering goroutines from 1 as its runtime does."""
+        waits = [f"goroutine {g + 1} blocked at {where}" for g, where in self.blocked]
+        return "\n".join(
+            ["fatal error: all goroutines are asleep - deadlock!", "", *waits]
+        )
+
+
+@dataclass
+class Scheduler:
+    """Cooperative round-robin scheduler state for Go goroutines.
+
+    The running goroutine keeps its state in the VM; *ready* holds the
+    others in the order they will run.  A goroutine only gives up the VM
+    when it blocks on a channel or finishes.  *pending_sends* maps a
+    goroutine blocked after sending on a channel to its position in the
+    channel's send order, so it resumes once that value is received.
+    *waiting_receivers* maps a goroutine blocked receiving to the channels
+    it waits on, which makes a ``select`` send on them ready.  *rng*
+    picks among ready ``select`` cases; seeding it makes runs repeatable.
+    *deadlock* records where each goroutine waited when none could run.
+    """
+
+    ready: list[Goroutine] = field(default_factory=list)
+    running_id: int = 0
+    next_id: int = 1
+    request: SchedulerRequest = SchedulerRequest.NONE
+    pending_sends: dict[int, int] = field(default_factory=dict)
+    waiting_receivers: dict[int, tuple[Address, ...]] = field(default_factory=dict)
+    rng: random.Random = field(default_factory=lambda: random.Random(0))
+    deadlock: Deadlock | None = None
+
+    @property
+    def deadlocked(self) -> bool:
+        return self.deadlock is not None
+
+
 @dataclass
 class VMState:
     _heap: dict[Address, HeapObject] = field(default_factory=dict)
@@ -152,6 +253,9 @@ class VMState:
     _regions: dict[Address, bytearray] = field(default_factory=dict)
     continuations: dict[ContinuationName, CodeLabel] = field(default_factory=dict)
     exception_stack: list[ExceptionHandler] = field(default_factory=list)
+    # Exception in flight; Go's recover() clears it
+    current_exception: ThrownException | None = None
+    scheduler: Scheduler = field(default_factory=Scheduler)
     data_layout: dict[str, dict] = field(default_factory=dict)
     io_provider: Any = (
         None  # Any: Optional CobolIOProvider — avoids COBOL import in core VM — see red-dragon-r32l
@@ -160,6 +264,8 @@ class VMState:
         None  # Any: optional random.Random — FUNCTION RANDOM sequence state
     )
     cobol_random_seed: int | None = None  # last positive seed passed to FUNCTION RANDOM
+    # Text the program printed, one entry per print call, in order
+    stdout: list[str] = field(default_factory=list)
 
     def heap_get(self, addr: Address) -> HeapObject:
         """Get heap object by address. Returns NO_HEAP_OBJECT if not found."""
@@ -224,6 +330,10 @@ class VMState:
     def current_frame(self) -> StackFrame:
         return self.call_stack[-1]
 
+    def stdout_text(self) -> str:
+        """Everything the program has printed so far, as one string."""
+        return "".join(self.stdout)
+
     def to_dict(self) -> dict:
         result: dict[str, Any] = {
             "heap": {str(k): v.to_dict() for k, v in self._heap.items()},
@@ -245,6 +355,8 @@ class VMState:
             }
         if self.data_layout:
             result["data_layout"] = dict(self.data_layout)
+        if self.stdout:
+            result["stdout"] = self.stdout_text()
         return result
 
 
@@ -258,6 +370,12 @@ class HeapWrite(BaseModel):
     value: Any  # Any: heterogeneous heap field value (TypedValue | raw runtime) — see red-dragon-r32l
 
 
+class HeapDelete(BaseModel):
+    model_config = ConfigDict(arbitrary_types_allowed=True)
+    obj_addr: Address
+    field: FieldName
+
+
 class NewObject(BaseModel):
     model_config = ConfigDict(arbitrary_types_allowed=True)
     addr: Address
@@ -275,12 +393,14 @@ class BuiltinResult:
     """Uniform return type for all builtins.
 
     Pure builtins return BuiltinResult(value=...) with empty side-effect lists.
-    Heap-mutating builtins express mutations as new_objects + heap_writes.
+    Heap-mutating builtins express mutations as new_objects + heap_writes
+    (and heap_deletes for fields they remove).
     """
 
     value: Any  # Any: heterogeneous VM return value (int | str | bool | TypedValue | Pointer | SymbolicValue | None | Uncomputable) — see red-dragon-r32l
     new_objects: list[NewObject] = field(default_factory=list)
     heap_writes: list[HeapWrite] = field(default_factory=list)
+    heap_deletes: list[HeapDelete] = field(default_factory=list)
 
 
 class StackFramePush(BaseModel):
@@ -304,6 +424,7 @@ class StateUpdate(BaseModel):
         {}
     )  # Any: TypedValue | raw runtime value (pre-materialization) — see red-dragon-r32l
     heap_writes: list[HeapWrite] = []
+    heap_deletes: list[HeapDelete] = []
     new_objects: list[NewObject] = []
     region_writes: list[RegionWrite] = []
     new_regions: dict[str, int] = {}
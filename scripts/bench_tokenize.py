@@ -0,0 +1,97 @@
+#!/usr/bin/env python3
+"""Benchmark the token stream over the Exercism solutions.
+
+Tokenizes every solution three ways: reading only each token's kind and
+category, reading its text as well, and reading its text and location,
+which is what scanning cost when every token was materialized up front.
+For each it reports the wall time, the tokens per second, and the peak
+memory traced while the tokens were alive.
+
+Usage:
+    uv run python scripts/bench_tokenize.py
+    uv run python scripts/bench_tokenize.py --rounds 10
+"""
+
+from __future__ import annotations
+
+import argparse
+import logging
+import sys
+import time
+import tracemalloc
+from collections.abc import Callable
+from pathlib import Path
+
+sys.path.insert(0, str(Path(__file__).resolve().parent.parent))
+
+from interpreter.constants import Language
+from interpreter.frontends import SUPPORTED_DETERMINISTIC_LANGUAGES
+from interpreter.project.language_detection import detect_language
+from interpreter.scanner import tokenize
+from interpreter.token_types import Token
+
+logger = logging.getLogger(__name__)
+
+_EXERCISES = (
+    Path(__file__).resolve().parent.parent / "tests" / "unit" / "exercism" / "exercises"
+)
+
+_READS: tuple[tuple[str, Callable[[Token], object]], ...] = (
+    ("kinds", lambda token: token.category),
+    ("text", lambda token: token.text),
+    ("location", lambda token: (token.text, token.location)),
+)
+
+
+def _corpus() -> list[tuple[Language, str]]:
+    files = sorted(_EXERCISES.glob("*/solutions/*"))
+    return [
+        (language, path.read_text(encoding="utf-8", errors="replace"))
+        for path in files
+        for language in [detect_language(path)]
+        if language in SUPPORTED_DETERMINISTIC_LANGUAGES
+    ]
+
+
+def _run(
+    read: Callable[[Token], object], corpus: list[tuple[Language, str]], rounds: int
+) -> tuple[float, int, int]:
+    tracemalloc.start()
+    started = time.perf_counter()
+    tokens = [
+        token
+        for _ in range(rounds)
+        for language, source in corpus
+        for token in tokenize(source, language)
+        if read(token) is not None
+    ]
+    elapsed = time.perf_counter() - started
+    _, peak = tracemalloc.get_traced_memory()
+    tracemalloc.stop()
+    return elapsed, len(tokens), peak
+
+
+def main():
+    parser = argparse.ArgumentParser(description="Benchmark the token stream")
+    parser.add_argument(
+        "--rounds", type=int, default=3, help="Times to tokenize each file"
+    )
+    args = parser.parse_args()
+    logging.basicConfig(level=logging.INFO, format="%(message)s")
+
+    corpus = _corpus()
+    logger.info("%d files, %d rounds", len(corpus), args.rounds)
+    logger.info("%-9s %10s %12s %12s", "reads", "wall (s)", "tokens/s", "peak (MB)")
+    for name, read in _READS:
+        elapsed, count, peak = _run(read, corpus, args.rounds)
+        logger.info(
+            "%-9s %10.3f %12.0f %12.1f",
+            name,
+            elapsed,
+            count / elapsed if elapsed else 0.0,
+            peak / 1_000_000,
+        )
+
+
+if __name__ == "__main__":
+    main()
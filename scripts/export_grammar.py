@@ -0,0 +1,79 @@
+#!/usr/bin/env python3
+"""Export the grammar each deterministic frontend lowers as EBNF and SVG.
+
+Reads the grammar off the frontends' dispatch tables and writes, for each
+language, ``<language>.ebnf`` and a ``<language>/`` directory holding one
+railroad diagram per production, named ``<production>.svg``.
+
+The compiled parsers do not carry their grammar rules, so the production
+of each lowered node kind is only exported with ``--tree-sitter-grammars``:
+a directory holding ``<language>/grammar.json``, the ``src/grammar.json``
+of each tree-sitter grammar repository.
+
+Usage:
+    uv run python scripts/export_grammar.py
+    uv run python scripts/export_grammar.py --language go --output /tmp/grammar
+    uv run python scripts/export_grammar.py --tree-sitter-grammars ~/grammars
+"""
+
+from __future__ import annotations
+
+import argparse
+import json
+import logging
+import sys
+from pathlib import Path
+
+sys.path.insert(0, str(Path(__file__).resolve().parent.parent))
+
+from interpreter.constants import Language
+from interpreter.frontends import SUPPORTED_DETERMINISTIC_LANGUAGES
+from interpreter.grammar_export import implemented_grammar, to_ebnf
+from interpreter.railroad import railroad_svg
+
+logger = logging.getLogger(__name__)
+
+
+def main():
+    parser = argparse.ArgumentParser(description="Export the implemented grammar")
+    parser.add_argument(
+        "--language",
+        choices=list(SUPPORTED_DETERMINISTIC_LANGUAGES),
+        help="Export only this language",
+    )
+    parser.add_argument(
+        "--output", type=Path, default=Path("grammar"), help="Output directory"
+    )
+    parser.add_argument(
+        "--tree-sitter-grammars",
+        type=Path,
+        help="Directory of <language>/grammar.json, to export each kind's rule",
+    )
+    args = parser.parse_args()
+    logging.basicConfig(level=logging.INFO, format="%(message)s")
+
+    languages = [args.language] if args.language else SUPPORTED_DETERMINISTIC_LANGUAGES
+    for name in languages:
+        rules = (
+            args.tree_sitter_grammars / name / "grammar.json"
+            if args.tree_sitter_grammars
+            else None
+        )
+        grammar = implemented_grammar(
+            Language(name),
+            json.loads(rules.read_text(encoding="utf-8"))
+            if rules and rules.is_file()
+            else None,
+        )
+        diagrams = args.output / name
+        diagrams.mkdir(parents=True, exist_ok=True)
+        (args.output / f"{name}.ebnf").write_text(to_ebnf(grammar), encoding="utf-8")
+        for production in grammar.productions:
+            (diagrams / f"{production.name}.svg").write_text(
+                railroad_svg(production), encoding="utf-8"
+            )
+        logger.info("%s: %d productions", name, len(grammar.productions))
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,74 @@
+#!/usr/bin/env python3
+"""Fuzz the deterministic frontends from the Exercism solutions.
+
+Mutates every Exercism solution with a seeded random generator, lowers the
+result, and reports each input the frontend crashed on.  Failing inputs are
+written to the output directory, one file per failure, for replaying with
+``interpreter.fuzz_parse.fuzz_parse``.
+
+Usage:
+    uv run python scripts/fuzz_parse.py
+    uv run python scripts/fuzz_parse.py --iterations 5000 --seed 7
+    uv run python scripts/fuzz_parse.py --language go --output /tmp/crashes
+"""
+
+from __future__ import annotations
+
+import argparse
+import logging
+import sys
+from pathlib import Path
+
+sys.path.insert(0, str(Path(__file__).resolve().parent.parent))
+
+from interpreter.constants import Language
+from interpreter.fuzz_parse import run_fuzz, seed_corpus
+
+logger = logging.getLogger(__name__)
+
+_EXERCISES = (
+    Path(__file__).resolve().parent.parent / "tests" / "unit" / "exercism" / "exercises"
+)
+
+
+def main():
+    parser = argparse.ArgumentParser(description="Fuzz the deterministic frontends")
+    parser.add_argument(
+        "--iterations", type=int, default=1000, help="Inputs per language"
+    )
+    parser.add_argument("--seed", type=int, default=0, help="Random seed")
+    parser.add_argument(
+        "--language",
+        choices=[language.value for language in Language],
+        help="Fuzz only this language",
+    )
+    parser.add_argument(
+        "--output", type=Path, default=Path("fuzz-crashes"), help="Crash directory"
+    )
+    args = parser.parse_args()
+    logging.basicConfig(level=logging.INFO, format="%(message)s")
+
+    corpus = seed_corpus(list(_EXERCISES.glob("*/solutions/*")))
+    if args.language:
+        corpus = {
+            language: seeds
+            for language, seeds in corpus.items()
+            if language.value == args.language
+        }
+    failures = run_fuzz(corpus, args.iterations, args.seed)
+    for i, failure in enumerate(failures):
+        path = args.output / f"{failure.language.value}-{i}.bin"
+        path.parent.mkdir(parents=True, exist_ok=True)
+        path.write_bytes(failure.data)
+        logger.info("%s: %r", path, failure.error)
+    logger.info(
+        "%d languages, %d inputs each: %d failures",
+        len(corpus),
+        args.iterations,
+        len(failures),
+    )
+    sys.exit(1 if failures else 0)
+
+
+if __name__ == "__main__":
+    main()
@@ -2,7 +2,8 @@
 
 Centralizes the common ``run(source) -> unwrap top-level locals`` pattern used
 across the per-language ``test_<lang>_*_execution`` modules so the VM call
-shape is defined exactly once.
+shape is defined exactly once, along with ``run_stdout`` for programs whose
+result is what they print.
 """
 
 from __future__ import annotations
@@ -22,3 +23,14 @@ def run_locals(source: str, language: Language, max_steps: int = 500) -> dict:
         entry_point=EntryPoint.top_level(),
     )
     return unwrap_locals(vm.call_stack[0].local_vars)
+
+
+def run_stdout(source: str, language: Language, max_steps: int = 500) -> str:
+    """Run a single-source program at top level; return everything it printed."""
+    vm = run(
+        source,
+        language=language,
+        max_steps=max_steps,
+        entry_point=EntryPoint.top_level(),
+    )
+    return vm.stdout_text()
@@ -285,7 +285,9 @@ class TestGoMultiFile:
                     "package utils\n" "func Add(a int, b int) int { return a + b }\n"
                 ),
                 "main.go": (
-                    "package main\n" 'import "./utils"\n' "var result = Add(10, 20)\n"
+                    "package main\n"
+                    'import "./utils"\n'
+                    "var result = utils.Add(10, 20)\n"
                 ),
             },
             "main.go",
@@ -0,0 +1,176 @@
+"""Integration tests: Go programs split across files and packages.
+
+A directory is compiled as one program: files of a package share their
+package-level names, other packages are imported by relative path or by
+the module path in go.mod, and only their capitalized names are visible.
+"""
+
+from pathlib import Path
+
+import pytest
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.go.namespace import GoImportCycleError
+from interpreter.frontends.go.packages import GoPackageMemberError
+from interpreter.project.compiler import compile_directory
+from interpreter.run import ExecutionStrategies, execute_cfg, initial_vm_state
+from interpreter.run_types import VMConfig
+from interpreter.types.typed_value import TypedValue
+from interpreter.var_name import VarName
+from tests.covers import covers
+
+_RECT_GO = """\
+package geometry
+
+type Rect struct {
+    W, H int
+}
+
+func (r Rect) Area() int {
+    return r.W * r.H
+}
+"""
+
+_SQUARE_GO = """\
+package geometry
+
+var created = 0
+
+func Square(side int) Rect {
+    created++
+    return Rect{side, side}
+}
+
+func Created() int {
+    return created
+}
+"""
+
+
+def _write(root: Path, files: dict[str, str]) -> None:
+    for name, content in files.items():
+        path = root / name
+        path.parent.mkdir(parents=True, exist_ok=True)
+        path.write_text(content)
+
+
+def _run_directory(root: Path, files: dict[str, str]) -> dict:
+    _write(root, files)
+    linked = compile_directory(root, Language.GO)
+    vm, _ = execute_cfg(
+        linked.merged_cfg,
+        linked.merged_cfg.entry,
+        linked.merged_registry,
+        VMConfig(max_steps=1000),
+        ExecutionStrategies(
+            func_symbol_table=linked.func_symbol_table,
+            class_symbol_table=linked.class_symbol_table,
+        ),
+        vm=initial_vm_state(),
+    )
+    return {
+        k: v.value if isinstance(v, TypedValue) else v
+        for k, v in vm.call_stack[0].local_vars.items()
+    }
+
+
+class TestGoPackagesExecution:
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_package_imported_through_go_mod_module_path(self, tmp_path):
+        result = _run_directory(
+            tmp_path,
+            {
+                "go.mod": "module example.com/shapes\n\ngo 1.21\n",
+                "geometry/rect.go": _RECT_GO,
+                "geometry/square.go": _SQUARE_GO,
+                "main.go": """\
+package main
+
+import "example.com/shapes/geometry"
+
+func main() {
+    r := geometry.Rect{W: 2, H: 3}
+    area := r.Area()
+    sq := geometry.Square(4)
+    sqArea := sq.Area()
+    geometry.Square(1)
+    made := geometry.Created()
+}
+""",
+            },
+        )
+        assert result[VarName("area")] == 6
+        assert result[VarName("sqArea")] == 16
+        assert result[VarName("made")] == 2
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_package_main_split_across_files(self, tmp_path):
+        result = _run_directory(
+            tmp_path,
+            {
+                "main.go": """\
+package main
+
+import "./geometry"
+
+func main() {
+    total := sum(sides)
+    r := geometry.Square(total)
+    area := r.Area()
+}
+""",
+                "sum.go": """\
+package main
+
+var sides = []int{1, 2, 3}
+
+func sum(xs []int) int {
+    total := 0
+    for _, x := range xs {
+        total += x
+    }
+    return total
+}
+""",
+                "geometry/rect.go": _RECT_GO,
+                "geometry/square.go": _SQUARE_GO,
+            },
+        )
+        assert result[VarName("total")] == 6
+        assert result[VarName("area")] == 36
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_unexported_name_is_rejected(self, tmp_path):
+        _write(
+            tmp_path,
+            {
+                "geometry/square.go": _SQUARE_GO,
+                "geometry/rect.go": _RECT_GO,
+                "main.go": (
+                    'package main\nimport "./geometry"\n'
+                    "func main() {\n    n := geometry.created\n}\n"
+                ),
+            },
+        )
+        with pytest.raises(
+            GoPackageMemberError, match="name created not exported by package geometry"
+        ):
+            compile_directory(tmp_path, Language.GO)
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_import_cycle_is_rejected(self, tmp_path):
+        _write(
+            tmp_path,
+            {
+                "a/a.go": 'package a\nimport "../b"\nfunc A() int { return b.B() }\n',
+                "b/b.go": 'package b\nimport "../a"\nfunc B() int { return a.A() }\n',
+                "main.go": (
+                    'package main\nimport "./a"\n' "func main() {\n    x := a.A()\n}\n"
+                ),
+            },
+        )
+        with pytest.raises(
+            GoImportCycleError, match="import cycle not allowed: a imports b imports a"
+        ):
+            compile_directory(tmp_path, Language.GO)
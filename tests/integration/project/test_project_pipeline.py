@@ -11,6 +11,7 @@ from interpreter.func_name import FuncName
 from interpreter.project.compiler import compile_directory, compile_module
 from interpreter.project.types import LinkedProgram, ModuleUnit
 from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
 
 
 class TestCompileModulePython:
@@ -211,3 +212,41 @@ class TestCompileModuleC:
         )
 
         assert len(linked.modules) == 2
+
+
+class TestParallelCompile:
+    """Files compiled concurrently link exactly as if compiled in turn."""
+
+    @pytest.fixture
+    def project(self, tmp_path):
+        for i in range(8):
+            (tmp_path / f"mod{i}.py").write_text(
+                f"def f{i}(x):\n    return x + {i}\n"
+            )
+        (tmp_path / "main.py").write_text(
+            "from mod3 import f3\nfrom mod5 import f5\n\nresult = f3(f5(1))\n"
+        )
+        return tmp_path
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_same_program_as_sequential(self, project):
+        sequential = compile_directory(project, Language.PYTHON, max_workers=1)
+        parallel = compile_directory(project, Language.PYTHON, max_workers=4)
+
+        assert list(parallel.modules) == list(sequential.modules)
+        assert [str(i) for i in parallel.merged_ir] == [
+            str(i) for i in sequential.merged_ir
+        ]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_diagnostics_merged_by_path(self, tmp_path):
+        (tmp_path / "b.py").write_text("x = = 1\n")
+        (tmp_path / "a.py").write_text("def f(:\n    return 1\n")
+        (tmp_path / "ok.py").write_text("y = 2\n")
+
+        linked = compile_directory(tmp_path, Language.PYTHON, max_workers=3)
+
+        paths = [path.name for path, _ in linked.diagnostics]
+        assert paths == sorted(paths)
+        assert set(paths) == {"a.py", "b.py"}
+        assert linked.modules[(tmp_path / "ok.py").resolve()].diagnostics == ()
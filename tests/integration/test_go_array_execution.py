@@ -0,0 +1,167 @@
+"""Integration tests for Go fixed-size arrays.
+
+Verifies array literals, zero values, index reads and writes, len(), and
+the out-of-range panic through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoArrayLiteralExecution:
+    @covers(GoFeature.ARRAY)
+    def test_literal_elements_are_readable(self):
+        source = """\
+package main
+func main() {
+    a := [3]int{10, 20, 30}
+    first := a[0]
+    last := a[2]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("first")] == 10
+        assert vars_[VarName("last")] == 30
+
+    @covers(GoFeature.ARRAY)
+    def test_partial_literal_zero_fills_remaining_slots(self):
+        source = """\
+package main
+func main() {
+    a := [5]int{1, 2}
+    tail := a[4]
+    n := len(a)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("tail")] == 0
+        assert vars_[VarName("n")] == 5
+
+    @covers(GoFeature.ARRAY)
+    def test_keyed_literal_places_elements_by_index(self):
+        source = """\
+package main
+func main() {
+    a := [...]string{2: "c", 0: "a"}
+    n := len(a)
+    first := a[0]
+    middle := a[1]
+    last := a[2]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 3
+        assert vars_[VarName("first")] == "a"
+        assert vars_[VarName("middle")] == ""
+        assert vars_[VarName("last")] == "c"
+
+
+class TestGoArrayZeroValueExecution:
+    @covers(GoFeature.ARRAY)
+    def test_var_array_has_zero_elements(self):
+        source = """\
+package main
+func main() {
+    var flags [4]bool
+    var nums [3]int
+    f := flags[3]
+    n := nums[1]
+    size := len(flags)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("f")] is False
+        assert vars_[VarName("n")] == 0
+        assert vars_[VarName("size")] == 4
+
+    @covers(GoFeature.ARRAY)
+    def test_nested_array_rows_are_independent(self):
+        source = """\
+package main
+func main() {
+    var grid [2][2]int
+    grid[0][1] = 5
+    top := grid[0][1]
+    bottom := grid[1][1]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("top")] == 5
+        assert vars_[VarName("bottom")] == 0
+
+
+class TestGoArrayIndexExecution:
+    @covers(GoFeature.ARRAY)
+    def test_assignment_through_index(self):
+        source = """\
+package main
+func main() {
+    var a [3]int
+    for i := 0; i < len(a); i++ {
+        a[i] = i * i
+    }
+    total := a[0] + a[1] + a[2]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 0 + 1 + 4
+
+    @covers(GoFeature.ARRAY)
+    def test_range_over_array(self):
+        source = """\
+package main
+func main() {
+    a := [4]int{1, 2, 3, 4}
+    total := 0
+    for _, v := range a {
+        total = total + v
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 10
+
+    @covers(GoFeature.ARRAY)
+    def test_sieve_counts_primes(self):
+        source = """\
+package main
+func main() {
+    var composite [30]bool
+    count := 0
+    for i := 2; i < 30; i++ {
+        if !composite[i] {
+            count = count + 1
+            for j := i * i; j < 30; j = j + i {
+                composite[j] = true
+            }
+        }
+    }
+}
+"""
+        vars_ = _run_go(source, max_steps=5000)
+        assert vars_[VarName("count")] == 10
+
+    @covers(GoFeature.ARRAY)
+    def test_out_of_range_index_panics(self):
+        source = """\
+package main
+func main() {
+    var a [3]int
+    before := 1
+    i := 3
+    a[i] = 9
+    after := 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("before")] == 1
+        assert VarName("after") not in vars_
@@ -0,0 +1,98 @@
+"""Integration tests for Go bool values.
+
+Verifies that true/false literals, comparisons, logical operators and
+bool-returning functions produce real booleans (not 0/1 ints) through the
+full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 500) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoBoolExecution:
+    @covers(GoFeature.BOOLEAN)
+    def test_bool_literals(self):
+        source = """\
+package main
+func main() {
+    t := true
+    f := false
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("t")] is True
+        assert vars_[VarName("f")] is False
+
+    @covers(GoFeature.BOOLEAN)
+    def test_comparisons_produce_bool(self):
+        source = """\
+package main
+func main() {
+    x := 3
+    lt := x < 5
+    eq := x == 4
+    ne := x != 4
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("lt")] is True
+        assert vars_[VarName("eq")] is False
+        assert vars_[VarName("ne")] is True
+
+    @covers(GoFeature.BOOLEAN)
+    def test_not_operator_negates_bool(self):
+        source = """\
+package main
+func main() {
+    ready := false
+    waiting := !ready
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("waiting")] is True
+
+    @covers(GoFeature.BOOLEAN)
+    def test_bool_function_result_drives_if(self):
+        source = """\
+package main
+
+func isEven(n int) bool {
+    return n % 2 == 0
+}
+
+func main() {
+    even := isEven(4)
+    label := "odd"
+    if isEven(10) {
+        label = "even"
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("even")] is True
+        assert vars_[VarName("label")] == "even"
+
+    @covers(GoFeature.BOOLEAN)
+    def test_bool_variable_declared_with_type(self):
+        source = """\
+package main
+func main() {
+    var done bool = true
+    state := 0
+    if done {
+        state = 1
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("done")] is True
+        assert vars_[VarName("state")] == 1
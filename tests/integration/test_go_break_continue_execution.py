@@ -0,0 +1,268 @@
+"""Integration tests for Go break/continue.
+
+Verifies that break and continue target the innermost enclosing loop —
+including across nested loops and from inside switch/select bodies —
+through the full parse → lower → execute pipeline, and that labeled
+break, continue and goto reach the statement they name.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoBreakExecution:
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_break_exits_c_style_loop(self):
+        source = """\
+package main
+func main() {
+    last := 0
+    for i := 0; i < 100; i++ {
+        if i == 5 {
+            break
+        }
+        last = i
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("last")] == 4
+
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_break_exits_infinite_loop(self):
+        source = """\
+package main
+func main() {
+    n := 0
+    for {
+        n = n + 1
+        if n >= 7 {
+            break
+        }
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 7
+
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_break_exits_range_loop(self):
+        source = """\
+package main
+func main() {
+    seen := 0
+    for i := range 10 {
+        if i == 3 {
+            break
+        }
+        seen = seen + 1
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("seen")] == 3
+
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_break_in_inner_loop_keeps_outer_running(self):
+        source = """\
+package main
+func main() {
+    outer := 0
+    inner := 0
+    for i := 0; i < 3; i++ {
+        outer = outer + 1
+        for j := 0; j < 10; j++ {
+            if j == 2 {
+                break
+            }
+            inner = inner + 1
+        }
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("outer")] == 3
+        assert vars_[VarName("inner")] == 6
+
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_break_inside_switch_exits_only_switch(self):
+        source = """\
+package main
+func main() {
+    iterations := 0
+    for i := 0; i < 4; i++ {
+        switch i {
+        case 1:
+            break
+        }
+        iterations = iterations + 1
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("iterations")] == 4
+
+
+class TestGoContinueExecution:
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_continue_skips_rest_of_body(self):
+        source = """\
+package main
+func main() {
+    total := 0
+    for i := 0; i < 6; i++ {
+        if i % 2 == 0 {
+            continue
+        }
+        total = total + i
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 1 + 3 + 5
+
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_continue_in_condition_only_loop_rechecks_condition(self):
+        source = """\
+package main
+func main() {
+    i := 0
+    odd := 0
+    for i < 5 {
+        i = i + 1
+        if i % 2 == 0 {
+            continue
+        }
+        odd = odd + 1
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("odd")] == 3
+
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_continue_in_inner_loop_advances_inner_only(self):
+        source = """\
+package main
+func main() {
+    pairs := 0
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            if i == j {
+                continue
+            }
+            pairs = pairs + 1
+        }
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("pairs")] == 6
+
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_continue_inside_switch_continues_enclosing_loop(self):
+        source = """\
+package main
+func main() {
+    counted := 0
+    for i := 0; i < 5; i++ {
+        switch i {
+        case 2, 3:
+            continue
+        }
+        counted = counted + 1
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("counted")] == 3
+
+
+class TestGoLabeledJumpExecution:
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_labeled_break_exits_both_loops(self):
+        source = """\
+package main
+func main() {
+    fi, fj := -1, -1
+search:
+    for i := 0; i < 5; i++ {
+        for j := 0; j < 5; j++ {
+            if i*j == 6 {
+                fi, fj = i, j
+                break search
+            }
+        }
+    }
+}
+"""
+        vars_ = _run_go(source, max_steps=3000)
+        assert vars_[VarName("fi")] == 2
+        assert vars_[VarName("fj")] == 3
+
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_labeled_continue_skips_rest_of_outer_iteration(self):
+        source = """\
+package main
+func main() {
+    count := 0
+rows:
+    for i := 0; i < 4; i++ {
+        for j := 0; j < 4; j++ {
+            if j > i {
+                continue rows
+            }
+            count = count + 1
+        }
+    }
+}
+"""
+        vars_ = _run_go(source, max_steps=3000)
+        assert vars_[VarName("count")] == 1 + 2 + 3 + 4
+
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_labeled_break_leaves_loop_from_inside_switch(self):
+        source = """\
+package main
+func main() {
+    n := 0
+loop:
+    for {
+        switch n {
+        case 3:
+            break loop
+        default:
+            n = n + 1
+        }
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 3
+
+    @covers(GoFeature.GOTO)
+    def test_goto_loops_backwards(self):
+        source = """\
+package main
+func main() {
+    i := 0
+    sum := 0
+again:
+    sum = sum + i
+    i = i + 1
+    if i < 5 {
+        goto again
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("sum")] == 10
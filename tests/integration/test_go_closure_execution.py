@@ -0,0 +1,186 @@
+"""Integration tests for Go closures.
+
+Verifies that function literals capture enclosing variables by reference —
+counter factories, independent environments per factory call, writes seen
+by the enclosing function, and recursive closures — through the full
+parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoClosureFactoryExecution:
+    @covers(GoFeature.CLOSURE)
+    def test_counter_factory_keeps_state(self):
+        source = """\
+package main
+func counter() func() int {
+    count := 0
+    return func() int {
+        count++
+        return count
+    }
+}
+func main() {
+    next := counter()
+    a := next()
+    b := next()
+    c := next()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] == 1
+        assert vars_[VarName("b")] == 2
+        assert vars_[VarName("c")] == 3
+
+    @covers(GoFeature.CLOSURE)
+    def test_each_factory_call_gets_its_own_environment(self):
+        source = """\
+package main
+func counter() func() int {
+    count := 0
+    return func() int {
+        count++
+        return count
+    }
+}
+func main() {
+    first := counter()
+    second := counter()
+    first()
+    first()
+    a := first()
+    b := second()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] == 3
+        assert vars_[VarName("b")] == 1
+
+    @covers(GoFeature.CLOSURE)
+    def test_closure_captures_parameter(self):
+        source = """\
+package main
+func adder(base int) func(int) int {
+    return func(n int) int {
+        return base + n
+    }
+}
+func main() {
+    addTen := adder(10)
+    r := addTen(5)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 15
+
+
+class TestGoClosureSharingExecution:
+    @covers(GoFeature.CLOSURE)
+    def test_enclosing_function_sees_closure_writes(self):
+        source = """\
+package main
+func sumTo(n int) int {
+    total := 0
+    addTo := func(k int) {
+        total = total + k
+    }
+    for i := 1; i <= n; i++ {
+        addTo(i)
+    }
+    return total
+}
+func main() {
+    s := sumTo(4)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("s")] == 10
+
+    @covers(GoFeature.CLOSURE)
+    def test_closure_sees_later_enclosing_writes(self):
+        source = """\
+package main
+func run() int {
+    x := 1
+    get := func() int {
+        return x
+    }
+    x = 5
+    return get()
+}
+func main() {
+    r := run()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 5
+
+    @covers(GoFeature.CLOSURE)
+    def test_closure_in_main_mutates_local(self):
+        source = """\
+package main
+func main() {
+    hits := 0
+    hit := func() {
+        hits = hits + 1
+    }
+    hit()
+    hit()
+    n := hits
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 2
+
+    @covers(GoFeature.CLOSURE)
+    def test_closure_parameter_does_not_clobber_captured_name(self):
+        source = """\
+package main
+func run() int {
+    n := 100
+    double := func(n int) int {
+        return n * 2
+    }
+    d := double(4)
+    return n + d
+}
+func main() {
+    r := run()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 108
+
+
+class TestGoRecursiveClosureExecution:
+    @covers(GoFeature.CLOSURE)
+    def test_closure_calls_itself_through_variable(self):
+        source = """\
+package main
+func fibN(n int) int {
+    var fib func(int) int
+    fib = func(k int) int {
+        if k < 2 {
+            return k
+        }
+        return fib(k-1) + fib(k-2)
+    }
+    return fib(n)
+}
+func main() {
+    f := fibN(7)
+}
+"""
+        vars_ = _run_go(source, max_steps=5000)
+        assert vars_[VarName("f")] == 13
@@ -0,0 +1,124 @@
+"""Integration tests for Go compound assignment.
+
+Verifies that x op= y behaves as x = x op y for the arithmetic and
+bitwise operators, on variables, struct fields, slice elements and map
+entries, through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoCompoundAssignmentExecution:
+    @covers(GoFeature.COMPOUND_ASSIGNMENT)
+    def test_arithmetic_operators(self):
+        source = """\
+package main
+func main() {
+    a := 10
+    a += 5
+    b := 10
+    b -= 3
+    c := 10
+    c *= 4
+    d := 17
+    d /= 5
+    e := 17
+    e %= 5
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] == 15
+        assert vars_[VarName("b")] == 7
+        assert vars_[VarName("c")] == 40
+        assert vars_[VarName("d")] == 3
+        assert vars_[VarName("e")] == 2
+
+    @covers(GoFeature.COMPOUND_ASSIGNMENT)
+    def test_bitwise_operators(self):
+        source = """\
+package main
+func main() {
+    x := 6
+    x &= 3
+    y := 4
+    y |= 1
+    z := 5
+    z ^= 1
+    l := 1
+    l <<= 4
+    r := 64
+    r >>= 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("x")] == 2
+        assert vars_[VarName("y")] == 5
+        assert vars_[VarName("z")] == 4
+        assert vars_[VarName("l")] == 16
+        assert vars_[VarName("r")] == 16
+
+    @covers(GoFeature.COMPOUND_ASSIGNMENT)
+    def test_accumulate_in_loop(self):
+        source = """\
+package main
+func main() {
+    total := 0
+    for i := 1; i <= 10; i += 1 {
+        total += i
+    }
+    s := ""
+    for i := 0; i < 3; i++ {
+        s += "ab"
+    }
+}
+"""
+        vars_ = _run_go(source, max_steps=3000)
+        assert vars_[VarName("total")] == 55
+        assert vars_[VarName("s")] == "ababab"
+
+    @covers(GoFeature.COMPOUND_ASSIGNMENT)
+    def test_field_element_and_map_targets(self):
+        source = """\
+package main
+type Counter struct {
+    n int
+}
+func main() {
+    c := Counter{n: 1}
+    c.n += 2
+    xs := []int{1, 2, 3}
+    xs[1] *= 10
+    counts := map[string]int{}
+    counts["go"] += 1
+    counts["go"] += 1
+    n := c.n
+    mid := xs[1]
+    gos := counts["go"]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 3
+        assert vars_[VarName("mid")] == 20
+        assert vars_[VarName("gos")] == 2
+
+    @covers(GoFeature.COMPOUND_ASSIGNMENT)
+    def test_float_division(self):
+        source = """\
+package main
+func main() {
+    f := 7.0
+    f /= 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("f")] == 3.5
@@ -0,0 +1,124 @@
+"""Integration tests for Go constants.
+
+Verifies package-level and local const declarations, compile-time
+folding of constant expressions with Go's integer division, iota
+enumerations that repeat the previous expression, and typed constants,
+through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 500) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoConstExpressionExecution:
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_package_consts_build_on_each_other(self):
+        source = """\
+package main
+const KB = 1 << 10
+const MB = KB * KB
+const Label = "size"
+func main() {
+    mb := MB
+    label := Label + ":" + "MB"
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("mb")] == 1048576
+        assert vars_[VarName("label")] == "size:MB"
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_integer_division_truncates_toward_zero(self):
+        source = """\
+package main
+const Q = -7 / 2
+const R = -7 % 2
+const F = 7.0 / 2
+func main() {
+    q := Q
+    r := R
+    f := F
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("q")] == -3
+        assert vars_[VarName("r")] == -1
+        assert vars_[VarName("f")] == 3.5
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_local_const_and_multiple_names(self):
+        source = """\
+package main
+func area(r int) int {
+    const scale, offset = 3, 1
+    return r*r*scale + offset
+}
+func main() {
+    a := area(2)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] == 13
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_typed_const_takes_float_kind(self):
+        source = """\
+package main
+const Ratio float64 = 3
+func main() {
+    half := Ratio / 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("half")] == 1.5
+
+
+class TestGoIotaEnumerationExecution:
+    @covers(GoFeature.IOTA)
+    def test_iota_powers_repeat_previous_expression(self):
+        source = """\
+package main
+const (
+    _  = iota
+    KB = 1 << (10 * iota)
+    MB
+    GB
+)
+func main() {
+    kb := KB
+    mb := MB
+    gb := GB
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("kb")] == 1024
+        assert vars_[VarName("mb")] == 1048576
+        assert vars_[VarName("gb")] == 1073741824
+
+    @covers(GoFeature.IOTA)
+    def test_iota_with_typed_enum(self):
+        source = """\
+package main
+type Weekday int
+const (
+    Sunday Weekday = iota
+    Monday
+    Tuesday
+)
+func main() {
+    t := Tuesday
+    weekend := Sunday == 0
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("t")] == 2
+        assert vars_[VarName("weekend")] is True
@@ -0,0 +1,163 @@
+"""Integration tests for Go defer.
+
+Verifies last-in-first-out execution of deferred calls at function exit,
+argument evaluation at defer time, deferred closures updating named
+results, deferred method calls, and defer in main, through the full
+parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoDeferOrderExecution:
+    @covers(GoFeature.DEFER)
+    def test_deferred_calls_run_last_in_first_out(self):
+        source = """\
+package main
+func order() (s int) {
+    defer func() { s = s*10 + 1 }()
+    defer func() { s = s*10 + 2 }()
+    defer func() { s = s*10 + 3 }()
+    return 0
+}
+func main() {
+    r := order()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 321
+
+    @covers(GoFeature.DEFER)
+    def test_defer_in_loop_runs_each_iteration_in_reverse(self):
+        source = """\
+package main
+func loop() (s int) {
+    for i := 1; i <= 3; i++ {
+        defer func(k int) { s = s*10 + k }(i)
+    }
+    return 0
+}
+func main() {
+    r := loop()
+}
+"""
+        vars_ = _run_go(source, max_steps=3000)
+        assert vars_[VarName("r")] == 321
+
+
+class TestGoDeferArgumentExecution:
+    @covers(GoFeature.DEFER)
+    def test_arguments_evaluated_at_defer_time(self):
+        source = """\
+package main
+func capture() (r int) {
+    x := 1
+    defer func(v int) { r = v }(x)
+    x = 5
+    return x
+}
+func main() {
+    r := capture()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 1
+
+    @covers(GoFeature.DEFER)
+    def test_method_receiver_evaluated_at_defer_time(self):
+        source = """\
+package main
+type Counter struct {
+    n int
+}
+func (c *Counter) Inc() {
+    c.n = c.n + 1
+}
+func bump(c *Counter) int {
+    defer c.Inc()
+    return c.n
+}
+func main() {
+    c := &Counter{}
+    before := bump(c)
+    after := c.n
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("before")] == 0
+        assert vars_[VarName("after")] == 1
+
+
+class TestGoDeferReturnValueExecution:
+    @covers(GoFeature.DEFER)
+    def test_deferred_closure_changes_named_result(self):
+        source = """\
+package main
+func double() (n int) {
+    defer func() { n = n * 2 }()
+    n = 4
+    return n + 1
+}
+func main() {
+    r := double()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 10
+
+    @covers(GoFeature.DEFER)
+    def test_unnamed_result_is_fixed_before_defers_run(self):
+        source = """\
+package main
+func plain() int {
+    x := 1
+    defer func() { x = 100 }()
+    return x
+}
+func main() {
+    r := plain()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 1
+
+    @covers(GoFeature.DEFER)
+    def test_bare_return_returns_named_result(self):
+        source = """\
+package main
+func first(sum int) (x int) {
+    x = sum * 4
+    return
+}
+func main() {
+    r := first(9)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 36
+
+
+class TestGoDeferInMainExecution:
+    @covers(GoFeature.DEFER)
+    def test_defer_in_main_runs_after_body(self):
+        source = """\
+package main
+func main() {
+    x := 1
+    defer func() { x = 2 }()
+    y := x
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("y")] == 1
+        assert vars_[VarName("x")] == 2
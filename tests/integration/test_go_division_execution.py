@@ -0,0 +1,124 @@
+"""Integration tests for Go integer division and remainder.
+
+Verifies that integer quotients truncate toward zero and remainders take
+the sign of the dividend, that float division is unaffected, and that an
+integer division by zero panics with a runtime error carrying its source
+location and stack, through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+import pytest
+
+from interpreter.constants import Language
+from interpreter.field_name import FieldKind, FieldName
+from interpreter.frontends.go.features import GoFeature
+from interpreter.project.entry_point import EntryPoint
+from interpreter.run import run
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoDivisionExecution:
+    @covers(GoFeature.ARITHMETIC)
+    def test_negative_operands_truncate_toward_zero(self):
+        vars_ = _run_go("""\
+package main
+func main() {
+    a, b := -7, 2
+    q := a / b
+    r := a % b
+    q2 := 7 / -2
+    r2 := 7 % -2
+    x := 7
+    x /= 2
+    y := -9
+    y %= 4
+    roundTrip := (a / b) * b + a % b
+    big := 9007199254740993
+    exact := big / 1
+}
+""")
+        assert vars_[VarName("q")] == -3
+        assert vars_[VarName("r")] == -1
+        assert vars_[VarName("q2")] == -3
+        assert vars_[VarName("r2")] == 1
+        assert vars_[VarName("x")] == 3
+        assert vars_[VarName("y")] == -1
+        assert vars_[VarName("roundTrip")] == -7
+        assert vars_[VarName("exact")] == 9007199254740993
+
+    @covers(GoFeature.ARITHMETIC)
+    def test_float_division_is_not_truncated(self):
+        vars_ = _run_go("""\
+package main
+type Celsius float64
+func main() {
+    f := -7.0
+    half := f / 2
+    c := Celsius(100)
+    scaled := c * 9 / 5
+}
+""")
+        assert vars_[VarName("half")] == pytest.approx(-3.5)
+        assert vars_[VarName("scaled")] == pytest.approx(180.0)
+
+    @covers(GoFeature.ARITHMETIC)
+    def test_divide_by_zero_panics_with_location_and_stack(self):
+        source = """\
+package main
+func div(a, b int) int {
+    return a / b
+}
+func main() {
+    before := 1
+    n := div(1, 0)
+    after := 2
+}
+"""
+        vm = run(
+            source,
+            language=Language.GO,
+            max_steps=2000,
+            entry_point=EntryPoint.top_level(),
+        )
+        local_vars = vm.call_stack[0].local_vars
+        assert VarName("after") not in local_vars
+        error = local_vars[VarName("__go_panic__")].value
+        fields = vm.heap_get(error.base).fields
+        assert (
+            fields[FieldName("message")].value
+            == "panic: runtime error: integer divide by zero"
+        )
+        assert fields[FieldName("location")].value.startswith("3:")
+        stack = vm.heap_get(fields[FieldName("stack")].value.base).fields
+        assert [
+            stack[FieldName(str(i), FieldKind.INDEX)].value for i in range(2)
+        ] == ["div", "main"]
+
+    @covers(GoFeature.ARITHMETIC)
+    def test_divide_by_zero_can_be_recovered(self):
+        vars_ = _run_go("""\
+package main
+func safeMod(a, b int) (r int, ok bool) {
+    defer func() {
+        if recover() != nil {
+            ok = false
+        }
+    }()
+    return a % b, true
+}
+func main() {
+    r, ok := safeMod(7, 0)
+    r2, ok2 := safeMod(7, 3)
+}
+""")
+        assert vars_[VarName("ok")] is False
+        assert vars_[VarName("r")] == 0
+        assert vars_[VarName("r2")] == 1
+        assert vars_[VarName("ok2")] is True
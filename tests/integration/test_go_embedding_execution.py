@@ -0,0 +1,143 @@
+"""Integration tests for Go struct embedding.
+
+Verifies that fields and methods of embedded structs are promoted to the
+outer struct, that shallower fields shadow deeper ones, and that promoted
+methods satisfy interfaces and run on the embedded value, through the
+full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoEmbeddingExecution:
+    @covers(GoFeature.STRUCT_EMBEDDING)
+    def test_promoted_fields_and_methods(self):
+        vars_ = _run_go("""\
+package main
+type Animal struct {
+    Name string
+}
+func (a Animal) Speak() string {
+    return a.Name + " barks"
+}
+func (a *Animal) Rename(name string) {
+    a.Name = name
+}
+type Dog struct {
+    Animal
+    Breed string
+}
+func main() {
+    d := Dog{Animal{"rex"}, "lab"}
+    d.Rename("max")
+    name := d.Name
+    inner := d.Animal.Name
+    speech := d.Speak()
+    d.Name = "bo"
+    renamed := d.Animal.Name
+    breed := d.Breed
+}
+""")
+        assert vars_[VarName("name")] == "max"
+        assert vars_[VarName("inner")] == "max"
+        assert vars_[VarName("speech")] == "max barks"
+        assert vars_[VarName("renamed")] == "bo"
+        assert vars_[VarName("breed")] == "lab"
+
+    @covers(GoFeature.STRUCT_EMBEDDING)
+    def test_shallower_field_shadows_deeper_one(self):
+        vars_ = _run_go("""\
+package main
+type Base struct {
+    ID int
+}
+func (b Base) Describe() string {
+    return "base"
+}
+type Mid struct {
+    Base
+    Level int
+}
+type Top struct {
+    Mid
+    ID int
+}
+func main() {
+    t := Top{Mid: Mid{Base{1}, 2}, ID: 9}
+    id := t.ID
+    baseID := t.Mid.ID
+    level := t.Level
+    desc := t.Describe()
+    p := &t
+    p.Level = 5
+    raised := t.Mid.Level
+}
+""")
+        assert vars_[VarName("id")] == 9
+        assert vars_[VarName("baseID")] == 1
+        assert vars_[VarName("level")] == 2
+        assert vars_[VarName("desc")] == "base"
+        assert vars_[VarName("raised")] == 5
+
+    @covers(GoFeature.STRUCT_EMBEDDING)
+    def test_promoted_methods_satisfy_interface(self):
+        vars_ = _run_go("""\
+package main
+type Adder interface {
+    Add(k int) int
+}
+type Counter struct {
+    n int
+}
+func (c *Counter) Add(k int) int {
+    c.n += k
+    return c.n
+}
+type Service struct {
+    *Counter
+    name string
+}
+func main() {
+    s := Service{&Counter{}, "svc"}
+    var a Adder = s
+    a.Add(2)
+    total := a.Add(3)
+    count := s.n
+    _, ok := a.(Service)
+}
+""")
+        assert vars_[VarName("total")] == 5
+        assert vars_[VarName("count")] == 5
+        assert vars_[VarName("ok")] is True
+
+    @covers(GoFeature.STRUCT_EMBEDDING)
+    def test_promoted_key_in_struct_literal(self):
+        vars_ = _run_go("""\
+package main
+type Point struct {
+    X, Y int
+}
+type Circle struct {
+    Point
+    R int
+}
+func main() {
+    c := Circle{X: 3, R: 2}
+    x := c.X
+    y := c.Point.Y
+    r := c.R
+}
+""")
+        assert vars_[VarName("x")] == 3
+        assert vars_[VarName("y")] == 0
+        assert vars_[VarName("r")] == 2
@@ -0,0 +1,121 @@
+"""Integration test: Go error values and (T, error) returns through the VM.
+
+Verifies that errors.New values carry their message, that functions
+return several results together, and that user types with an Error()
+method satisfy the builtin error interface.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals, run_stdout
+
+_TRIANGLE = """\
+package main
+import "errors"
+func kind(a, b, c int) (string, error) {
+    if a <= 0 || b <= 0 || c <= 0 {
+        return "", errors.New("all sides must be positive")
+    }
+    if a == b && b == c {
+        return "equilateral", nil
+    }
+    if a == b || b == c || a == c {
+        return "isosceles", nil
+    }
+    return "scalene", nil
+}
+"""
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoErrorExecution:
+    @covers(GoFeature.MULTIPLE_RETURN)
+    def test_value_and_nil_error_are_both_returned(self):
+        vars_ = _run_go(
+            _TRIANGLE
+            + """\
+func main() {
+    k, err := kind(3, 3, 4)
+    ok := err == nil
+}
+"""
+        )
+        assert vars_[VarName("k")] == "isosceles"
+        assert vars_[VarName("ok")] is True
+
+    @covers(GoFeature.ERRORS_PACKAGE)
+    def test_errors_new_message_is_returned_by_error_method(self):
+        vars_ = _run_go(
+            _TRIANGLE
+            + """\
+func main() {
+    k, err := kind(0, 1, 1)
+    failed := err != nil
+    msg := err.Error()
+}
+"""
+        )
+        assert vars_[VarName("k")] == ""
+        assert vars_[VarName("failed")] is True
+        assert vars_[VarName("msg")] == "all sides must be positive"
+
+    @covers(GoFeature.ERRORS_PACKAGE)
+    def test_println_prints_error_message(self):
+        stdout = run_stdout(
+            'package main\nimport (\n    "errors"\n    "fmt"\n)\n'
+            'func main() {\n    fmt.Println(errors.New("boom"))\n}\n',
+            Language.GO,
+        )
+        assert stdout == "boom\n"
+
+    @covers(GoFeature.ERROR_TYPE)
+    def test_user_type_with_error_method_is_an_error(self):
+        vars_ = _run_go("""\
+package main
+type NegErr struct {
+    n int
+}
+func (e NegErr) Error() string {
+    return "negative"
+}
+func check(n int) (int, error) {
+    if n < 0 {
+        return 0, NegErr{n}
+    }
+    return n, nil
+}
+func main() {
+    v, err := check(-2)
+    msg := err.Error()
+    _, isErr := err.(error)
+}
+""")
+        assert vars_[VarName("v")] == 0
+        assert vars_[VarName("msg")] == "negative"
+        assert vars_[VarName("isErr")] is True
+
+    @covers(GoFeature.MULTIPLE_RETURN)
+    def test_deferred_update_of_named_results(self):
+        vars_ = _run_go("""\
+package main
+func parse() (n int, err error) {
+    defer func() {
+        n = n * 10
+    }()
+    n = 4
+    return
+}
+func main() {
+    n, err := parse()
+    ok := err == nil
+}
+""")
+        assert vars_[VarName("n")] == 40
+        assert vars_[VarName("ok")] is True
@@ -0,0 +1,103 @@
+"""Integration tests for Go float64 values.
+
+Verifies float literals, float arithmetic, typed float declarations and
+explicit float64()/int() conversions through the full parse → lower →
+execute pipeline.
+"""
+
+from __future__ import annotations
+
+import pytest
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 500) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoFloatExecution:
+    @covers(GoFeature.FLOAT)
+    def test_float_literal_arithmetic(self):
+        source = """\
+package main
+func main() {
+    a := 1.5
+    b := 2.25
+    sum := a + b
+    product := a * b
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("sum")] == pytest.approx(3.75)
+        assert vars_[VarName("product")] == pytest.approx(3.375)
+
+    @covers(GoFeature.FLOAT)
+    def test_float_division_is_not_truncated(self):
+        source = """\
+package main
+func main() {
+    x := 7.0
+    y := x / 2.0
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("y")] == pytest.approx(3.5)
+
+    @covers(GoFeature.FLOAT)
+    def test_float64_conversion_enables_true_division(self):
+        source = """\
+package main
+func main() {
+    total := 7
+    count := 2
+    mean := float64(total) / float64(count)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("mean")] == pytest.approx(3.5)
+        assert isinstance(vars_[VarName("mean")], float)
+
+    @covers(GoFeature.FLOAT)
+    def test_int_conversion_truncates_float(self):
+        source = """\
+package main
+func main() {
+    f := -2.9
+    n := int(f)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == -2
+
+    @covers(GoFeature.FLOAT)
+    def test_typed_float_declaration_widens_int_constant(self):
+        source = """\
+package main
+func main() {
+    var ratio float64 = 3
+    half := ratio / 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("half")] == pytest.approx(1.5)
+
+    @covers(GoFeature.FLOAT)
+    def test_float_return_from_function(self):
+        source = """\
+package main
+
+func average(a float64, b float64) float64 {
+    return (a + b) / 2
+}
+
+func main() {
+    avg := average(1.0, 2.0)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("avg")] == pytest.approx(1.5)
@@ -0,0 +1,93 @@
+"""Integration tests for Go's fmt package and captured program output.
+
+Verifies that fmt.Println and fmt.Print format their operands as Go's %v
+does, with Println spacing every operand and Print only those where
+neither is a string, and that the output is captured on the VM, through
+the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from tests.covers import covers
+from tests.integration.exec_helpers import run_stdout
+
+
+def _run_go(source: str, max_steps: int = 1000) -> str:
+    return run_stdout(source, Language.GO, max_steps)
+
+
+class TestGoFmtExecution:
+    @covers(GoFeature.FMT_PACKAGE)
+    def test_println_spaces_operands_and_ends_line(self):
+        source = """\
+package main
+import "fmt"
+func main() {
+    answer := 6 * 7
+    fmt.Println("answer:", answer)
+    fmt.Println()
+    fmt.Println(true, false)
+}
+"""
+        assert _run_go(source) == "answer: 42\n\ntrue false\n"
+
+    @covers(GoFeature.FMT_PACKAGE)
+    def test_print_spaces_only_between_non_strings(self):
+        source = """\
+package main
+import "fmt"
+func main() {
+    fmt.Print("a", "b", 1, 2, "c", 3)
+    fmt.Print("\\n")
+}
+"""
+        assert _run_go(source) == "ab1 2c3\n"
+
+    @covers(GoFeature.FMT_PACKAGE)
+    def test_floats_use_shortest_form(self):
+        source = """\
+package main
+import "fmt"
+func main() {
+    fmt.Println(3.0, 2.5, 1e6, 0.00001)
+}
+"""
+        assert _run_go(source) == "3 2.5 1e+06 1e-05\n"
+
+    @covers(GoFeature.FMT_PACKAGE)
+    def test_composite_values(self):
+        source = """\
+package main
+import "fmt"
+type Point struct {
+    X int
+    Y int
+}
+func main() {
+    fmt.Println([]int{1, 2, 3})
+    fmt.Println(map[string]int{"b": 2, "a": 1})
+    fmt.Println(Point{3, 4})
+    var s []int
+    fmt.Println(s == nil)
+}
+"""
+        assert _run_go(source) == "[1 2 3]\nmap[a:1 b:2]\n{3 4}\ntrue\n"
+
+    @covers(GoFeature.FMT_PACKAGE)
+    def test_output_from_called_function_in_order(self):
+        source = """\
+package main
+import "fmt"
+func countdown(n int) {
+    for i := n; i > 0; i-- {
+        fmt.Println(i)
+    }
+    fmt.Println("liftoff")
+}
+func main() {
+    countdown(3)
+}
+"""
+        assert _run_go(source, max_steps=2000) == "3\n2\n1\nliftoff\n"
@@ -0,0 +1,210 @@
+"""Integration tests for Go for-range loops.
+
+Verifies that ``for k, v := range x`` iterates strings (binding runes),
+slices, maps and integers through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoRangeOverString:
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_string_binds_runes(self):
+        source = """\
+package main
+func main() {
+    total := 0
+    for _, c := range "abc" {
+        total = total + c
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 97 + 98 + 99
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_string_compares_with_rune_literal(self):
+        source = """\
+package main
+func main() {
+    count := 0
+    for _, c := range "banana" {
+        if c == 'a' {
+            count = count + 1
+        }
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("count")] == 3
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_string_index_only(self):
+        source = """\
+package main
+func main() {
+    last := -1
+    for i := range "hello" {
+        last = i
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("last")] == 4
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_string_keys_are_byte_offsets_of_runes(self):
+        source = """\
+package main
+func main() {
+    offsets := 0
+    accent := 0
+    for i, c := range "héllo" {
+        offsets = offsets*10 + i
+        if i == 1 {
+            accent = c
+        }
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("offsets")] == 1345
+        assert vars_[VarName("accent")] == ord("é")
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_empty_string_skips_body(self):
+        source = """\
+package main
+func main() {
+    answer := 42
+    for range "" {
+        answer = 0
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("answer")] == 42
+
+
+class TestGoRangeOverSlice:
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_slice_sums_values(self):
+        source = """\
+package main
+func main() {
+    items := []int{1, 2, 3, 4, 5}
+    total := 0
+    for _, v := range items {
+        total = total + v
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 15
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_slice_index_is_int(self):
+        source = """\
+package main
+func main() {
+    items := []int{10, 20, 30}
+    weighted := 0
+    for i, v := range items {
+        weighted = weighted + i*v
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("weighted")] == 0 * 10 + 1 * 20 + 2 * 30
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_nested_range_loops(self):
+        source = """\
+package main
+func main() {
+    pairs := 0
+    for range "abc" {
+        for range "de" {
+            pairs = pairs + 1
+        }
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("pairs")] == 6
+
+
+class TestGoRangeOverInt:
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_int_counts_up(self):
+        source = """\
+package main
+func main() {
+    total := 0
+    for i := range 5 {
+        total = total + i
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 10
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_over_integer_variable_counts_up(self):
+        source = """\
+package main
+func main() {
+    n := 4
+    total := 0
+    for i := range n {
+        total = total + i
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 6
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_assigns_existing_variable(self):
+        source = """\
+package main
+func main() {
+    k := -1
+    for k = range 3 {
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("k")] == 2
+
+
+class TestGoRangeOverMap:
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_map_visits_every_entry(self):
+        source = """\
+package main
+func main() {
+    m := make(map[string]int)
+    m["a"] = 1
+    m["b"] = 2
+    total := 0
+    count := 0
+    for _, v := range m {
+        total = total + v
+        count = count + 1
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 3
+        assert vars_[VarName("count")] == 2
@@ -86,8 +86,8 @@ func main() {
 
 class TestGoFallthroughExecution:
     @covers(GoFeature.FALLTHROUGH)
-    def test_fallthrough_does_not_crash(self):
-        """Switch with fallthrough should execute without errors."""
+    def test_fallthrough_runs_next_case_body(self):
+        """fallthrough transfers control into the next case body unconditionally."""
         source = """\
 package main
 func main() {
@@ -103,9 +103,27 @@ func main() {
 }
 """
         vars_ = _run_go(source)
-        # Our VM processes switch cases independently; fallthrough is a no-op.
-        # case 1 matched, set y=10, fallthrough is no-op, case 2 body skipped.
-        assert vars_[VarName("y")] == 10
+        assert vars_[VarName("y")] == 20
+
+    @covers(GoFeature.FALLTHROUGH)
+    def test_fallthrough_into_default(self):
+        """fallthrough from the case before default runs the default body."""
+        source = """\
+package main
+func main() {
+    x := 1
+    hits := 0
+    switch x {
+    case 1:
+        hits = hits + 1
+        fallthrough
+    default:
+        hits = hits + 10
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("hits")] == 11
 
     @covers(GoFeature.SWITCH_STATEMENT)
     def test_switch_without_fallthrough_still_works(self):
@@ -126,6 +144,100 @@ func main() {
         vars_ = _run_go(source)
         assert vars_[VarName("y")] == 20
 
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_multi_value_case(self):
+        """case a, b, c: matches when the tag equals any listed value."""
+        source = """\
+package main
+func main() {
+    x := 3
+    y := 0
+    switch x {
+    case 1, 2:
+        y = 10
+    case 3, 4:
+        y = 20
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("y")] == 20
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_tagless_switch_picks_first_true_case(self):
+        """switch { case cond: } behaves like an if/else-if chain."""
+        source = """\
+package main
+func main() {
+    x := 15
+    y := ""
+    switch {
+    case x > 20:
+        y = "big"
+    case x > 10:
+        y = "medium"
+    case x > 0:
+        y = "small"
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("y")] == "medium"
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_default_listed_first_is_tried_last(self):
+        """A leading default clause must not shadow a later matching case."""
+        source = """\
+package main
+func main() {
+    x := 2
+    y := 0
+    switch x {
+    default:
+        y = -1
+    case 1:
+        y = 10
+    case 2:
+        y = 20
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("y")] == 20
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_default_taken_when_nothing_matches(self):
+        source = """\
+package main
+func main() {
+    x := 9
+    y := 0
+    switch x {
+    case 1:
+        y = 10
+    default:
+        y = -1
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("y")] == -1
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_switch_with_init_statement(self):
+        source = """\
+package main
+func main() {
+    y := 0
+    switch x := 5; x {
+    case 5:
+        y = 50
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("y")] == 50
+
 
 class TestGoVariadicArgumentExecution:
     @covers(GoFeature.VARIADIC)
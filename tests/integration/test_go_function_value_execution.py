@@ -0,0 +1,137 @@
+"""Integration tests for Go functions as first-class values.
+
+Verifies function-typed parameters and results, functions stored in
+variables, slices, maps and struct fields, and indirect calls through
+them, through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoFunctionParameterExecution:
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_named_function_passed_as_argument(self):
+        source = """\
+package main
+func double(n int) int {
+    return n * 2
+}
+func apply(f func(int) int, v int) int {
+    return f(v)
+}
+func main() {
+    r := apply(double, 21)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 42
+
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_map_over_slice_with_function_literal(self):
+        source = """\
+package main
+func mapInts(xs []int, f func(int) int) []int {
+    out := []int{}
+    for _, x := range xs {
+        out = append(out, f(x))
+    }
+    return out
+}
+func main() {
+    squares := mapInts([]int{1, 2, 3}, func(x int) int { return x * x })
+    last := squares[2]
+    n := len(squares)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("last")] == 9
+        assert vars_[VarName("n")] == 3
+
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_function_returned_and_called_immediately(self):
+        source = """\
+package main
+func multiplier(k int) func(int) int {
+    return func(n int) int {
+        return n * k
+    }
+}
+func main() {
+    r := multiplier(3)(7)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 21
+
+
+class TestGoFunctionVariableExecution:
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_function_assigned_to_variable_and_reassigned(self):
+        source = """\
+package main
+func inc(n int) int {
+    return n + 1
+}
+func dec(n int) int {
+    return n - 1
+}
+func main() {
+    var op func(int) int
+    isNil := op == nil
+    op = inc
+    a := op(10)
+    op = dec
+    b := op(10)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("isNil")] is True
+        assert vars_[VarName("a")] == 11
+        assert vars_[VarName("b")] == 9
+
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_functions_in_slice_and_map(self):
+        source = """\
+package main
+func add(a int, b int) int {
+    return a + b
+}
+func sub(a int, b int) int {
+    return a - b
+}
+func main() {
+    ops := []func(int, int) int{add, sub}
+    table := map[string]func(int, int) int{"plus": add, "minus": sub}
+    x := ops[1](10, 4)
+    y := table["plus"](10, 4)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("x")] == 6
+        assert vars_[VarName("y")] == 14
+
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_function_stored_in_struct_field(self):
+        source = """\
+package main
+type Handler struct {
+    name string
+    run  func(int) int
+}
+func main() {
+    h := Handler{name: "triple", run: func(n int) int { return n * 3 }}
+    r := h.run(5)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 15
@@ -0,0 +1,270 @@
+"""Integration tests for Go goroutines and channels.
+
+Verifies goroutine launch, unbuffered hand-off, buffered channels,
+close/range and comma-ok receives, deadlock detection and panics inside
+goroutines on the cooperative scheduler, through the full
+parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.field_name import FieldName
+from interpreter.frontends.go.features import GoFeature
+from interpreter.project.entry_point import EntryPoint
+from interpreter.run import run
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 5000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+def _run_go_vm(source: str, max_steps: int = 5000):
+    return run(
+        source,
+        language=Language.GO,
+        max_steps=max_steps,
+        entry_point=EntryPoint.top_level(),
+    )
+
+
+class TestGoUnbufferedChannelExecution:
+    @covers(GoFeature.GO_STATEMENT)
+    def test_goroutine_sends_to_main(self):
+        source = """\
+package main
+func produce(ch chan int) {
+    ch <- 42
+}
+func main() {
+    ch := make(chan int)
+    go produce(ch)
+    v := <-ch
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("v")] == 42
+
+    @covers(GoFeature.SEND_STATEMENT)
+    def test_unbuffered_send_waits_for_receiver(self):
+        source = """\
+package main
+type Log struct {
+    steps int
+}
+func main() {
+    ch := make(chan int)
+    log := &Log{}
+    go func() {
+        ch <- 1
+        log.steps = log.steps*10 + 2
+    }()
+    log.steps = 1
+    v := <-ch
+    done := log.steps
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("v")] == 1
+        assert vars_[VarName("done")] == 1
+
+    @covers(GoFeature.RECEIVE_STATEMENT)
+    def test_ping_pong_alternates(self):
+        source = """\
+package main
+func pong(ping chan int, reply chan int) {
+    for i := 0; i < 3; i++ {
+        n := <-ping
+        reply <- n * 2
+    }
+}
+func main() {
+    ping := make(chan int)
+    reply := make(chan int)
+    go pong(ping, reply)
+    total := 0
+    for i := 1; i <= 3; i++ {
+        ping <- i
+        total = total + <-reply
+    }
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 12
+
+
+class TestGoBufferedChannelExecution:
+    @covers(GoFeature.MAKE)
+    def test_buffered_channel_holds_values_without_receiver(self):
+        source = """\
+package main
+func main() {
+    ch := make(chan int, 2)
+    ch <- 1
+    ch <- 2
+    n := len(ch)
+    a := <-ch
+    b := <-ch
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 2
+        assert vars_[VarName("a")] == 1
+        assert vars_[VarName("b")] == 2
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_over_closed_channel(self):
+        source = """\
+package main
+func produce(ch chan int) {
+    for i := 1; i <= 4; i++ {
+        ch <- i
+    }
+    close(ch)
+}
+func main() {
+    ch := make(chan int, 2)
+    go produce(ch)
+    sum := 0
+    for v := range ch {
+        sum = sum + v
+    }
+}
+"""
+        vars_ = _run_go(source, max_steps=8000)
+        assert vars_[VarName("sum")] == 10
+
+    @covers(GoFeature.GO_STATEMENT)
+    def test_workers_send_results(self):
+        source = """\
+package main
+func square(n int, out chan int) {
+    out <- n * n
+}
+func main() {
+    out := make(chan int)
+    for i := 1; i <= 3; i++ {
+        go square(i, out)
+    }
+    total := 0
+    for i := 0; i < 3; i++ {
+        total = total + <-out
+    }
+}
+"""
+        vars_ = _run_go(source, max_steps=8000)
+        assert vars_[VarName("total")] == 14
+
+
+class TestGoClosedChannelExecution:
+    @covers(GoFeature.RECEIVE_STATEMENT)
+    def test_comma_ok_on_closed_channel(self):
+        source = """\
+package main
+func main() {
+    ch := make(chan int, 1)
+    ch <- 7
+    close(ch)
+    a, ok1 := <-ch
+    b, ok2 := <-ch
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] == 7
+        assert vars_[VarName("ok1")] is True
+        assert vars_[VarName("b")] == 0
+        assert vars_[VarName("ok2")] is False
+
+    @covers(GoFeature.SEND_STATEMENT)
+    def test_send_on_closed_channel_panics(self):
+        source = """\
+package main
+func send(ch chan int) (msg string) {
+    defer func() {
+        if r := recover(); r != nil {
+            msg = r.(string)
+        }
+    }()
+    ch <- 1
+    return "sent"
+}
+func main() {
+    ch := make(chan int, 1)
+    close(ch)
+    m := send(ch)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("m")] == "send on closed channel"
+
+
+class TestGoSchedulerFailureExecution:
+    @covers(GoFeature.RECEIVE_STATEMENT)
+    def test_receive_with_no_sender_deadlocks(self):
+        source = """\
+package main
+func main() {
+    ch := make(chan int)
+    before := 1
+    v := <-ch
+    after := 2
+}
+"""
+        vm = _run_go_vm(source)
+        assert vm.scheduler.deadlocked
+        local_vars = vm.call_stack[0].local_vars
+        assert VarName("before") in local_vars
+        assert VarName("after") not in local_vars
+
+    @covers(GoFeature.SEND_STATEMENT)
+    def test_unbuffered_send_with_no_receiver_reports_deadlock(self):
+        source = """\
+package main
+func wait(done chan int) {
+    <-done
+}
+func main() {
+    ch := make(chan int)
+    done := make(chan int)
+    go wait(done)
+    ch <- 1
+    after := 1
+}
+"""
+        vm = _run_go_vm(source)
+        assert VarName("after") not in vm.call_stack[0].local_vars
+        deadlock = vm.scheduler.deadlock
+        assert [(g, where.start_line) for g, where in deadlock.blocked] == [
+            (0, 9),
+            (1, 3),
+        ]
+        (_, send), (_, receive) = deadlock.blocked
+        assert str(deadlock).splitlines() == [
+            "fatal error: all goroutines are asleep - deadlock!",
+            "",
+            f"goroutine 1 blocked at {send}",
+            f"goroutine 2 blocked at {receive}",
+        ]
+
+    @covers(GoFeature.GO_STATEMENT)
+    def test_panic_in_goroutine_ends_program(self):
+        source = """\
+package main
+func crash(ch chan int) {
+    panic("worker failed")
+}
+func main() {
+    ch := make(chan int)
+    go crash(ch)
+    v := <-ch
+    after := 1
+}
+"""
+        vm = _run_go_vm(source)
+        assert VarName("after") not in vm.call_stack[0].local_vars
+        error = vm.call_stack[-1].local_vars[VarName("__go_panic__")].value
+        fields = vm.heap_get(error.base).fields
+        assert fields[FieldName("message")].value == "panic: worker failed"
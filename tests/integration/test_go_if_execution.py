@@ -0,0 +1,109 @@
+"""Integration tests for Go if statements with init statements.
+
+Verifies if x := f(); cond with the init variable visible across the
+whole if / else if / else chain, shadowing that leaves the outer
+variable untouched, per-branch inits in else-if chains, and comma-ok
+inits, through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoIfExecution:
+    @covers(GoFeature.IF_ELSE)
+    def test_init_variable_visible_in_every_branch(self):
+        source = """\
+package main
+func square(n int) int {
+    return n * n
+}
+func describe(n int) string {
+    if sq := square(n); sq > 50 {
+        return "big"
+    } else if sq > 10 {
+        return "medium"
+    } else {
+        return "small"
+    }
+}
+func main() {
+    a := describe(8)
+    b := describe(4)
+    c := describe(2)
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("a")] == "big"
+        assert vars_[VarName("b")] == "medium"
+        assert vars_[VarName("c")] == "small"
+
+    @covers(GoFeature.IF_ELSE)
+    def test_init_shadows_outer_variable(self):
+        source = """\
+package main
+func main() {
+    x := 1
+    inner := 0
+    if x := 10; x > 5 {
+        inner = x
+    }
+    outer := x
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("inner")] == 10
+        assert vars_[VarName("outer")] == 1
+
+    @covers(GoFeature.IF_ELSE)
+    def test_else_if_with_its_own_init(self):
+        source = """\
+package main
+func grade(score int) string {
+    if pass := score >= 50; !pass {
+        return "fail"
+    } else if rem := score % 10; rem >= 5 {
+        return "high"
+    } else {
+        return "low"
+    }
+}
+func main() {
+    f := grade(30)
+    h := grade(77)
+    l := grade(62)
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("f")] == "fail"
+        assert vars_[VarName("h")] == "high"
+        assert vars_[VarName("l")] == "low"
+
+    @covers(GoFeature.IF_ELSE)
+    def test_comma_ok_init(self):
+        source = """\
+package main
+func lookup(m map[string]int, k string) int {
+    if v, ok := m[k]; ok {
+        return v
+    }
+    return -1
+}
+func main() {
+    ages := map[string]int{"ann": 31}
+    hit := lookup(ages, "ann")
+    miss := lookup(ages, "bob")
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("hit")] == 31
+        assert vars_[VarName("miss")] == -1
@@ -0,0 +1,92 @@
+"""Integration tests for Go increment and decrement statements.
+
+Verifies that x++ and x-- update variables, struct fields, slice
+elements, map entries and pointees in place, and drive loops counting
+up and down, through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoIncDecExecution:
+    @covers(GoFeature.INC_DEC)
+    def test_variables(self):
+        source = """\
+package main
+func main() {
+    up := 1
+    up++
+    up++
+    down := 1
+    down--
+    f := 1.5
+    f++
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("up")] == 3
+        assert vars_[VarName("down")] == 0
+        assert vars_[VarName("f")] == 2.5
+
+    @covers(GoFeature.INC_DEC)
+    def test_loops_counting_both_ways(self):
+        source = """\
+package main
+func main() {
+    asc := 0
+    for i := 0; i < 5; i++ {
+        asc = asc*10 + i
+    }
+    desc := 0
+    for j := 4; j >= 0; j-- {
+        desc = desc*10 + j
+    }
+}
+"""
+        vars_ = _run_go(source, max_steps=3000)
+        assert vars_[VarName("asc")] == 1234
+        assert vars_[VarName("desc")] == 43210
+
+    @covers(GoFeature.INC_DEC)
+    def test_field_element_map_and_pointer_targets(self):
+        source = """\
+package main
+type Counter struct {
+    n int
+}
+func bump(p *int) {
+    *p++
+}
+func main() {
+    c := Counter{n: 5}
+    c.n--
+    xs := []int{1, 2, 3}
+    xs[2]++
+    seen := map[string]int{}
+    for _, w := range []string{"a", "b", "a"} {
+        seen[w]++
+    }
+    k := 41
+    bump(&k)
+    n := c.n
+    last := xs[2]
+    as := seen["a"]
+    bs := seen["b"]
+}
+"""
+        vars_ = _run_go(source, max_steps=3000)
+        assert vars_[VarName("n")] == 4
+        assert vars_[VarName("last")] == 4
+        assert vars_[VarName("as")] == 2
+        assert vars_[VarName("bs")] == 1
+        assert vars_[VarName("k")] == 42
@@ -0,0 +1,98 @@
+"""Integration tests for Go init functions and the configurable entry function.
+
+Verifies that package-level init functions run after the package
+variables are initialized and before main, in declaration order, and that
+the frontend can run another function as the program in place of main,
+through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.project.entry_point import EntryPoint
+from interpreter.run import run
+from interpreter.types.typed_value import unwrap_locals
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+def _run_go_entry(source: str, entry_func: str, max_steps: int = 1000) -> dict:
+    vm = run(
+        source,
+        language=Language.GO,
+        max_steps=max_steps,
+        entry_point=EntryPoint.top_level(),
+        go_entry_func=entry_func,
+    )
+    return unwrap_locals(vm.call_stack[0].local_vars)
+
+
+class TestGoInitExecution:
+    @covers(GoFeature.INIT_FUNCTION)
+    def test_init_functions_run_in_order_after_package_vars(self):
+        vars_ = _run_go("""\
+package main
+var order []string
+var base = start()
+func start() int {
+    order = append(order, "var")
+    return 10
+}
+func init() {
+    order = append(order, "init0")
+    base += 1
+}
+func main() {
+    order = append(order, "main")
+    steps := len(order)
+    first := order[0]
+    second := order[1]
+    third := order[2]
+    last := order[3]
+    total := base
+}
+func init() {
+    order = append(order, "init1")
+    base *= 2
+}
+""")
+        assert vars_[VarName("steps")] == 4
+        assert vars_[VarName("first")] == "var"
+        assert vars_[VarName("second")] == "init0"
+        assert vars_[VarName("third")] == "init1"
+        assert vars_[VarName("last")] == "main"
+        assert vars_[VarName("total")] == 22
+
+
+class TestGoEntryFunctionExecution:
+    @covers(GoFeature.ENTRY_FUNCTION)
+    def test_entry_function_runs_in_place_of_main(self):
+        vars_ = _run_go_entry(
+            """\
+package main
+var calls int
+func init() {
+    calls = 1
+}
+func square(n int) int {
+    return n * n
+}
+func main() {
+    skipped := true
+}
+func Exercise() {
+    result := square(7)
+    seen := calls
+}
+""",
+            "Exercise",
+        )
+        assert vars_[VarName("result")] == 49
+        assert vars_[VarName("seen")] == 1
+        assert VarName("skipped") not in vars_
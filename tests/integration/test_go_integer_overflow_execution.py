@@ -0,0 +1,96 @@
+"""Integration test: per-run integer overflow modes through the full VM pipeline.
+
+The grains total (sum of 2**i for i < 64) is 2**64 - 1 with exact
+integers, wraps to -1 as a Go int64, and stops the run at the first
+overflowing addition when overflow traps.
+"""
+
+from __future__ import annotations
+
+import pytest
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.project.entry_point import EntryPoint
+from interpreter.run import run
+from interpreter.run_types import IntegerOverflowMode
+from interpreter.types.typed_value import unwrap_locals
+from interpreter.var_name import VarName
+from interpreter.vm.integer_overflow import IntegerOverflowError
+from tests.covers import covers
+
+GRAINS = """\
+package main
+
+func total() int {
+    result := 0
+    power := 1
+    for i := 1; i <= 64; i++ {
+        result = result + power
+        power = power * 2
+    }
+    return result
+}
+
+func main() {
+    answer := total()
+}
+"""
+
+
+def _run_go(source: str, mode: IntegerOverflowMode) -> dict:
+    vm = run(
+        source,
+        language=Language.GO,
+        max_steps=2000,
+        entry_point=EntryPoint.top_level(),
+        integer_overflow=mode,
+    )
+    return unwrap_locals(vm.call_stack[0].local_vars)
+
+
+class TestGoIntegerOverflowExecution:
+    @covers(GoFeature.ARITHMETIC)
+    def test_big_integers_are_exact_by_default(self):
+        vars_ = _run_go(GRAINS, IntegerOverflowMode.BIG)
+        assert vars_[VarName("answer")] == 2**64 - 1
+
+    @covers(GoFeature.ARITHMETIC)
+    def test_wrap_matches_go_int64(self):
+        vars_ = _run_go(GRAINS, IntegerOverflowMode.WRAP)
+        assert vars_[VarName("answer")] == -1
+
+    @covers(GoFeature.ARITHMETIC)
+    def test_wrap_max_int_plus_one_is_min_int(self):
+        source = """\
+package main
+func main() {
+    x := 9223372036854775807
+    x++
+    y := -x
+}
+"""
+        vars_ = _run_go(source, IntegerOverflowMode.WRAP)
+        assert vars_[VarName("x")] == -(2**63)
+        assert vars_[VarName("y")] == -(2**63)
+
+    @covers(GoFeature.ARITHMETIC)
+    def test_trap_reports_overflowing_expression(self):
+        with pytest.raises(IntegerOverflowError) as exc_info:
+            _run_go(GRAINS, IntegerOverflowMode.TRAP)
+        err = exc_info.value
+        # power = power * 2 once power reaches 2**62
+        assert err.location.start_line == 8
+        assert err.expression == f"{2**62} * 2"
+
+    @covers(GoFeature.ARITHMETIC)
+    def test_trap_leaves_in_range_arithmetic_alone(self):
+        source = """\
+package main
+func main() {
+    x := 9223372036854775806
+    x++
+}
+"""
+        vars_ = _run_go(source, IntegerOverflowMode.TRAP)
+        assert vars_[VarName("x")] == 2**63 - 1
@@ -0,0 +1,182 @@
+"""Integration tests for Go interfaces.
+
+Verifies dynamic dispatch through interface values, type assertions
+(single-value and comma-ok), and type switches through the full
+parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+_SHAPES = """\
+package main
+type Shape interface {
+    Area() int
+}
+type Rect struct {
+    w, h int
+}
+type Square struct {
+    side int
+}
+func (r Rect) Area() int {
+    return r.w * r.h
+}
+func (s Square) Area() int {
+    return s.side * s.side
+}
+"""
+
+
+class TestGoInterfaceDispatchExecution:
+    @covers(GoFeature.INTERFACE)
+    def test_dispatch_over_slice_of_interface_values(self):
+        source = (
+            _SHAPES
+            + """\
+func main() {
+    shapes := []Shape{Rect{2, 3}, Square{4}}
+    total := 0
+    for _, s := range shapes {
+        total = total + s.Area()
+    }
+}
+"""
+        )
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 6 + 16
+
+    @covers(GoFeature.INTERFACE)
+    def test_interface_parameter(self):
+        source = (
+            _SHAPES
+            + """\
+func doubled(s Shape) int {
+    return 2 * s.Area()
+}
+func main() {
+    var s Shape = Square{3}
+    d := doubled(s)
+}
+"""
+        )
+        vars_ = _run_go(source)
+        assert vars_[VarName("d")] == 18
+
+
+class TestGoTypeAssertionExecution:
+    @covers(GoFeature.TYPE_ASSERTION)
+    def test_assertion_to_concrete_type(self):
+        source = (
+            _SHAPES
+            + """\
+func main() {
+    var s Shape = Rect{2, 5}
+    r := s.(Rect)
+    w := r.w
+}
+"""
+        )
+        vars_ = _run_go(source)
+        assert vars_[VarName("w")] == 2
+
+    @covers(GoFeature.TYPE_ASSERTION)
+    def test_failed_assertion_panics(self):
+        source = (
+            _SHAPES
+            + """\
+func main() {
+    var s Shape = Rect{2, 5}
+    before := 1
+    sq := s.(Square)
+    after := 2
+}
+"""
+        )
+        vars_ = _run_go(source)
+        assert vars_[VarName("before")] == 1
+        assert VarName("after") not in vars_
+
+    @covers(GoFeature.TYPE_ASSERTION)
+    def test_comma_ok_assertion(self):
+        source = (
+            _SHAPES
+            + """\
+func main() {
+    var x interface{} = Square{2}
+    sq, isSquare := x.(Square)
+    side := sq.side
+    n, isInt := x.(int)
+    asShape, isShape := x.(Shape)
+    area := asShape.Area()
+}
+"""
+        )
+        vars_ = _run_go(source)
+        assert vars_[VarName("isSquare")] is True
+        assert vars_[VarName("side")] == 2
+        assert vars_[VarName("isInt")] is False
+        assert vars_[VarName("n")] == 0
+        assert vars_[VarName("isShape")] is True
+        assert vars_[VarName("area")] == 4
+
+
+class TestGoTypeSwitchExecution:
+    @covers(GoFeature.TYPE_SWITCH)
+    def test_type_switch_binds_value_and_matches_multiple_types(self):
+        source = """\
+package main
+func describe(x interface{}) string {
+    switch v := x.(type) {
+    default:
+        return "other"
+    case int, float64:
+        return "number"
+    case string:
+        return "text:" + v
+    }
+}
+func main() {
+    a := describe(3)
+    b := describe(2.5)
+    c := describe("go")
+    d := describe(true)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] == "number"
+        assert vars_[VarName("b")] == "number"
+        assert vars_[VarName("c")] == "text:go"
+        assert vars_[VarName("d")] == "other"
+
+    @covers(GoFeature.TYPE_SWITCH)
+    def test_type_switch_on_interface_case(self):
+        source = (
+            _SHAPES
+            + """\
+func area(x interface{}) int {
+    switch s := x.(type) {
+    case Shape:
+        return s.Area()
+    }
+    return -1
+}
+func main() {
+    a := area(Rect{3, 3})
+    b := area("nope")
+}
+"""
+        )
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] == 9
+        assert vars_[VarName("b")] == -1
@@ -0,0 +1,79 @@
+"""Integration tests for Go's len builtin.
+
+Verifies len() of strings counted in UTF-8 bytes, of nil slices and
+maps, and len() bounding a loop over a slice parameter, through the
+full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoLenExecution:
+    @covers(GoFeature.LEN)
+    def test_len_of_string_counts_bytes(self):
+        source = """\
+package main
+func main() {
+    ascii := len("gopher")
+    accented := len("héllo")
+    empty := len("")
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("ascii")] == 6
+        assert vars_[VarName("accented")] == 6
+        assert vars_[VarName("empty")] == 0
+
+    @covers(GoFeature.LEN)
+    def test_len_of_nil_collections_is_zero(self):
+        source = """\
+package main
+func main() {
+    var s []int
+    var m map[string]int
+    ns := len(s)
+    nm := len(m)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("ns")] == 0
+        assert vars_[VarName("nm")] == 0
+
+    @covers(GoFeature.LEN)
+    def test_len_bounds_loop_over_parameter(self):
+        source = """\
+package main
+func sum(xs []int) int {
+    total := 0
+    for i := 0; i < len(xs); i++ {
+        total = total + xs[i]
+    }
+    return total
+}
+func main() {
+    s := sum([]int{4, 5, 6})
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("s")] == 15
+
+    @covers(GoFeature.LEN)
+    def test_len_result_is_an_int(self):
+        source = """\
+package main
+func main() {
+    half := len("abcde") / 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("half")] == 2
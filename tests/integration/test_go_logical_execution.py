@@ -0,0 +1,121 @@
+"""Integration tests for Go's && and || operators.
+
+Verifies the truth tables, that the right operand is skipped when the
+left one decides the result (no side effects, no nil dereference, no
+out-of-range index), and that chains combine left to right, through the
+full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoLogicalExecution:
+    @covers(GoFeature.LOGICAL_OPERATORS)
+    def test_truth_tables(self):
+        source = """\
+package main
+func main() {
+    t := true
+    f := false
+    tt := t && t
+    tf := t && f
+    ft := f && t
+    ot := f || t
+    of := f || f
+    to := t || f
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("tt")] is True
+        assert vars_[VarName("tf")] is False
+        assert vars_[VarName("ft")] is False
+        assert vars_[VarName("ot")] is True
+        assert vars_[VarName("of")] is False
+        assert vars_[VarName("to")] is True
+
+    @covers(GoFeature.LOGICAL_OPERATORS)
+    def test_skipped_right_operand_has_no_side_effects(self):
+        source = """\
+package main
+var calls = 0
+func touch(v bool) bool {
+    calls++
+    return v
+}
+func main() {
+    a := false && touch(true)
+    b := true || touch(false)
+    afterSkips := calls
+    c := true && touch(true)
+    d := false || touch(false)
+    total := calls
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] is False
+        assert vars_[VarName("b")] is True
+        assert vars_[VarName("afterSkips")] == 0
+        assert vars_[VarName("c")] is True
+        assert vars_[VarName("d")] is False
+        assert vars_[VarName("total")] == 2
+
+    @covers(GoFeature.LOGICAL_OPERATORS)
+    def test_guards_protect_right_operand(self):
+        source = """\
+package main
+type Node struct {
+    val int
+}
+func positive(n *Node) bool {
+    return n != nil && n.val > 0
+}
+func firstIsZero(xs []int) bool {
+    return len(xs) > 0 && xs[0] == 0
+}
+func main() {
+    var missing *Node
+    noNode := positive(missing)
+    someNode := positive(&Node{val: 3})
+    empty := firstIsZero([]int{})
+    zero := firstIsZero([]int{0, 1})
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("noNode")] is False
+        assert vars_[VarName("someNode")] is True
+        assert vars_[VarName("empty")] is False
+        assert vars_[VarName("zero")] is True
+
+    @covers(GoFeature.LOGICAL_OPERATORS)
+    def test_chains_and_conditions(self):
+        source = """\
+package main
+func classify(a int, b int, c int) int {
+    if a == b && b == c {
+        return 3
+    }
+    if a == b || b == c || a == c {
+        return 2
+    }
+    return 1
+}
+func main() {
+    equilateral := classify(2, 2, 2)
+    isosceles := classify(3, 4, 4)
+    scalene := classify(3, 4, 5)
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("equilateral")] == 3
+        assert vars_[VarName("isosceles")] == 2
+        assert vars_[VarName("scalene")] == 1
@@ -0,0 +1,133 @@
+"""Integration tests for Go maps.
+
+Verifies map literals, index reads with a zero-value default, writes,
+delete(), and the comma-ok lookup through the full parse → lower → execute
+pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoMapLiteralExecution:
+    @covers(GoFeature.MAP)
+    def test_literal_entries_are_readable(self):
+        source = """\
+package main
+func main() {
+    ages := map[string]int{"ann": 31, "bob": 42}
+    a := ages["ann"]
+    b := ages["bob"]
+    n := len(ages)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] == 31
+        assert vars_[VarName("b")] == 42
+        assert vars_[VarName("n")] == 2
+
+    @covers(GoFeature.MAP)
+    def test_int_keyed_literal(self):
+        source = """\
+package main
+func main() {
+    squares := map[int]int{1: 1, 2: 4, 3: 9}
+    nine := squares[3]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("nine")] == 9
+
+
+class TestGoMapIndexExecution:
+    @covers(GoFeature.MAP)
+    def test_missing_key_reads_zero_value(self):
+        source = """\
+package main
+func main() {
+    counts := make(map[string]int)
+    names := map[int]string{}
+    n := counts["missing"]
+    s := names[7]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 0
+        assert vars_[VarName("s")] == ""
+
+    @covers(GoFeature.MAP)
+    def test_word_count_accumulates_from_zero(self):
+        source = """\
+package main
+func main() {
+    words := []string{"go", "is", "go", "fun", "go"}
+    counts := make(map[string]int)
+    for _, w := range words {
+        counts[w] = counts[w] + 1
+    }
+    goCount := counts["go"]
+    funCount := counts["fun"]
+    distinct := len(counts)
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("goCount")] == 3
+        assert vars_[VarName("funCount")] == 1
+        assert vars_[VarName("distinct")] == 3
+
+    @covers(GoFeature.MAP)
+    def test_delete_removes_entry(self):
+        source = """\
+package main
+func main() {
+    m := map[string]int{"a": 1, "b": 2}
+    delete(m, "a")
+    n := len(m)
+    a := m["a"]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 1
+        assert vars_[VarName("a")] == 0
+
+
+class TestGoMapCommaOkExecution:
+    @covers(GoFeature.MAP)
+    def test_comma_ok_reports_presence(self):
+        source = """\
+package main
+func main() {
+    m := map[string]int{"x": 5}
+    v, ok := m["x"]
+    w, found := m["y"]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("v")] == 5
+        assert vars_[VarName("ok")] is True
+        assert vars_[VarName("w")] == 0
+        assert vars_[VarName("found")] is False
+
+    @covers(GoFeature.MAP)
+    def test_comma_ok_assignment_to_existing_vars(self):
+        source = """\
+package main
+func main() {
+    m := map[int]bool{3: true}
+    var v bool
+    var ok bool
+    v, ok = m[3]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("v")] is True
+        assert vars_[VarName("ok")] is True
@@ -0,0 +1,117 @@
+"""Integration tests for Go's math package.
+
+Verifies math.Sqrt, Abs, Pow, Max, Min, Floor and Ceil on float64 values
+(integer arguments converting as untyped constants), the package
+constants, and small numeric programs built on them, through the full
+parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+import math
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoMathExecution:
+    @covers(GoFeature.MATH_PACKAGE)
+    def test_float_functions(self):
+        source = """\
+package main
+import "math"
+func main() {
+    root := math.Sqrt(2.25)
+    abs := math.Abs(-3.5)
+    pow := math.Pow(2, 10)
+    hi := math.Max(1.5, 2.5)
+    lo := math.Min(1.5, 2.5)
+    down := math.Floor(2.7)
+    up := math.Ceil(2.1)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("root")] == 1.5
+        assert vars_[VarName("abs")] == 3.5
+        assert vars_[VarName("pow")] == 1024.0
+        assert vars_[VarName("hi")] == 2.5
+        assert vars_[VarName("lo")] == 1.5
+        assert vars_[VarName("down")] == 2.0
+        assert vars_[VarName("up")] == 3.0
+
+    @covers(GoFeature.MATH_PACKAGE)
+    def test_sqrt_of_negative_is_nan(self):
+        source = """\
+package main
+import "math"
+func main() {
+    r := math.Sqrt(-1)
+}
+"""
+        vars_ = _run_go(source)
+        assert math.isnan(vars_[VarName("r")])
+
+    @covers(GoFeature.MATH_PACKAGE)
+    def test_constants(self):
+        source = """\
+package main
+import "math"
+const halfTurn = math.Pi
+func main() {
+    big := math.MaxInt32
+    turn := 2 * halfTurn
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("big")] == 2**31 - 1
+        assert vars_[VarName("turn")] == 2 * math.pi
+
+    @covers(GoFeature.MATH_PACKAGE)
+    def test_armstrong_number(self):
+        source = """\
+package main
+import "math"
+func isArmstrong(n int) bool {
+    digits := 0
+    for m := n; m > 0; m = m / 10 {
+        digits = digits + 1
+    }
+    sum := 0.0
+    for m := n; m > 0; m = m / 10 {
+        sum = sum + math.Pow(float64(m%10), float64(digits))
+    }
+    return int(sum) == n
+}
+func main() {
+    yes := isArmstrong(153)
+    no := isArmstrong(154)
+}
+"""
+        vars_ = _run_go(source, max_steps=3000)
+        assert vars_[VarName("yes")] is True
+        assert vars_[VarName("no")] is False
+
+    @covers(GoFeature.MATH_PACKAGE)
+    def test_pythagorean_hypotenuse(self):
+        source = """\
+package main
+import "math"
+func isTriplet(a int, b int) bool {
+    c := math.Sqrt(float64(a*a + b*b))
+    return c == math.Floor(c)
+}
+func main() {
+    right := isTriplet(3, 4)
+    wrong := isTriplet(2, 3)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("right")] is True
+        assert vars_[VarName("wrong")] is False
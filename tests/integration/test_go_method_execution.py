@@ -0,0 +1,149 @@
+"""Integration tests for Go methods.
+
+Verifies method dispatch on struct receivers, pointer vs value receiver
+semantics, and methods declared before their receiver type through the
+full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoMethodDispatchExecution:
+    @covers(GoFeature.METHOD_CALL)
+    def test_value_method_reads_fields(self):
+        source = """\
+package main
+type Rect struct {
+    W, H int
+}
+func (r Rect) Area() int {
+    return r.W * r.H
+}
+func main() {
+    r := Rect{3, 4}
+    area := r.Area()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("area")] == 12
+
+    @covers(GoFeature.METHOD_CALL)
+    def test_same_method_name_on_two_types(self):
+        source = """\
+package main
+type Square struct {
+    side int
+}
+type Circle struct {
+    r int
+}
+func (s Square) Size() int {
+    return s.side * s.side
+}
+func (c Circle) Size() int {
+    return 3 * c.r * c.r
+}
+func main() {
+    s := Square{2}
+    c := Circle{1}
+    a := s.Size()
+    b := c.Size()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("a")] == 4
+        assert vars_[VarName("b")] == 3
+
+    @covers(GoFeature.METHOD_DECLARATION)
+    def test_method_declared_before_struct(self):
+        source = """\
+package main
+func (c Clock) Minutes() int {
+    return c.h*60 + c.m
+}
+type Clock struct {
+    h, m int
+}
+func main() {
+    c := Clock{h: 1, m: 5}
+    total := c.Minutes()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 65
+
+    @covers(GoFeature.METHOD_CALL)
+    def test_method_calls_another_method_with_args(self):
+        source = """\
+package main
+type Account struct {
+    balance int
+}
+func (a *Account) Deposit(n int) {
+    a.balance = a.balance + n
+}
+func (a *Account) DepositTwice(n int) {
+    a.Deposit(n)
+    a.Deposit(n)
+}
+func main() {
+    acct := Account{}
+    acct.DepositTwice(5)
+    balance := acct.balance
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("balance")] == 10
+
+
+class TestGoReceiverSemanticsExecution:
+    @covers(GoFeature.METHOD_DECLARATION)
+    def test_pointer_receiver_mutates_caller(self):
+        source = """\
+package main
+type Counter struct {
+    n int
+}
+func (c *Counter) Inc() {
+    c.n = c.n + 1
+}
+func main() {
+    c := Counter{}
+    c.Inc()
+    c.Inc()
+    n := c.n
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 2
+
+    @covers(GoFeature.METHOD_DECLARATION)
+    def test_value_receiver_mutates_a_copy(self):
+        source = """\
+package main
+type Counter struct {
+    n int
+}
+func (c Counter) Bumped() int {
+    c.n = c.n + 10
+    return c.n
+}
+func main() {
+    c := Counter{n: 1}
+    inside := c.Bumped()
+    outside := c.n
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("inside")] == 11
+        assert vars_[VarName("outside")] == 1
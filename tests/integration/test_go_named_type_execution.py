@@ -0,0 +1,98 @@
+"""Integration tests for Go named types and type aliases.
+
+Verifies that values of a named type (``type MyInt int``) or an alias
+(``type Celsius = float64``) behave like their underlying type, that
+explicit conversions between them execute concretely, and that aliases
+are interchangeable with their target, through the full
+parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 500) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoNamedTypeExecution:
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_named_int_keeps_integer_arithmetic(self):
+        source = """\
+package main
+type Count int
+func main() {
+    var c Count = 7
+    half := c / 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("half")] == 3
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_conversions_between_named_types(self):
+        source = """\
+package main
+type Celsius float64
+type Fahrenheit float64
+func toF(c Celsius) Fahrenheit {
+    return Fahrenheit(c*9/5 + 32)
+}
+func main() {
+    f := toF(Celsius(100))
+    whole := int(f)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("f")] == 212.0
+        assert vars_[VarName("whole")] == 212
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_conversion_truncates_to_named_int(self):
+        source = """\
+package main
+type Cents int
+func main() {
+    c := Cents(12.75)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("c")] == 12
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_named_string_type(self):
+        source = """\
+package main
+type Name string
+func greet(n Name) Name {
+    return "hi " + n
+}
+func main() {
+    g := greet(Name("gopher"))
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("g")] == "hi gopher"
+
+
+class TestGoTypeAliasExecution:
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_alias_mixes_with_target_type(self):
+        source = """\
+package main
+type Celsius = float64
+func warm(c Celsius) float64 {
+    return c + 1.5
+}
+func main() {
+    var base float64 = 20
+    t := warm(base)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("t")] == 21.5
@@ -0,0 +1,109 @@
+"""Integration tests for Go zero values and nil.
+
+Verifies that variables and struct fields declared without an initializer
+hold their type's zero value, that nil pointers, slices, maps and
+interfaces compare equal to nil and behave as empty collections, and that
+writing into a nil map panics, through the full parse → lower → execute
+pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoZeroValueExecution:
+    @covers(GoFeature.ZERO_VALUE)
+    def test_struct_fields_take_zero_values(self):
+        vars_ = _run_go("""\
+package main
+type Grid [3]int
+type Node struct {
+    val  int
+    next *Node
+}
+type Board struct {
+    cells [2]int
+    name  string
+    ok    bool
+    ratio float64
+    tags  []string
+    index map[string]int
+    head  *Node
+}
+func main() {
+    var b Board
+    b.cells[1] = 4
+    var g Grid
+    g[2] = 7
+    cell := b.cells[1] + g[2]
+    empty := b.name == "" && !b.ok && b.ratio == 0.0
+    nilTags := b.tags == nil
+    nilIndex := b.index == nil
+    nilHead := b.head == nil
+    b.tags = append(b.tags, "x")
+    tagCount := len(b.tags)
+}
+""")
+        assert vars_[VarName("cell")] == 11
+        assert vars_[VarName("empty")] is True
+        assert vars_[VarName("nilTags")] is True
+        assert vars_[VarName("nilIndex")] is True
+        assert vars_[VarName("nilHead")] is True
+        assert vars_[VarName("tagCount")] == 1
+
+
+class TestGoNilExecution:
+    @covers(GoFeature.NIL)
+    def test_nil_collections_and_references(self):
+        vars_ = _run_go("""\
+package main
+type Node struct {
+    val int
+}
+func main() {
+    var xs []int
+    var m map[string]int
+    visits := 0
+    for range xs {
+        visits++
+    }
+    for range m {
+        visits++
+    }
+    missing := m["k"]
+    var err error
+    var p *Node
+    nilErr := err == nil
+    nilPtr := p == nil
+    p = &Node{val: 1}
+    setPtr := p != nil
+}
+""")
+        assert vars_[VarName("visits")] == 0
+        assert vars_[VarName("missing")] == 0
+        assert vars_[VarName("nilErr")] is True
+        assert vars_[VarName("nilPtr")] is True
+        assert vars_[VarName("setPtr")] is True
+
+    @covers(GoFeature.NIL)
+    def test_nil_map_write_panics(self):
+        vars_ = _run_go("""\
+package main
+func main() {
+    var m map[string]int
+    before := 1
+    m["a"] = 1
+    after := 2
+}
+""")
+        assert vars_[VarName("before")] == 1
+        assert VarName("after") not in vars_
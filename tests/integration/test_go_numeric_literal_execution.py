@@ -0,0 +1,69 @@
+"""Integration tests for Go integer and float literal syntax.
+
+Verifies hexadecimal, octal and binary integer literals, digit
+separators and hexadecimal floats, in expressions and in folded
+constants, through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 500) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoNumericLiteralExecution:
+    @covers(GoFeature.INT_LITERAL)
+    def test_every_base_and_separator(self):
+        vars_ = _run_go("""\
+package main
+func main() {
+    hex := 0xFF + 0X_10
+    perm := 0o755
+    legacy := 0644
+    flags := 0b1010 | 0b0101
+    million := 1_000_000
+}
+""")
+        assert vars_[VarName("hex")] == 271
+        assert vars_[VarName("perm")] == 493
+        assert vars_[VarName("legacy")] == 420
+        assert vars_[VarName("flags")] == 15
+        assert vars_[VarName("million")] == 1000000
+
+    @covers(GoFeature.INT_LITERAL)
+    def test_prefixed_literals_in_constants(self):
+        vars_ = _run_go("""\
+package main
+const (
+    Read  = 0b100
+    Write = 0b010
+    All   = 0o7 &^ Write
+)
+func main() {
+    mode := Read | Write
+    all := All
+}
+""")
+        assert vars_[VarName("mode")] == 6
+        assert vars_[VarName("all")] == 5
+
+    @covers(GoFeature.FLOAT)
+    def test_hex_and_separated_floats(self):
+        vars_ = _run_go("""\
+package main
+func main() {
+    quarter := 0x1p-2
+    big := 1_500.25
+    avogadro := 6.022_140_76e23
+}
+""")
+        assert vars_[VarName("quarter")] == 0.25
+        assert vars_[VarName("big")] == 1500.25
+        assert vars_[VarName("avogadro")] == 6.02214076e23
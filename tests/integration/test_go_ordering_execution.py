@@ -0,0 +1,62 @@
+"""Integration tests for Go ordering operators on strings.
+
+Verifies that ``<``, ``<=``, ``>`` and ``>=`` order strings byte-wise,
+prefixes before longer strings and multi-byte characters after ASCII, so
+a sort written in Go orders words as it does in Go, through the full
+parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoStringOrderingExecution:
+    @covers(GoFeature.ORDERING)
+    def test_string_comparisons(self):
+        vars_ = _run_go("""\
+package main
+func main() {
+    a, b := "apple", "banana"
+    lt := a < b
+    gt := a > b
+    prefix := "app" < a
+    same := a <= "apple" && a >= "apple"
+    upper := "Zebra" < "apple"
+    accented := "z" < "é"
+}
+""")
+        assert vars_[VarName("lt")] is True
+        assert vars_[VarName("gt")] is False
+        assert vars_[VarName("prefix")] is True
+        assert vars_[VarName("same")] is True
+        assert vars_[VarName("upper")] is True
+        assert vars_[VarName("accented")] is True
+
+    @covers(GoFeature.ORDERING)
+    def test_insertion_sort_of_words(self):
+        vars_ = _run_go("""\
+package main
+func main() {
+    words := []string{"pear", "fig", "apple", "banana", "cherry"}
+    for i := 1; i < len(words); i++ {
+        for j := i; j > 0 && words[j] < words[j-1]; j-- {
+            words[j], words[j-1] = words[j-1], words[j]
+        }
+    }
+    first := words[0]
+    second := words[1]
+    last := words[4]
+}
+""")
+        assert vars_[VarName("first")] == "apple"
+        assert vars_[VarName("second")] == "banana"
+        assert vars_[VarName("last")] == "pear"
@@ -0,0 +1,87 @@
+"""Integration tests for Go package-level variables.
+
+Verifies that top-level var initializers run before main wherever they
+appear in the file, in dependency order even through function calls, and
+that every function reads and updates the same package variable, through
+the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoPackageVarInitializationExecution:
+    @covers(GoFeature.VAR_DECLARATION)
+    def test_var_declared_after_main_is_initialized_first(self):
+        source = """\
+package main
+func main() {
+    got := greeting + "!"
+}
+var greeting = "hello"
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("got")] == "hello!"
+
+    @covers(GoFeature.VAR_DECLARATION)
+    def test_initializers_run_in_dependency_order(self):
+        source = """\
+package main
+var (
+    total = count * price
+    count = 3
+    price = 7
+)
+func main() {
+    t := total
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("t")] == 21
+
+    @covers(GoFeature.VAR_DECLARATION)
+    def test_initializer_calls_function_reading_later_var(self):
+        source = """\
+package main
+var doubled = double()
+func double() int {
+    return base * 2
+}
+var base = 21
+func main() {
+    d := doubled
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("d")] == 42
+
+
+class TestGoPackageVarSharingExecution:
+    @covers(GoFeature.VAR_DECLARATION)
+    def test_functions_share_package_var(self):
+        source = """\
+package main
+var counter int = 10
+func bump() {
+    counter = counter + 1
+}
+func read() int {
+    return counter
+}
+func main() {
+    bump()
+    bump()
+    n := read()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 12
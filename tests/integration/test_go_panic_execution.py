@@ -0,0 +1,176 @@
+"""Integration tests for Go panic and recover.
+
+Verifies that a panic unwinds through calling functions running their
+deferred calls, that recover() in a deferred call stops the panic and
+returns its value, and that an uncaught panic halts the program with a
+structured runtime error carrying the panic message and stack trace,
+through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.field_name import FieldKind, FieldName
+from interpreter.frontends.go.features import GoFeature
+from interpreter.project.entry_point import EntryPoint
+from interpreter.run import run
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoRecoverExecution:
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_recover_in_deferred_closure_sets_named_result(self):
+        source = """\
+package main
+func safe() (msg string) {
+    defer func() {
+        if r := recover(); r != nil {
+            msg = "recovered"
+        }
+    }()
+    panic("boom")
+}
+func main() {
+    m := safe()
+    after := 1
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("m")] == "recovered"
+        assert vars_[VarName("after")] == 1
+
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_recover_returns_panic_value(self):
+        source = """\
+package main
+func catch() (v any) {
+    defer func() { v = recover() }()
+    panic(42)
+}
+func main() {
+    r := catch()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == 42
+
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_recover_without_panic_returns_nil(self):
+        source = """\
+package main
+func calm() (ok bool) {
+    defer func() { ok = recover() == nil }()
+    return false
+}
+func main() {
+    r := calm()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] is True
+
+
+class TestGoPanicUnwindExecution:
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_panic_unwinds_through_intermediate_function(self):
+        source = """\
+package main
+func inner() int {
+    panic("deep")
+    return 1
+}
+func middle() int {
+    x := inner()
+    return x + 1
+}
+func outer() (res string) {
+    defer func() {
+        if r := recover(); r != nil {
+            res = "caught"
+        }
+    }()
+    middle()
+    return "normal"
+}
+func main() {
+    r := outer()
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == "caught"
+
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_defers_run_while_panicking(self):
+        source = """\
+package main
+type Log struct {
+    n int
+}
+func step(l *Log) {
+    defer func() { l.n = l.n + 1 }()
+    panic("stop")
+}
+func run(l *Log) (ok bool) {
+    defer func() { ok = recover() != nil }()
+    step(l)
+    return false
+}
+func main() {
+    l := &Log{}
+    ok := run(l)
+    n := l.n
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("ok")] is True
+        assert vars_[VarName("n")] == 1
+
+
+class TestGoUncaughtPanicExecution:
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_uncaught_panic_halts_program(self):
+        source = """\
+package main
+func main() {
+    before := 1
+    panic("boom")
+    after := 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("before")] == 1
+        assert VarName("after") not in vars_
+
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_uncaught_panic_becomes_runtime_error_with_stack(self):
+        source = """\
+package main
+func inner() {
+    panic("boom")
+}
+func outer() {
+    inner()
+}
+func main() {
+    outer()
+}
+"""
+        vm = run(
+            source,
+            language=Language.GO,
+            max_steps=2000,
+            entry_point=EntryPoint.top_level(),
+        )
+        error = vm.call_stack[0].local_vars[VarName("__go_panic__")].value
+        fields = vm.heap_get(error.base).fields
+        assert fields[FieldName("message")].value == "panic: boom"
+        stack = vm.heap_get(fields[FieldName("stack")].value.base).fields
+        assert [
+            stack[FieldName(str(i), FieldKind.INDEX)].value for i in range(3)
+        ] == ["inner", "outer", "main"]
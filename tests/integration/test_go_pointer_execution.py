@@ -0,0 +1,175 @@
+"""Integration tests for Go pointers.
+
+Verifies &x / *p on local variables, mutation through pointer parameters,
+pointers to structs, pointer comparison, and the nil-dereference panic
+through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoPointerDerefExecution:
+    @covers(GoFeature.POINTER)
+    def test_write_through_pointer_updates_variable(self):
+        source = """\
+package main
+func main() {
+    x := 1
+    p := &x
+    *p = 42
+    y := x
+    z := *p
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("y")] == 42
+        assert vars_[VarName("z")] == 42
+
+    @covers(GoFeature.POINTER)
+    def test_pointer_parameter_mutates_caller_variable(self):
+        source = """\
+package main
+func inc(p *int) {
+    *p = *p + 1
+}
+func main() {
+    x := 1
+    inc(&x)
+    inc(&x)
+    y := x
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("y")] == 3
+
+    @covers(GoFeature.POINTER)
+    def test_swap_through_pointers(self):
+        source = """\
+package main
+func swap(a *int, b *int) {
+    t := *a
+    *a = *b
+    *b = t
+}
+func main() {
+    x := 1
+    y := 2
+    swap(&x, &y)
+    sx := x
+    sy := y
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("sx")] == 2
+        assert vars_[VarName("sy")] == 1
+
+
+class TestGoStructPointerExecution:
+    @covers(GoFeature.POINTER)
+    def test_pointer_receiver_mutates_struct(self):
+        source = """\
+package main
+type Counter struct {
+    n int
+}
+func (c *Counter) Inc() {
+    c.n = c.n + 1
+}
+func main() {
+    c := &Counter{}
+    c.Inc()
+    c.Inc()
+    n := c.n
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 2
+
+    @covers(GoFeature.POINTER)
+    def test_pointer_to_struct_variable_shares_fields(self):
+        source = """\
+package main
+type Point struct {
+    X int
+}
+func moveRight(p *Point) {
+    p.X = p.X + 10
+}
+func main() {
+    pt := Point{1}
+    moveRight(&pt)
+    x := pt.X
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("x")] == 11
+
+
+class TestGoPointerComparisonExecution:
+    @covers(GoFeature.POINTER)
+    def test_pointers_compare_by_target(self):
+        source = """\
+package main
+func main() {
+    x := 1
+    y := 1
+    p := &x
+    q := &x
+    r := &y
+    same := p == q
+    diff := p == r
+    var np *int
+    isNil := np == nil
+    notNil := p != nil
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("same")] is True
+        assert vars_[VarName("diff")] is False
+        assert vars_[VarName("isNil")] is True
+        assert vars_[VarName("notNil")] is True
+
+
+class TestGoNilDereferenceExecution:
+    @covers(GoFeature.POINTER)
+    def test_nil_dereference_panics(self):
+        source = """\
+package main
+func main() {
+    var p *int
+    before := 1
+    v := *p
+    after := 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("before")] == 1
+        assert VarName("after") not in vars_
+
+    @covers(GoFeature.POINTER)
+    def test_nil_struct_pointer_field_access_panics(self):
+        source = """\
+package main
+type Node struct {
+    val int
+}
+func main() {
+    var n *Node
+    before := 1
+    v := n.val
+    after := 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("before")] == 1
+        assert VarName("after") not in vars_
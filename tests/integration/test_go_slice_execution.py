@@ -0,0 +1,279 @@
+"""Integration tests for Go slices.
+
+Verifies slice literals, make(), append() with capacity growth, cap(),
+len() and s[lo:hi] slicing — including slices sharing a backing array —
+through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoAppendExecution:
+    @covers(GoFeature.APPEND)
+    def test_append_grows_empty_slice(self):
+        source = """\
+package main
+func main() {
+    s := []int{}
+    for i := 0; i < 4; i++ {
+        s = append(s, i*10)
+    }
+    n := len(s)
+    last := s[3]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 4
+        assert vars_[VarName("last")] == 30
+
+    @covers(GoFeature.APPEND)
+    def test_append_to_nil_slice(self):
+        source = """\
+package main
+func main() {
+    var s []string
+    s = append(s, "a", "b")
+    n := len(s)
+    second := s[1]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 2
+        assert vars_[VarName("second")] == "b"
+
+    @covers(GoFeature.APPEND)
+    def test_append_spread_concatenates_slices(self):
+        source = """\
+package main
+func main() {
+    a := []int{1, 2}
+    b := []int{3, 4, 5}
+    a = append(a, b...)
+    n := len(a)
+    total := a[0] + a[1] + a[2] + a[3] + a[4]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 5
+        assert vars_[VarName("total")] == 15
+
+    @covers(GoFeature.APPEND)
+    def test_append_leaves_original_slice_unchanged(self):
+        source = """\
+package main
+func main() {
+    a := []int{1, 2, 3}
+    b := append(a, 4)
+    lenA := len(a)
+    lenB := len(b)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("lenA")] == 3
+        assert vars_[VarName("lenB")] == 4
+
+    @covers(GoFeature.APPEND)
+    def test_capacity_doubles_when_exceeded(self):
+        source = """\
+package main
+func main() {
+    s := make([]int, 0, 2)
+    s = append(s, 1, 2)
+    c1 := cap(s)
+    s = append(s, 3)
+    c2 := cap(s)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("c1")] == 2
+        assert vars_[VarName("c2")] == 4
+
+
+    @covers(GoFeature.APPEND)
+    def test_append_within_capacity_aliases_original(self):
+        source = """\
+package main
+func main() {
+    a := make([]int, 2, 4)
+    b := append(a, 7)
+    b[0] = 5
+    first := a[0]
+    third := a[:3][2]
+    lenA := len(a)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("first")] == 5
+        assert vars_[VarName("third")] == 7
+        assert vars_[VarName("lenA")] == 2
+
+
+class TestGoMakeSliceExecution:
+    @covers(GoFeature.MAKE)
+    def test_make_slice_is_zero_filled(self):
+        source = """\
+package main
+func main() {
+    s := make([]int, 3)
+    s[1] = 7
+    n := len(s)
+    c := cap(s)
+    total := s[0] + s[1] + s[2]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 3
+        assert vars_[VarName("c")] == 3
+        assert vars_[VarName("total")] == 7
+
+    @covers(GoFeature.MAKE)
+    def test_make_bool_slice_sieve(self):
+        source = """\
+package main
+func main() {
+    limit := 20
+    composite := make([]bool, limit)
+    primes := []int{}
+    for i := 2; i < limit; i++ {
+        if !composite[i] {
+            primes = append(primes, i)
+            for j := i * i; j < limit; j = j + i {
+                composite[j] = true
+            }
+        }
+    }
+    count := len(primes)
+    largest := primes[count-1]
+}
+"""
+        vars_ = _run_go(source, max_steps=5000)
+        assert vars_[VarName("count")] == 8
+        assert vars_[VarName("largest")] == 19
+
+
+class TestGoSliceExpressionExecution:
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_slice_of_slice_has_length(self):
+        source = """\
+package main
+func main() {
+    s := []int{10, 20, 30, 40, 50}
+    mid := s[1:4]
+    n := len(mid)
+    first := mid[0]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 3
+        assert vars_[VarName("first")] == 20
+
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_open_ended_slices(self):
+        source = """\
+package main
+func main() {
+    s := []int{1, 2, 3, 4}
+    head := s[:2]
+    tail := s[2:]
+    total := head[0] + head[1] + tail[0] + tail[1]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 10
+
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_slice_shares_backing_array(self):
+        source = """\
+package main
+func main() {
+    a := []int{1, 2, 3}
+    b := a[:2]
+    b[0] = 9
+    a[1] = 8
+    first := a[0]
+    second := b[1]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("first")] == 9
+        assert vars_[VarName("second")] == 8
+
+    @covers(GoFeature.SLICE_EXPRESSION, GoFeature.ARRAY)
+    def test_slice_of_array_aliases_it(self):
+        source = """\
+package main
+func main() {
+    var arr [4]int
+    s := arr[1:3]
+    s[1] = 5
+    third := arr[2]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("third")] == 5
+
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_capacity_runs_to_end_of_backing_array(self):
+        source = """\
+package main
+func main() {
+    s := make([]int, 2, 5)
+    tail := cap(s[1:])
+    limited := cap(s[1:2:3])
+    grown := s[:4]
+    n := len(grown)
+    last := grown[3]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("tail")] == 4
+        assert vars_[VarName("limited")] == 2
+        assert vars_[VarName("n")] == 4
+        assert vars_[VarName("last")] == 0
+
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_bounds_beyond_capacity_panic(self):
+        source = """\
+package main
+func cut(s []int, hi int) (msg string) {
+    defer func() {
+        if r := recover(); r != nil {
+            msg = r.(string)
+        }
+    }()
+    t := s[:hi]
+    return "ok"
+}
+func main() {
+    s := make([]int, 1, 3)
+    within := cut(s, 3)
+    past := cut(s, 4)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("within")] == "ok"
+        assert vars_[VarName("past")] == "runtime error: slice bounds out of range"
+
+    @covers(GoFeature.SLICE_TYPE)
+    def test_out_of_range_slice_index_panics(self):
+        source = """\
+package main
+func main() {
+    s := []int{1, 2}
+    before := s[1]
+    after := s[2]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("before")] == 2
+        assert VarName("after") not in vars_
@@ -0,0 +1,108 @@
+"""Integration tests for Go string indexing.
+
+Verifies that s[i] yields the i-th UTF-8 byte as an integer, that bytes
+compare with rune literals and take part in arithmetic, and that an
+out-of-range index panics, through the full parse → lower → execute
+pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoStringIndexExecution:
+    @covers(GoFeature.INDEXING)
+    def test_index_yields_byte_value(self):
+        source = """\
+package main
+func main() {
+    s := "Go"
+    g := s[0]
+    o := s[1]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("g")] == 71
+        assert vars_[VarName("o")] == 111
+
+    @covers(GoFeature.INDEXING)
+    def test_index_counts_utf8_bytes(self):
+        source = """\
+package main
+func main() {
+    s := "héllo"
+    lead := s[1]
+    trail := s[2]
+    l := s[3]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("lead")] == 0xC3
+        assert vars_[VarName("trail")] == 0xA9
+        assert vars_[VarName("l")] == ord("l")
+
+    @covers(GoFeature.RUNE_LITERAL)
+    def test_byte_compares_with_rune_literal(self):
+        source = """\
+package main
+func countG(dna string) int {
+    n := 0
+    for i := 0; i < len(dna); i++ {
+        if dna[i] == 'G' {
+            n = n + 1
+        }
+    }
+    return n
+}
+func main() {
+    c := countG("GATTGAG")
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("c")] == 3
+
+    @covers(GoFeature.INDEXING)
+    def test_byte_arithmetic_converts_digit(self):
+        source = """\
+package main
+func digit(s string, i int) int {
+    return int(s[i] - '0')
+}
+func main() {
+    d := digit("2047", 2)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("d")] == 4
+
+    @covers(GoFeature.INDEXING)
+    def test_index_past_end_panics(self):
+        source = """\
+package main
+func at(s string, i int) (msg string) {
+    defer func() {
+        if r := recover(); r != nil {
+            msg = r.(string)
+        }
+    }()
+    b := s[i]
+    _ = b
+    return "ok"
+}
+func main() {
+    inside := at("abc", 2)
+    past := at("abc", 3)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("inside")] == "ok"
+        assert vars_[VarName("past")] == "runtime error: index out of range"
@@ -0,0 +1,78 @@
+"""Integration tests for Go string and rune literals.
+
+Verifies that escape sequences in interpreted strings decode to the
+bytes Go stores, that raw strings keep backslashes and newlines, and
+that escaped runes are code points, through the full parse → lower →
+execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals, run_stdout
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoStringLiteralExecution:
+    @covers(GoFeature.STRING_LITERAL)
+    def test_escapes_count_as_their_bytes(self):
+        vars_ = _run_go(r"""
+package main
+func main() {
+    tab := len("a\tb")
+    world := "\xe4\xb8\x96"
+    worldLen := len(world)
+    accent := len("\u00e9")
+    first := "\x41\102"[1]
+}
+""")
+        assert vars_[VarName("tab")] == 3
+        assert vars_[VarName("world")] == "世"
+        assert vars_[VarName("worldLen")] == 3
+        assert vars_[VarName("accent")] == 2
+        assert vars_[VarName("first")] == 66
+
+    @covers(GoFeature.STRING_LITERAL)
+    def test_raw_string_keeps_backslashes_and_newlines(self):
+        vars_ = _run_go(
+            "package main\nfunc main() {\n"
+            "    path := `C:\\dir\\n`\n"
+            "    lines := `a\nb`\n"
+            "    n := len(path)\n}\n"
+        )
+        assert vars_[VarName("path")] == "C:\\dir\\n"
+        assert vars_[VarName("lines")] == "a\nb"
+        assert vars_[VarName("n")] == 8
+
+    @covers(GoFeature.STRING_LITERAL)
+    def test_println_prints_decoded_escapes(self):
+        stdout = run_stdout(
+            r"""package main
+import "fmt"
+func main() {
+    fmt.Println("say \"hi\"\tnow")
+}
+""",
+            Language.GO,
+        )
+        assert stdout == 'say "hi"\tnow\n'
+
+    @covers(GoFeature.RUNE_LITERAL)
+    def test_escaped_runes_are_code_points(self):
+        vars_ = _run_go(r"""
+package main
+func main() {
+    quote := '\''
+    newline := '\n'
+    smile := '\U0001F600'
+}
+""")
+        assert vars_[VarName("quote")] == 39
+        assert vars_[VarName("newline")] == 10
+        assert vars_[VarName("smile")] == 0x1F600
@@ -0,0 +1,96 @@
+"""Integration tests for Go string slicing.
+
+Verifies that s[lo:hi] extracts substrings by UTF-8 byte offset, that
+omitted bounds default to the start and end of the string, and that
+out-of-range bounds panic with Go's runtime error, through the full
+parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoStringSliceExecution:
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_substring_and_omitted_bounds(self):
+        source = """\
+package main
+func main() {
+    s := "gopher"
+    mid := s[1:4]
+    head := s[:2]
+    tail := s[4:]
+    all := s[:]
+    empty := s[3:3]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("mid")] == "oph"
+        assert vars_[VarName("head")] == "go"
+        assert vars_[VarName("tail")] == "er"
+        assert vars_[VarName("all")] == "gopher"
+        assert vars_[VarName("empty")] == ""
+
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_bounds_are_byte_offsets(self):
+        source = """\
+package main
+func main() {
+    s := "héllo"
+    accented := s[1:3]
+    rest := s[3:]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("accented")] == "é"
+        assert vars_[VarName("rest")] == "llo"
+
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_reverse_by_single_byte_substrings(self):
+        source = """\
+package main
+func reverse(s string) string {
+    out := ""
+    for i := len(s) - 1; i >= 0; i-- {
+        out = out + s[i:i+1]
+    }
+    return out
+}
+func main() {
+    r := reverse("stressed")
+}
+"""
+        vars_ = _run_go(source, max_steps=3000)
+        assert vars_[VarName("r")] == "desserts"
+
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_out_of_range_bounds_panic(self):
+        source = """\
+package main
+func cut(s string, lo int, hi int) (msg string) {
+    defer func() {
+        if r := recover(); r != nil {
+            msg = r.(string)
+        }
+    }()
+    return s[lo:hi]
+}
+func main() {
+    ok := cut("abc", 1, 3)
+    past := cut("abc", 1, 4)
+    inverted := cut("abc", 2, 1)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("ok")] == "bc"
+        assert vars_[VarName("past")] == "runtime error: slice bounds out of range"
+        assert vars_[VarName("inverted")] == "runtime error: slice bounds out of range"
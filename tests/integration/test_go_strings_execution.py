@@ -0,0 +1,77 @@
+"""Integration tests for Go's strings package.
+
+Verifies that ``import "strings"`` makes Contains, ToUpper, ToLower,
+Split, Index and Repeat callable, including under an import alias, and
+that Split yields a slice usable with len and indexing, through the full
+parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoStringsExecution:
+    @covers(GoFeature.STRINGS_PACKAGE)
+    def test_case_mapping_and_search(self):
+        source = """\
+package main
+import "strings"
+func main() {
+    up := strings.ToUpper("Gopher")
+    low := strings.ToLower("Gopher")
+    has := strings.Contains("gopher", "oph")
+    missing := strings.Contains("gopher", "x")
+    at := strings.Index("héllo", "llo")
+    none := strings.Index("gopher", "z")
+    echo := strings.Repeat("ab", 3)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("up")] == "GOPHER"
+        assert vars_[VarName("low")] == "gopher"
+        assert vars_[VarName("has")] is True
+        assert vars_[VarName("missing")] is False
+        assert vars_[VarName("at")] == 3
+        assert vars_[VarName("none")] == -1
+        assert vars_[VarName("echo")] == "ababab"
+
+    @covers(GoFeature.STRINGS_PACKAGE)
+    def test_split_yields_slice(self):
+        source = """\
+package main
+import "strings"
+func main() {
+    parts := strings.Split("a,b,c", ",")
+    n := len(parts)
+    last := parts[2]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 3
+        assert vars_[VarName("last")] == "c"
+
+    @covers(GoFeature.STRINGS_PACKAGE)
+    def test_aliased_import(self):
+        source = """\
+package main
+import (
+    s "strings"
+)
+func shout(word string) string {
+    return s.ToUpper(word) + "!"
+}
+func main() {
+    r := shout("go")
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("r")] == "GO!"
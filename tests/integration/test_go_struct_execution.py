@@ -0,0 +1,223 @@
+"""Integration tests for Go structs.
+
+Verifies struct literals (keyed and positional), zero-valued fields, field
+reads and writes, nested structs, and structs held in slices through the
+full parse → lower → execute pipeline, including literals whose element
+struct or slice types are elided (`[]Point{{1, 2}}`).
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 1000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoStructLiteralExecution:
+    @covers(GoFeature.STRUCT)
+    def test_keyed_literal_fields_are_readable(self):
+        source = """\
+package main
+type Point struct {
+    X int
+    Y int
+}
+func main() {
+    p := Point{Y: 4, X: 3}
+    x := p.X
+    y := p.Y
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("x")] == 3
+        assert vars_[VarName("y")] == 4
+
+    @covers(GoFeature.STRUCT)
+    def test_positional_literal_follows_declaration_order(self):
+        source = """\
+package main
+type Clock struct {
+    hour, minute int
+}
+func main() {
+    c := Clock{10, 37}
+    h := c.hour
+    m := c.minute
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("h")] == 10
+        assert vars_[VarName("m")] == 37
+
+    @covers(GoFeature.STRUCT)
+    def test_omitted_fields_are_zero(self):
+        source = """\
+package main
+type Robot struct {
+    name  string
+    moves int
+    on    bool
+}
+func main() {
+    r := Robot{moves: 2}
+    name := r.name
+    on := r.on
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("name")] == ""
+        assert vars_[VarName("on")] is False
+
+    @covers(GoFeature.STRUCT)
+    def test_var_struct_and_nested_struct_are_zero(self):
+        source = """\
+package main
+type Point struct {
+    X, Y int
+}
+type Segment struct {
+    From Point
+    To   Point
+}
+func main() {
+    var s Segment
+    toY := s.To.Y
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("toY")] == 0
+
+
+class TestGoStructFieldExecution:
+    @covers(GoFeature.FIELD_ACCESS)
+    def test_field_assignment(self):
+        source = """\
+package main
+type Counter struct {
+    n int
+}
+func main() {
+    c := Counter{}
+    for i := 0; i < 3; i++ {
+        c.n = c.n + 2
+    }
+    total := c.n
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("total")] == 6
+
+    @covers(GoFeature.FIELD_ACCESS)
+    def test_nested_field_assignment(self):
+        source = """\
+package main
+type Point struct {
+    X, Y int
+}
+type Segment struct {
+    From, To Point
+}
+func main() {
+    var s Segment
+    s.To.X = 7
+    toX := s.To.X
+    fromX := s.From.X
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("toX")] == 7
+        assert vars_[VarName("fromX")] == 0
+
+    @covers(GoFeature.STRUCT)
+    def test_struct_returned_from_function(self):
+        source = """\
+package main
+type Clock struct {
+    hour, minute int
+}
+func newClock(h int, m int) Clock {
+    total := h*60 + m
+    return Clock{total / 60 % 24, total % 60}
+}
+func main() {
+    c := newClock(23, 75)
+    h := c.hour
+    m := c.minute
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("h")] == 0
+        assert vars_[VarName("m")] == 15
+
+    @covers(GoFeature.STRUCT)
+    def test_slice_of_structs(self):
+        source = """\
+package main
+type Item struct {
+    name  string
+    price int
+}
+func main() {
+    items := []Item{}
+    items = append(items, Item{"a", 3})
+    items = append(items, Item{name: "b", price: 4})
+    total := 0
+    for _, it := range items {
+        total = total + it.price
+    }
+    second := items[1].name
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("total")] == 7
+        assert vars_[VarName("second")] == "b"
+
+
+class TestGoElidedLiteralExecution:
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_slice_of_elided_struct_literals(self):
+        source = """\
+package main
+type Point struct {
+    X, Y int
+}
+func main() {
+    ps := []Point{{1, 2}, {X: 3}, {Y: 5}}
+    sum := 0
+    for _, p := range ps {
+        sum = sum + p.X*10 + p.Y
+    }
+    n := len(ps)
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("sum")] == 12 + 30 + 5
+        assert vars_[VarName("n")] == 3
+
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_map_of_elided_struct_literals_and_nested_slices(self):
+        source = """\
+package main
+type Point struct {
+    X, Y int
+}
+func main() {
+    corners := map[string]*Point{"origin": {}, "far": {4, 6}}
+    farY := corners["far"].Y
+    originX := corners["origin"].X
+    grid := [][]int{{1, 2, 3}, {4}}
+    cell := grid[0][2] + grid[1][0]
+    rows := len(grid)
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("farY")] == 6
+        assert vars_[VarName("originX")] == 0
+        assert vars_[VarName("cell")] == 7
+        assert vars_[VarName("rows")] == 2
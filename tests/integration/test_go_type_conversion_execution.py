@@ -73,3 +73,109 @@ func main() {
 """
         vars_ = _run_go(source)
         assert vars_[VarName("y")] == 3
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_float64_conversion_makes_division_fractional(self):
+        source = """\
+package main
+func main() {
+    n := 7
+    half := float64(n) / 2
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("half")] == 3.5
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_string_of_integer_is_code_point(self):
+        source = """\
+package main
+func main() {
+    s := string(65)
+    r := 'é'
+    t := string(r)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("s")] == "A"
+        assert vars_[VarName("t")] == "é"
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_string_of_indexed_byte(self):
+        source = """\
+package main
+func main() {
+    s := "GATTACA"
+    first := string(s[0])
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("first")] == "G"
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_byte_slice_holds_utf8_bytes(self):
+        source = """\
+package main
+func main() {
+    b := []byte("héllo")
+    n := len(b)
+    second := b[1]
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("n")] == 6
+        assert vars_[VarName("second")] == 0xC3
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_string_of_byte_slice_round_trips(self):
+        source = """\
+package main
+func main() {
+    b := []byte("héllo")
+    s := string(b)
+    lit := string([]byte{104, 105})
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("s")] == "héllo"
+        assert vars_[VarName("lit")] == "hi"
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_string_of_rune_slice_encodes_each_code_point(self):
+        source = """\
+package main
+func main() {
+    s := string([]rune{0xC3, 0xA9})
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("s")] == "Ã©"
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_string_of_invalid_bytes_gives_replacement_character(self):
+        source = """\
+package main
+func main() {
+    b := []byte{0xff}
+    s := string(b)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("s")] == "\ufffd"
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_rune_slice_reverses_a_string(self):
+        source = """\
+package main
+func main() {
+    runes := []rune("héllo")
+    n := len(runes)
+    for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+        runes[i], runes[j] = runes[j], runes[i]
+    }
+    reversed := string(runes)
+}
+"""
+        vars_ = _run_go(source, max_steps=2000)
+        assert vars_[VarName("n")] == 5
+        assert vars_[VarName("reversed")] == "olléh"
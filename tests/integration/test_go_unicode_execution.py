@@ -0,0 +1,131 @@
+"""Integration tests for Unicode-aware Go string handling and identifiers.
+
+Verifies that []rune(s) and string(runes) round-trip non-ASCII text, and
+that ``import "unicode"`` and ``import "unicode/utf8"`` classify runes and
+count them, through the full parse → lower → execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoUnicodeExecution:
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_reverse_string_with_accents(self):
+        vars_ = _run_go("""\
+package main
+func Reverse(s string) string {
+    r := []rune(s)
+    for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+        r[i], r[j] = r[j], r[i]
+    }
+    return string(r)
+}
+func main() {
+    a := Reverse("résumé")
+    b := Reverse("子猫")
+    n := len([]rune("résumé"))
+}
+""")
+        assert vars_[VarName("a")] == "émusér"
+        assert vars_[VarName("b")] == "猫子"
+        assert vars_[VarName("n")] == 6
+
+    @covers(GoFeature.UNICODE_PACKAGE)
+    def test_rune_classification_and_case_mapping(self):
+        vars_ = _run_go("""\
+package main
+import "unicode"
+func main() {
+    letter := unicode.IsLetter('é')
+    digit := unicode.IsDigit('7')
+    space := unicode.IsSpace('\\t')
+    upper := unicode.IsUpper('Ä')
+    lower := unicode.IsLower('Ä')
+    punct := unicode.IsPunct('!')
+    big := unicode.ToUpper('é')
+    small := string(unicode.ToLower('Ä'))
+}
+""")
+        assert vars_[VarName("letter")] is True
+        assert vars_[VarName("digit")] is True
+        assert vars_[VarName("space")] is True
+        assert vars_[VarName("upper")] is True
+        assert vars_[VarName("lower")] is False
+        assert vars_[VarName("punct")] is True
+        assert vars_[VarName("big")] == ord("É")
+        assert vars_[VarName("small")] == "ä"
+
+    @covers(GoFeature.UNICODE_PACKAGE)
+    def test_utf8_counts_runes_and_bytes(self):
+        vars_ = _run_go("""\
+package main
+import "unicode/utf8"
+func main() {
+    word := "naïve"
+    runes := utf8.RuneCountInString(word)
+    bytes := len(word)
+    width := utf8.RuneLen('世')
+    bad := utf8.RuneLen(-1)
+}
+""")
+        assert vars_[VarName("runes")] == 5
+        assert vars_[VarName("bytes")] == 6
+        assert vars_[VarName("width")] == 3
+        assert vars_[VarName("bad")] == -1
+
+    @covers(GoFeature.UNICODE_PACKAGE)
+    def test_capitalized_words_counted_by_rune(self):
+        vars_ = _run_go("""\
+package main
+import "unicode"
+func main() {
+    capitals := 0
+    for _, c := range "Élan Über élite" {
+        if unicode.IsUpper(c) {
+            capitals++
+        }
+    }
+}
+""")
+        assert vars_[VarName("capitals")] == 2
+
+
+class TestGoUnicodeIdentifierExecution:
+    @covers(GoFeature.UNICODE_IDENTIFIER)
+    def test_non_english_identifiers(self):
+        vars_ = _run_go("""\
+package main
+func größe(länge int, 幅 int) int {
+    return länge * 幅
+}
+func main() {
+    π2 := 6
+    変数 := größe(π2, 7)
+}
+""")
+        assert vars_[VarName("変数")] == 42
+
+    @covers(GoFeature.UNICODE_IDENTIFIER)
+    def test_decomposed_spelling_is_the_same_name(self):
+        # cafe\u0301 is café spelled with a combining accent (NFD); the
+        # identifier is normalized, the string literal is not.
+        vars_ = _run_go("""\
+package main
+func main() {
+    cafe\u0301 := 1
+    café++
+    n := len([]rune("cafe\u0301"))
+}
+""")
+        assert vars_[VarName("café")] == 2
+        assert vars_[VarName("n")] == 5
@@ -0,0 +1,119 @@
+"""Integration tests for Go variadic functions.
+
+Verifies ...T parameters, packing of trailing call arguments, empty
+variadic calls, and xs... spreading through the full parse → lower →
+execute pipeline.
+"""
+
+from __future__ import annotations
+
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.var_name import VarName
+from tests.covers import covers
+from tests.integration.exec_helpers import run_locals
+
+
+def _run_go(source: str, max_steps: int = 2000) -> dict:
+    return run_locals(source, Language.GO, max_steps)
+
+
+class TestGoVariadicParameterExecution:
+    @covers(GoFeature.VARIADIC)
+    def test_sum_of_packed_arguments(self):
+        source = """\
+package main
+func sum(nums ...int) int {
+    total := 0
+    for _, n := range nums {
+        total = total + n
+    }
+    return total
+}
+func main() {
+    s := sum(1, 2, 3, 4)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("s")] == 10
+
+    @covers(GoFeature.VARIADIC)
+    def test_no_variadic_arguments_gives_empty_slice(self):
+        source = """\
+package main
+func count(nums ...int) int {
+    return len(nums)
+}
+func main() {
+    none := count()
+    some := count(7, 8)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("none")] == 0
+        assert vars_[VarName("some")] == 2
+
+    @covers(GoFeature.VARIADIC)
+    def test_fixed_parameters_before_variadic(self):
+        source = """\
+package main
+func maxOf(first int, rest ...int) int {
+    best := first
+    for _, n := range rest {
+        if n > best {
+            best = n
+        }
+    }
+    return best
+}
+func main() {
+    m := maxOf(3, 9, 4)
+    only := maxOf(5)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("m")] == 9
+        assert vars_[VarName("only")] == 5
+
+
+class TestGoVariadicSpreadExecution:
+    @covers(GoFeature.VARIADIC)
+    def test_spread_slice_into_variadic(self):
+        source = """\
+package main
+func sum(nums ...int) int {
+    total := 0
+    for _, n := range nums {
+        total = total + n
+    }
+    return total
+}
+func main() {
+    xs := []int{5, 6, 7}
+    s := sum(xs...)
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("s")] == 18
+
+    @covers(GoFeature.VARIADIC)
+    def test_variadic_method(self):
+        source = """\
+package main
+type Acc struct {
+    total int
+}
+func (a *Acc) Add(nums ...int) {
+    for _, n := range nums {
+        a.total = a.total + n
+    }
+}
+func main() {
+    a := &Acc{}
+    a.Add(1, 2)
+    a.Add(3)
+    t := a.total
+}
+"""
+        vars_ = _run_go(source)
+        assert vars_[VarName("t")] == 6
@@ -0,0 +1,65 @@
+"""Integration tests for the printed three-address code.
+
+The frontends lower source to a linear TAC IR (``interpreter/instructions.py``)
+of temporaries, labels and jumps, and ``dump_ir`` prints it one instruction
+per line.  These tests read the printed IR back and check its shape.
+"""
+
+from __future__ import annotations
+
+import re
+
+from interpreter.api import dump_ir
+from interpreter.constants import Language
+from interpreter.frontends.python.features import PythonFeature
+from tests.covers import covers
+
+_LOOP = """\
+i = 0
+total = 0
+while i < 10:
+    total = total + i
+    i = i + 1
+"""
+
+_REGISTER = re.compile(r"%\d+")
+
+
+def _lines(source: str) -> list[str]:
+    """The printed instructions, without their source locations."""
+    return [
+        line.split("  #")[0].strip()
+        for line in dump_ir(source, Language.PYTHON).splitlines()
+    ]
+
+
+class TestTacIrDump:
+    @covers(PythonFeature.WHILE_LOOP)
+    def test_every_jump_targets_a_printed_label(self):
+        lines = _lines(_LOOP)
+        labels = {line[:-1] for line in lines if line.endswith(":")}
+        jumps = [line for line in lines if line.startswith(("branch ", "branch_if "))]
+
+        conditional = [line for line in jumps if line.startswith("branch_if ")]
+        assert len(conditional) == 1
+        assert any(line.startswith("branch ") for line in jumps)
+        for line in jumps:
+            targets = line.split()[-1].split(",")
+            assert set(targets) <= labels
+
+    @covers(PythonFeature.WHILE_LOOP)
+    def test_every_temporary_is_assigned_before_it_is_read(self):
+        assigned: set[str] = set()
+        for line in _lines(_LOOP):
+            target, _, rest = line.partition(" = ")
+            reads = rest if rest else line
+            assert set(_REGISTER.findall(reads)) <= assigned, line
+            if rest and _REGISTER.fullmatch(target):
+                assigned.add(target)
+
+    @covers(PythonFeature.WHILE_LOOP)
+    def test_operations_combine_temporaries(self):
+        lines = _lines(_LOOP)
+
+        assert any(re.fullmatch(r"%\d+ = binop < %\d+ %\d+", line) for line in lines)
+        assert any(re.fullmatch(r"%\d+ = binop \+ %\d+ %\d+", line) for line in lines)
@@ -2800,22 +2800,22 @@ fn main() {
 
 
 class TestGoTypeAliasIntegration:
-    """Integration: Go type alias declaration seeds and resolves aliases."""
+    """Integration: Go aliases and named types seed and resolve separately."""
 
     @covers(GoFeature.TYPE_ALIAS)
-    def test_go_type_alias_seeds_alias(self):
-        """Go: type UserId int; var x UserId = 42 → x is Int."""
+    def test_go_named_type_seeds_named_type(self):
+        """Go: type UserId int → UserId is a named type over Int, not an alias."""
         source = """\
 package main
 type UserId int
 var x UserId = 42
 """
         _, env = _lower_and_infer(source, "go")
-        assert TypeName("UserId") in env.type_aliases
-        assert env.type_aliases[TypeName("UserId")] == scalar(TypeName("Int"))
+        assert env.named_types[TypeName("UserId")] == scalar(TypeName("Int"))
+        assert TypeName("UserId") not in env.type_aliases
 
     @covers(GoFeature.TYPE_ALIAS)
-    def test_go_type_alias_resolves_var_type(self):
+    def test_go_named_type_resolves_var_type(self):
         """Go: type Score float64; var s Score = 9.5 → var type is Float."""
         source = """\
 package main
@@ -2823,8 +2823,20 @@ type Score float64
 var s Score = 9.5
 """
         _, env = _lower_and_infer(source, "go")
-        assert TypeName("Score") in env.type_aliases
-        assert env.type_aliases[TypeName("Score")] == scalar(TypeName("Float"))
+        assert env.var_types[VarName("s")] == scalar(TypeName("Float"))
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_go_type_alias_seeds_alias(self):
+        """Go: type Celsius = float64 → Celsius alias is Float."""
+        source = """\
+package main
+type Celsius = float64
+var c Celsius = 21.5
+"""
+        _, env = _lower_and_infer(source, "go")
+        assert env.type_aliases[TypeName("Celsius")] == scalar(TypeName("Float"))
+        assert TypeName("Celsius") not in env.named_types
+        assert env.var_types[VarName("c")] == scalar(TypeName("Float"))
 
 
 # ---------------------------------------------------------------------------
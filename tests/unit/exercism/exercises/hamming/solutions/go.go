@@ -1,9 +1,9 @@
 package main
 
-func hammingDistance(s1 string, s2 string, n int) int {
+func hammingDistance(s1 string, s2 string) int {
     distance := 0
     i := 0
-    for i < n {
+    for i < len(s1) {
         if s1[i] != s2[i] {
             distance = distance + 1
         }
@@ -13,6 +13,6 @@ func hammingDistance(s1 string, s2 string, n int) int {
 }
 
 func main() {
-    answer := hammingDistance("GAGCCTACTAACGGGAT", "CATCGTAATGACGGCCT", 17)
+    answer := hammingDistance("GAGCCTACTAACGGGAT", "CATCGTAATGACGGCCT")
     _ = answer
 }
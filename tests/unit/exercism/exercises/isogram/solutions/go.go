@@ -1,44 +1,21 @@
 package main
 
-func toLowerChar(c string) string {
-    if c == "A" { return "a" }
-    if c == "B" { return "b" }
-    if c == "C" { return "c" }
-    if c == "D" { return "d" }
-    if c == "E" { return "e" }
-    if c == "F" { return "f" }
-    if c == "G" { return "g" }
-    if c == "H" { return "h" }
-    if c == "I" { return "i" }
-    if c == "J" { return "j" }
-    if c == "K" { return "k" }
-    if c == "L" { return "l" }
-    if c == "M" { return "m" }
-    if c == "N" { return "n" }
-    if c == "O" { return "o" }
-    if c == "P" { return "p" }
-    if c == "Q" { return "q" }
-    if c == "R" { return "r" }
-    if c == "S" { return "s" }
-    if c == "T" { return "t" }
-    if c == "U" { return "u" }
-    if c == "V" { return "v" }
-    if c == "W" { return "w" }
-    if c == "X" { return "x" }
-    if c == "Y" { return "y" }
-    if c == "Z" { return "z" }
+func toLowerChar(c byte) byte {
+    if c >= 'A' {
+        if c <= 'Z' { return c + 32 }
+    }
     return c
 }
 
 func isIsogram(word string, n int) int {
     i := 0
     for i < n {
-        if word[i] == " " { i = i + 1; continue }
-        if word[i] == "-" { i = i + 1; continue }
+        if word[i] == ' ' { i = i + 1; continue }
+        if word[i] == '-' { i = i + 1; continue }
         j := i + 1
         for j < n {
-            if word[j] == " " { j = j + 1; continue }
-            if word[j] == "-" { j = j + 1; continue }
+            if word[j] == ' ' { j = j + 1; continue }
+            if word[j] == '-' { j = j + 1; continue }
             if toLowerChar(word[i]) == toLowerChar(word[j]) {
                 return 0
             }
@@ -1,16 +1,9 @@
 package main
 
-func charToDigit(c string) int {
-    if c == "0" { return 0 }
-    if c == "1" { return 1 }
-    if c == "2" { return 2 }
-    if c == "3" { return 3 }
-    if c == "4" { return 4 }
-    if c == "5" { return 5 }
-    if c == "6" { return 6 }
-    if c == "7" { return 7 }
-    if c == "8" { return 8 }
-    if c == "9" { return 9 }
+func charToDigit(c byte) int {
+    if c >= '0' {
+        if c <= '9' { return int(c - '0') }
+    }
     return -1
 }
 
@@ -19,7 +12,7 @@ func isValid(number string, n int) int {
     i := 0
     for i < n {
         c := number[i]
-        if c == " " { i = i + 1; continue }
+        if c == ' ' { i = i + 1; continue }
         d := charToDigit(c)
         if d == -1 { return 0 }
         digitCount = digitCount + 1
@@ -31,7 +24,7 @@ func isValid(number string, n int) int {
     i = n - 1
     for i >= 0 {
         c := number[i]
-        if c == " " { i = i - 1; continue }
+        if c == ' ' { i = i - 1; continue }
         d := charToDigit(c)
         if count % 2 == 1 {
             d = d * 2
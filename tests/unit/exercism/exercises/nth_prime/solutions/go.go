@@ -2,22 +2,22 @@ package main
 
 func nthPrime(n int) int {
     count := 0
-    candidate := 2
+    candidate := 1
     for count < n {
+        candidate = candidate + 1
         isPrime := 1
         divisor := 2
         for divisor * divisor <= candidate {
             if candidate % divisor == 0 {
                 isPrime = 0
+                break
             }
             divisor = divisor + 1
         }
-        if isPrime == 1 {
-            count = count + 1
-        }
-        if count < n {
-            candidate = candidate + 1
+        if isPrime == 0 {
+            continue
         }
+        count = count + 1
     }
     return candidate
 }
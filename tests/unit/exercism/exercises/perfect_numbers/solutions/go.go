@@ -9,13 +9,14 @@ func classify(n int) string {
         }
         i = i + 1
     }
-    if total == n {
+    switch {
+    case total == n:
         return "perfect"
-    }
-    if total > n {
+    case total > n:
         return "abundant"
+    default:
+        return "deficient"
     }
-    return "deficient"
 }
 
 func main() {
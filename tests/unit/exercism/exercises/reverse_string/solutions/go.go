@@ -4,7 +4,7 @@ func reverseString(s string, n int) string {
     result := ""
     i := n - 1
     for i >= 0 {
-        result = result + s[i]
+        result = result + s[i:i+1]
         i = i - 1
     }
     return result
@@ -1,19 +1,17 @@
 package main
 
-func toRna(dna string, n int) string {
+func toRna(dna string) string {
     result := ""
     i := 0
-    for i < n {
-        if dna[i] == "G" {
+    for i < len(dna) {
+        switch dna[i] {
+        case 'G':
             result = result + "C"
-        }
-        if dna[i] == "C" {
+        case 'C':
             result = result + "G"
-        }
-        if dna[i] == "T" {
+        case 'T':
             result = result + "A"
-        }
-        if dna[i] == "A" {
+        case 'A':
             result = result + "U"
         }
         i = i + 1
@@ -22,6 +20,6 @@ func toRna(dna string, n int) string {
 }
 
 func main() {
-    answer := toRna("ACGTGGTCTTAA", 12)
+    answer := toRna("ACGTGGTCTTAA")
     _ = answer
 }
@@ -23,7 +23,7 @@ func spaceAge(planet string, seconds int) float64 {
     if planet == "Neptune" {
         ratio = 164.79132
     }
-    return seconds / (31557600.0 * ratio)
+    return float64(seconds) / (31557600.0 * ratio)
 }
 
 func main() {
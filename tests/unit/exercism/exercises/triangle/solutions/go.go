@@ -1,92 +1,19 @@
 package main
 
-func isEquilateral(a int, b int, c int) int {
-    if a <= 0 {
-        return 0
-    }
-    if b <= 0 {
-        return 0
-    }
-    if c <= 0 {
-        return 0
-    }
-    if a + b <= c {
-        return 0
-    }
-    if b + c <= a {
-        return 0
-    }
-    if a + c <= b {
-        return 0
-    }
-    if a == b {
-        if b == c {
-            return 1
-        }
-    }
-    return 0
+func isTriangle(a int, b int, c int) bool {
+    return a > 0 && b > 0 && c > 0 && a+b > c && b+c > a && a+c > b
 }
 
-func isIsosceles(a int, b int, c int) int {
-    if a <= 0 {
-        return 0
-    }
-    if b <= 0 {
-        return 0
-    }
-    if c <= 0 {
-        return 0
-    }
-    if a + b <= c {
-        return 0
-    }
-    if b + c <= a {
-        return 0
-    }
-    if a + c <= b {
-        return 0
-    }
-    if a == b {
-        return 1
-    }
-    if b == c {
-        return 1
-    }
-    if a == c {
-        return 1
-    }
-    return 0
+func isEquilateral(a int, b int, c int) bool {
+    return isTriangle(a, b, c) && a == b && b == c
 }
 
-func isScalene(a int, b int, c int) int {
-    if a <= 0 {
-        return 0
-    }
-    if b <= 0 {
-        return 0
-    }
-    if c <= 0 {
-        return 0
-    }
-    if a + b <= c {
-        return 0
-    }
-    if b + c <= a {
-        return 0
-    }
-    if a + c <= b {
-        return 0
-    }
-    if a == b {
-        return 0
-    }
-    if b == c {
-        return 0
-    }
-    if a == c {
-        return 0
-    }
-    return 1
+func isIsosceles(a int, b int, c int) bool {
+    return isTriangle(a, b, c) && (a == b || b == c || a == c)
+}
+
+func isScalene(a int, b int, c int) bool {
+    return isTriangle(a, b, c) && a != b && b != c && a != c
 }
 
 func main() {
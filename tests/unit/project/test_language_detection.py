@@ -0,0 +1,117 @@
+"""Tests for source-language detection by extension, shebang and content."""
+
+from pathlib import Path
+
+import pytest
+
+from interpreter.constants import Language
+from interpreter.project.language_detection import (
+    LanguageDetectionError,
+    detect_directory_languages,
+    detect_language,
+)
+from tests.covers import NotLanguageFeature, covers
+
+_LEAP_SOLUTIONS = (
+    Path(__file__).parent.parent / "exercism" / "exercises" / "leap" / "solutions"
+)
+
+
+class TestDetectLanguage:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize(
+        "name, language",
+        [
+            ("main.py", Language.PYTHON),
+            ("app.MJS", Language.JAVASCRIPT),
+            ("view.tsx", Language.TYPESCRIPT),
+            ("Main.java", Language.JAVA),
+            ("main.go", Language.GO),
+            ("lib.rs", Language.RUST),
+            ("prog.cc", Language.CPP),
+            ("unit.pas", Language.PASCAL),
+            ("PAYROLL.cbl", Language.COBOL),
+        ],
+    )
+    def test_by_extension(self, tmp_path, name, language):
+        path = tmp_path / name
+        path.write_text("")
+        assert detect_language(path) == language
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_header_defaults_to_c(self, tmp_path):
+        path = tmp_path / "util.h"
+        path.write_text("int add(int a, int b);\n")
+        assert detect_language(path) == Language.C
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_header_with_cpp_markers_is_cpp(self, tmp_path):
+        path = tmp_path / "shape.h"
+        path.write_text("#include <vector>\nclass Shape {\n};\n")
+        assert detect_language(path) == Language.CPP
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize(
+        "first_line, language",
+        [
+            ("#!/usr/bin/env python3", Language.PYTHON),
+            ("#!/usr/bin/ruby", Language.RUBY),
+            ("#!/usr/bin/env node", Language.JAVASCRIPT),
+            ("#!/usr/local/bin/lua5.4", Language.LUA),
+        ],
+    )
+    def test_extensionless_file_by_shebang(self, tmp_path, first_line, language):
+        path = tmp_path / "script"
+        path.write_text(f"{first_line}\nx = 1\n")
+        assert detect_language(path) == language
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize(
+        "source, language",
+        [
+            ("<?php\necho 1;\n", Language.PHP),
+            ("package main\n\nfunc main() {}\n", Language.GO),
+            ("       IDENTIFICATION DIVISION.\n", Language.COBOL),
+            ("program Hello;\nbegin\nend.\n", Language.PASCAL),
+        ],
+    )
+    def test_extensionless_file_by_content(self, tmp_path, source, language):
+        path = tmp_path / "source"
+        path.write_text(source)
+        assert detect_language(path) == language
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_unrecognized_file_raises(self, tmp_path):
+        path = tmp_path / "notes.txt"
+        path.write_text("just some prose\n")
+        with pytest.raises(LanguageDetectionError, match="notes.txt"):
+            detect_language(path)
+
+
+class TestDetectDirectoryLanguages:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_every_language_in_exercism_solutions(self):
+        languages = detect_directory_languages(_LEAP_SOLUTIONS)
+        assert set(languages) == {
+            Language(path.stem) for path in _LEAP_SOLUTIONS.iterdir()
+        }
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_languages_in_enum_order(self, tmp_path):
+        (tmp_path / "b.rb").write_text("")
+        (tmp_path / "a.go").write_text("")
+        (tmp_path / "c.py").write_text("")
+        assert detect_directory_languages(tmp_path) == (
+            Language.PYTHON,
+            Language.RUBY,
+            Language.GO,
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_ignores_non_source_files(self, tmp_path):
+        (tmp_path / "README.md").write_text("# docs\n")
+        (tmp_path / "Makefile").write_text("all:\n")
+        sub = tmp_path / "src"
+        sub.mkdir()
+        (sub / "main.rs").write_text("fn main() {}\n")
+        assert detect_directory_languages(tmp_path) == (Language.RUST,)
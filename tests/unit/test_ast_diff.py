@@ -0,0 +1,97 @@
+"""Tests for the syntax-tree diff."""
+
+from __future__ import annotations
+
+import tree_sitter_language_pack as tslp
+
+from interpreter.api import diff_ast
+from interpreter.ast_diff import diff_trees
+from interpreter.ast_diff_types import EditKind, TreeEdit
+from interpreter.cst import build_cst
+from interpreter.cst_types import CstNode
+from interpreter.ir import NO_SOURCE_LOCATION, SourceLocation
+from tests.covers import NotLanguageFeature, covers
+
+
+def _cst(source: bytes, language: str = "python") -> CstNode:
+    tree = tslp.get_parser(language).parse(source)
+    return build_cst(tree.root_node, source)
+
+
+def _diff(old: bytes, new: bytes) -> tuple[TreeEdit, ...]:
+    return diff_trees(_cst(old), _cst(new))
+
+
+class TestDiffTrees:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_layout_and_comments_are_no_change(self):
+        old = b"def f(x):\n    return x + 1\n"
+        new = b"def f( x ):  # add one\n\n    return x+1\n"
+        assert _diff(old, new) == ()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_inserted_statement(self):
+        edits = _diff(b"x = 1\nprint(x)\n", b"x = 1\nx = x + 1\nprint(x)\n")
+        assert [(e.kind, e.node_kind, e.new_text) for e in edits] == [
+            (EditKind.INSERT, "expression_statement", "x = x + 1")
+        ]
+        assert edits[0].new_location.start_line == 2
+        assert edits[0].old_location == NO_SOURCE_LOCATION
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_deleted_statement(self):
+        edits = _diff(b"x = 1\nx = x + 1\nprint(x)\n", b"x = 1\nprint(x)\n")
+        assert [(e.kind, e.node_kind, e.old_text) for e in edits] == [
+            (EditKind.DELETE, "expression_statement", "x = x + 1")
+        ]
+        assert edits[0].old_location.start_line == 2
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_renamed_variable_is_updates(self):
+        edits = _diff(b"x = 1\nprint(x)\n", b"y = 1\nprint(y)\n")
+        assert [(e.kind, e.old_text, e.new_text) for e in edits] == [
+            (EditKind.UPDATE, "x", "y"),
+            (EditKind.UPDATE, "x", "y"),
+        ]
+        assert [e.new_location.start_line for e in edits] == [1, 2]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_swapped_functions_is_one_move(self):
+        f = b"def f():\n    return 1\n"
+        g = b"def g():\n    return 2\n"
+        edits = _diff(f + b"\n\n" + g, g + b"\n\n" + f)
+        assert [(e.kind, e.node_kind) for e in edits] == [
+            (EditKind.MOVE, "function_definition")
+        ]
+        assert edits[0].new_text.startswith("def f")
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_subtree_moved_into_a_block(self):
+        old = b"def f():\n    pass\n\nif c:\n    g()\n"
+        new = b"if c:\n    g()\n    def f():\n        pass\n"
+        edits = _diff(old, new)
+        assert [(e.kind, e.node_kind) for e in edits] == [
+            (EditKind.MOVE, "function_definition")
+        ]
+        assert edits[0].old_location.start_line == 1
+        assert edits[0].new_location.start_line == 3
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_api(self):
+        edits = diff_ast("x = 1\n", "x = 2\n", "python")
+        assert [(e.kind, e.node_kind, e.old_text, e.new_text) for e in edits] == [
+            (EditKind.UPDATE, "integer", "1", "2")
+        ]
+
+
+class TestTreeEdit:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_str(self):
+        old = SourceLocation(start_line=1, start_col=0, end_line=1, end_col=1)
+        new = SourceLocation(start_line=3, start_col=4, end_line=3, end_col=9)
+        update = TreeEdit(EditKind.UPDATE, "identifier", old, new, "x", "count")
+        insert = TreeEdit(
+            EditKind.INSERT, "block", NO_SOURCE_LOCATION, new, "", "x = 1\ny = 2"
+        )
+        assert str(update) == "update identifier 1:0-1:1 -> 3:4-3:9: x -> count"
+        assert str(insert) == "insert block at 3:4-3:9: x = 1"
@@ -8,11 +8,20 @@ from interpreter.func_name import FuncName
 from interpreter.types.typed_value import TypedValue, typed_from_runtime
 from interpreter.vm.builtins import (
     Builtins,
+    _builtin_array_of,
+    _builtin_go_append,
+    _builtin_go_cap,
+    _builtin_go_range_value,
+    _builtin_go_slice,
+    _builtin_go_slice_in_bounds,
+    _builtin_go_string,
+    _builtin_go_string_of_bytes,
+    _builtin_go_string_of_runes,
     _builtin_object_rest,
     _builtin_print,
     _builtin_slice,
 )
-from interpreter.vm.vm import Operators, apply_update
+from interpreter.vm.vm import Operators, _heap_addr, _heap_slot, apply_update
 from interpreter.vm.vm_types import (
     BuiltinResult,
     HeapObject,
@@ -257,6 +266,125 @@ class TestBuiltinSlice:
         assert result.value == "llo"
 
 
+def _go_call(builtin, vm: VMState, *args) -> TypedValue:
+    """Run a Go builtin on raw or typed *args*, apply its effects, return its value."""
+    result = builtin(
+        [a if isinstance(a, TypedValue) else typed_from_runtime(a) for a in args], vm
+    )
+    _apply_builtin_result(vm, result)
+    return result.value
+
+
+def _go_element(vm: VMState, value: TypedValue, index: int):
+    """Element *index* of a Go slice or array, read through its backing array."""
+    addr, slot = _heap_slot(
+        vm, _heap_addr(value.value), FieldName(str(index), FieldKind.INDEX)
+    )
+    return vm.heap_get(addr).fields[slot].value
+
+
+class TestBuiltinGoSlice:
+    def _vm(self) -> VMState:
+        vm = VMState()
+        vm.call_stack.append(StackFrame(function_name=FuncName("test")))
+        return vm
+
+    def test_slice_is_a_view_of_the_backing_array(self):
+        vm = self._vm()
+        array = _go_call(_builtin_array_of, vm, 1, 2, 3)
+        head = _go_call(_builtin_go_slice, vm, array, 0, 2)
+
+        addr, slot = _heap_slot(
+            vm, _heap_addr(head.value), FieldName("0", FieldKind.INDEX)
+        )
+        vm.heap_get(addr).fields[slot] = typed_from_runtime(9)
+
+        assert _go_element(vm, array, 0) == 9
+        assert _go_call(_builtin_go_cap, vm, head) == 3
+
+    def test_reslicing_offsets_into_the_same_array(self):
+        vm = self._vm()
+        array = _go_call(_builtin_array_of, vm, 10, 20, 30, 40)
+        middle = _go_call(_builtin_go_slice, vm, array, 1, 3)
+        tail = _go_call(_builtin_go_slice, vm, middle, 1, None)
+
+        assert _go_element(vm, tail, 0) == 30
+        assert _go_call(_builtin_go_cap, vm, tail) == 2
+
+    def test_bounds_are_checked_against_capacity(self):
+        vm = self._vm()
+        array = _go_call(_builtin_array_of, vm, 1, 2, 3)
+        head = _go_call(_builtin_go_slice, vm, array, 0, 1)
+
+        assert _go_call(_builtin_go_slice_in_bounds, vm, head, 0, 3) is True
+        assert _go_call(_builtin_go_slice_in_bounds, vm, head, 0, 4) is False
+        assert _go_call(_builtin_go_slice_in_bounds, vm, head, 0, 2, 1) is False
+
+    def test_append_within_capacity_writes_the_backing_array(self):
+        vm = self._vm()
+        array = _go_call(_builtin_array_of, vm, 1, 2, 3)
+        head = _go_call(_builtin_go_slice, vm, array, 0, 1)
+        grown = _go_call(_builtin_go_append, vm, head, 7)
+
+        assert _go_element(vm, array, 1) == 7
+        assert _go_element(vm, grown, 1) == 7
+
+    def test_append_past_capacity_copies(self):
+        vm = self._vm()
+        array = _go_call(_builtin_array_of, vm, 1, 2)
+        grown = _go_call(_builtin_go_append, vm, array, 3)
+
+        assert _go_call(_builtin_go_cap, vm, grown) == 4
+        assert _go_element(vm, grown, 2) == 3
+        original = vm.heap_get(_heap_addr(array.value))
+        assert FieldName("2", FieldKind.INDEX) not in original.fields
+
+
+class TestBuiltinGoRangeValue:
+    def test_string_yields_the_rune_at_each_byte_offset(self):
+        vm = VMState()
+        vm.call_stack.append(StackFrame(function_name=FuncName("test")))
+        text = "aé€😀"
+
+        runes = [
+            _go_call(_builtin_go_range_value, vm, text, offset)
+            for offset in (0, 1, 3, 6)
+        ]
+
+        assert runes == [ord("a"), ord("é"), ord("€"), ord("😀")]
+
+
+class TestBuiltinGoString:
+    def _vm(self) -> VMState:
+        vm = VMState()
+        vm.call_stack.append(StackFrame(function_name=FuncName("test")))
+        return vm
+
+    def test_runes_are_code_points_even_when_they_look_like_utf8(self):
+        vm = self._vm()
+        runes = _go_call(_builtin_array_of, vm, 0xC3, 0xA9)
+
+        assert _go_call(_builtin_go_string_of_runes, vm, runes) == "Ã©"
+
+    def test_invalid_bytes_decode_to_the_replacement_character(self):
+        vm = self._vm()
+        data = _go_call(_builtin_array_of, vm, 0x68, 0xFF, 0xC3, 0xA9)
+
+        assert _go_call(_builtin_go_string_of_bytes, vm, data) == "h\ufffdé"
+
+    def test_nil_slice_is_the_empty_string(self):
+        vm = self._vm()
+
+        assert _go_call(_builtin_go_string_of_bytes, vm, None) == ""
+        assert _go_call(_builtin_go_string_of_runes, vm, None) == ""
+
+    def test_integer_is_a_code_point(self):
+        vm = self._vm()
+
+        assert _go_call(_builtin_go_string, vm, 0xE9) == "é"
+        assert _go_call(_builtin_go_string, vm, 0xD800) == "\ufffd"
+
+
 class TestBuiltinObjectRest:
     def test_object_rest_excludes_keys(self):
         vm = VMState()
@@ -0,0 +1,62 @@
+"""Tests for the VM call-depth limit."""
+
+from __future__ import annotations
+
+import pytest
+
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.ir import SourceLocation
+from interpreter.run import run
+from interpreter.types.typed_value import unwrap_locals
+from interpreter.var_name import VarName
+from interpreter.vm.call_depth import CallDepthExceededError, check_call_depth
+from tests.covers import NotLanguageFeature, covers
+
+_LOCATION = SourceLocation(start_line=2, start_col=11, end_line=2, end_col=19)
+
+RUNAWAY = """\
+def f(n):
+    return f(n + 1)
+
+f(0)
+"""
+
+COUNTDOWN = """\
+def down(n):
+    if n == 0:
+        return 0
+    return down(n - 1) + 1
+
+answer = down(20)
+"""
+
+
+class TestCheckCallDepth:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_within_limit_passes(self):
+        check_call_depth(50, 50, "f", _LOCATION)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_beyond_limit_raises_with_location(self):
+        with pytest.raises(CallDepthExceededError) as exc_info:
+            check_call_depth(51, 50, "f", _LOCATION)
+        err = exc_info.value
+        assert err.function_name == "f"
+        assert err.max_depth == 50
+        assert err.location == _LOCATION
+        assert "call depth limit exceeded" in str(err)
+
+
+class TestCallDepthExecution:
+    @covers(PythonFeature.FUNCTION_CALL)
+    def test_runaway_recursion_stops_at_the_call_site(self):
+        with pytest.raises(CallDepthExceededError) as exc_info:
+            run(RUNAWAY, max_steps=100_000, max_call_depth=50)
+        err = exc_info.value
+        assert err.max_depth == 50
+        assert err.location.start_line == 2
+
+    @covers(PythonFeature.FUNCTION_CALL)
+    def test_recursion_within_the_limit_runs(self):
+        vm = run(COUNTDOWN, max_steps=10_000, max_call_depth=50)
+        assert unwrap_locals(vm.call_stack[0].local_vars)[VarName("answer")] == 20
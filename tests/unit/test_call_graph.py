@@ -1,18 +1,34 @@
 """Unit tests for call graph construction — TDD: written BEFORE implementation."""
 
+from interpreter.cfg import build_cfg
 from interpreter.cfg_types import CFG, BasicBlock
 from interpreter.func_name import FuncName
+from interpreter.instructions import (
+    Branch,
+    CallFunction,
+    CallUnknown,
+    Const,
+    DeclVar,
+    Label_,
+    LoadVar,
+    Return_,
+    Symbolic,
+)
 from interpreter.interprocedural.call_graph import (
     build_call_graph,
     build_function_entries,
+    call_graph_to_mermaid,
 )
 from interpreter.interprocedural.types import (
+    ROOT_FUNCTION,
     FunctionEntry,
     InstructionLocation,
 )
 from interpreter.ir import CodeLabel, IRInstruction, Opcode
 from interpreter.register import Register
 from interpreter.registry import FunctionRegistry
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
 
 
 def _make_cfg(blocks: dict[str, BasicBlock], entry: str = "entry") -> CFG:
@@ -278,3 +294,143 @@ class TestBuildCallGraphNonExistent:
         site = next(iter(cg.call_sites))
         assert site.callees == frozenset()
         assert site.arg_operands == ("%1",)
+
+
+def _higher_order_program() -> tuple[CFG, FunctionRegistry]:
+    """apply(fn, x) calls fn(x) and make() returns double; the top level
+    calls apply(double, 3), then make()(4).  unused is never called."""
+    r = [Register(f"%{n}") for n in range(20)]
+    fn, x, n, double = VarName("fn"), VarName("x"), VarName("n"), VarName("double")
+    ir = [
+        Branch(label=CodeLabel("end_apply_1")),
+        Label_(label=CodeLabel("func_apply_0")),
+        Symbolic(result_reg=r[0], hint="param:fn"),
+        DeclVar(name=fn, value_reg=r[0]),
+        Symbolic(result_reg=r[1], hint="param:x"),
+        DeclVar(name=x, value_reg=r[1]),
+        LoadVar(result_reg=r[2], name=x),
+        CallFunction(result_reg=r[3], func_name=FuncName("fn"), args=(r[2],)),
+        Return_(value_reg=r[3]),
+        Label_(label=CodeLabel("end_apply_1")),
+        Branch(label=CodeLabel("end_double_3")),
+        Label_(label=CodeLabel("func_double_2")),
+        Symbolic(result_reg=r[4], hint="param:n"),
+        DeclVar(name=n, value_reg=r[4]),
+        LoadVar(result_reg=r[5], name=n),
+        Return_(value_reg=r[5]),
+        Label_(label=CodeLabel("end_double_3")),
+        Branch(label=CodeLabel("end_make_5")),
+        Label_(label=CodeLabel("func_make_4")),
+        Const.func_ref(result_reg=r[6], value="func_double_2"),
+        Return_(value_reg=r[6]),
+        Label_(label=CodeLabel("end_make_5")),
+        Branch(label=CodeLabel("end_unused_7")),
+        Label_(label=CodeLabel("func_unused_6")),
+        Return_(),
+        Label_(label=CodeLabel("end_unused_7")),
+        Const.func_ref(result_reg=r[10], value="func_double_2"),
+        DeclVar(name=double, value_reg=r[10]),
+        LoadVar(result_reg=r[11], name=double),
+        Const.int_(r[12], 3),
+        CallFunction(
+            result_reg=r[13], func_name=FuncName("func_apply_0"), args=(r[11], r[12])
+        ),
+        CallFunction(result_reg=r[14], func_name=FuncName("func_make_4")),
+        Const.int_(r[15], 4),
+        CallUnknown(result_reg=r[16], target_reg=r[14], args=(r[15],)),
+    ]
+    registry = _make_registry(
+        func_params={
+            "func_apply_0": ["fn", "x"],
+            "func_double_2": ["n"],
+            "func_make_4": [],
+            "func_unused_6": [],
+        }
+    )
+    return build_cfg(ir), registry
+
+
+class TestBuildCallGraphFunctionValues:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_parameter_holding_a_function_is_called(self):
+        cfg, registry = _higher_order_program()
+
+        cg = build_call_graph(cfg, registry)
+
+        (apply,) = (f for f in cg.functions if f.label == "func_apply_0")
+        assert {c.label for c in cg.callees_of(apply)} == {"func_double_2"}
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_returned_function_is_called_from_the_top_level(self):
+        cfg, registry = _higher_order_program()
+
+        cg = build_call_graph(cfg, registry)
+
+        assert {c.label for c in cg.callees_of(ROOT_FUNCTION)} == {
+            "func_apply_0",
+            "func_make_4",
+            "func_double_2",
+        }
+        (unknown,) = (
+            site
+            for site in cg.call_sites
+            if isinstance(site.instruction(cfg), CallUnknown)
+        )
+        assert unknown.caller == ROOT_FUNCTION
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_uncalled_function_is_not_reachable(self):
+        cfg, registry = _higher_order_program()
+
+        cg = build_call_graph(cfg, registry)
+
+        dead = cg.functions - cg.reachable_from()
+        assert {f.label for f in dead} == {"func_unused_6"}
+
+
+class TestCallGraphToMermaid:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_nodes_and_edges(self):
+        cfg, registry = _higher_order_program()
+
+        mermaid = call_graph_to_mermaid(build_call_graph(cfg, registry))
+
+        lines = mermaid.splitlines()
+        assert lines[0] == "flowchart LR"
+        assert '    __root__["top level"]' in lines
+        assert '    func_unused_6["func_unused_6"]' in lines
+        assert "    __root__ --> func_apply_0" in lines
+        assert "    func_apply_0 --> func_double_2" in lines
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_call_with_several_callees_is_dashed(self):
+        call_inst = IRInstruction(
+            opcode=Opcode.CALL_METHOD,
+            operands=["%0", "speak"],
+            result_reg=Register("%1"),
+        )
+        cfg = _make_cfg(
+            blocks={
+                "func_main": BasicBlock(
+                    label=CodeLabel("func_main"), instructions=[call_inst]
+                ),
+                "func_Dog_speak": BasicBlock(
+                    label=CodeLabel("func_Dog_speak"), instructions=[]
+                ),
+                "func_Cat_speak": BasicBlock(
+                    label=CodeLabel("func_Cat_speak"), instructions=[]
+                ),
+            }
+        )
+        registry = _make_registry(
+            func_params={"func_main": [], "func_Dog_speak": [], "func_Cat_speak": []},
+            class_methods={
+                "Dog": {FuncName("speak"): ["func_Dog_speak"]},
+                "Cat": {FuncName("speak"): ["func_Cat_speak"]},
+            },
+        )
+
+        mermaid = call_graph_to_mermaid(build_call_graph(cfg, registry))
+
+        assert "    func_main -.-> func_Cat_speak" in mermaid.splitlines()
+        assert "__root__" not in mermaid
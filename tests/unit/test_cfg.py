@@ -5,10 +5,19 @@ import pytest
 from interpreter.cfg import (
     _collapse_inst_lines,
     build_cfg,
+    build_function_cfgs,
     cfg_to_mermaid,
     extract_function_instructions,
 )
-from interpreter.instructions import Label_
+from interpreter.cfg_types import Edge
+from interpreter.instructions import (
+    Branch,
+    BranchIf,
+    Const,
+    Label_,
+    Return_,
+    Symbolic,
+)
 from interpreter.ir import CodeLabel, Opcode
 from interpreter.register import Register
 from tests.unit.cfg_helpers import make_instructions as _make_instructions
@@ -552,3 +561,46 @@ class TestHaltTerminatesBlock:
 
         entry_block = cfg.blocks[CodeLabel("entry")]
         assert entry_block.successors == []
+
+
+class TestFunctionCfgs:
+    def _program(self):
+        """foo branches on its parameter to one of two returns; the top level
+        branches around foo's body and refers to it."""
+        foo, end = CodeLabel("func_foo_0"), CodeLabel("end_foo_1")
+        then, orelse = CodeLabel("then_2"), CodeLabel("else_3")
+        return [
+            Label_(label=CodeLabel("entry")),
+            Const.int_(Register("%0"), 1),
+            Branch(label=end),
+            Label_(label=foo),
+            Symbolic(result_reg=Register("%1"), hint="param:x"),
+            BranchIf(cond_reg=Register("%1"), branch_targets=(then, orelse)),
+            Label_(label=then),
+            Return_(value_reg=Register("%1")),
+            Label_(label=orelse),
+            Return_(value_reg=Register("%0")),
+            Label_(label=end),
+            Const.func_ref(Register("%2"), str(foo)),
+            Return_(value_reg=Register("%0")),
+        ]
+
+    def test_edges_and_exits_of_the_whole_program(self):
+        cfg = build_cfg(self._program())
+
+        assert Edge(CodeLabel("entry"), CodeLabel("end_foo_1")) in cfg.edges()
+        assert Edge(CodeLabel("func_foo_0"), CodeLabel("else_3")) in cfg.edges()
+        assert cfg.exits() == ["then_2", "else_3", "end_foo_1"]
+
+    def test_one_cfg_per_function_keyed_by_entry_label(self):
+        cfgs = build_function_cfgs(self._program())
+
+        assert set(cfgs) == {"", "func_foo_0"}
+        top, foo = cfgs[""], cfgs["func_foo_0"]
+        assert top.entry == "entry"
+        assert list(top.blocks) == ["entry", "end_foo_1"]
+        assert top.edges() == [Edge(CodeLabel("entry"), CodeLabel("end_foo_1"))]
+        assert foo.entry == "func_foo_0"
+        assert list(foo.blocks) == ["func_foo_0", "then_2", "else_3"]
+        assert foo.exits() == ["then_2", "else_3"]
+        assert foo.blocks[CodeLabel("func_foo_0")].predecessors == []
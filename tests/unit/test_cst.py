@@ -0,0 +1,97 @@
+"""Tests for the lossless concrete syntax tree."""
+
+from __future__ import annotations
+
+import pytest
+import tree_sitter_language_pack as tslp
+
+from interpreter.api import parse_cst
+from interpreter.constants import Language
+from interpreter.cst import build_cst
+from interpreter.cst_types import CstToken
+from tests.covers import NotLanguageFeature, covers
+
+
+def _cst(source: bytes, language: str = "python"):
+    tree = tslp.get_parser(language).parse(source)
+    return build_cst(tree.root_node, source)
+
+
+class TestRoundTrip:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize(
+        "language, source",
+        [
+            ("python", b"# header\n\ndef f(x):  # trailing\n    return x+1\n\n\n"),
+            ("go", b"package main\n\n// doc\nfunc main() {\n\tx := 1 /* c */\n}\n"),
+            ("javascript", b"  let a = [1,\n  2]; // end"),
+            ("c", b"int main(void)\r\n{\r\n    return 0;\r\n}\r\n"),
+            ("ruby", b"=begin\nblock\n=end\nputs 'hi'\n"),
+        ],
+    )
+    def test_reproduces_source_byte_for_byte(self, language, source):
+        assert _cst(source, language).to_bytes() == source
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_malformed_source(self):
+        source = b"def f(:\n    return 1\nx = = 2\n"
+        assert _cst(source).to_bytes() == source
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_empty_and_comment_only_source(self):
+        assert _cst(b"").to_bytes() == b""
+        assert _cst(b"# only a comment\n").to_bytes() == b"# only a comment\n"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_non_utf8_bytes(self):
+        source = b"x = '\xff\xfe'\n"
+        assert _cst(source).to_bytes() == source
+
+
+class TestTrivia:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_comment_leads_the_following_token(self):
+        cst = _cst(b"x = 1\n# note\ny = 2\n")
+        y = next(t for t in cst.tokens() if t.text == b"y")
+        assert y.leading_trivia == b"\n# note\n"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_whitespace_between_tokens(self):
+        cst = _cst(b"x  =\t1\n")
+        assert [(t.leading_trivia, t.text) for t in cst.tokens()] == [
+            (b"", b"x"),
+            (b"  ", b"="),
+            (b"\t", b"1"),
+        ]
+        assert cst.trailing_trivia == b"\n"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_punctuation_is_kept_as_tokens(self):
+        cst = _cst(b"f(a, b)\n")
+        assert [t.text for t in cst.tokens()] == [b"f", b"(", b"a", b",", b"b", b")"]
+
+
+class TestStructure:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_nodes_keep_tree_sitter_kinds_and_spans(self):
+        cst = _cst(b"x = 1\n")
+        [statement] = cst.children
+        assert cst.kind == "module"
+        assert statement.kind == "expression_statement"
+        assert (statement.location.start_byte, statement.location.end_byte) == (0, 5)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_token_location(self):
+        cst = _cst(b"x = 1\ny = 22\n")
+        token = next(t for t in cst.tokens() if t.text == b"22")
+        assert isinstance(token, CstToken)
+        assert token.kind == "integer"
+        assert (token.location.start_line, token.location.start_col) == (2, 4)
+
+
+class TestParseCst:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_api_round_trip(self):
+        source = "package main\n\n// ünïcode comment\nfunc main() {}\n"
+        cst = parse_cst(source, Language.GO)
+        assert cst.to_bytes().decode("utf-8") == source
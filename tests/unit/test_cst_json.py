@@ -0,0 +1,110 @@
+"""Tests for the JSON form of the concrete syntax tree."""
+
+from __future__ import annotations
+
+import json
+
+import pytest
+import tree_sitter_language_pack as tslp
+
+from interpreter.cst import build_cst
+from interpreter.cst_json import cst_from_dict, cst_to_dict, dumps_cst, loads_cst
+from interpreter.cst_types import CstNode, CstToken
+from interpreter.ir import NO_SOURCE_LOCATION
+from tests.covers import NotLanguageFeature, covers
+
+
+def _cst(source: bytes, language: str = "python") -> CstNode:
+    tree = tslp.get_parser(language).parse(source)
+    return build_cst(tree.root_node, source)
+
+
+class TestRoundTrip:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize(
+        "language, source",
+        [
+            ("python", b"# header\ndef f(x):  # trailing\n    return x+1\n\n"),
+            ("go", b"package main\n\n// d\xc3\xa9j\xc3\xa0\nfunc main() {}\n"),
+            ("python", b"x = '\xff\xfe'\n"),
+            ("python", b""),
+        ],
+    )
+    def test_dumps_then_loads_is_identity(self, language, source):
+        cst = _cst(source, language)
+        restored = loads_cst(dumps_cst(cst))
+        assert restored == cst
+        assert restored.to_bytes() == source
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_output_is_stable(self):
+        cst = _cst(b"x = 1\n")
+        assert dumps_cst(cst) == dumps_cst(loads_cst(dumps_cst(cst)))
+
+
+class TestShape:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_document_and_elements_are_typed(self):
+        document = json.loads(dumps_cst(_cst(b"x  = 1\n")))
+        assert (document["format"], document["version"]) == ("red-dragon-cst", 1)
+        root = document["root"]
+        assert (root["type"], root["kind"]) == ("node", "module")
+        assert root["trailing_trivia"] == "\n"
+        equals = root["children"][0]["children"][0]["children"][1]
+        assert equals == {
+            "type": "token",
+            "kind": "=",
+            "text": "=",
+            "leading_trivia": "  ",
+            "location": {
+                "start_line": 1,
+                "start_col": 3,
+                "end_line": 1,
+                "end_col": 4,
+                "start_byte": 3,
+                "end_byte": 4,
+            },
+        }
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_invalid_utf8_is_base64(self):
+        token = CstToken("string", b"'\xff'", b"", NO_SOURCE_LOCATION)
+        assert cst_to_dict(token)["text"] == {"base64": "J/8n"}
+
+
+class TestHandBuiltTrees:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_optional_fields_default(self):
+        data = {
+            "type": "node",
+            "kind": "module",
+            "children": [{"type": "token", "kind": "identifier", "text": "x"}],
+        }
+        node = cst_from_dict(data)
+        assert node == CstNode(
+            "module",
+            (CstToken("identifier", b"x", b"", NO_SOURCE_LOCATION),),
+            NO_SOURCE_LOCATION,
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_unknown_element_type_raises(self):
+        with pytest.raises(ValueError, match="leaf"):
+            cst_from_dict({"type": "leaf", "kind": "x", "text": "x"})
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize(
+        "document, message",
+        [
+            ("[]", "Not a red-dragon-cst document"),
+            ('{"format": "red-dragon-cst", "version": 99, "root": {}}', "version 99"),
+            (
+                '{"format": "red-dragon-cst", "version": 1,'
+                ' "root": {"type": "token", "kind": "x", "text": "x"}}',
+                "must be a node",
+            ),
+        ],
+    )
+    def test_rejects_other_documents(self, document, message):
+        with pytest.raises(ValueError, match=message):
+            loads_cst(document)
@@ -0,0 +1,82 @@
+"""Tests for the S-expression dump of the concrete syntax tree."""
+
+from __future__ import annotations
+
+import tree_sitter_language_pack as tslp
+
+from interpreter.api import dump_sexp
+from interpreter.constants import Language
+from interpreter.cst import build_cst
+from interpreter.cst_sexp import cst_to_sexp
+from interpreter.cst_types import CstNode, CstToken
+from interpreter.ir import NO_SOURCE_LOCATION
+from tests.covers import NotLanguageFeature, covers
+
+_NTH_PRIME = b"def nth_prime(n):\n    return n\n"
+
+
+def _cst(source: bytes, language: str = "python") -> CstNode:
+    tree = tslp.get_parser(language).parse(source)
+    return build_cst(tree.root_node, source)
+
+
+def _token(kind: str, text: bytes) -> CstToken:
+    return CstToken(kind, text, b"", NO_SOURCE_LOCATION)
+
+
+class TestCstToSexp:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_golden(self):
+        assert cst_to_sexp(_cst(_NTH_PRIME)) == (
+            "(module\n"
+            "  (function_definition\n"
+            "    (identifier nth_prime)\n"
+            "    (parameters (identifier n))\n"
+            "    (block (return_statement (identifier n)))))"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_fits_on_one_line_when_it_can(self):
+        assert cst_to_sexp(_cst(b"x = 1\n")) == (
+            "(module (expression_statement (assignment (identifier x) (integer 1))))"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_narrow_width_breaks_every_node_that_does_not_fit(self):
+        assert cst_to_sexp(_cst(b"x = 1\n"), width=30) == (
+            "(module\n"
+            "  (expression_statement\n"
+            "    (assignment\n"
+            "      (identifier x)\n"
+            "      (integer 1))))"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_anonymous_tokens_on_request(self):
+        assert cst_to_sexp(_cst(b"f(a)\n"), anonymous=True, width=200) == (
+            '(module (expression_statement (call (identifier f) (argument_list "("'
+            ' (identifier a) ")"))))'
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_text_with_spaces_or_parens_is_quoted(self):
+        node = CstNode(
+            "string",
+            (_token("string_content", b'a (b) "c"'),),
+            NO_SOURCE_LOCATION,
+        )
+        assert cst_to_sexp(node) == r'(string (string_content "a (b) \"c\""))'
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_missing_token(self):
+        node = CstNode("call", (_token(")", b""),), NO_SOURCE_LOCATION)
+        assert cst_to_sexp(node) == '(call (MISSING ")"))'
+
+
+class TestDumpSexp:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_api(self):
+        source = "package main\n\nfunc main() {}\n"
+        sexp = dump_sexp(source, Language.GO)
+        assert sexp.startswith("(source_file")
+        assert "(package_clause (package_identifier main))" in sexp
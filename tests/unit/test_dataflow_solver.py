@@ -0,0 +1,173 @@
+"""Tests for the generic worklist dataflow solver."""
+
+from __future__ import annotations
+
+import math
+
+from interpreter.cfg import build_cfg
+from interpreter.dataflow_solver import solve_dataflow
+from interpreter.dataflow_solver_types import DataflowProblem, Direction
+from interpreter.instructions import (
+    Branch,
+    BranchIf,
+    Const,
+    DeclVar,
+    InstructionBase,
+    Label_,
+    LoadVar,
+    Return_,
+    StoreVar,
+    Symbolic,
+)
+from interpreter.ir import CodeLabel
+from interpreter.liveness import LIVENESS
+from interpreter.register import Register
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+ENTRY, THEN, JOIN = CodeLabel("entry"), CodeLabel("then"), CodeLabel("join")
+COND, BODY, DONE = CodeLabel("cond"), CodeLabel("body"), CodeLabel("done")
+NAMES = frozenset({"x", "y"})
+
+
+def _assigned(inst: InstructionBase, names: frozenset[str]) -> frozenset[str]:
+    if isinstance(inst, (DeclVar, StoreVar)):
+        return names | {str(inst.name)}
+    return names
+
+
+def _counted(inst: InstructionBase, count: float) -> float:
+    return count + 1 if isinstance(inst, StoreVar) else count
+
+
+def _diamond() -> list[InstructionBase]:
+    """y is assigned before the branch, x only when c holds; both are read
+    after the join."""
+    return [
+        Label_(label=ENTRY),
+        Symbolic(result_reg=Register("%c"), hint="param:c"),
+        Const.int_(Register("%0"), 0),
+        DeclVar(name=VarName("y"), value_reg=Register("%0")),
+        BranchIf(cond_reg=Register("%c"), branch_targets=(THEN, JOIN)),
+        Label_(label=THEN),
+        StoreVar(name=VarName("x"), value_reg=Register("%0")),
+        Label_(label=JOIN),
+        LoadVar(result_reg=Register("%1"), name=VarName("x")),
+        LoadVar(result_reg=Register("%2"), name=VarName("y")),
+        Return_(value_reg=Register("%2")),
+    ]
+
+
+def _loop() -> list[InstructionBase]:
+    """x is assigned only in the body of a loop."""
+    return [
+        Label_(label=ENTRY),
+        Symbolic(result_reg=Register("%c"), hint="param:c"),
+        Label_(label=COND),
+        BranchIf(cond_reg=Register("%c"), branch_targets=(BODY, DONE)),
+        Label_(label=BODY),
+        StoreVar(name=VarName("x"), value_reg=Register("%c")),
+        Branch(label=COND),
+        Label_(label=DONE),
+        Return_(value_reg=Register("%c")),
+    ]
+
+
+class TestForward:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_must_analysis_meets_at_joins(self):
+        problem: DataflowProblem[frozenset[str]] = DataflowProblem(
+            direction=Direction.FORWARD,
+            transfer=_assigned,
+            merge=frozenset.intersection,
+            initial=NAMES,
+            boundary=frozenset(),
+        )
+
+        facts = solve_dataflow(build_cfg(_diamond()), problem)
+
+        assert facts.at_exit[ENTRY] == {"y"}
+        assert facts.at_exit[THEN] == {"x", "y"}
+        assert facts.at_entry[JOIN] == {"y"}
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_facts_flow_around_back_edges(self):
+        problem: DataflowProblem[frozenset[str]] = DataflowProblem(
+            direction=Direction.FORWARD,
+            transfer=_assigned,
+            merge=frozenset.union,
+            initial=frozenset(),
+            boundary=frozenset(),
+        )
+
+        facts = solve_dataflow(build_cfg(_loop()), problem)
+
+        assert facts.at_entry[COND] == {"x"}
+        assert facts.at_entry[DONE] == {"x"}
+        assert facts.at_exit[ENTRY] == frozenset()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_edge_refines_or_drops_the_fact_along_it(self):
+        def edge(block, target, names):
+            if block.label == ENTRY:
+                return names | {"c"} if target == THEN else None
+            return names
+
+        problem: DataflowProblem[frozenset[str]] = DataflowProblem(
+            direction=Direction.FORWARD,
+            transfer=_assigned,
+            edge=edge,
+            merge=frozenset.intersection,
+            initial=NAMES | {"c"},
+            boundary=frozenset(),
+        )
+
+        facts = solve_dataflow(build_cfg(_diamond()), problem)
+
+        assert facts.at_entry[THEN] == {"c", "y"}
+        assert facts.at_entry[JOIN] == {"c", "x", "y"}
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_widening_stops_a_fact_growing_around_a_loop(self):
+        problem: DataflowProblem[float] = DataflowProblem(
+            direction=Direction.FORWARD,
+            transfer=_counted,
+            merge=max,
+            initial=0,
+            boundary=0,
+            widen=lambda previous, merged: math.inf if merged > previous else merged,
+        )
+
+        facts = solve_dataflow(build_cfg(_loop()), problem)
+
+        assert facts.at_entry[COND] == math.inf
+        assert facts.at_exit[BODY] == math.inf
+        assert facts.at_exit[ENTRY] == 0
+
+
+class TestBackward:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_liveness_flows_against_the_edges(self):
+        facts = solve_dataflow(build_cfg(_diamond()), LIVENESS)
+
+        assert facts.at_entry[JOIN] == {"x", "y"}
+        assert facts.at_entry[THEN] == {"y"}
+        assert facts.at_exit[ENTRY] == {"x", "y"}
+        assert facts.at_entry[ENTRY] == {"x"}
+        assert facts.at_exit[JOIN] == frozenset()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_boundary_holds_at_the_exits(self):
+        problem: DataflowProblem[frozenset[str]] = DataflowProblem(
+            direction=Direction.BACKWARD,
+            transfer=LIVENESS.transfer,
+            merge=frozenset.union,
+            initial=frozenset(),
+            boundary=frozenset({"result"}),
+        )
+
+        facts = solve_dataflow(build_cfg(_loop()), problem)
+
+        assert facts.at_exit[DONE] == {"result"}
+        assert facts.at_exit[BODY] == {"result"}
+        assert facts.at_entry[ENTRY] == {"result"}
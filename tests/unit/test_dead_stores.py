@@ -0,0 +1,143 @@
+"""Tests for reporting assignments whose value is never read."""
+
+from __future__ import annotations
+
+from interpreter.api import dead_stores
+from interpreter.constants import Language
+from interpreter.dead_stores import find_dead_stores
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.frontends.scopes import Scope, ScopeBuilder, SymbolKind
+from interpreter.instructions import (
+    Branch,
+    BranchIf,
+    Const,
+    DeclVar,
+    InstructionBase,
+    Label_,
+    LoadVar,
+    Return_,
+    StoreVar,
+)
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.register import Register
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+JAVA_SOURCE = """\
+class M {
+    static int sign(int n) {
+        int s = 0;
+        s = 1;
+        if (n < 0) {
+            s = -1;
+        } else {
+            s = 1;
+        }
+        return s;
+    }
+}
+"""
+
+PYTHON_SOURCE = """\
+def largest(xs):
+    best = 0
+    best = xs[0]
+    for x in xs:
+        if x > best:
+            best = x
+    return best
+"""
+
+_ZERO = Register("%0")
+
+
+def _line(line: int) -> SourceLocation:
+    return SourceLocation(start_line=line, start_col=0, end_line=line, end_col=9)
+
+
+def _scopes() -> Scope:
+    builder = ScopeBuilder(
+        SourceLocation(start_line=1, start_col=0, end_line=20, end_col=0)
+    )
+    builder.declare("x", SymbolKind.VARIABLE, _line(1))
+    builder.declare("y", SymbolKind.VARIABLE, _line(1))
+    return builder.build()
+
+
+def _store(name: str, line: int) -> StoreVar:
+    return StoreVar(name=VarName(name), value_reg=_ZERO, source_location=_line(line))
+
+
+def _program() -> list[InstructionBase]:
+    """x stored on lines 2-3, 5 (one branch) and 8; read on line 7 only."""
+    then, join = CodeLabel("then"), CodeLabel("join")
+    return [
+        Label_(label=CodeLabel("entry")),
+        Const.int_(_ZERO, 0),
+        DeclVar(name=VarName("x"), value_reg=_ZERO, source_location=_line(1)),
+        _store("x", 2),
+        _store("x", 3),
+        BranchIf(cond_reg=_ZERO, branch_targets=(then, join)),
+        Label_(label=then),
+        _store("x", 5),
+        Branch(label=join),
+        Label_(label=join),
+        LoadVar(result_reg=Register("%1"), name=VarName("x")),
+        _store("x", 8),
+        _store("y", 9),
+    ]
+
+
+def _closure(reads: bool) -> list[InstructionBase]:
+    """f, which reads x if *reads*, then x stored twice at the top level."""
+    end, body = CodeLabel("end_f_1"), CodeLabel("func_f_0")
+    read = [LoadVar(result_reg=Register("%2"), name=VarName("x"))] if reads else []
+    return [
+        Label_(label=CodeLabel("entry")),
+        Const.int_(_ZERO, 0),
+        Branch(label=end),
+        Label_(label=body),
+        *read,
+        Return_(),
+        Label_(label=end),
+        Const.func_ref(Register("%1"), str(body)),
+        DeclVar(name=VarName("f"), value_reg=Register("%1")),
+        _store("x", 2),
+        _store("x", 3),
+        LoadVar(result_reg=Register("%3"), name=VarName("x")),
+    ]
+
+
+class TestFindDeadStores:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_overwritten_and_dropped_stores_are_reported(self):
+        stores = find_dead_stores(_scopes(), _program())
+
+        assert [(s.location.start_line, s.name) for s in stores] == [
+            (2, "x"),
+            (8, "x"),
+        ]
+        assert str(stores[0]) == "2:0-2:9: warning: value assigned to x is never read"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_variable_another_function_reads_is_not_judged(self):
+        assert find_dead_stores(_scopes(), _closure(reads=True)) == ()
+        assert [
+            s.location.start_line
+            for s in find_dead_stores(_scopes(), _closure(reads=False))
+        ] == [2]
+
+
+class TestDeadStores:
+    @covers(JavaFeature.IF_ELSE)
+    def test_java_assignment_overwritten_on_every_branch(self):
+        stores = dead_stores(JAVA_SOURCE, Language.JAVA)
+
+        assert [(s.location.start_line, s.name) for s in stores] == [(4, "s")]
+
+    @covers(PythonFeature.FOR_LOOP)
+    def test_python_first_assignment_overwritten(self):
+        stores = dead_stores(PYTHON_SOURCE, Language.PYTHON)
+
+        assert [(s.location.start_line, s.name) for s in stores] == [(2, "best")]
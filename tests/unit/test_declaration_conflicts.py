@@ -0,0 +1,158 @@
+"""Tests for reporting redeclared names and shadowed variables."""
+
+from __future__ import annotations
+
+from interpreter.api import declaration_conflicts
+from interpreter.constants import Language
+from interpreter.declaration_conflict_types import Conflict
+from interpreter.declaration_conflicts import find_declaration_conflicts
+from interpreter.diagnostic import Severity
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.frontends.scopes import Scope, ScopeBuilder, ScopeKind, SymbolKind
+from interpreter.ir import SourceLocation
+from tests.covers import NotLanguageFeature, covers
+
+JAVA_SOURCE = """\
+class M {
+    static int sum(int[] xs) {
+        int total = 0;
+        for (int x : xs) {
+            for (int x : xs) {
+                total = total + x;
+            }
+        }
+        int total = 1;
+        return total;
+    }
+}
+"""
+
+GO_SOURCE = """\
+package main
+
+func pair() (int, error) {
+\treturn 1, nil
+}
+
+func load() (n int, err error) {
+\tv, err := pair()
+\tn = v
+\treturn
+}
+
+func main() {
+\tx := 1
+\tx := 2
+\ta, err := pair()
+\tb, err := pair()
+\tfor i := 0; i < 3; i++ {
+\t\tfor i := 0; i < 2; i++ {
+\t\t\tprintln(i, x, a, b, err)
+\t\t}
+\t}
+\tload()
+}
+"""
+
+
+def _span(line: int, start_col: int = 0, end_col: int = 10) -> SourceLocation:
+    return SourceLocation(
+        start_line=line, start_col=start_col, end_line=line, end_col=end_col
+    )
+
+
+def _region(start_line: int, end_line: int) -> SourceLocation:
+    return SourceLocation(
+        start_line=start_line, start_col=0, end_line=end_line, end_col=0
+    )
+
+
+def _shadowing() -> Scope:
+    """Function f(n) whose body block declares n again, and m."""
+    builder = ScopeBuilder(_region(1, 20))
+    builder.enter(ScopeKind.FUNCTION, _region(1, 10), "f")
+    builder.declare("n", SymbolKind.PARAMETER, _span(1, 6, 7))
+    builder.enter(ScopeKind.BLOCK, _region(2, 9))
+    builder.declare("n", SymbolKind.VARIABLE, _span(3), "n$1")
+    builder.declare("m", SymbolKind.VARIABLE, _span(4))
+    return builder.build()
+
+
+class TestRedeclaredSymbols:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_second_declaration_in_a_scope_is_kept_as_redeclared(self):
+        builder = ScopeBuilder(_region(1, 20))
+        builder.declare("x", SymbolKind.VARIABLE, _span(2))
+        builder.declare("x", SymbolKind.VARIABLE, _span(2, 4, 5))
+        builder.declare("x", SymbolKind.VARIABLE, _span(3))
+        scopes = builder.build()
+
+        assert [s.location.start_line for s in scopes.symbols] == [2]
+        assert [s.location.start_line for s in scopes.redeclared] == [3]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_function_declared_again_as_a_variable_is_not_redeclared(self):
+        builder = ScopeBuilder(_region(1, 20))
+        builder.declare("f", SymbolKind.FUNCTION, _span(2))
+        builder.declare("f", SymbolKind.VARIABLE, _span(5))
+
+        assert builder.build().redeclared == ()
+
+
+class TestFindDeclarationConflicts:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_shadowed_parameter_is_a_warning(self):
+        conflicts = find_declaration_conflicts(_shadowing(), Language.GO)
+
+        assert [(c.name, c.conflict) for c in conflicts] == [("n", Conflict.SHADOWS)]
+        assert conflicts[0].severity == Severity.WARNING
+        assert str(conflicts[0]) == (
+            "3:0-3:10: warning: declaration of n shadows the parameter at 1:6-1:7"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_shadowing_is_an_error_in_java_and_optional_elsewhere(self):
+        scopes = _shadowing()
+
+        (conflict,) = find_declaration_conflicts(scopes, Language.JAVA, False)
+        assert (conflict.conflict, conflict.severity) == (
+            Conflict.ALREADY_DEFINED,
+            Severity.ERROR,
+        )
+        assert find_declaration_conflicts(scopes, Language.GO, False) == ()
+
+
+class TestDeclarationConflicts:
+    @covers(JavaFeature.ENHANCED_FOR_LOOP)
+    def test_java_redeclaration_and_shadowed_loop_variable(self):
+        conflicts = declaration_conflicts(JAVA_SOURCE, Language.JAVA)
+
+        assert [(c.location.start_line, c.name, c.conflict) for c in conflicts] == [
+            (5, "x", Conflict.ALREADY_DEFINED),
+            (9, "total", Conflict.REDECLARED),
+        ]
+        assert conflicts[1].previous.start_line == 3
+
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_go_short_var_decl_redeclares_only_without_new_names(self):
+        conflicts = declaration_conflicts(GO_SOURCE, Language.GO)
+
+        assert [(c.location.start_line, c.name, c.conflict) for c in conflicts] == [
+            (15, "x", Conflict.REDECLARED),
+            (19, "i", Conflict.SHADOWS),
+        ]
+        assert declaration_conflicts(GO_SOURCE, Language.GO, shadowing=False) == (
+            conflicts[0],
+        )
+
+    @covers(PythonFeature.FUNCTION_DECLARATION)
+    def test_python_nested_function_parameter_shadows(self):
+        source = "def f(n):\n    def g(n):\n        return n\n    return g(n)\n"
+
+        conflicts = declaration_conflicts(source, Language.PYTHON)
+
+        assert [(c.location.start_line, c.severity) for c in conflicts] == [
+            (2, Severity.WARNING)
+        ]
@@ -0,0 +1,135 @@
+"""Tests for reporting reads of variables that may not have been assigned."""
+
+from __future__ import annotations
+
+from interpreter.api import unassigned_reads
+from interpreter.constants import Language
+from interpreter.definite_assignment import find_unassigned_reads
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.rust.features import RustFeature
+from interpreter.frontends.scopes import EMPTY_SCOPE
+from interpreter.instructions import (
+    Branch,
+    BranchIf,
+    Const,
+    DeclVar,
+    InstructionBase,
+    Label_,
+    LoadVar,
+    StoreVar,
+)
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.register import Register
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+JAVA_SOURCE = """\
+class M {
+    static int pick(boolean ready) {
+        int x;
+        if (ready) {
+            x = 1;
+        }
+        return x;
+    }
+
+    static int both(boolean ready) {
+        int y;
+        if (ready) {
+            y = 1;
+        } else {
+            y = 2;
+        }
+        return y;
+    }
+}
+"""
+
+RUST_SOURCE = """\
+fn main() {
+    let n;
+    let mut i = 0;
+    while i < 3 {
+        n = i;
+        i = i + 1;
+    }
+    let m = n;
+}
+"""
+
+
+def _diamond(declared: Const, else_assigns: bool) -> list[InstructionBase]:
+    """x declared from *declared*, assigned in the then-branch, then read."""
+    cond, one, two, read = (Register(f"%{i}") for i in range(1, 5))
+    then, other, join = CodeLabel("then"), CodeLabel("else"), CodeLabel("join")
+    else_body: list[InstructionBase] = (
+        [Const.int_(two, 2), StoreVar(name=VarName("x"), value_reg=two)]
+        if else_assigns
+        else []
+    )
+    return [
+        Label_(label=CodeLabel("entry")),
+        declared,
+        DeclVar(name=VarName("x"), value_reg=declared.result_reg),
+        Const.bool_(cond, True),
+        BranchIf(cond_reg=cond, branch_targets=(then, other)),
+        Label_(label=then),
+        Const.int_(one, 1),
+        StoreVar(name=VarName("x"), value_reg=one),
+        Branch(label=join),
+        Label_(label=other),
+        *else_body,
+        Branch(label=join),
+        Label_(label=join),
+        LoadVar(
+            result_reg=read,
+            name=VarName("x"),
+            source_location=SourceLocation(
+                start_line=9, start_col=4, end_line=9, end_col=5
+            ),
+        ),
+    ]
+
+
+class TestFindUnassignedReads:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_read_after_assignment_on_one_branch_is_reported(self):
+        instructions = _diamond(Const.unassigned(Register("%0")), else_assigns=False)
+
+        reads = find_unassigned_reads(EMPTY_SCOPE, instructions)
+
+        assert [(r.name, r.location.start_line) for r in reads] == [("x", 9)]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_assignment_on_every_branch_is_not_reported(self):
+        instructions = _diamond(Const.unassigned(Register("%0")), else_assigns=True)
+
+        assert find_unassigned_reads(EMPTY_SCOPE, instructions) == ()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_explicit_null_is_an_assignment(self):
+        instructions = _diamond(Const.null_(Register("%0")), else_assigns=False)
+
+        assert find_unassigned_reads(EMPTY_SCOPE, instructions) == ()
+
+
+class TestUnassignedReads:
+    @covers(JavaFeature.IF_ELSE)
+    def test_java_read_after_conditional_assignment(self):
+        reads = unassigned_reads(JAVA_SOURCE, Language.JAVA)
+
+        assert [(r.location.start_line, r.message) for r in reads] == [
+            (7, "variable x might not have been assigned"),
+        ]
+
+    @covers(RustFeature.WHILE_LOOP)
+    def test_rust_read_after_loop_that_may_not_run(self):
+        reads = unassigned_reads(RUST_SOURCE, Language.RUST)
+
+        assert [(r.location.start_line, r.name) for r in reads] == [(8, "n")]
+
+    @covers(JavaFeature.LOCAL_VARIABLE)
+    def test_initialized_declaration_is_not_reported(self):
+        source = "class M { static int f() { int x = 1; return x; } }"
+
+        assert unassigned_reads(source, Language.JAVA) == ()
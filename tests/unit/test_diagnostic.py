@@ -0,0 +1,330 @@
+"""Tests for the common Diagnostic form of every check's findings."""
+
+from __future__ import annotations
+
+import subprocess
+import sys
+from pathlib import Path
+
+import pytest
+
+from interpreter.api import diagnostics
+from interpreter.constants import Language
+from interpreter.declaration_conflict_types import Conflict, DeclarationConflict
+from interpreter.diagnostic import (
+    Diagnostic,
+    DiagnosticCode,
+    DiagnosticConfig,
+    Level,
+    SecondarySpan,
+    Severity,
+    parse_level,
+)
+from interpreter.frontends.go.features import GoFeature
+from interpreter.ir import SourceLocation
+from interpreter.recursion_types import Recursion
+from interpreter.types.type_check import TypeDiagnostic, TypeNote
+from interpreter.unused_variable_types import UnusedVariable
+from tests.covers import NotLanguageFeature, covers
+
+GO_SOURCE = """\
+package main
+
+func add(a, b int) int {
+\treturn a + b
+}
+
+func main() {
+\ttotal := 0
+\tx := add(1, 2, 3)
+\tprintln(x)
+}
+"""
+
+
+def _span(line: int, start_col: int = 0, end_col: int = 5) -> SourceLocation:
+    return SourceLocation(
+        start_line=line, start_col=start_col, end_line=line, end_col=end_col
+    )
+
+
+class TestAsDiagnostic:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_type_error_notes_become_secondary_spans(self):
+        error = TypeDiagnostic(
+            _span(9),
+            DiagnosticCode.WRONG_ARGUMENT_COUNT,
+            "too many arguments in call to add (have 3, want 2)",
+            (TypeNote(_span(3), "add declared here"),),
+        )
+
+        diagnostic = error.as_diagnostic()
+
+        assert str(diagnostic) == (
+            "9:0-9:5: error[RD1004]: too many arguments in call to add "
+            "(have 3, want 2)"
+        )
+        assert diagnostic.secondary == (SecondarySpan(_span(3), "add declared here"),)
+        assert str(diagnostic.secondary[0]) == "3:0-3:5: note: add declared here"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_severity_and_code_follow_the_finding(self):
+        shadow = DeclarationConflict(
+            location=_span(4), name="n", previous=_span(1), conflict=Conflict.SHADOWS
+        )
+        unused = UnusedVariable(location=_span(2), name="x", is_parameter=False)
+        recursion = Recursion(location=_span(1), function="f")
+
+        assert shadow.as_diagnostic() == Diagnostic(
+            code=DiagnosticCode.SHADOWED,
+            severity=Severity.WARNING,
+            location=_span(4),
+            message="declaration of n shadows the variable at 1:0-1:5",
+            secondary=(SecondarySpan(_span(1), "shadowed declaration of n"),),
+        )
+        assert unused.as_diagnostic().notes == (
+            "rename it to _x if it is unused on purpose",
+        )
+        assert not unused.as_diagnostic().is_error
+        assert str(recursion.as_diagnostic()) == (
+            "1:0-1:5: error[RD3003]: recursion not allowed in this dialect "
+            "(f calls itself)"
+        )
+
+
+class TestDiagnostics:
+    @covers(GoFeature.FUNCTION_CALL)
+    def test_go_findings_of_every_check_in_source_order(self):
+        found = diagnostics(GO_SOURCE, Language.GO)
+
+        assert [(d.location.start_line, d.code, d.severity) for d in found] == [
+            (8, DiagnosticCode.UNUSED_VARIABLE, Severity.WARNING),
+            (9, DiagnosticCode.WRONG_ARGUMENT_COUNT, Severity.ERROR),
+        ]
+        assert found[1].secondary[0].location.start_line == 3
+
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_strict_makes_unused_variables_errors(self):
+        found = diagnostics(GO_SOURCE, Language.GO, DiagnosticConfig(strict=True))
+
+        assert all(d.is_error for d in found)
+
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_a_code_turned_off_is_not_reported(self):
+        config = DiagnosticConfig().with_level(
+            DiagnosticCode.UNUSED_VARIABLE, Level.OFF
+        )
+
+        found = diagnostics(GO_SOURCE, Language.GO, config)
+
+        assert [d.code for d in found] == [DiagnosticCode.WRONG_ARGUMENT_COUNT]
+
+
+_GO_EMBEDDED = """\
+package main
+type Inner struct { X int }
+type Other struct { X int }
+type Outer struct {
+    Inner
+    *Other
+}
+"""
+
+
+class TestCompileErrors:
+    @covers(
+        GoFeature.ARITHMETIC,
+        GoFeature.TYPE_ALIAS,
+        GoFeature.TYPE_CONVERSION,
+        GoFeature.INDEXING,
+        GoFeature.COMPOSITE_LITERAL,
+        GoFeature.CONST_DECLARATION,
+        GoFeature.LABELED_STATEMENT,
+        GoFeature.MULTIPLE_RETURN,
+        GoFeature.INIT_FUNCTION,
+        GoFeature.VAR_DECLARATION,
+        GoFeature.GENERIC_FUNCTION,
+        GoFeature.STRUCT_EMBEDDING,
+        GoFeature.NIL,
+        GoFeature.STRING_LITERAL,
+    )
+    @pytest.mark.parametrize(
+        "source, code, line",
+        [
+            (
+                "package main\nfunc main() {\n    n := 4\n    _ = n / 0\n}\n",
+                DiagnosticCode.CONSTANT_DIVISION_BY_ZERO,
+                4,
+            ),
+            (
+                "package main\ntype Celsius float64\ntype Fahrenheit float64\n"
+                "func main() {\n    var f Fahrenheit = 50\n    c := Celsius(10)\n"
+                "    c = f\n}\n",
+                DiagnosticCode.TYPE_MISMATCH,
+                7,
+            ),
+            (
+                'package main\nfunc main() {\n    s := "7"\n    n := int(s)\n}\n',
+                DiagnosticCode.INVALID_CONVERSION,
+                4,
+            ),
+            (
+                "package main\nfunc isG(dna string, i int) bool {\n"
+                '    return dna[i] == "G"\n}\n',
+                DiagnosticCode.INCOMPARABLE_TYPES,
+                3,
+            ),
+            (
+                'package main\nfunc main() {\n    xs := []int{1, "two"}\n}\n',
+                DiagnosticCode.INVALID_COMPOSITE_LITERAL,
+                3,
+            ),
+            (
+                "package main\nconst limit = 3\nfunc main() {\n    limit += 1\n}\n",
+                DiagnosticCode.ASSIGNED_CONSTANT,
+                4,
+            ),
+            (
+                "package main\nfunc main() {\nouter:\n    for { break }\n}\n",
+                DiagnosticCode.INVALID_LABEL,
+                3,
+            ),
+            (
+                "package main\nfunc one() int { return 1 }\n"
+                "func main() {\n    a, b := one()\n}\n",
+                DiagnosticCode.ASSIGNMENT_MISMATCH,
+                4,
+            ),
+            (
+                "package main\nfunc main() int { return 1 }\n",
+                DiagnosticCode.INVALID_ENTRY_FUNCTION,
+                2,
+            ),
+            (
+                "package main\nvar a = b\nvar b = a\nfunc main() {}\n",
+                DiagnosticCode.INITIALIZATION_CYCLE,
+                2,
+            ),
+            (
+                'package main\nimport "cmp"\n'
+                "func Max[T cmp.Ordered](a, b T) T {\n    return a\n}\n"
+                'func main() {\n    m := Max(1, "a")\n}\n',
+                DiagnosticCode.INVALID_TYPE_ARGUMENT,
+                7,
+            ),
+            (
+                _GO_EMBEDDED + "func main() {\n    o := Outer{}\n    x := o.X\n}\n",
+                DiagnosticCode.AMBIGUOUS_SELECTOR,
+                10,
+            ),
+            (
+                "package main\nfunc main() {\n    x := nil\n}\n",
+                DiagnosticCode.UNTYPED_NIL,
+                3,
+            ),
+            (
+                'package main\nfunc main() {\n    s := "a\\qb"\n}\n',
+                DiagnosticCode.INVALID_LITERAL,
+                3,
+            ),
+            (
+                "package main\nfunc main() {\n    y := int8(300)\n}\n",
+                DiagnosticCode.CONSTANT_OVERFLOW,
+                3,
+            ),
+        ],
+    )
+    def test_go_compile_error_is_reported_at_its_node(self, source, code, line):
+        found = diagnostics(source, Language.GO)
+
+        (error,) = [d for d in found if d.code == code]
+        assert error.severity == Severity.ERROR
+        assert error.location.start_line == line
+
+
+def _check(tmp_path: Path, *options: str) -> subprocess.CompletedProcess:
+    """Run ``interpreter.py --check`` on GO_SOURCE saved as main.go."""
+    source = tmp_path / "main.go"
+    source.write_text(GO_SOURCE)
+    return subprocess.run(
+        [sys.executable, "interpreter.py", str(source), "--check", *options],
+        capture_output=True,
+        text=True,
+    )
+
+
+class TestCheckCommandLine:
+    @covers(GoFeature.FUNCTION_CALL)
+    def test_check_prints_every_finding_and_fails_on_an_error(self, tmp_path):
+        result = _check(tmp_path)
+
+        assert result.returncode == 1, result.stderr
+        lines = result.stdout.splitlines()
+        assert "warning[RD2004]: declared and not used: total" in lines[0]
+        assert "error[RD1004]: too many arguments in call to add" in lines[1]
+        assert lines[0].startswith(f"{tmp_path / 'main.go'}:8:")
+
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_levels_turn_the_error_off(self, tmp_path):
+        result = _check(tmp_path, "-W", "RD1004=off")
+
+        assert result.returncode == 0, result.stderr
+        assert "RD1004" not in result.stdout
+        assert "warning[RD2004]" in result.stdout
+
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_strict_reports_warnings_as_errors(self, tmp_path):
+        result = _check(tmp_path, "-W", "RD1004=off", "--strict")
+
+        assert result.returncode == 1, result.stderr
+        assert "error[RD2004]" in result.stdout
+
+
+def _found() -> tuple[Diagnostic, ...]:
+    """An unused variable, then a shadowed one; both warnings."""
+    return (
+        UnusedVariable(location=_span(2), name="x", is_parameter=False).as_diagnostic(),
+        DeclarationConflict(
+            location=_span(4), name="n", previous=_span(1), conflict=Conflict.SHADOWS
+        ).as_diagnostic(),
+    )
+
+
+class TestDiagnosticConfig:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_defaults_keep_each_severity(self):
+        found = _found()
+
+        assert DiagnosticConfig().apply(found) == found
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_explicit_levels_win_over_strict(self):
+        found = _found()
+        config = DiagnosticConfig.from_options(
+            ["RD2004=warning", "shadowed=off"], strict=True
+        )
+
+        assert [(d.code, d.severity) for d in config.apply(found)] == [
+            (DiagnosticCode.UNUSED_VARIABLE, Severity.WARNING)
+        ]
+        assert [d.severity for d in DiagnosticConfig(strict=True).apply(found)] == [
+            Severity.ERROR,
+            Severity.ERROR,
+        ]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_options_name_a_code_by_code_or_name(self):
+        assert parse_level("rd1004=Error") == (
+            DiagnosticCode.WRONG_ARGUMENT_COUNT,
+            Level.ERROR,
+        )
+        assert parse_level("unused-variable=off") == (
+            DiagnosticCode.UNUSED_VARIABLE,
+            Level.OFF,
+        )
+        with pytest.raises(ValueError, match="unknown diagnostic code: RD9999"):
+            parse_level("RD9999=off")
+        with pytest.raises(ValueError, match="use off, warning or error"):
+            parse_level("RD2004=loud")
+        with pytest.raises(ValueError, match="expected CODE=LEVEL"):
+            parse_level("RD2004")
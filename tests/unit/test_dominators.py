@@ -0,0 +1,172 @@
+"""Tests for dominator trees, dominance frontiers and natural loops."""
+
+from __future__ import annotations
+
+from interpreter.api import dominator_trees
+from interpreter.cfg import build_cfg
+from interpreter.cfg_types import Edge
+from interpreter.constants import Language
+from interpreter.dominators import (
+    back_edges,
+    build_dominator_tree,
+    dominator_tree_to_mermaid,
+    natural_loop,
+)
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.instructions import (
+    Branch,
+    BranchIf,
+    InstructionBase,
+    Label_,
+    Return_,
+    Symbolic,
+)
+from interpreter.ir import CodeLabel
+from interpreter.register import Register
+from tests.covers import NotLanguageFeature, covers
+
+PYTHON_SOURCE = """\
+def count(n):
+    i = 0
+    while i < n:
+        i = i + 1
+    return i
+"""
+
+JAVA_SOURCE = """\
+class Counter {
+    static int sign(int n) {
+        int s = 0;
+        if (n > 0) {
+            s = 1;
+        } else {
+            s = -1;
+        }
+        return s;
+    }
+}
+"""
+
+ENTRY, COND, BODY, DONE = (
+    CodeLabel("entry"),
+    CodeLabel("cond"),
+    CodeLabel("body"),
+    CodeLabel("done"),
+)
+
+
+def _loop() -> list[InstructionBase]:
+    """entry falls into cond, which loops through body or leaves for done."""
+    return [
+        Label_(label=ENTRY),
+        Symbolic(result_reg=Register("%c"), hint="param:c"),
+        Label_(label=COND),
+        BranchIf(cond_reg=Register("%c"), branch_targets=(BODY, DONE)),
+        Label_(label=BODY),
+        Branch(label=COND),
+        Label_(label=DONE),
+        Return_(value_reg=Register("%c")),
+    ]
+
+
+def _unreached() -> list[InstructionBase]:
+    """entry returns at once, leaving orphan unreached."""
+    return [
+        Label_(label=ENTRY),
+        Symbolic(result_reg=Register("%c"), hint="param:c"),
+        Return_(value_reg=Register("%c")),
+        Label_(label=CodeLabel("orphan")),
+        Return_(value_reg=Register("%c")),
+    ]
+
+
+class TestBuildDominatorTree:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_loop_head_dominates_body_and_exit(self):
+        tree = build_dominator_tree(build_cfg(_loop()))
+
+        assert tree.idom == {ENTRY: ENTRY, COND: ENTRY, BODY: COND, DONE: COND}
+        assert list(tree.order) == [ENTRY, COND, DONE, BODY]
+        assert tree.children(COND) == [DONE, BODY]
+        assert tree.dominators(BODY) == [BODY, COND, ENTRY]
+        assert tree.dominates(COND, DONE)
+        assert not tree.dominates(BODY, DONE)
+        assert tree.depth(BODY) == 2
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_frontiers_are_the_joins_reached_without_dominating_them(self):
+        tree = build_dominator_tree(build_cfg(_loop()))
+
+        assert tree.frontiers == {
+            ENTRY: frozenset(),
+            COND: frozenset({COND}),
+            BODY: frozenset({COND}),
+            DONE: frozenset(),
+        }
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_unreached_blocks_are_left_out(self):
+        tree = build_dominator_tree(build_cfg(_unreached()))
+
+        assert CodeLabel("orphan") not in tree
+        assert list(tree.order) == [ENTRY]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_str_indents_by_depth_with_frontiers(self):
+        tree = build_dominator_tree(build_cfg(_loop()))
+
+        assert str(tree).splitlines() == [
+            "entry",
+            "  cond  frontier: cond",
+            "    done",
+            "    body  frontier: cond",
+        ]
+
+
+class TestLoops:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_back_edge_closes_the_loop_at_its_head(self):
+        cfg = build_cfg(_loop())
+        tree = build_dominator_tree(cfg)
+
+        assert back_edges(cfg, tree) == [Edge(BODY, COND)]
+        assert natural_loop(cfg, Edge(BODY, COND)) == frozenset({COND, BODY})
+
+
+class TestDominatorTreeToMermaid:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_solid_edges_to_children_and_dotted_edges_to_frontiers(self):
+        mermaid = dominator_tree_to_mermaid(build_dominator_tree(build_cfg(_loop())))
+
+        lines = mermaid.splitlines()
+        assert lines[0] == "flowchart TD"
+        assert "    entry --> cond" in lines
+        assert "    cond --> body" in lines
+        assert "    cond --> done" in lines
+        assert "    body -.-> cond" in lines
+        assert "    cond -.-> cond" in lines
+        assert "    style entry fill:#28a745,color:#fff" in lines
+
+
+class TestDominatorTrees:
+    @covers(PythonFeature.WHILE_LOOP, PythonFeature.FUNCTION_DECLARATION)
+    def test_python_loop_head_is_its_own_frontier(self):
+        trees = dominator_trees(PYTHON_SOURCE, Language.PYTHON)
+
+        (function,) = [name for name in trees if name]
+        tree = trees[function]
+        loops = [label for label in tree.order if label in tree.frontiers[label]]
+        assert len(loops) == 1
+        assert loops[0] != tree.entry
+
+    @covers(JavaFeature.IF_ELSE, JavaFeature.LOCAL_VARIABLE)
+    def test_java_branches_share_the_join_as_frontier(self):
+        trees = dominator_trees(JAVA_SOURCE, Language.JAVA)
+
+        (function,) = [name for name in trees if name]
+        tree = trees[function]
+        branches = tree.children(tree.entry)
+        joins = {join for label in branches for join in tree.frontiers[label]}
+        assert len(joins) == 1
+        assert joins <= set(branches)
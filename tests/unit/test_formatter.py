@@ -0,0 +1,127 @@
+"""Tests for the canonical source formatter."""
+
+from __future__ import annotations
+
+import re
+from pathlib import Path
+
+import pytest
+
+from interpreter.api import format_code
+from interpreter.constants import Language
+from interpreter.formatter import format_source
+from interpreter.project.language_detection import detect_language
+from tests.covers import NotLanguageFeature, covers
+
+_UNIT = Path(__file__).parent
+_CORPUS = sorted(
+    [
+        *(_UNIT / "exercism" / "exercises").glob("*/solutions/*"),
+        *(_UNIT.parent / "fixtures" / "projects").rglob("*"),
+    ]
+)
+_SOURCES = [
+    (path, language)
+    for path in _CORPUS
+    if path.is_file() and (language := detect_language(path)) != Language.COBOL
+]
+
+
+def _ids(case: tuple[Path, Language]) -> str:
+    return str(case[0].relative_to(_UNIT.parent))
+
+
+class TestCorpus:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize("case", _SOURCES, ids=_ids)
+    def test_idempotent(self, case):
+        path, language = case
+        once = format_source(path.read_text(), language)
+        assert format_source(once, language) == once
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize("case", _SOURCES, ids=_ids)
+    def test_only_whitespace_changes(self, case):
+        path, language = case
+        source = path.read_text()
+        formatted = format_source(source, language)
+        assert re.sub(r"\s+", "", formatted) == re.sub(r"\s+", "", source)
+
+
+class TestLayout:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_spacing_within_a_line(self):
+        source = "int  main(void)   {\n    return   0;   \n}\n"
+        assert format_source(source, Language.C) == (
+            "int main(void) {\n    return 0;\n}\n"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_reindents_to_bracket_depth(self):
+        source = "class A {\nvoid f() {\n        g(1,\n2);\n  }\n}\n"
+        assert format_source(source, Language.JAVA) == (
+            "class A {\n"
+            "    void f() {\n"
+            "        g(1,\n"
+            "            2);\n"
+            "    }\n"
+            "}\n"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_go_uses_tabs_and_outdents_case_labels(self):
+        source = (
+            "package main\n\nfunc f(x int) int {\n  switch x {\n    case 1:\n"
+            "      return 2\n    default:\n      return 0\n  }\n}\n"
+        )
+        assert format_source(source, Language.GO) == (
+            "package main\n\nfunc f(x int) int {\n\tswitch x {\n\tcase 1:\n"
+            "\t\treturn 2\n\tdefault:\n\t\treturn 0\n\t}\n}\n"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_case_bodies_indented_past_their_label(self):
+        source = (
+            "int f(int x) {\nswitch (x) {\ncase 1:\nreturn 2;\ndefault:\n"
+            "return 0;\n}\n}\n"
+        )
+        assert format_source(source, Language.C) == (
+            "int f(int x) {\n"
+            "    switch (x) {\n"
+            "        case 1:\n"
+            "            return 2;\n"
+            "        default:\n"
+            "            return 0;\n"
+            "    }\n"
+            "}\n"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_python_keeps_indentation(self):
+        source = "def f(x):\n  if x:\n      return  1\n  return 0\n"
+        assert format_source(source, Language.PYTHON) == (
+            "def f(x):\n  if x:\n      return 1\n  return 0\n"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_blank_lines_collapse_and_file_ends_in_one_newline(self):
+        source = "\n\nx = 1\n\n\n\ny = 2\n\n\n"
+        assert format_source(source, Language.PYTHON) == "x = 1\n\ny = 2\n"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_strings_and_comments_kept_verbatim(self):
+        source = 'x  =  "a   b"   #   keep   this\n'
+        assert format_source(source, Language.PYTHON) == (
+            'x = "a   b" #   keep   this\n'
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_cobol_is_rejected(self):
+        with pytest.raises(ValueError, match="COBOL"):
+            format_source("       IDENTIFICATION DIVISION.\n", Language.COBOL)
+
+
+class TestFormatCode:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_api_accepts_a_language_name(self):
+        assert format_code("let  a=1;", "javascript") == "let a=1;\n"
@@ -0,0 +1,108 @@
+"""Tests for FrontendRegistry and third-party frontend plugins."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+import pytest
+
+from interpreter.frontend import Frontend
+from interpreter.frontend_observer import FrontendObserver
+from interpreter.frontend_plugin import BuiltinFrontendPlugin, FrontendPlugin
+from interpreter.frontend_registry import FrontendRegistry, default_frontend_registry
+from interpreter.instructions import Const, InstructionBase
+from interpreter.language import Language
+from interpreter.namespace_resolver import NamespaceResolver
+from interpreter.register import Register
+from tests.covers import NotLanguageFeature, covers
+
+
+class ToyFrontend(Frontend):
+    """Lowers every source file to a single constant load."""
+
+    def lower(
+        self,
+        source: bytes,
+        namespace_resolver: NamespaceResolver = NamespaceResolver(),
+    ) -> list[InstructionBase]:
+        return [Const.string(Register("%0"), source.decode())]
+
+
+@dataclass(frozen=True)
+class ToyPlugin:
+    name: str = "toy"
+    extensions: tuple[str, ...] = (".toy",)
+
+    def create(self, observer: FrontendObserver) -> Frontend:
+        return ToyFrontend()
+
+
+class TestFrontendRegistry:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_registered_plugin_builds_its_frontend(self):
+        registry = FrontendRegistry().register(ToyPlugin())
+        ir = registry.create("toy").lower(b"42")
+        assert isinstance(ir[0], Const)
+        assert ir[0].value == "42"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_register_returns_new_registry(self):
+        empty = FrontendRegistry()
+        registry = empty.register(ToyPlugin())
+        assert empty.names() == ()
+        assert registry.names() == ("toy",)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_duplicate_name_raises(self):
+        registry = FrontendRegistry().register(ToyPlugin())
+        with pytest.raises(ValueError, match="already registered: toy"):
+            registry.register(ToyPlugin(extensions=(".tt",)))
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_unknown_name_raises(self):
+        registry = FrontendRegistry().register(ToyPlugin())
+        with pytest.raises(ValueError, match="No frontend registered for 'zig'"):
+            registry.get("zig")
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_for_extension_lists_claimants_in_order(self):
+        registry = (
+            FrontendRegistry()
+            .register(ToyPlugin())
+            .register(ToyPlugin(name="toy2", extensions=(".toy", ".t2")))
+        )
+        assert [p.name for p in registry.for_extension(".toy")] == ["toy", "toy2"]
+        assert [p.name for p in registry.for_extension(".T2")] == ["toy2"]
+        assert registry.for_extension(".zig") == ()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_plugin_satisfies_protocol(self):
+        assert isinstance(ToyPlugin(), FrontendPlugin)
+        assert isinstance(BuiltinFrontendPlugin(Language.GO, (".go",)), FrontendPlugin)
+
+
+class TestDefaultFrontendRegistry:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_covers_every_builtin_language(self):
+        names = default_frontend_registry().names()
+        assert names == tuple(lang.value for lang in Language)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_builtin_extensions(self):
+        registry = default_frontend_registry()
+        assert registry.get("go").extensions == (".go",)
+        assert [p.name for p in registry.for_extension(".h")] == ["c", "cpp"]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_builtin_plugin_builds_deterministic_frontend(self):
+        from interpreter.frontends.python import PythonFrontend
+
+        frontend = default_frontend_registry().create("python")
+        assert isinstance(frontend, PythonFrontend)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_third_party_plugin_extends_default(self):
+        registry = default_frontend_registry().register(ToyPlugin())
+        assert registry.names()[-1] == "toy"
+        with pytest.raises(ValueError, match="already registered: python"):
+            registry.register(ToyPlugin(name="python"))
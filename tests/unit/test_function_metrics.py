@@ -0,0 +1,176 @@
+"""Tests for per-function cyclomatic complexity and nesting depth."""
+
+from __future__ import annotations
+
+from interpreter.api import function_metrics
+from interpreter.constants import Language
+from interpreter.cst_types import CstNode
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.function_metrics import compute_function_metrics
+from interpreter.function_metrics_types import TOP_LEVEL
+from interpreter.instructions import (
+    Branch,
+    BranchIf,
+    Const,
+    InstructionBase,
+    Label_,
+    LoadVar,
+    Return_,
+)
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.register import Register
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+PYTHON_SOURCE = """\
+def classify(xs):
+    total = 0
+    for x in xs:
+        if x > 0:
+            total += x
+        elif x < 0:
+            total -= x
+    return total
+
+
+def double(n):
+    return n * 2
+"""
+
+JAVA_SOURCE = """\
+class M {
+    static int sign(int n) {
+        if (n > 0) {
+            return 1;
+        } else if (n < 0) {
+            return -1;
+        }
+        return 0;
+    }
+}
+"""
+
+GO_SOURCE = """\
+package main
+
+func main() {
+\tfor i := 0; i < 3; i++ {
+\t\tswitch i {
+\t\tcase 0:
+\t\t\tprintln("zero")
+\t\tdefault:
+\t\t\tif i > 1 {
+\t\t\t\tprintln("big")
+\t\t\t}
+\t\t}
+\t}
+}
+"""
+
+
+def _location(start_line: int, end_line: int) -> SourceLocation:
+    return SourceLocation(
+        start_line=start_line, start_col=0, end_line=end_line, end_col=1
+    )
+
+
+def _function() -> list[InstructionBase]:
+    """``f``, declared on lines 1-6, returns 1 or 2 depending on ``c``."""
+    cond, one, two = Register("%0"), Register("%1"), Register("%2")
+    then, other = CodeLabel("if_true_2"), CodeLabel("if_false_3")
+    return [
+        Branch(label=CodeLabel("end_f_1"), source_location=_location(1, 6)),
+        Label_(label=CodeLabel("func_f_0")),
+        LoadVar(result_reg=cond, name=VarName("c")),
+        BranchIf(cond_reg=cond, branch_targets=(then, other)),
+        Label_(label=then),
+        Const.int_(one, 1),
+        Return_(value_reg=one),
+        Label_(label=other),
+        Const.int_(two, 2),
+        Return_(value_reg=two),
+        Label_(label=CodeLabel("end_f_1")),
+    ]
+
+
+def _node(kind: str, start_line: int, end_line: int, *children: CstNode) -> CstNode:
+    return CstNode(
+        kind=kind, children=children, location=_location(start_line, end_line)
+    )
+
+
+class TestComputeFunctionMetrics:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_each_branch_adds_one_and_else_if_does_not_nest(self):
+        # if (2-5) { while (3-4) {} } else if (5-5) {}, in f (1-6)
+        cst = _node(
+            "program",
+            1,
+            8,
+            _node(
+                "function_definition",
+                1,
+                6,
+                _node(
+                    "if_statement",
+                    2,
+                    5,
+                    _node("block", 2, 4, _node("while_statement", 3, 4)),
+                    _node("else_clause", 5, 5, _node("if_statement", 5, 5)),
+                ),
+            ),
+        )
+
+        top, f = compute_function_metrics(_function(), cst, Language.C)
+
+        assert (top.function, top.complexity, top.nesting) == (TOP_LEVEL, 1, 0)
+        assert (f.function, f.complexity, f.nesting) == ("f", 2, 2)
+        assert str(f) == "1:0-6:1: f: complexity 2, nesting 2"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_nested_function_is_measured_on_its_own(self):
+        cst = _node(
+            "program",
+            1,
+            8,
+            _node(
+                "function_definition",
+                1,
+                6,
+                _node("while_statement", 2, 2),
+            ),
+            _node("if_statement", 7, 8, _node("for_statement", 8, 8)),
+        )
+
+        top, f = compute_function_metrics(_function(), cst, Language.C)
+
+        assert (top.nesting, f.nesting) == (2, 1)
+
+
+class TestFunctionMetrics:
+    @covers(PythonFeature.FOR_LOOP)
+    def test_python_loop_with_if_elif(self):
+        metrics = function_metrics(PYTHON_SOURCE, Language.PYTHON)
+
+        assert [(m.function, m.complexity, m.nesting) for m in metrics] == [
+            (TOP_LEVEL, 1, 0),
+            ("classify", 4, 2),
+            ("double", 1, 0),
+        ]
+        assert metrics[1].location.start_line == 1
+
+    @covers(JavaFeature.IF_ELSE)
+    def test_java_else_if_chain(self):
+        metrics = function_metrics(JAVA_SOURCE, Language.JAVA)
+
+        sign = next(m for m in metrics if m.function == "sign")
+        assert (sign.complexity, sign.nesting, sign.location.start_line) == (3, 1, 2)
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_go_main_is_measured_at_the_top_level(self):
+        (top,) = function_metrics(GO_SOURCE, Language.GO)
+
+        assert top.function == TOP_LEVEL
+        assert top.nesting == 3
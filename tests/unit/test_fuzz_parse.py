@@ -0,0 +1,155 @@
+"""Tests for the parser fuzz target and the hardening it checks."""
+
+from __future__ import annotations
+
+import random
+from pathlib import Path
+
+import pytest
+import tree_sitter_language_pack as tslp
+
+from interpreter.constants import Language
+from interpreter.frontend_observer import NullFrontendObserver
+from interpreter.frontends import get_deterministic_frontend
+from interpreter.frontends.context import GrammarConstants, TreeSitterEmitContext
+from interpreter.frontends.nesting import MAX_NESTING_DEPTH, NestingTooDeepError
+from interpreter.fuzz_parse import fuzz_parse, mutate, run_fuzz, seed_corpus
+from interpreter.instructions import Const, Symbolic
+from interpreter.register import Register
+from tests.covers import NotLanguageFeature, covers
+
+_EXERCISES = Path(__file__).parent / "exercism" / "exercises"
+
+
+def _corpus() -> dict[Language, tuple[bytes, ...]]:
+    return seed_corpus(list(_EXERCISES.glob("*/solutions/*")))
+
+
+class TestSeedCorpus:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_every_frontend_has_seeds(self):
+        corpus = _corpus()
+        assert Language.GO in corpus
+        assert Language.PYTHON in corpus
+        assert all(seeds for seeds in corpus.values())
+
+
+class TestMutate:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_same_seed_same_mutations(self):
+        data, other = b"func main() { x := f(1, 2) }", b"if (a) { b }"
+        first = [mutate(data, other, random.Random(3)) for _ in range(50)]
+        second = [mutate(data, other, random.Random(3)) for _ in range(50)]
+        assert first == second
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_mutates_empty_input(self):
+        rng = random.Random(0)
+        assert all(isinstance(mutate(b"", b"", rng), bytes) for _ in range(100))
+
+
+class TestFuzzParse:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_seeded_run_finds_no_crashes(self):
+        failures = run_fuzz(_corpus(), iterations=20, seed=0)
+        assert [(f.language, f.error) for f in failures] == []
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize("language", [Language.PYTHON, Language.GO, Language.C])
+    @pytest.mark.parametrize(
+        "data",
+        [
+            b"(" * 5000,
+            b"{[(" * 1000,
+            b")]}" * 1000,
+            b"f(" * 2000 + b"x",
+            b"\x00\xff\xfe\x80",
+            b"x = '\xff\xfe'\n",
+            b"if (x { while [ y ) }",
+        ],
+    )
+    def test_malformed_input_does_not_crash(self, language, data):
+        fuzz_parse(data, language)
+
+
+class TestNestingLimit:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_deep_valid_nesting_is_an_input_error(self):
+        depth = MAX_NESTING_DEPTH * 2
+        source = b"x = " + b"(" * depth + b"1" + b")" * depth + b"\n"
+        frontend = get_deterministic_frontend(Language.PYTHON)
+        with pytest.raises(NestingTooDeepError, match="nests deeper than"):
+            frontend.lower(source)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_nesting_within_the_limit_lowers(self):
+        depth = MAX_NESTING_DEPTH // 2
+        source = b"x = " + b"(" * depth + b"1" + b")" * depth + b"\n"
+        assert get_deterministic_frontend(Language.PYTHON).lower(source)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_limit_is_configurable(self):
+        depth = MAX_NESTING_DEPTH * 2
+        source = b"x = " + b"(" * depth + b"1" + b")" * depth + b"\n"
+        frontend = get_deterministic_frontend(
+            Language.PYTHON, max_nesting_depth=depth + 16
+        )
+        assert frontend.lower(source)
+        strict = get_deterministic_frontend(Language.PYTHON, max_nesting_depth=8)
+        with pytest.raises(NestingTooDeepError, match="deeper than 8 levels") as info:
+            strict.lower(source)
+        assert info.value.location.start_line == 1
+
+
+def _context(source: bytes) -> TreeSitterEmitContext:
+    return TreeSitterEmitContext(
+        source=source,
+        language=Language.PYTHON,
+        observer=NullFrontendObserver(),
+        constants=GrammarConstants(),
+    )
+
+
+def _failing_handler(ctx: TreeSitterEmitContext, node) -> Register:
+    ctx.emit_inst(Const.int_(ctx.fresh_reg(), 1))
+    ctx.loop_stack.append({})
+    raise IndexError("handler expected a child that is not there")
+
+
+def _buggy_handler(ctx: TreeSitterEmitContext, node) -> Register:
+    reg = ctx.fresh_reg()
+    ctx.emit_instruction(Const.int_(reg, 1))  # no such method: a handler bug
+    return reg
+
+
+class TestMalformedSubtreeRecovery:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_failing_handler_on_malformed_node_lowers_to_symbolic(self):
+        source = b"f(1, +)\n"
+        node = tslp.get_parser("python").parse(source).root_node
+        ctx = _context(source)
+        ctx.expr_dispatch[node.type] = _failing_handler
+        assert node.has_error
+        ctx.lower_expr(node)
+        assert ctx.loop_stack == []
+        assert [type(inst) for inst in ctx.instructions] == [Symbolic]
+        assert ctx.instructions[0].hint == f"unsupported:{node.type}"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_failing_handler_on_well_formed_node_raises(self):
+        source = b"f(1, 2)\n"
+        node = tslp.get_parser("python").parse(source).root_node
+        ctx = _context(source)
+        ctx.expr_dispatch[node.type] = _failing_handler
+        with pytest.raises(IndexError):
+            ctx.lower_expr(node)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_handler_bug_on_malformed_node_still_raises(self):
+        source = b"f(1, +)\n"
+        node = tslp.get_parser("python").parse(source).root_node
+        ctx = _context(source)
+        ctx.expr_dispatch[node.type] = _buggy_handler
+        assert node.has_error
+        with pytest.raises(AttributeError, match="emit_instruction"):
+            ctx.lower_expr(node)
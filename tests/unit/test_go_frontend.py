@@ -2,12 +2,37 @@
 
 from __future__ import annotations
 
+import pytest
+
 from interpreter.frontends.go import GoFrontend
+from interpreter.frontends.go.control_flow import GoLabelError
+from interpreter.frontends.go.declarations import (
+    GoAssignmentMismatchError,
+    GoEntryFunctionError,
+    GoInitializationCycleError,
+)
+from interpreter.frontends.go.embedding import GoAmbiguousSelectorError
+from interpreter.frontends.go.expressions import (
+    GoCompositeLiteralError,
+    GoConstantAssignmentError,
+    GoConstantOverflowError,
+    GoConversionError,
+    GoDivisionByZeroError,
+    GoLiteralError,
+    GoMismatchedTypesError,
+    GoTypeMismatchError,
+    GoUntypedNilError,
+    _go_float_value,
+    _go_int_value,
+)
 from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.go.generics import GoGenericError
 from interpreter.instructions import InstructionBase
-from interpreter.ir import Opcode
+from interpreter.ir import Opcode, SpreadArguments
 from interpreter.parser import TreeSitterParserFactory
+from interpreter.type_name import TypeName
 from interpreter.types.type_environment_builder import TypeEnvironmentBuilder
+from interpreter.types.type_expr import array_of, scalar
 from tests.covers import NotLanguageFeature, covers
 
 
@@ -61,6 +86,46 @@ class TestGoFrontendShortVarDecl:
         name_stores = [s for s in stores if "name" in s.operands]
         assert len(name_stores) >= 1
 
+    @covers(GoFeature.SHORT_VAR_DECL)
+    @pytest.mark.parametrize(
+        "initializer, expected",
+        [
+            ("10", "Int"),
+            ("2.5", "Float"),
+            ("'x'", "Int"),
+            ('"hi"', "String"),
+            ("true", "Bool"),
+            ("1 + 2.5", "Float"),
+            ("7 / 2", "Int"),
+            ("(1 << 3) * 2", "Int"),
+            ("1 < 2", "Bool"),
+            ("limit * 2", "Float"),
+        ],
+    )
+    def test_untyped_constant_takes_its_default_type(self, initializer, expected):
+        source = (
+            "package main; const limit = 1.5; "
+            f"func main() {{ x := {initializer} }}"
+        )
+        _, builder = _parse_go_with_types(source)
+        assert builder.var_types["x"] == scalar(expected)
+
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_typed_operand_gives_the_expression_its_type(self):
+        source = """\
+package main
+func main() {
+    var n float64 = 2
+    m := n * 2
+    k := -n
+    ok := n > 1 && m < 5
+}
+"""
+        _, builder = _parse_go_with_types(source)
+        assert builder.var_types["m"] == scalar("Float")
+        assert builder.var_types["k"] == scalar("Float")
+        assert builder.var_types["ok"] == scalar("Bool")
+
 
 class TestGoFrontendAssignment:
     @covers(GoFeature.ASSIGNMENT)
@@ -82,6 +147,73 @@ class TestGoFrontendAssignment:
         assert len(binops) >= 1
         assert "+" in binops[0].operands
 
+    @covers(GoFeature.COMPOUND_ASSIGNMENT)
+    def test_compound_assignment_reads_combines_and_stores(self):
+        source = "package main; func main() { total := 1; total += 5 }"
+        ir = _parse_and_lower(source)
+        binops = _find_all(ir, Opcode.BINOP)
+        assert any("+" in inst.operands for inst in binops)
+        loads = _find_all(ir, Opcode.LOAD_VAR)
+        assert any("total" in inst.operands for inst in loads)
+        stores = _find_all(ir, Opcode.STORE_VAR)
+        assert any("total" in inst.operands for inst in stores)
+
+    @covers(GoFeature.COMPOUND_ASSIGNMENT)
+    def test_each_compound_operator_maps_to_its_binop(self):
+        source = """\
+package main
+func main() {
+    x := 100
+    x -= 1
+    x *= 2
+    x /= 3
+    x %= 7
+    x <<= 1
+}
+"""
+        ir = _parse_and_lower(source)
+        operators = [inst.operands[0] for inst in _find_all(ir, Opcode.BINOP)]
+        for op in ("-", "*", "<<"):
+            assert op in operators
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        for builtin in ("__go_quo__", "__go_rem__"):
+            assert any(builtin in inst.operands for inst in calls)
+
+    @covers(GoFeature.COMPOUND_ASSIGNMENT)
+    def test_compound_assignment_to_constant_rejected(self):
+        source = "package main; const limit = 3; func main() { limit += 1 }"
+        with pytest.raises(GoConstantAssignmentError):
+            _parse_and_lower(source)
+
+
+class TestGoLogicalOperators:
+    @covers(GoFeature.LOGICAL_OPERATORS)
+    def test_and_branches_instead_of_binop(self):
+        source = "package main; func main() { a := true; b := false; c := a && b }"
+        ir, builder = _parse_go_with_types(source)
+        operators = [inst.operands[0] for inst in _find_all(ir, Opcode.BINOP)]
+        assert "&&" not in operators
+        assert _find_all(ir, Opcode.BRANCH_IF)
+        assert builder.var_types["c"] == scalar("Bool")
+
+    @covers(GoFeature.LOGICAL_OPERATORS)
+    def test_right_operand_lowered_after_branch(self):
+        source = """\
+package main
+func check() bool { return true }
+func main() {
+    ok := false || check()
+}
+"""
+        ir = _parse_and_lower(source)
+        opcodes = [inst.opcode for inst in ir]
+        call_index = next(
+            i
+            for i, inst in enumerate(ir)
+            if inst.opcode == Opcode.CALL_FUNCTION and "check" in inst.operands
+        )
+        assert Opcode.BRANCH_IF in opcodes[:call_index]
+
 
 class TestGoFrontendFunctionDecl:
     @covers(GoFeature.FUNCTION_DECLARATION)
@@ -166,6 +298,26 @@ func f() {
             label_set
         ), f"Unreachable targets: {branch_targets - label_set}"
 
+    @covers(GoFeature.IF_ELSE)
+    def test_init_variable_shadowing_outer_is_mangled(self):
+        source = """\
+package main
+func main() {
+    x := 1
+    if x := 2; x > 1 {
+        y := x
+    }
+    z := x
+}
+"""
+        ir = _parse_and_lower(source)
+        decls = [str(inst.operands[0]) for inst in _find_all(ir, Opcode.DECL_VAR)]
+        assert "x" in decls
+        assert any(name.startswith("x$") for name in decls)
+        loads = [str(inst.operands[0]) for inst in _find_all(ir, Opcode.LOAD_VAR)]
+        assert "x" in loads
+        assert any(name.startswith("x$") for name in loads)
+
 
 class TestGoFrontendForLoop:
     @covers(GoFeature.FOR_LOOP)
@@ -200,6 +352,115 @@ func main() {
         assert Opcode.BRANCH in opcodes
 
 
+class TestGoFrontendForRange:
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_materialises_keys(self):
+        source = """package main
+func main() {
+    for i, c := range "abc" {
+        x := i + c
+    }
+}"""
+        ir = _parse_and_lower(source)
+        calls = [str(inst.func_name) for inst in _find_all(ir, Opcode.CALL_FUNCTION)]
+        assert "__go_range_keys__" in calls
+        assert "__go_range_value__" in calls
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_index_only_skips_value_fetch(self):
+        source = """package main
+func main() {
+    for i := range "abcde" {
+        x := i
+    }
+}"""
+        ir = _parse_and_lower(source)
+        calls = [str(inst.func_name) for inst in _find_all(ir, Opcode.CALL_FUNCTION)]
+        assert "__go_range_keys__" in calls
+        assert "__go_range_value__" not in calls
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_integer_range_counts_without_materialising_keys(self):
+        source = """package main
+func main() {
+    n := 4
+    xs := []int{1, 2}
+    for i := range 5 {
+        x := i
+    }
+    for range n {
+    }
+    for j := range len(xs) {
+        y := j
+    }
+}"""
+        ir = _parse_and_lower(source)
+        calls = [str(inst.func_name) for inst in _find_all(ir, Opcode.CALL_FUNCTION)]
+        labels = [str(inst.label) for inst in _find_all(ir, Opcode.LABEL)]
+        assert "__go_range_keys__" not in calls
+        assert len([l for l in labels if "range_cond" in l]) == 3
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_with_assign_stores_existing_vars(self):
+        source = """package main
+func main() {
+    k := 0
+    for k = range "ab" {
+    }
+}"""
+        ir = _parse_and_lower(source)
+        stores = _find_all(ir, Opcode.STORE_VAR)
+        assert any(str(s.name) == "k" for s in stores)
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_bare_range_without_variables(self):
+        source = """package main
+func main() {
+    n := 0
+    for range 3 {
+        n = n + 1
+    }
+}"""
+        ir = _parse_and_lower(source)
+        labels = [str(inst.label) for inst in _find_all(ir, Opcode.LABEL)]
+        assert any("range_cond" in l for l in labels)
+        assert any("range_end" in l for l in labels)
+
+
+class TestGoFrontendBreakContinue:
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_continue_in_type_switch_targets_loop(self):
+        source = """package main
+func main() {
+    for i := 0; i < 3; i++ {
+        switch v := x.(type) {
+        case int:
+            continue
+        }
+    }
+}"""
+        ir = _parse_and_lower(source)
+        branch_targets = [str(inst.label) for inst in _find_all(ir, Opcode.BRANCH)]
+        assert any("for_update" in t for t in branch_targets)
+
+    @covers(GoFeature.BREAK_CONTINUE)
+    def test_break_in_select_targets_select_end(self):
+        source = """package main
+func main() {
+    for {
+        select {
+        default:
+            break
+        }
+    }
+}"""
+        ir = _parse_and_lower(source)
+        labels = _labels_in_order(ir)
+        select_end = next(l for l in labels if "select_end" in l)
+        branch_targets = [str(inst.label) for inst in _find_all(ir, Opcode.BRANCH)]
+        assert select_end in branch_targets
+
+
 class TestGoFrontendSelectorExpression:
     @covers(GoFeature.FIELD_ACCESS)
     def test_field_access_produces_load_field(self):
@@ -213,7 +474,7 @@ class TestGoFrontendSelectorExpression:
 class TestGoFrontendMethodCall:
     @covers(GoFeature.METHOD_CALL)
     def test_method_call_produces_call_method(self):
-        source = 'package main; import "fmt"; func main() { fmt.Println("hello") }'
+        source = 'package main; func main() { w := Writer{}; w.Println("hello") }'
         ir = _parse_and_lower(source)
         method_calls = _find_all(ir, Opcode.CALL_METHOD)
         assert len(method_calls) >= 1
@@ -256,6 +517,26 @@ class TestGoFrontendIncDec:
         minus_ops = [b for b in binops if "-" in b.operands]
         assert len(minus_ops) >= 1
 
+    @covers(GoFeature.INC_DEC)
+    def test_inc_of_map_entry_reads_through_map_get(self):
+        source = """\
+package main
+func main() {
+    m := map[string]int{}
+    m["a"]++
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_map_get__" in inst.operands for inst in calls)
+        assert _find_all(ir, Opcode.STORE_INDEX)
+
+    @covers(GoFeature.INC_DEC)
+    def test_inc_of_constant_rejected(self):
+        source = "package main; const n = 1; func main() { n++ }"
+        with pytest.raises(GoConstantAssignmentError):
+            _parse_and_lower(source)
+
 
 class TestGoFrontendReturn:
     @covers(GoFeature.RETURN)
@@ -288,8 +569,9 @@ func swap(a int, b int) (int, int) {
 }"""
         ir = _parse_and_lower(source)
         returns = _find_all(ir, Opcode.RETURN)
-        # Multiple return values produce multiple RETURN instructions
-        assert len(returns) >= 2
+        # The results are packed into one tuple, returned by a single RETURN
+        assert len(returns) == 1
+        assert len(_find_all(ir, Opcode.STORE_INDEX)) == 2
         loads = _find_all(ir, Opcode.LOAD_VAR)
         assert any("b" in inst.operands for inst in loads)
         assert any("a" in inst.operands for inst in loads)
@@ -301,7 +583,7 @@ class TestGoFrontendFallback:
         source = "package main; func main() { go func() {} () }"
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("go" in inst.operands for inst in calls)
+        assert any("__go_spawn__" in inst.operands for inst in calls)
 
     @covers(GoFeature.FUNCTION_DECLARATION)
     def test_entry_label_always_present(self):
@@ -370,11 +652,11 @@ func divide(a int, b int) (int, int) {
 """
         ir = _parse_and_lower(source)
         returns = _find_all(ir, Opcode.RETURN)
-        assert len(returns) >= 2
-        binops = _find_all(ir, Opcode.BINOP)
-        operators = [inst.operands[0] for inst in binops if inst.operands]
-        assert "/" in operators
-        assert "%" in operators
+        assert len(returns) == 1
+        assert len(_find_all(ir, Opcode.NEW_ARRAY)) == 1
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_quo__" in inst.operands for inst in calls)
+        assert any("__go_rem__" in inst.operands for inst in calls)
 
     @covers(GoFeature.FIELD_ACCESS)
     def test_nested_for_with_field_access(self):
@@ -460,7 +742,7 @@ func quadruple(x int) int {
         assert len(returns) >= 2
 
     @covers(GoFeature.DEFER)
-    def test_defer_produces_call_function(self):
+    def test_defer_pushes_onto_defer_stack(self):
         source = """\
 package main
 func main() {
@@ -471,7 +753,8 @@ func main() {
 """
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("defer" in inst.operands for inst in calls)
+        assert any("__go_append__" in inst.operands for inst in calls)
+        assert not any("defer" in inst.operands for inst in calls)
 
 
 class TestGoCompositeLiteral:
@@ -500,12 +783,97 @@ func main() { nums := []int{1, 2, 3} }
 """
         ir = _parse_and_lower(source)
         opcodes = _opcodes(ir)
-        assert Opcode.NEW_OBJECT in opcodes
+        assert Opcode.NEW_ARRAY in opcodes
         assert Opcode.STORE_INDEX in opcodes
         store_indices = _find_all(ir, Opcode.STORE_INDEX)
         assert len(store_indices) >= 3
 
 
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_elided_element_literals_take_the_element_type(self):
+        ir = _parse_and_lower(
+            "package main\ntype Point struct { X, Y int }\n"
+            "func main() { ps := []*Point{{1, 2}, {X: 3}} }"
+        )
+        points = [
+            inst
+            for inst in _find_all(ir, Opcode.NEW_OBJECT)
+            if str(inst.type_hint) == "Point"
+        ]
+        assert len(points) == 2
+
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_elided_map_value_and_nested_slice_literals(self):
+        ir = _parse_and_lower(
+            "package main\ntype Point struct { X, Y int }\n"
+            "func main() {\n"
+            '    m := map[string]Point{"a": {1, 2}}\n'
+            "    grid := [][]int{{1, 2}, {3}}\n}"
+        )
+        assert any(
+            str(inst.type_hint) == "Point" for inst in _find_all(ir, Opcode.NEW_OBJECT)
+        )
+        assert len(_find_all(ir, Opcode.NEW_ARRAY)) == 3
+
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_slice_element_of_wrong_type_is_rejected(self):
+        with pytest.raises(
+            GoCompositeLiteralError,
+            match=r'cannot use "two" \(untyped string constant\) as int value '
+            r"in array or slice literal",
+        ):
+            _parse_and_lower('package main\nfunc main() { xs := []int{1, "two"} }')
+
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_untyped_numeric_constants_fit_numeric_elements(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { xs := []float64{1, 'a', 2.5}\n"
+            " ys := []int{2.0} }"
+        )
+        assert len(_find_all(ir, Opcode.NEW_ARRAY)) == 2
+
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_fractional_constant_in_int_slice_is_rejected(self):
+        with pytest.raises(GoCompositeLiteralError, match="untyped float constant"):
+            _parse_and_lower("package main\nfunc main() { xs := []int{1.5} }")
+
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_map_key_and_value_are_checked(self):
+        with pytest.raises(GoCompositeLiteralError, match="as string value in map"):
+            _parse_and_lower(
+                "package main\nfunc main() { m := map[string]int{1: 2} }"
+            )
+        with pytest.raises(GoCompositeLiteralError, match="variable of type string"):
+            _parse_and_lower(
+                "package main\nfunc main() { var s string\n"
+                ' m := map[string]int{"a": s} }'
+            )
+
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_struct_field_of_wrong_type_is_rejected(self):
+        with pytest.raises(
+            GoCompositeLiteralError, match="as int value in struct literal"
+        ):
+            _parse_and_lower(
+                "package main\ntype Point struct { X, Y int }\n"
+                'func main() { p := Point{X: "1"} }'
+            )
+
+    @covers(GoFeature.COMPOSITE_LITERAL)
+    def test_unknown_field_and_surplus_values_are_rejected(self):
+        point = "package main\ntype Point struct { X, Y int }\n"
+        with pytest.raises(
+            GoCompositeLiteralError,
+            match="unknown field Z in struct literal of type Point",
+        ):
+            _parse_and_lower(point + "func main() { p := Point{Z: 1} }")
+        with pytest.raises(
+            GoCompositeLiteralError,
+            match="too many values in struct literal of type Point",
+        ):
+            _parse_and_lower(point + "func main() { p := Point{1, 2, 3} }")
+
+
 class TestGoTypeAssertionExpression:
     @covers(GoFeature.TYPE_ASSERTION)
     def test_type_assertion_produces_call_function(self):
@@ -518,7 +886,7 @@ func main() {
 """
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("type_assert" in inst.operands for inst in calls)
+        assert any("__go_type_is__" in inst.operands for inst in calls)
 
     @covers(GoFeature.TYPE_ASSERTION)
     def test_type_assertion_includes_type(self):
@@ -531,8 +899,8 @@ func main() {
 """
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        ta_calls = [c for c in calls if "type_assert" in c.operands]
-        assert any("int" in str(inst.operands) for inst in ta_calls)
+        assert any("__go_type_is__" in inst.operands for inst in calls)
+        assert any(inst.value == "Int" for inst in _find_all(ir, Opcode.CONST))
 
 
 class TestGoSliceExpression:
@@ -547,21 +915,38 @@ func main() {
 """
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("slice" in inst.operands for inst in calls)
+        assert any("__go_slice_in_bounds__" in c.operands for c in calls)
+        assert any("__go_slice__" in c.operands for c in calls)
+        assert len(_find_all(ir, Opcode.THROW)) == 1
 
     @covers(GoFeature.SLICE_EXPRESSION)
-    def test_slice_with_indices(self):
+    def test_string_slice_is_bounds_checked(self):
         source = """\
 package main
 func main() {
     s := "hello"
     t := s[0:2]
 }
+"""
+        ir, builder = _parse_go_with_types(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_slice_in_bounds__" in c.operands for c in calls)
+        assert any("__go_string_slice__" in c.operands for c in calls)
+        assert not any("slice" in c.operands for c in calls)
+        assert len(_find_all(ir, Opcode.THROW)) == 1
+        assert builder.var_types["t"] == scalar("String")
+
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_string_parameter_slice_without_start(self):
+        source = """\
+package main
+func head(s string) string {
+    return s[:2]
+}
 """
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        slice_calls = [c for c in calls if "slice" in c.operands]
-        assert len(slice_calls) >= 1
+        assert any("__go_string_slice__" in c.operands for c in calls)
 
     @covers(GoFeature.SLICE_EXPRESSION)
     def test_slice_without_end(self):
@@ -574,7 +959,24 @@ func main() {
 """
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("slice" in inst.operands for inst in calls)
+        assert any("__go_slice__" in inst.operands for inst in calls)
+
+    @covers(GoFeature.SLICE_EXPRESSION)
+    def test_full_slice_expression_passes_max(self):
+        source = """\
+package main
+func main() {
+    a := []int{1, 2, 3}
+    b := a[0:1:2]
+}
+"""
+        ir = _parse_and_lower(source)
+        (call,) = [
+            c
+            for c in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "__go_slice__" in c.operands
+        ]
+        assert len(call.operands) == 5
 
 
 class TestGoFuncLiteral:
@@ -617,22 +1019,59 @@ func main() {
         ]
         assert len(param_symbolics) >= 2
 
+    @covers(GoFeature.FUNC_LITERAL)
+    def test_func_literal_seeds_return_type(self):
+        _, builder = _parse_go_with_types(
+            "package main\nfunc main() { f := func(x int) bool { return x > 0 } }"
+        )
+        rt = {k: v for k, v in builder.func_return_types.items() if "__anon" in k}
+        assert list(rt.values()) == [scalar(TypeName("Bool"))]
+
+    @covers(GoFeature.CLOSURE)
+    def test_assignment_in_func_literal_targets_shadowed_variable(self):
+        source = """\
+package main
+func main() {
+    x := 1
+    if true {
+        x := 2
+        f := func() { x = 3 }
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        stores = [str(inst.name) for inst in _find_all(ir, Opcode.STORE_VAR)]
+        assert stores == ["x$1"]
+
 
 class TestGoDeferStatement:
     @covers(GoFeature.DEFER)
-    def test_defer_produces_defer_call(self):
+    def test_defer_packs_thunk_and_arguments(self):
         source = """\
 package main
 func main() {
-    defer close()
+    x := 1
+    defer show(x, 2)
 }
 """
         ir = _parse_and_lower(source)
-        calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("defer" in inst.operands for inst in calls)
+        entries = [
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "arrayOf" in inst.operands and inst.args
+        ]
+        assert len(entries) == 1
+        assert len(entries[0].args) == 3
+        thunk_calls = [
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "show" in inst.operands
+        ]
+        assert len(thunk_calls) == 1
+        assert isinstance(thunk_calls[0].args[0], SpreadArguments)
 
     @covers(GoFeature.DEFER)
-    def test_defer_method_call(self):
+    def test_defer_method_call_captures_receiver(self):
         source = """\
 package main
 func main() {
@@ -641,90 +1080,365 @@ func main() {
 }
 """
         ir = _parse_and_lower(source)
-        calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("defer" in inst.operands for inst in calls)
+        closes = [
+            inst
+            for inst in _find_all(ir, Opcode.CALL_METHOD)
+            if "Close" in inst.operands
+        ]
+        assert len(closes) == 1
+        entries = [
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "arrayOf" in inst.operands and inst.args
+        ]
+        assert len(entries[0].args) == 2
 
+    @covers(GoFeature.DEFER)
+    def test_return_branches_to_defer_exit(self):
+        source = """\
+package main
+func f() int {
+    defer cleanup()
+    return 7
+}
+"""
+        ir = _parse_and_lower(source)
+        exits = [
+            inst.label
+            for inst in _find_all(ir, Opcode.LABEL)
+            if str(inst.label).startswith("defer_exit")
+        ]
+        assert len(exits) == 1
+        branches = [
+            inst for inst in _find_all(ir, Opcode.BRANCH) if inst.label == exits[0]
+        ]
+        assert len(branches) == 2
+        assert any(
+            inst.opcode == Opcode.CALL_UNKNOWN
+            and any(isinstance(a, SpreadArguments) for a in inst.args)
+            for inst in ir
+        )
 
-class TestGoGoStatement:
-    @covers(GoFeature.GO_STATEMENT)
-    def test_go_statement_produces_go_call(self):
+    @covers(GoFeature.DEFER)
+    def test_function_without_defer_returns_directly(self):
+        ir = _parse_and_lower("package main\nfunc f() int {\n    return 7\n}")
+        labels = _find_all(ir, Opcode.LABEL)
+        assert not any(str(inst.label).startswith("defer_exit") for inst in labels)
+
+    @covers(GoFeature.DEFER)
+    def test_bare_return_returns_named_result(self):
         source = """\
 package main
-func main() {
-    go doWork()
+func f() (n int) {
+    n = 3
+    return
 }
 """
         ir = _parse_and_lower(source)
+        decls = [i for i in _find_all(ir, Opcode.DECL_VAR) if "n" in i.operands]
+        assert len(decls) == 1
+        loads = [i for i in _find_all(ir, Opcode.LOAD_VAR) if "n" in i.operands]
+        returns = [inst for inst in _find_all(ir, Opcode.RETURN) if not inst.implicit]
+        assert [r.value_reg for r in returns] == [loads[-1].result_reg]
+
+
+class TestGoPanicRecover:
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_panic_throws_its_argument(self):
+        ir = _parse_and_lower('package main\nfunc f() { panic("boom") }')
+        throws = _find_all(ir, Opcode.THROW)
+        assert len(throws) == 1
+        assert not any(
+            "panic" in inst.operands for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+        )
+
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_recover_lowers_to_builtin(self):
+        ir = _parse_and_lower("package main\nfunc f() { r := recover() }")
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("go" in inst.operands for inst in calls)
+        assert any("__go_recover__" in inst.operands for inst in calls)
 
-    @covers(GoFeature.GO_STATEMENT)
-    def test_go_with_func_literal(self):
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_function_with_defer_catches_panics_to_run_defers(self):
         source = """\
 package main
-func main() {
-    go func() { doSomething() }()
+func f() {
+    defer cleanup()
+    work()
 }
 """
         ir = _parse_and_lower(source)
+        pushes = _find_all(ir, Opcode.TRY_PUSH)
+        assert len(pushes) == 1
+        assert str(pushes[0].catch_labels[0]).startswith("defer_panic")
+        assert len(_find_all(ir, Opcode.TRY_POP)) == 1
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_panicking__" in inst.operands for inst in calls)
+        assert len(_find_all(ir, Opcode.THROW)) == 1
+
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_function_without_defer_has_no_handler(self):
+        ir = _parse_and_lower("package main\nfunc f() { work() }")
+        assert not _find_all(ir, Opcode.TRY_PUSH)
+
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_main_converts_uncaught_panic_and_halts(self):
+        ir = _parse_and_lower("package main\nfunc main() { x := 1 }")
+        pushes = _find_all(ir, Opcode.TRY_PUSH)
+        assert len(pushes) == 1
+        assert str(pushes[0].catch_labels[0]).startswith("go_uncaught_panic")
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("go" in inst.operands for inst in calls)
+        assert any("__go_runtime_error__" in inst.operands for inst in calls)
+        assert any(
+            "__go_panic__" in inst.operands for inst in _find_all(ir, Opcode.DECL_VAR)
+        )
+        assert len(_find_all(ir, Opcode.HALT)) == 1
 
 
-class TestGoExpressionSwitch:
-    @covers(GoFeature.SWITCH_STATEMENT)
-    def test_switch_produces_branch_if(self):
+class TestGoGoStatement:
+    @covers(GoFeature.GO_STATEMENT)
+    def test_go_statement_spawns_goroutine(self):
         source = """\
 package main
 func main() {
-    x := 1
-    switch x {
-    case 1:
-        y := 10
-    case 2:
-        y := 20
-    default:
-        y := 0
-    }
+    go doWork()
 }
 """
         ir = _parse_and_lower(source)
-        branches = _find_all(ir, Opcode.BRANCH_IF)
-        assert len(branches) >= 2
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_spawn__" in inst.operands for inst in calls)
 
-    @covers(GoFeature.SWITCH_STATEMENT)
-    def test_switch_case_bodies_lowered(self):
+    @covers(GoFeature.GO_STATEMENT)
+    def test_go_with_func_literal(self):
         source = """\
 package main
 func main() {
-    switch x {
-    case 1:
-        result := "one"
-    case 2:
-        result := "two"
-    }
+    go func() { doSomething() }()
 }
 """
         ir = _parse_and_lower(source)
-        stores = _find_all(ir, Opcode.DECL_VAR)
-        assert any("result" in inst.operands for inst in stores)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_spawn__" in inst.operands for inst in calls)
 
-    @covers(GoFeature.SWITCH_STATEMENT)
-    def test_switch_end_label(self):
+
+    @covers(GoFeature.GO_STATEMENT)
+    def test_goroutine_entry_block_calls_and_exits(self):
         source = """\
 package main
 func main() {
-    switch x {
-    case 1:
-        y := 1
-    }
+    go work(1, 2)
 }
 """
         ir = _parse_and_lower(source)
-        labels = _labels_in_order(ir)
-        assert any("switch_end" in lbl for lbl in labels)
-
-
+        consts = [str(inst.value) for inst in _find_all(ir, Opcode.CONST)]
+        starts = [
+            str(inst.label)
+            for inst in _find_all(ir, Opcode.LABEL)
+            if str(inst.label).startswith("goroutine_start")
+        ]
+        assert len(starts) == 1
+        assert any(starts[0] in value for value in consts)
+        assert any(
+            "__go_entry__" in inst.operands for inst in _find_all(ir, Opcode.LOAD_VAR)
+        )
+        assert any(
+            isinstance(a, SpreadArguments)
+            for inst in _find_all(ir, Opcode.CALL_UNKNOWN)
+            for a in inst.args
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_exit__" in inst.operands for inst in calls)
+
+    @covers(GoFeature.GO_STATEMENT)
+    def test_goroutine_panic_handler_halts(self):
+        ir = _parse_and_lower("package main\nfunc f() { go work() }")
+        pushes = _find_all(ir, Opcode.TRY_PUSH)
+        assert len(pushes) == 1
+        assert str(pushes[0].catch_labels[0]).startswith("goroutine_panic")
+        assert len(_find_all(ir, Opcode.HALT)) == 1
+
+
+class TestGoChannelOps:
+    @covers(GoFeature.MAKE)
+    def test_make_chan_records_capacity_and_zero(self):
+        ir = _parse_and_lower("package main\nfunc f() { ch := make(chan int, 3) }")
+        assert len(_find_all(ir, Opcode.NEW_OBJECT)) == 1
+        stored = {str(inst.field_name) for inst in _find_all(ir, Opcode.STORE_FIELD)}
+        assert {"length", "capacity", "zero"} <= stored
+
+    @covers(GoFeature.RECEIVE_STATEMENT)
+    def test_receive_expression_lowers_to_go_chan_recv(self):
+        ir = _parse_and_lower(
+            "package main\nfunc f() { ch := make(chan int)\n v := <-ch }"
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_chan_recv__" in inst.operands for inst in calls)
+        assert not _find_all(ir, Opcode.UNOP)
+
+    @covers(GoFeature.RECEIVE_STATEMENT)
+    def test_comma_ok_receive(self):
+        ir = _parse_and_lower(
+            "package main\nfunc f() { ch := make(chan int)\n v, ok := <-ch }"
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_chan_recv_ok__" in inst.operands for inst in calls)
+        decls = {str(inst.operands[0]) for inst in _find_all(ir, Opcode.DECL_VAR)}
+        assert {"v", "ok"} <= decls
+
+    @covers(GoFeature.SEND_STATEMENT)
+    def test_send_on_closed_channel_panics(self):
+        ir = _parse_and_lower("package main\nfunc f() { ch <- 1 }")
+        assert len(_find_all(ir, Opcode.THROW)) == 1
+        assert any(
+            "send on closed channel" in str(inst.value)
+            for inst in _find_all(ir, Opcode.CONST)
+        )
+
+    @covers(GoFeature.CHANNEL_TYPE)
+    def test_close_lowers_to_go_chan_close(self):
+        ir = _parse_and_lower("package main\nfunc f() { close(ch) }")
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_chan_close__" in inst.operands for inst in calls)
+        assert len(_find_all(ir, Opcode.THROW)) == 1
+
+    @covers(GoFeature.FOR_RANGE)
+    def test_range_over_channel_receives_until_closed(self):
+        source = """\
+package main
+func f(ch chan int) {
+    for v := range ch {
+        use(v)
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_chan_recv_ok__" in inst.operands for inst in calls)
+        assert not any("__go_range_keys__" in inst.operands for inst in calls)
+
+
+class TestGoExpressionSwitch:
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_switch_produces_branch_if(self):
+        source = """\
+package main
+func main() {
+    x := 1
+    switch x {
+    case 1:
+        y := 10
+    case 2:
+        y := 20
+    default:
+        y := 0
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        branches = _find_all(ir, Opcode.BRANCH_IF)
+        assert len(branches) >= 2
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_switch_case_bodies_lowered(self):
+        source = """\
+package main
+func main() {
+    switch x {
+    case 1:
+        result := "one"
+    case 2:
+        result := "two"
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        stores = _find_all(ir, Opcode.DECL_VAR)
+        assert any("result" in inst.operands for inst in stores)
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_switch_end_label(self):
+        source = """\
+package main
+func main() {
+    switch x {
+    case 1:
+        y := 1
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        labels = _labels_in_order(ir)
+        assert any("switch_end" in lbl for lbl in labels)
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_multi_value_case_compares_every_value(self):
+        source = """\
+package main
+func main() {
+    x := 3
+    switch x {
+    case 1, 2, 3:
+        y := 1
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        eq_binops = [
+            inst for inst in _find_all(ir, Opcode.BINOP) if inst.operator == "=="
+        ]
+        assert len(eq_binops) == 3
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_tagless_switch_branches_on_case_condition(self):
+        source = """\
+package main
+func main() {
+    x := 3
+    switch {
+    case x > 2:
+        y := 1
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        eq_binops = [
+            inst for inst in _find_all(ir, Opcode.BINOP) if inst.operator == "=="
+        ]
+        gt_binop = next(
+            inst for inst in _find_all(ir, Opcode.BINOP) if inst.operator == ">"
+        )
+        branch = next(iter(_find_all(ir, Opcode.BRANCH_IF)))
+        assert eq_binops == []
+        assert branch.cond_reg == gt_binop.result_reg
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_default_dispatched_after_all_cases(self):
+        source = """\
+package main
+func main() {
+    x := 2
+    switch x {
+    default:
+        y := 0
+    case 1:
+        y := 1
+    case 2:
+        y := 2
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        first_body = next(
+            i
+            for i, inst in enumerate(ir)
+            if inst.opcode == Opcode.LABEL and "case_body" in str(inst.label)
+        )
+        branch_ifs_before_bodies = [
+            inst for inst in ir[:first_body] if inst.opcode == Opcode.BRANCH_IF
+        ]
+        assert len(branch_ifs_before_bodies) == 2
+
+
 class TestGoTypeSwitchStatement:
     @covers(GoFeature.TYPE_SWITCH)
     def test_type_switch_produces_type_check(self):
@@ -742,7 +1456,8 @@ func main() {
 """
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("type_check" in inst.operands for inst in calls)
+        checks = [inst for inst in calls if "__go_type_is__" in inst.operands]
+        assert len(checks) == 2
 
     @covers(GoFeature.TYPE_SWITCH)
     def test_type_switch_produces_branches(self):
@@ -795,10 +1510,65 @@ func main() {
         labels = _labels_in_order(ir)
         assert any("select_end" in lbl for lbl in labels)
 
+    @covers(GoFeature.SELECT_STATEMENT)
+    def test_select_evaluates_channels_before_choosing(self):
+        source = """\
+package main
+func f(a chan int, b chan int) {
+    select {
+    case v := <-a:
+        x := v
+    case b <- 1:
+        y := 1
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        names = [str(inst.func_name) for inst in calls]
+        select_at = names.index("__go_select__")
+        assert len(calls[select_at].args) == 5
+        assert names.index("__go_chan_recv__") > select_at
+        assert names.index("__go_chan_send__") > select_at
+
+    @covers(GoFeature.SELECT_STATEMENT)
+    def test_select_comma_ok_receive_declares_both(self):
+        source = """\
+package main
+func f(ch chan int) {
+    select {
+    case v, ok := <-ch:
+        use(v, ok)
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_chan_recv_ok__" in inst.operands for inst in calls)
+        decls = {str(inst.operands[0]) for inst in _find_all(ir, Opcode.DECL_VAR)}
+        assert {"v", "ok"} <= decls
+
+    @covers(GoFeature.SELECT_STATEMENT)
+    def test_select_default_only_runs_without_communication(self):
+        source = """\
+package main
+func f() {
+    select {
+    default:
+        x := 1
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        (select_call,) = [c for c in calls if str(c.func_name) == "__go_select__"]
+        assert len(select_call.args) == 1
+        assert not any("__go_chan" in str(c.func_name) for c in calls)
+
 
 class TestGoSendStatement:
     @covers(GoFeature.SEND_STATEMENT)
-    def test_send_produces_chan_send(self):
+    def test_send_produces_go_chan_send(self):
         source = """\
 package main
 func main() {
@@ -807,7 +1577,7 @@ func main() {
 """
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("chan_send" in inst.operands for inst in calls)
+        assert any("__go_chan_send__" in inst.operands for inst in calls)
 
     @covers(GoFeature.SEND_STATEMENT)
     def test_send_with_variable(self):
@@ -819,7 +1589,7 @@ func main() {
 """
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("chan_send" in inst.operands for inst in calls)
+        assert any("__go_chan_send__" in inst.operands for inst in calls)
 
 
 class TestGoLabeledStatement:
@@ -831,6 +1601,7 @@ func main() {
     outer:
     for i := 0; i < 10; i++ {
         x := i
+        break outer
     }
 }
 """
@@ -845,6 +1616,9 @@ package main
 func main() {
     myLabel:
     x := 42
+    if x < 0 {
+        goto myLabel
+    }
 }
 """
         ir = _parse_and_lower(source)
@@ -854,6 +1628,77 @@ func main() {
         assert any("x" in inst.operands for inst in stores)
 
 
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_labeled_break_and_continue_target_the_outer_loop(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() {\n"
+            "outer:\n"
+            "    for i := 0; i < 3; i++ {\n"
+            "        for j := 0; j < 3; j++ {\n"
+            "            if j == 1 { continue outer }\n"
+            "            if i == 2 { break outer }\n"
+            "        }\n"
+            "    }\n}"
+        )
+        labels = _labels_in_order(ir)
+        outer_update = min(
+            (lbl for lbl in labels if lbl.startswith("for_update")),
+            key=lambda lbl: int(lbl.rsplit("_", 1)[1]),
+        )
+        outer_end = [lbl for lbl in labels if lbl.startswith("for_end")][-1]
+        targets = {str(inst.label) for inst in _find_all(ir, Opcode.BRANCH)}
+        assert {outer_update, outer_end} <= targets
+
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_same_label_in_two_functions_gets_distinct_ir_labels(self):
+        body = "{\nloop:\n    for { break loop }\n}\n"
+        ir = _parse_and_lower("package main\nfunc a() " + body + "func b() " + body)
+        labels = [lbl for lbl in _labels_in_order(ir) if lbl.startswith("label_loop")]
+        assert len(labels) == len(set(labels)) == 2
+
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_unused_label_is_rejected(self):
+        with pytest.raises(GoLabelError, match="label outer defined and not used"):
+            _parse_and_lower(
+                "package main\nfunc main() {\nouter:\n    for { break }\n}"
+            )
+
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_undefined_label_is_rejected(self):
+        with pytest.raises(GoLabelError, match="label missing not defined"):
+            _parse_and_lower("package main\nfunc main() { for { break missing } }")
+
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_break_to_label_outside_its_statement_is_rejected(self):
+        with pytest.raises(GoLabelError, match="invalid break label done"):
+            _parse_and_lower(
+                "package main\nfunc main() {\n"
+                "done:\n    for { break done }\n"
+                "    for { break done }\n}"
+            )
+
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_continue_to_labeled_switch_is_rejected(self):
+        with pytest.raises(GoLabelError, match="invalid continue label sw"):
+            _parse_and_lower(
+                "package main\nfunc main() {\n    x := 1\n"
+                "    for {\n"
+                "    sw:\n        switch x {\n"
+                "        case 1:\n            continue sw\n        }\n"
+                "    }\n}"
+            )
+
+    @covers(GoFeature.LABELED_STATEMENT)
+    def test_labels_are_scoped_to_their_function(self):
+        with pytest.raises(GoLabelError, match="label outer not defined"):
+            _parse_and_lower(
+                "package main\nfunc main() {\n"
+                "outer:\n    for {\n"
+                "        f := func() { goto outer }\n"
+                "        break outer\n    }\n}"
+            )
+
+
 class TestGoConstDeclaration:
     @covers(GoFeature.CONST_DECLARATION)
     def test_const_with_value(self):
@@ -889,6 +1734,153 @@ const X int = 10
         stores = _find_all(ir, Opcode.DECL_VAR)
         assert any("X" in inst.operands for inst in stores)
 
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_const_expression_folded_to_single_const(self):
+        source = """\
+package main
+const KB = 1 << 10
+const MB = KB * 1024
+"""
+        ir = _parse_and_lower(source)
+        assert not _find_all(ir, Opcode.BINOP)
+        values = [inst.value for inst in _find_all(ir, Opcode.CONST)]
+        assert 1024 in values
+        assert 1048576 in values
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_const_multiple_names_in_one_spec(self):
+        source = """\
+package main
+const a, b = 1, "two"
+"""
+        ir = _parse_and_lower(source)
+        decls = {str(inst.operands[0]) for inst in _find_all(ir, Opcode.DECL_VAR)}
+        assert {"a", "b"} <= decls
+        values = [inst.value for inst in _find_all(ir, Opcode.CONST)]
+        assert "two" in values
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_typed_float_const_is_float(self):
+        source = """\
+package main
+const Half float64 = 1 / 2
+const Ratio float64 = 3
+"""
+        ir = _parse_and_lower(source)
+        values = [inst.value for inst in _find_all(ir, Opcode.CONST)]
+        assert any(isinstance(v, float) and v == 0.0 for v in values)
+        assert any(isinstance(v, float) and v == 3.0 for v in values)
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_assignment_to_const_rejected(self):
+        source = """\
+package main
+const Limit = 10
+func main() {
+    Limit = 20
+}
+"""
+        with pytest.raises(GoConstantAssignmentError, match="Limit"):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_increment_of_local_const_rejected(self):
+        source = """\
+package main
+func main() {
+    const n = 1
+    n++
+}
+"""
+        with pytest.raises(GoConstantAssignmentError, match="n"):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_parameter_hides_const(self):
+        source = """\
+package main
+const n = 1
+func f(n int) int {
+    n = n + 1
+    return n
+}
+"""
+        ir = _parse_and_lower(source)
+        stores = [str(inst.operands[0]) for inst in _find_all(ir, Opcode.STORE_VAR)]
+        assert "n" in stores
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_local_const_ends_with_its_block(self):
+        source = """\
+package main
+func f() {
+    if true {
+        const n = 1
+    }
+}
+func g() {
+    n := 0
+    n = 2
+}
+"""
+        ir = _parse_and_lower(source)
+        stores = [str(inst.operands[0]) for inst in _find_all(ir, Opcode.STORE_VAR)]
+        assert "n" in stores
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_constant_expressions_fold_outside_declarations(self):
+        source = """\
+package main
+const N = 4
+func main() {
+    secs := 60 * 60
+    greeting := "hello, " + "world"
+    big := N > 2
+    shifted := 1 << 100 >> 97
+}
+"""
+        ir = _parse_and_lower(source)
+        assert not _find_all(ir, Opcode.BINOP)
+        values = [inst.value for inst in _find_all(ir, Opcode.CONST)]
+        assert 3600 in values
+        assert "hello, world" in values
+        assert any(value is True for value in values)
+        assert 8 in values
+
+    @covers(GoFeature.CONST_DECLARATION)
+    @pytest.mark.parametrize(
+        "declaration, message",
+        [
+            ("const b byte = 256", "constant 256 overflows byte"),
+            ("const n int8 = -129", "constant -129 overflows int8"),
+            ("var u uint = -1", "constant -1 overflows uint"),
+            ("x := 1 << 63", "constant 9223372036854775808 overflows int"),
+            ("y := int8(300)", "constant 300 overflows int8"),
+            ("const huge = 1 << 600", "constant overflow"),
+        ],
+    )
+    def test_constant_that_does_not_fit_its_type_rejected(self, declaration, message):
+        source = f"package main\nfunc main() {{\n    {declaration}\n}}\n"
+        with pytest.raises(GoConstantOverflowError, match=message):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_constant_within_range_accepted(self):
+        source = """\
+package main
+const big = 1 << 100
+func main() {
+    const top uint64 = 1<<64 - 1
+    var b byte = 255
+    f := float64(big)
+    small := big >> 90
+}
+"""
+        ir = _parse_and_lower(source)
+        values = [inst.value for inst in _find_all(ir, Opcode.CONST)]
+        assert 2**64 - 1 in values
+        assert 1024 in values
+
 
 class TestGoGotoStatement:
     @covers(GoFeature.GOTO)
@@ -902,8 +1894,11 @@ func main() {
 }
 """
         ir = _parse_and_lower(source)
+        end_label = next(
+            lbl for lbl in _labels_in_order(ir) if lbl.startswith("label_end")
+        )
         branches = _find_all(ir, Opcode.BRANCH)
-        assert any(inst.label == "end" for inst in branches)
+        assert any(str(inst.label) == end_label for inst in branches)
 
     @covers(GoFeature.GOTO)
     def test_goto_with_label(self):
@@ -916,8 +1911,11 @@ func main() {
 }
 """
         ir = _parse_and_lower(source)
+        target = next(
+            lbl for lbl in _labels_in_order(ir) if lbl.startswith("label_myLabel")
+        )
         branches = _find_all(ir, Opcode.BRANCH)
-        assert any(inst.label == "myLabel" for inst in branches)
+        assert any(str(inst.label) == target for inst in branches)
 
 
 class TestGoVarDeclarationMultiName:
@@ -959,6 +1957,70 @@ class TestGoVarDeclarationMultiName:
         assert "z" in store_names
 
 
+class TestGoPackageInitialization:
+    @staticmethod
+    def _decl_order(ir: list[InstructionBase]) -> list[str]:
+        return [str(inst.operands[0]) for inst in _find_all(ir, Opcode.DECL_VAR)]
+
+    @covers(GoFeature.VAR_DECLARATION)
+    def test_package_vars_initialized_before_main_body(self):
+        source = """\
+package main
+func main() {
+    y := x
+}
+var x = 1
+"""
+        order = self._decl_order(_parse_and_lower(source))
+        assert order.index("x") < order.index("y")
+
+    @covers(GoFeature.VAR_DECLARATION)
+    def test_initializer_dependency_orders_vars(self):
+        source = """\
+package main
+var a = b + 1
+var b = 2
+func main() {}
+"""
+        order = self._decl_order(_parse_and_lower(source))
+        assert order.index("b") < order.index("a")
+
+    @covers(GoFeature.VAR_DECLARATION)
+    def test_dependency_through_function_call(self):
+        source = """\
+package main
+var total = sum()
+func sum() int { return base * 2 }
+var base = 21
+func main() {}
+"""
+        order = self._decl_order(_parse_and_lower(source))
+        assert order.index("base") < order.index("total")
+
+    @covers(GoFeature.VAR_DECLARATION)
+    def test_initialization_cycle_rejected(self):
+        source = "package main\nvar a = b\nvar b = a\nfunc main() {}"
+        with pytest.raises(
+            GoInitializationCycleError, match="a refers to b refers to a"
+        ):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.VAR_DECLARATION)
+    def test_function_local_is_not_a_dependency(self):
+        source = """\
+package main
+var a = f()
+func f() int {
+    b := 1
+    return b
+}
+var b = a
+func main() {}
+"""
+        order = self._decl_order(_parse_and_lower(source))
+        assert order.index("a") < order.index("b")
+
+
 class TestGoReceiveStatement:
     @covers(GoFeature.RECEIVE_STATEMENT)
     def test_receive_statement_no_symbolic(self):
@@ -971,14 +2033,14 @@ class TestGoReceiveStatement:
         assert not any("receive_statement" in str(inst.operands) for inst in symbolics)
 
     @covers(GoFeature.RECEIVE_STATEMENT)
-    def test_receive_statement_chan_recv(self):
+    def test_receive_statement_go_chan_recv(self):
         source = (
             "package main\nfunc f() {\n  ch := make(chan int)\n"
             "  select {\n  case v := <-ch:\n    _ = v\n  }\n}"
         )
         ir = _parse_and_lower(source)
         calls = _find_all(ir, Opcode.CALL_FUNCTION)
-        assert any("chan_recv" in inst.operands for inst in calls)
+        assert any("__go_chan_recv__" in inst.operands for inst in calls)
 
 
 class TestGoChannelType:
@@ -1008,13 +2070,21 @@ class TestGoTypeConversionExpression:
     """
 
     @covers(GoFeature.TYPE_CONVERSION)
-    def test_slice_byte_conversion_produces_call_ctor(self):
-        """[]byte(s) should produce CALL_CTOR with '[]byte' as function name."""
+    def test_slice_byte_conversion_calls_bytes_builtin(self):
+        """[]byte(s) should call __go_bytes__ and give an []int-like result."""
         source = 'package main\nfunc main() { s := "hello"; x := []byte(s) }'
+        ir, builder = _parse_go_with_types(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        byte_calls = [c for c in calls if "__go_bytes__" in c.operands]
+        assert len(byte_calls) == 1
+        assert builder.var_types["x"] == array_of(scalar("Int"))
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_slice_rune_conversion_calls_runes_builtin(self):
+        source = 'package main\nfunc main() { x := []rune("héllo") }'
         ir = _parse_and_lower(source)
-        calls = _find_all(ir, Opcode.CALL_CTOR)
-        byte_calls = [c for c in calls if "[]byte" in c.operands]
-        assert len(byte_calls) >= 1
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_runes__" in c.operands for c in calls)
 
     @covers(GoFeature.TYPE_CONVERSION)
     def test_slice_byte_conversion_no_symbolic(self):
@@ -1047,12 +2117,72 @@ class TestGoTypeConversionExpression:
         """The operand expression should be lowered and passed as argument."""
         source = 'package main\nfunc main() { s := "hi"; x := []byte(s) }'
         ir = _parse_and_lower(source)
-        calls = _find_all(ir, Opcode.CALL_CTOR)
-        byte_calls = [c for c in calls if "[]byte" in c.operands]
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        byte_calls = [c for c in calls if "__go_bytes__" in c.operands]
         assert len(byte_calls) >= 1
         # The call should have 2 operands: function name + 1 arg register
         assert len(byte_calls[0].operands) == 2
 
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_string_conversion_calls_go_string_builtin(self):
+        source = "package main\nfunc main() { r := 'x'; s := string(r) }"
+        ir, builder = _parse_go_with_types(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert [str(c.func_name) for c in calls] == ["__go_string__"]
+        assert builder.var_types["s"] == scalar("String")
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_string_of_slice_calls_builtin_for_its_element_type(self):
+        source = """\
+package main
+func f(p []rune) string { return string(p) }
+func main() {
+    b := []byte("hi")
+    var r []rune
+    x := string(b)
+    y := string(r[1:])
+    z := string([]rune{0xC3, 0xA9})
+    w := string(make([]byte, 2))
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        names = [str(c.func_name) for c in calls if "string" in str(c.func_name)]
+        assert sorted(names) == [
+            "__go_string_of_bytes__",
+            "__go_string_of_bytes__",
+            "__go_string_of_runes__",
+            "__go_string_of_runes__",
+            "__go_string_of_runes__",
+        ]
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_string_to_int_conversion_rejected(self):
+        source = 'package main\nfunc main() { s := "7"; n := int(s) }'
+        with pytest.raises(
+            GoConversionError,
+            match=r"cannot convert s \(variable of type string\) to type int",
+        ):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_float_to_string_conversion_rejected(self):
+        source = "package main\nfunc main() { var f float64 = 1.5; s := string(f) }"
+        with pytest.raises(GoConversionError, match="float64"):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_untyped_constant_to_bool_conversion_rejected(self):
+        source = "package main\nfunc main() { b := bool(1) }"
+        with pytest.raises(GoConversionError, match="untyped int constant"):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.TYPE_CONVERSION)
+    def test_int_to_byte_slice_conversion_rejected(self):
+        source = "package main\nfunc main() { var n int = 3; b := []byte(n) }"
+        with pytest.raises(GoConversionError, match=r"to type \[\]byte"):
+            _parse_and_lower(source)
+
     @covers(GoFeature.TYPE_CONVERSION)
     def test_simple_int_conversion_still_works(self):
         """int(y) is call_expression — verify existing handler still covers it."""
@@ -1062,6 +2192,119 @@ class TestGoTypeConversionExpression:
         int_calls = [c for c in calls if "int" in c.operands]
         assert len(int_calls) >= 1
 
+    @covers(GoFeature.FLOAT)
+    def test_float64_conversion_lowers_to_float_builtin(self):
+        """float64(n) should call the float builtin and seed a Float result."""
+        source = "package main\nfunc main() { n := 3; x := float64(n) }"
+        ir, builder = _parse_go_with_types(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        float_calls = [c for c in calls if str(c.func_name) == "float"]
+        assert len(float_calls) == 1
+        assert builder.register_types[float_calls[0].result_reg] == scalar(
+            TypeName("Float")
+        )
+
+    @covers(GoFeature.FLOAT)
+    def test_sized_int_conversion_lowers_to_int_builtin(self):
+        source = "package main\nfunc main() { x := int64(2.5) }"
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert [str(c.func_name) for c in calls] == ["int"]
+
+
+class TestGoNamedTypes:
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_named_type_conversion_uses_underlying_builtin(self):
+        source = "package main\ntype MyInt int\nfunc main() { x := MyInt(2.0) }"
+        ir, builder = _parse_go_with_types(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert [str(c.func_name) for c in calls] == ["int"]
+        assert builder.register_types[calls[0].result_reg] == scalar(
+            TypeName("MyInt")
+        )
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_alias_conversion_declared_after_use(self):
+        source = (
+            "package main\nfunc main() { c := Celsius(3) }\ntype Celsius = float64"
+        )
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert [str(c.func_name) for c in calls] == ["float"]
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_named_string_conversion_uses_go_string_builtin(self):
+        source = 'package main\ntype Name string\nfunc main() { n := Name("go") }'
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert [str(c.func_name) for c in calls] == ["__go_string__"]
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_short_var_takes_conversion_type(self):
+        source = "package main\ntype MyInt int\nfunc main() { m := MyInt(1) }"
+        _, builder = _parse_go_with_types(source)
+        assert builder.var_types["m"] == scalar(TypeName("MyInt"))
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_named_type_not_assignable_to_underlying(self):
+        source = """\
+package main
+type MyInt int
+func main() {
+    var m MyInt = 1
+    var n int = m
+}
+"""
+        with pytest.raises(
+            GoTypeMismatchError,
+            match=r"cannot use m \(variable of type MyInt\) as Int value",
+        ):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_distinct_named_types_not_assignable(self):
+        source = """\
+package main
+type Celsius float64
+type Fahrenheit float64
+func main() {
+    var f Fahrenheit = 50
+    c := Celsius(10)
+    c = f
+}
+"""
+        with pytest.raises(GoTypeMismatchError, match="in assignment"):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_alias_assignable_to_target(self):
+        source = """\
+package main
+type Celsius = float64
+func main() {
+    var c Celsius = 21.5
+    var f float64 = c
+    c = f
+}
+"""
+        ir = _parse_and_lower(source)
+        assert len(_find_all(ir, Opcode.DECL_VAR)) >= 2
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_explicit_conversion_and_untyped_constant_accepted(self):
+        source = """\
+package main
+type MyInt int
+func main() {
+    var m MyInt = 7
+    var n int = int(m)
+    m = 3
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert [str(c.func_name) for c in calls] == ["int"]
+
 
 class TestGoGenericType:
     """generic_type: Foo[int] — Go 1.18+ generic type references.
@@ -1075,39 +2318,465 @@ class TestGoGenericType:
         """Foo[int]{} should not produce any unsupported symbolics."""
         source = "package main\nfunc main() { x := Foo[int]{} }"
         ir = _parse_and_lower(source)
-        symbolics = _find_all(ir, Opcode.SYMBOLIC)
-        assert not any("generic_type" in str(inst.operands) for inst in symbolics)
+        symbolics = _find_all(ir, Opcode.SYMBOLIC)
+        assert not any("generic_type" in str(inst.operands) for inst in symbolics)
+
+    @covers(GoFeature.GENERIC_TYPE)
+    def test_generic_var_decl_no_symbolic(self):
+        """var x Foo[int] should not produce any unsupported symbolics."""
+        source = "package main\nfunc main() { var x Foo[int] }"
+        ir = _parse_and_lower(source)
+        symbolics = _find_all(ir, Opcode.SYMBOLIC)
+        assert not any("generic_type" in str(inst.operands) for inst in symbolics)
+
+
+_GO_MAX = """\
+package main
+import "cmp"
+func Max[T cmp.Ordered](a, b T) T {
+    if a > b {
+        return a
+    }
+    return b
+}
+"""
+
+
+class TestGoGenericFunction:
+    @covers(GoFeature.GENERIC_FUNCTION)
+    def test_inferred_call_targets_instance_declared_before_main(self):
+        ir = _parse_and_lower(
+            _GO_MAX + "func main() {\n    x := Max(3, 7)\n    y := Max(2.5, 1)\n}\n"
+        )
+        calls = [str(inst.func_name) for inst in _find_all(ir, Opcode.CALL_FUNCTION)]
+        assert "Max[int]" in calls
+        assert "Max[float64]" in calls
+        decls = [str(inst.name) for inst in _find_all(ir, Opcode.DECL_VAR)]
+        assert {"Max", "Max[int]", "Max[float64]"} <= set(decls)
+        assert decls.index("Max[int]") < decls.index("x")
+
+    @covers(GoFeature.GENERIC_FUNCTION)
+    def test_instance_binds_type_parameter_in_body(self):
+        ir = _parse_and_lower(
+            "package main\n"
+            "func Zero[T any]() T {\n    var z T\n    return z\n}\n"
+            "func main() {\n    s := Zero[string]()\n}\n"
+        )
+        calls = [str(inst.func_name) for inst in _find_all(ir, Opcode.CALL_FUNCTION)]
+        assert "Zero[string]" in calls
+        assert any("" in inst.operands for inst in _find_all(ir, Opcode.CONST))
+
+    @covers(GoFeature.GENERIC_FUNCTION)
+    def test_slice_argument_binds_element_type(self):
+        ir = _parse_and_lower(
+            "package main\n"
+            "func First[T any](xs []T) T { return xs[0] }\n"
+            "func main() {\n    names := []string{\"a\"}\n    n := First(names)\n}\n"
+        )
+        calls = [str(inst.func_name) for inst in _find_all(ir, Opcode.CALL_FUNCTION)]
+        assert "First[string]" in calls
+
+    @covers(GoFeature.GENERIC_FUNCTION)
+    @pytest.mark.parametrize(
+        "call, message",
+        [
+            ('Max(1, "a")', "mismatched types untyped int and untyped string"),
+            ("Max(i, f)", "type float64 of f does not match inferred type int for T"),
+            ("Max[int, int](1, 2)", "got 2 type arguments but want 1"),
+            ("Max(true, false)", r"bool does not satisfy cmp.Ordered \(bool missing"),
+        ],
+    )
+    def test_rejected_instantiation(self, call, message):
+        with pytest.raises(GoGenericError, match=message):
+            _parse_and_lower(
+                _GO_MAX + "func main() {\n    var i int = 1\n    var f float64 = 2\n"
+                f"    m := {call}\n}}\n"
+            )
+
+    @covers(GoFeature.GENERIC_FUNCTION)
+    def test_uninferable_type_parameter_is_rejected(self):
+        with pytest.raises(GoGenericError, match="in call to Zero, cannot infer T"):
+            _parse_and_lower(
+                "package main\nfunc Zero[T any]() T {\n    var z T\n    return z\n}\n"
+                "func main() {\n    z := Zero()\n}\n"
+            )
+
+    @covers(GoFeature.GENERIC_FUNCTION)
+    def test_union_and_method_constraints(self):
+        source = (
+            "package main\n"
+            "type Number interface {\n    ~int | ~float64\n}\n"
+            "type Stringer interface {\n    String() string\n}\n"
+            "func Double[T Number](x T) T { return x + x }\n"
+            "func Show[T Stringer](x T) string { return x.String() }\n"
+        )
+        with pytest.raises(
+            GoGenericError,
+            match=r"string does not satisfy Number \(string missing in ~int \|",
+        ):
+            _parse_and_lower(source + 'func main() {\n    d := Double("a")\n}\n')
+        with pytest.raises(
+            GoGenericError, match=r"int does not satisfy Stringer \(missing method"
+        ):
+            _parse_and_lower(source + "func main() {\n    s := Show(3)\n}\n")
+
+
+class TestGoRuneLiteral:
+    @covers(GoFeature.RUNE_LITERAL)
+    def test_rune_literal_no_symbolic(self):
+        """Rune literal 'a' should not produce SYMBOLIC fallthrough."""
+        ir = _parse_and_lower("package main; func main() { x := 'a' }")
+        symbolics = _find_all(ir, Opcode.SYMBOLIC)
+        assert not any("rune_literal" in str(inst.operands) for inst in symbolics)
+
+    @covers(GoFeature.RUNE_LITERAL)
+    def test_rune_literal_emits_const(self):
+        """Rune literal should emit a CONST instruction."""
+        ir = _parse_and_lower("package main; func main() { x := 'a' }")
+        consts = _find_all(ir, Opcode.CONST)
+        assert any(97 in inst.operands for inst in consts)
+
+    @covers(GoFeature.RUNE_LITERAL)
+    def test_rune_literal_stored_to_variable(self):
+        """Rune literal should be stored in a variable."""
+        ir = _parse_and_lower("package main; func main() { x := 'a' }")
+        stores = _find_all(ir, Opcode.DECL_VAR)
+        assert any("x" in inst.operands for inst in stores)
+
+
+class TestGoNumericLiteral:
+    @covers(GoFeature.INT_LITERAL)
+    def test_prefixed_and_separated_literals_lower_to_values(self):
+        ir = _parse_and_lower(
+            "package main; func main() "
+            "{ a, b, c, d, e := 0x1F, 0o755, 0b1010, 1_000_000, 0755 }"
+        )
+        values = {op for inst in _find_all(ir, Opcode.CONST) for op in inst.operands}
+        assert {31, 493, 10, 1_000_000} <= values
+
+    @covers(GoFeature.FLOAT)
+    def test_hex_float_and_separated_float(self):
+        ir = _parse_and_lower("package main; func main() { a, b := 0x1p-2, 1_000.5 }")
+        values = {op for inst in _find_all(ir, Opcode.CONST) for op in inst.operands}
+        assert {0.25, 1000.5} <= values
+
+    @covers(GoFeature.INT_LITERAL)
+    def test_constant_folding_reads_every_base(self):
+        ir = _parse_and_lower(
+            "package main; const mask = 0xF0 | 0b1111; func main() { m := mask }"
+        )
+        assert any(255 in inst.operands for inst in _find_all(ir, Opcode.CONST))
+
+    @covers(GoFeature.INT_LITERAL)
+    @pytest.mark.parametrize(
+        "literal, message",
+        [
+            ("1__0", "'_' must separate successive digits"),
+            ("0x_", "'_' must separate successive digits"),
+            ("0x", "hexadecimal literal has no digits"),
+            ("0b102", "invalid digit '2' in binary literal"),
+            ("08", "invalid digit '8' in octal literal"),
+        ],
+    )
+    def test_malformed_integer_literal_is_rejected(self, literal, message):
+        with pytest.raises(GoLiteralError, match=f"{literal}: {message}"):
+            _go_int_value(literal)
+
+    @covers(GoFeature.FLOAT)
+    def test_hex_float_needs_exponent(self):
+        with pytest.raises(GoLiteralError, match="requires a 'p' exponent"):
+            _go_float_value("0x1.8")
+
+
+class TestGoStringLiteral:
+    @covers(GoFeature.STRING_LITERAL)
+    def test_escape_sequences_are_decoded(self):
+        ir = _parse_and_lower(
+            r'package main; func main() { s := "a\tb\n\"q\" \x41\u00e9\U0001F600\101" }'
+        )
+        consts = _find_all(ir, Opcode.CONST)
+        assert any('a\tb\n"q" A\u00e9\U0001f600A' in inst.operands for inst in consts)
+
+    @covers(GoFeature.STRING_LITERAL)
+    def test_hex_escapes_are_utf8_bytes(self):
+        ir = _parse_and_lower(r'package main; func main() { s := "\xe4\xb8\x96" }')
+        consts = _find_all(ir, Opcode.CONST)
+        assert any("\u4e16" in inst.operands for inst in consts)
+
+    @covers(GoFeature.STRING_LITERAL)
+    def test_raw_string_keeps_backslashes(self):
+        ir = _parse_and_lower("package main; func main() { s := `C:\\new\\t` }")
+        consts = _find_all(ir, Opcode.CONST)
+        assert any("C:\\new\\t" in inst.operands for inst in consts)
+
+    @covers(GoFeature.RUNE_LITERAL)
+    def test_rune_escapes_are_code_points(self):
+        ir = _parse_and_lower(
+            r"package main; func main() { a, b, c := '\'', '\x41', '\u00e9' }"
+        )
+        values = {op for inst in _find_all(ir, Opcode.CONST) for op in inst.operands}
+        assert {39, 65, 0xE9} <= values
+
+    @covers(GoFeature.STRING_LITERAL)
+    def test_unknown_escape_is_rejected(self):
+        with pytest.raises(GoLiteralError, match=r"unknown escape sequence \\q"):
+            _parse_and_lower(r'package main; func main() { s := "a\qb" }')
+
+    @covers(GoFeature.STRING_LITERAL)
+    def test_single_quote_escape_is_rejected_in_strings(self):
+        with pytest.raises(GoLiteralError, match="unknown escape sequence"):
+            _parse_and_lower(r'package main; func main() { s := "it\'s" }')
+
+    @covers(GoFeature.STRING_LITERAL)
+    def test_octal_escape_above_255_is_rejected(self):
+        with pytest.raises(GoLiteralError, match="octal escape value 256 > 255"):
+            _parse_and_lower(r'package main; func main() { s := "\400" }')
+
+    @covers(GoFeature.STRING_LITERAL)
+    def test_surrogate_escape_is_rejected(self):
+        with pytest.raises(
+            GoLiteralError, match="escape is invalid Unicode code point U\\+D800"
+        ):
+            _parse_and_lower(r'package main; func main() { s := "\uD800" }')
+
+    @covers(GoFeature.RUNE_LITERAL)
+    def test_double_quote_escape_is_rejected_in_runes(self):
+        with pytest.raises(GoLiteralError, match=r"unknown escape sequence \\\""):
+            _parse_and_lower(r"package main; func main() { r := '\"' }")
+
+    @covers(GoFeature.STRING_LITERAL)
+    def test_malformed_constant_string_is_rejected(self):
+        with pytest.raises(GoLiteralError, match="unknown escape sequence"):
+            _parse_and_lower(r'package main; const s = "\z"; func main() {}')
+
+
+class TestGoStringIndex:
+    @covers(GoFeature.INDEXING)
+    def test_string_parameter_index_reads_byte(self):
+        source = """\
+package main
+func first(s string) byte {
+    return s[0]
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_string_byte__" in inst.operands for inst in calls)
+        assert any("__go_in_bounds__" in inst.operands for inst in calls)
+        assert not _find_all(ir, Opcode.LOAD_INDEX)
+
+    @covers(GoFeature.INDEXING)
+    def test_string_literal_initializer_types_variable(self):
+        source = """\
+package main
+func main() {
+    s := "GATTACA"
+    b := s[2]
+}
+"""
+        ir, builder = _parse_go_with_types(source)
+        assert builder.var_types["s"] == scalar("String")
+        assert builder.var_types["b"] == scalar("Int")
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_string_byte__" in inst.operands for inst in calls)
+
+    @covers(GoFeature.INDEXING)
+    def test_comparing_byte_with_string_is_rejected(self):
+        source = """\
+package main
+func isG(dna string, i int) bool {
+    return dna[i] == "G"
+}
+"""
+        with pytest.raises(GoMismatchedTypesError, match="byte and untyped string"):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.SWITCH_STATEMENT)
+    def test_string_case_in_byte_switch_is_rejected(self):
+        source = """\
+package main
+func pair(dna string, i int) int {
+    switch dna[i] {
+    case "G":
+        return 1
+    }
+    return 0
+}
+"""
+        with pytest.raises(GoMismatchedTypesError, match='invalid case "G"'):
+            _parse_and_lower(source)
+
+    @covers(GoFeature.RUNE_LITERAL)
+    def test_comparing_byte_with_rune_literal_is_accepted(self):
+        source = """\
+package main
+func isG(dna string, i int) bool {
+    return dna[i] == 'G'
+}
+"""
+        ir = _parse_and_lower(source)
+        assert any(71 in inst.operands for inst in _find_all(ir, Opcode.CONST))
+
+
+class TestGoStringsPackage:
+    @covers(GoFeature.STRINGS_PACKAGE)
+    def test_imported_package_call_is_builtin_call(self):
+        source = """\
+package main
+import "strings"
+func main() {
+    u := strings.ToUpper("go")
+    i := strings.Index("gopher", "ph")
+}
+"""
+        ir, builder = _parse_go_with_types(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("strings.ToUpper" in inst.operands for inst in calls)
+        assert any("strings.Index" in inst.operands for inst in calls)
+        assert not _find_all(ir, Opcode.CALL_METHOD)
+        assert builder.var_types["u"] == scalar("String")
+        assert builder.var_types["i"] == scalar("Int")
+
+    @covers(GoFeature.STRINGS_PACKAGE)
+    def test_aliased_import(self):
+        source = """\
+package main
+import (
+    str "strings"
+)
+func main() {
+    b := str.Contains("gopher", "go")
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("strings.Contains" in inst.operands for inst in calls)
+
+    @covers(GoFeature.STRINGS_PACKAGE)
+    def test_package_call_without_import_stays_method_call(self):
+        source = """\
+package main
+func main() {
+    u := strings.ToUpper("go")
+}
+"""
+        ir = _parse_and_lower(source)
+        assert _find_all(ir, Opcode.CALL_METHOD)
+
+
+class TestGoUnicodePackages:
+    @covers(GoFeature.UNICODE_PACKAGE)
+    def test_unicode_calls_are_builtin_calls_named_by_import_path(self):
+        source = """\
+package main
+import (
+    "unicode"
+    "unicode/utf8"
+)
+func main() {
+    up := unicode.IsUpper('A')
+    n := utf8.RuneCountInString("héllo")
+}
+"""
+        ir, builder = _parse_go_with_types(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("unicode.IsUpper" in inst.operands for inst in calls)
+        assert any("unicode/utf8.RuneCountInString" in inst.operands for inst in calls)
+        assert builder.var_types["up"] == scalar("Bool")
+        assert builder.var_types["n"] == scalar("Int")
+
+
+class TestGoMathPackage:
+    @covers(GoFeature.MATH_PACKAGE)
+    def test_math_call_is_float_builtin_call(self):
+        source = """\
+package main
+import "math"
+func main() {
+    r := math.Sqrt(16)
+}
+"""
+        ir, builder = _parse_go_with_types(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("math.Sqrt" in inst.operands for inst in calls)
+        assert builder.var_types["r"] == scalar("Float")
+
+    @covers(GoFeature.MATH_PACKAGE)
+    def test_package_constant_lowers_to_const(self):
+        source = """\
+package main
+import "math"
+func main() {
+    big := math.MaxInt
+}
+"""
+        ir = _parse_and_lower(source)
+        assert not _find_all(ir, Opcode.LOAD_FIELD)
+        consts = _find_all(ir, Opcode.CONST)
+        assert any(inst.value == 2**63 - 1 for inst in consts)
+
+    @covers(GoFeature.MATH_PACKAGE)
+    def test_package_constant_folds_in_const_decl(self):
+        source = """\
+package main
+import "math"
+const tau = 2 * math.Pi
+func main() {
+    t := tau
+}
+"""
+        ir = _parse_and_lower(source)
+        consts = _find_all(ir, Opcode.CONST)
+        assert any(inst.value == 2 * 3.141592653589793 for inst in consts)
 
-    @covers(GoFeature.GENERIC_TYPE)
-    def test_generic_var_decl_no_symbolic(self):
-        """var x Foo[int] should not produce any unsupported symbolics."""
-        source = "package main\nfunc main() { var x Foo[int] }"
+
+class TestGoFmtPackage:
+    @covers(GoFeature.FMT_PACKAGE)
+    def test_println_is_builtin_call(self):
+        source = """\
+package main
+import "fmt"
+func main() {
+    fmt.Println("answer", 42)
+}
+"""
         ir = _parse_and_lower(source)
-        symbolics = _find_all(ir, Opcode.SYMBOLIC)
-        assert not any("generic_type" in str(inst.operands) for inst in symbolics)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("fmt.Println" in inst.operands for inst in calls)
+        assert not _find_all(ir, Opcode.CALL_METHOD)
 
+    @covers(GoFeature.FMT_PACKAGE)
+    def test_fmt_without_import_is_method_call(self):
+        source = """\
+package main
+func main() {
+    fmt.Println(1)
+}
+"""
+        ir = _parse_and_lower(source)
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert not any("fmt.Println" in inst.operands for inst in calls)
 
-class TestGoRuneLiteral:
-    @covers(GoFeature.RUNE_LITERAL)
-    def test_rune_literal_no_symbolic(self):
-        """Rune literal 'a' should not produce SYMBOLIC fallthrough."""
-        ir = _parse_and_lower("package main; func main() { x := 'a' }")
-        symbolics = _find_all(ir, Opcode.SYMBOLIC)
-        assert not any("rune_literal" in str(inst.operands) for inst in symbolics)
 
-    @covers(GoFeature.RUNE_LITERAL)
-    def test_rune_literal_emits_const(self):
-        """Rune literal should emit a CONST instruction."""
-        ir = _parse_and_lower("package main; func main() { x := 'a' }")
-        consts = _find_all(ir, Opcode.CONST)
-        assert any(97 in inst.operands for inst in consts)
+class TestGoBoolean:
+    @covers(GoFeature.BOOLEAN)
+    def test_true_false_emit_bool_consts(self):
+        ir = _parse_and_lower("package main; func main() { a := true; b := false }")
+        bool_values = [
+            inst.value
+            for inst in _find_all(ir, Opcode.CONST)
+            if isinstance(inst.value, bool)
+        ]
+        assert bool_values == [True, False]
 
-    @covers(GoFeature.RUNE_LITERAL)
-    def test_rune_literal_stored_to_variable(self):
-        """Rune literal should be stored in a variable."""
-        ir = _parse_and_lower("package main; func main() { x := 'a' }")
-        stores = _find_all(ir, Opcode.DECL_VAR)
-        assert any("x" in inst.operands for inst in stores)
+    @covers(GoFeature.BOOLEAN)
+    def test_bool_return_type_seeded(self):
+        _ir, builder = _parse_go_with_types(
+            "package main\nfunc isZero(n int) bool { return n == 0 }\n"
+        )
+        rt = {k: v for k, v in builder.func_return_types.items() if "isZero" in k}
+        assert len(rt) == 1
+        assert list(rt.values()) == [scalar(TypeName("Bool"))]
 
 
 class TestGoBlankIdentifier:
@@ -1144,7 +2813,7 @@ func main() {
 
     @covers(GoFeature.FALLTHROUGH)
     def test_fallthrough_is_noop(self):
-        """fallthrough should not emit any branch/jump — it's a no-op in our model."""
+        """fallthrough itself emits nothing — the switch lowering branches onward."""
         source = """\
 package main
 func main() {
@@ -1166,6 +2835,30 @@ func main() {
             "fallthrough_statement" in str(inst.operands) for inst in symbolics
         )
 
+    @covers(GoFeature.FALLTHROUGH)
+    def test_fallthrough_branches_to_next_case_body(self):
+        source = """\
+package main
+func main() {
+    x := 1
+    switch x {
+    case 1:
+        x = 10
+        fallthrough
+    case 2:
+        x = 20
+    }
+}
+"""
+        ir = _parse_and_lower(source)
+        body_labels = [
+            str(inst.label)
+            for inst in _find_all(ir, Opcode.LABEL)
+            if "case_body" in str(inst.label)
+        ]
+        branch_targets = [str(inst.label) for inst in _find_all(ir, Opcode.BRANCH)]
+        assert body_labels[1] in branch_targets
+
 
 class TestGoVariadicArgument:
     @covers(GoFeature.VARIADIC)
@@ -1314,3 +3007,796 @@ class TestGoMakeDesugaring:
         ir = _parse_and_lower("package main\nfunc main() { c := make(chan int) }")
         new_objs = _find_all(ir, Opcode.NEW_OBJECT)
         assert len(new_objs) >= 1
+
+
+class TestGoArray:
+    @covers(GoFeature.ARRAY)
+    def test_array_literal_emits_new_array_with_length(self):
+        ir = _parse_and_lower("package main\nfunc main() { a := [5]int{1, 2, 3} }")
+        assert len(_find_all(ir, Opcode.NEW_ARRAY)) == 1
+        assert not _find_all(ir, Opcode.NEW_OBJECT)
+        length_stores = [
+            inst
+            for inst in _find_all(ir, Opcode.STORE_FIELD)
+            if inst.field_name.value == "length"
+        ]
+        assert len(length_stores) == 1
+        consts = _find_all(ir, Opcode.CONST)
+        assert any(inst.operands == [5] for inst in consts)
+
+    @covers(GoFeature.ARRAY)
+    def test_array_literal_zero_fills_before_storing_elements(self):
+        ir = _parse_and_lower("package main\nfunc main() { a := [4]int{7} }")
+        opcodes = _opcodes(ir)
+        fills = [
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "__go_array_fill__" in inst.operands
+        ]
+        assert len(fills) == 1
+        fill_pos = ir.index(fills[0])
+        store_pos = opcodes.index(Opcode.STORE_INDEX)
+        assert fill_pos < store_pos
+
+    @covers(GoFeature.ARRAY)
+    def test_constant_expression_array_length(self):
+        source = """\
+package main
+const N = 3
+func main() {
+    var grid [N * 2]int
+    names := [...]string{N - 1: "last"}
+}
+"""
+        ir = _parse_and_lower(source)
+        assert len(_find_all(ir, Opcode.NEW_ARRAY)) == 2
+        sizes = [inst.size_reg for inst in _find_all(ir, Opcode.NEW_ARRAY)]
+        consts = {
+            str(inst.result_reg): inst.value for inst in _find_all(ir, Opcode.CONST)
+        }
+        assert [consts.get(str(size)) for size in sizes] == [6, 3]
+
+    @covers(GoFeature.ARRAY)
+    def test_implicit_length_array_sized_from_elements(self):
+        ir = _parse_and_lower("package main\nfunc main() { a := [...]int{4, 5, 6} }")
+        assert len(_find_all(ir, Opcode.NEW_ARRAY)) == 1
+        assert len(_find_all(ir, Opcode.STORE_INDEX)) == 3
+        consts = _find_all(ir, Opcode.CONST)
+        assert any(inst.operands == [3] for inst in consts)
+
+    @covers(GoFeature.ARRAY)
+    def test_var_array_without_value_is_zero_filled(self):
+        ir = _parse_and_lower("package main\nfunc main() { var a [3]bool }")
+        assert len(_find_all(ir, Opcode.NEW_ARRAY)) == 1
+        assert any(
+            "__go_array_fill__" in inst.operands
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+        )
+
+    @covers(GoFeature.ARRAY)
+    def test_array_var_type_is_seeded(self):
+        _, builder = _parse_go_with_types(
+            "package main\nfunc main() { var a [3]int\n b := [2]string{} }"
+        )
+        assert str(builder.var_types["a"]) == "Array[int]"
+        assert str(builder.var_types["b"]) == "Array[string]"
+
+    @covers(GoFeature.ARRAY)
+    def test_array_index_emits_bounds_check(self):
+        source = """\
+package main
+func main() {
+    var a [3]int
+    a[1] = 4
+    x := a[2]
+}
+"""
+        ir = _parse_and_lower(source)
+        checks = [
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "__go_in_bounds__" in inst.operands
+        ]
+        assert len(checks) == 2
+        assert len(_find_all(ir, Opcode.THROW)) == 2
+
+    @covers(GoFeature.ARRAY)
+    def test_map_index_has_no_bounds_check(self):
+        source = """\
+package main
+func main() {
+    m := make(map[string]int)
+    x := m["a"]
+}
+"""
+        ir = _parse_and_lower(source)
+        assert not any(
+            "__go_in_bounds__" in inst.operands
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+        )
+
+
+class TestGoSlice:
+    @covers(GoFeature.MAKE)
+    def test_make_slice_records_length_and_capacity(self):
+        ir = _parse_and_lower("package main\nfunc main() { s := make([]int, 2, 8) }")
+        special_fields = [
+            inst.field_name.value
+            for inst in _find_all(ir, Opcode.STORE_FIELD)
+            if inst.field_name.value in ("length", "capacity")
+        ]
+        assert special_fields == ["length", "capacity"]
+        assert any(
+            "__go_array_fill__" in inst.operands
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+        )
+
+    @covers(GoFeature.APPEND)
+    def test_append_lowers_to_go_append_builtin(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { s := []int{}\n s = append(s, 1, 2) }"
+        )
+        appends = [
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "__go_append__" in inst.operands
+        ]
+        assert len(appends) == 1
+        assert len(appends[0].args) == 3
+
+    @covers(GoFeature.APPEND)
+    def test_append_spread_argument_is_spread(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { a := []int{1}\n b := []int{2}\n"
+            " a = append(a, b...) }"
+        )
+        append = next(
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "__go_append__" in inst.operands
+        )
+        assert isinstance(append.args[-1], SpreadArguments)
+
+    @covers(GoFeature.APPEND)
+    def test_cap_lowers_to_go_cap_builtin(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { s := make([]int, 1)\n c := cap(s) }"
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_cap__" in inst.operands for inst in calls)
+
+    @covers(GoFeature.LEN)
+    def test_len_lowers_to_go_len_builtin_typed_int(self):
+        ir, builder = _parse_go_with_types(
+            'package main\nfunc main() { s := "go"\n n := len(s) }'
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert [str(c.func_name) for c in calls] == ["__go_len__"]
+        assert builder.register_types[calls[0].result_reg] == scalar(
+            TypeName("Int")
+        )
+
+    @covers(GoFeature.LEN)
+    def test_len_of_constant_string_folds_to_byte_count(self):
+        ir = _parse_and_lower('package main\nconst W = "héllo"\nconst N = len(W)')
+        values = [inst.value for inst in _find_all(ir, Opcode.CONST)]
+        assert 6 in values
+
+    @covers(GoFeature.SLICE_TYPE)
+    def test_slice_vars_get_array_type(self):
+        _, builder = _parse_go_with_types(
+            "package main\nfunc main() { var a []int\n b := make([]string, 2) }"
+        )
+        assert str(builder.var_types["a"]) == "Array[int]"
+        assert str(builder.var_types["b"]) == "Array[string]"
+
+
+class TestGoMap:
+    @covers(GoFeature.MAP)
+    def test_map_literal_stores_each_entry_by_key(self):
+        ir = _parse_and_lower(
+            'package main\nfunc main() { m := map[string]int{"a": 1, "b": 2} }'
+        )
+        assert len(_find_all(ir, Opcode.NEW_OBJECT)) == 1
+        assert len(_find_all(ir, Opcode.STORE_INDEX)) == 2
+
+    @covers(GoFeature.MAP)
+    def test_map_index_reads_with_zero_default(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { m := make(map[string]int)\n"
+            ' n := m["x"] }'
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        get = next(inst for inst in calls if "__go_map_get__" in inst.operands)
+        assert len(get.args) == 3
+        assert not _find_all(ir, Opcode.LOAD_INDEX)
+
+    @covers(GoFeature.MAP)
+    def test_comma_ok_declares_presence_flag(self):
+        ir, builder = _parse_go_with_types(
+            "package main\nfunc main() { m := map[string]bool{}\n"
+            ' v, ok := m["x"] }'
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_map_has__" in inst.operands for inst in calls)
+        decls = _find_all(ir, Opcode.DECL_VAR)
+        assert any("v" in inst.operands for inst in decls)
+        assert any("ok" in inst.operands for inst in decls)
+        assert str(builder.var_types["m"]) == "Map[string, bool]"
+
+    @covers(GoFeature.MAP)
+    def test_delete_lowers_to_go_map_delete_builtin(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { m := map[int]int{1: 1}\n delete(m, 1) }"
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("__go_map_delete__" in inst.operands for inst in calls)
+
+
+class TestGoStruct:
+    @covers(GoFeature.STRUCT)
+    def test_positional_literal_stores_declared_field_names(self):
+        ir = _parse_and_lower(
+            "package main\ntype Point struct { X int; Y int }\n"
+            "func main() { p := Point{3, 4} }"
+        )
+        stored = [inst.field_name.value for inst in _find_all(ir, Opcode.STORE_FIELD)]
+        assert stored == ["X", "Y"]
+        assert not _find_all(ir, Opcode.STORE_INDEX)
+
+    @covers(GoFeature.STRUCT)
+    def test_omitted_fields_get_zero_values(self):
+        ir = _parse_and_lower(
+            "package main\ntype Clock struct { h, m int; label string }\n"
+            "func main() { c := Clock{m: 5} }"
+        )
+        stored = [inst.field_name.value for inst in _find_all(ir, Opcode.STORE_FIELD)]
+        assert sorted(stored) == ["h", "label", "m"]
+        assert any(inst.value == "" for inst in _find_all(ir, Opcode.CONST))
+
+    @covers(GoFeature.STRUCT)
+    def test_var_struct_is_zero_object(self):
+        ir = _parse_and_lower(
+            "package main\ntype Point struct { X, Y int }\n"
+            "func main() { var p Point }"
+        )
+        assert len(_find_all(ir, Opcode.NEW_OBJECT)) == 1
+        assert len(_find_all(ir, Opcode.STORE_FIELD)) == 2
+
+    @covers(GoFeature.STRUCT)
+    def test_array_of_structs_gets_distinct_zero_elements(self):
+        ir = _parse_and_lower(
+            "package main\ntype Point struct { X, Y int }\n"
+            "func main() { var ps [3]Point }"
+        )
+        assert len(_find_all(ir, Opcode.NEW_OBJECT)) == 3
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert not any("__go_array_fill__" in inst.operands for inst in calls)
+
+
+_GO_EMBEDDED = """\
+package main
+type Inner struct { X int }
+type Other struct { X int }
+type Outer struct {
+    Inner
+    *Other
+}
+type Wrapper struct { Inner }
+type Holder struct { *Other }
+"""
+
+
+class TestGoStructEmbedding:
+    @covers(GoFeature.STRUCT_EMBEDDING)
+    def test_promoted_field_is_read_and_written_through_embedded_field(self):
+        ir = _parse_and_lower(
+            "package main\ntype Inner struct { X int }\n"
+            "type Outer struct { Inner; Y int }\n"
+            "func main() {\n    o := Outer{}\n    o.X = 3\n    x := o.X\n}\n"
+        )
+        loads = [inst.field_name.value for inst in _find_all(ir, Opcode.LOAD_FIELD)]
+        assert loads == ["Inner", "Inner", "X"]
+        stored = [inst.field_name.value for inst in _find_all(ir, Opcode.STORE_FIELD)]
+        assert stored[-1] == "X"
+
+    @covers(GoFeature.STRUCT_EMBEDDING)
+    def test_promoted_method_gets_forwarding_method(self):
+        ir = _parse_and_lower(
+            "package main\ntype Inner struct { X int }\n"
+            "func (i Inner) Describe() int { return i.X }\n"
+            "type Outer struct { *Inner }\n"
+            "func main() {}\n"
+        )
+        calls = _find_all(ir, Opcode.CALL_METHOD)
+        assert [str(inst.method_name) for inst in calls] == ["Describe"]
+        assert isinstance(calls[0].args[0], SpreadArguments)
+        decls = [str(inst.name) for inst in _find_all(ir, Opcode.DECL_VAR)]
+        assert decls.count("Describe") == 2
+
+    @covers(GoFeature.STRUCT_EMBEDDING)
+    def test_promoted_key_in_struct_literal(self):
+        ir = _parse_and_lower(
+            "package main\ntype Inner struct { X int }\n"
+            "type Outer struct { Inner }\n"
+            "func main() {\n    o := Outer{X: 1}\n}\n"
+        )
+        loads = [inst.field_name.value for inst in _find_all(ir, Opcode.LOAD_FIELD)]
+        assert loads == ["Inner"]
+
+    @covers(GoFeature.STRUCT_EMBEDDING)
+    @pytest.mark.parametrize("statement", ["x := o.X", "o.X = 1"])
+    def test_ambiguous_selector_is_rejected(self, statement):
+        source = _GO_EMBEDDED + "func main() {\n    o := Outer{}\n"
+        with pytest.raises(GoAmbiguousSelectorError, match="ambiguous selector o.X"):
+            _parse_and_lower(source + f"    {statement}\n}}\n")
+
+    @covers(GoFeature.STRUCT_EMBEDDING)
+    @pytest.mark.parametrize(
+        "literal, message",
+        [
+            ("Outer{X: 1}", "unknown field X in struct literal of type Outer"),
+            (
+                "Wrapper{Inner: Inner{}, X: 1}",
+                "cannot specify promoted field X and enclosing embedded field Inner",
+            ),
+            ("Holder{X: 1}", "invalid implicit pointer indirection to reach X"),
+        ],
+    )
+    def test_rejected_promoted_literal_key(self, literal, message):
+        source = _GO_EMBEDDED + f"func main() {{\n    v := {literal}\n}}\n"
+        with pytest.raises(GoCompositeLiteralError, match=message):
+            _parse_and_lower(source)
+
+
+class TestGoMethod:
+    @covers(GoFeature.METHOD_DECLARATION)
+    def test_method_is_lowered_inside_receiver_class_block(self):
+        ir = _parse_and_lower(
+            "package main\nfunc (p Point) Norm() int { return p.X }\n"
+            "type Point struct { X int }"
+        )
+        labels = [str(inst.label) for inst in _find_all(ir, Opcode.LABEL)]
+        class_idx = next(i for i, lbl in enumerate(labels) if "class_Point" in lbl)
+        func_idx = next(i for i, lbl in enumerate(labels) if "func_Norm" in lbl)
+        assert class_idx < func_idx
+
+    @covers(GoFeature.METHOD_DECLARATION)
+    def test_value_receiver_is_copied(self):
+        ir = _parse_and_lower(
+            "package main\ntype Point struct { X int }\n"
+            "func (p Point) Shift() { p.X = 1 }"
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert any("clone" in inst.operands for inst in calls)
+
+    @covers(GoFeature.METHOD_DECLARATION)
+    def test_pointer_receiver_is_not_copied(self):
+        ir = _parse_and_lower(
+            "package main\ntype Point struct { X int }\n"
+            "func (p *Point) Shift() { p.X = 1 }"
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert not any("clone" in inst.operands for inst in calls)
+
+    @covers(GoFeature.METHOD_DECLARATION)
+    def test_unnamed_receiver_keeps_parameter_slot(self):
+        ir = _parse_and_lower(
+            "package main\ntype T struct {}\nfunc (T) Add(a int) int { return a }"
+        )
+        hints = [str(inst.hint) for inst in _find_all(ir, Opcode.SYMBOLIC)]
+        assert hints[:2] == ["param:_", "param:a"]
+
+
+class TestGoInterface:
+    _SHAPES = (
+        "package main\ntype Shape interface { Area() int }\n"
+        "type Sq struct { s int }\nfunc (q Sq) Area() int { return q.s * q.s }\n"
+    )
+
+    @covers(GoFeature.INTERFACE)
+    def test_implementing_struct_is_seeded_as_interface_impl(self):
+        _, builder = _parse_go_with_types(self._SHAPES)
+        assert builder.interface_implementations["Sq"] == ["Shape"]
+
+    @covers(GoFeature.TYPE_ASSERTION)
+    def test_interface_assertion_checks_implementing_structs(self):
+        ir = _parse_and_lower(
+            self._SHAPES + "func main() { var x interface{} = Sq{2}\n s := x.(Shape) }"
+        )
+        check = next(
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "__go_type_is__" in inst.operands
+        )
+        assert len(check.args) == 2
+        assert any(inst.value == "Sq" for inst in _find_all(ir, Opcode.CONST))
+        assert _find_all(ir, Opcode.THROW)
+
+    @covers(GoFeature.TYPE_ASSERTION)
+    def test_comma_ok_assertion_does_not_panic(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { var x interface{} = 1\n n, ok := x.(int) }"
+        )
+        assert not _find_all(ir, Opcode.THROW)
+        decls = _find_all(ir, Opcode.DECL_VAR)
+        assert any("ok" in inst.operands for inst in decls)
+
+
+class TestGoErrorType:
+    _DIVIDE = (
+        "package main\nimport \"errors\"\n"
+        "func divide(a, b int) (int, error) {\n"
+        "    if b == 0 { return 0, errors.New(\"division by zero\") }\n"
+        "    return a / b, nil\n}\n"
+    )
+
+    @covers(GoFeature.ERRORS_PACKAGE)
+    def test_errors_new_is_typed_error(self):
+        ir, builder = _parse_go_with_types(self._DIVIDE)
+        calls = [
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "errors.New" in inst.operands
+        ]
+        assert len(calls) == 1
+        assert builder.register_types[calls[0].result_reg] == scalar(
+            TypeName("error")
+        )
+
+    @covers(GoFeature.MULTIPLE_RETURN)
+    def test_value_err_short_var_decl_unpacks_and_types_results(self):
+        ir, builder = _parse_go_with_types(
+            self._DIVIDE + "func main() { q, err := divide(7, 2) }"
+        )
+        assert len(_find_all(ir, Opcode.LOAD_INDEX)) == 2
+        assert builder.var_types["q"] == scalar("Int")
+        assert builder.var_types["err"] == scalar(TypeName("error"))
+
+    @covers(GoFeature.MULTIPLE_RETURN)
+    def test_single_variable_for_two_results_is_rejected(self):
+        with pytest.raises(
+            GoAssignmentMismatchError,
+            match=r"1 variable but divide\(7, 2\) returns 2 values",
+        ):
+            _parse_and_lower(self._DIVIDE + "func main() { q := divide(7, 2) }")
+
+    @covers(GoFeature.MULTIPLE_RETURN)
+    def test_two_variables_for_one_result_is_rejected(self):
+        with pytest.raises(
+            GoAssignmentMismatchError, match=r"2 variables but one\(\) returns 1 value"
+        ):
+            _parse_and_lower(
+                "package main\nfunc one() int { return 1 }\n"
+                "func main() { a, b := one() }"
+            )
+
+    @covers(GoFeature.ERROR_TYPE)
+    def test_error_assertion_accepts_builtin_and_user_errors(self):
+        ir = _parse_and_lower(
+            "package main\ntype NegErr struct { n int }\n"
+            "func (e NegErr) Error() string { return \"negative\" }\n"
+            "func main() { var x interface{} = NegErr{-1}\n e, ok := x.(error) }"
+        )
+        names = {inst.value for inst in _find_all(ir, Opcode.CONST)}
+        assert {"NegErr", "errors.errorString"} <= names
+
+
+class TestGoPointer:
+    @covers(GoFeature.POINTER)
+    def test_address_of_variable_emits_address_of(self):
+        ir = _parse_and_lower("package main\nfunc main() { x := 1\n p := &x }")
+        addr = _find_all(ir, Opcode.ADDRESS_OF)
+        assert len(addr) == 1
+        assert "x" in str(addr[0].var_name)
+
+    @covers(GoFeature.POINTER)
+    def test_address_of_struct_literal_is_the_object(self):
+        ir = _parse_and_lower(
+            "package main\ntype Point struct { X int }\n"
+            "func main() { p := &Point{1} }"
+        )
+        assert not _find_all(ir, Opcode.ADDRESS_OF)
+        assert len(_find_all(ir, Opcode.NEW_OBJECT)) == 1
+
+    @covers(GoFeature.POINTER)
+    def test_dereference_is_nil_checked(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { x := 1\n p := &x\n y := *p }"
+        )
+        assert len(_find_all(ir, Opcode.LOAD_INDIRECT)) == 1
+        throws = _find_all(ir, Opcode.THROW)
+        assert len(throws) == 1
+        assert any(
+            "nil pointer dereference at 4:7" in str(inst.value)
+            for inst in _find_all(ir, Opcode.CONST)
+        )
+
+    @covers(GoFeature.POINTER)
+    def test_store_through_pointer_emits_store_indirect(self):
+        ir = _parse_and_lower("package main\nfunc set(p *int) { *p = 5 }")
+        assert len(_find_all(ir, Opcode.STORE_INDIRECT)) == 1
+
+    @covers(GoFeature.POINTER)
+    def test_pointer_param_is_typed_as_pointer(self):
+        _, builder = _parse_go_with_types(
+            "package main\nfunc inc(p *int) { *p = *p + 1 }"
+        )
+        assert str(builder.var_types["p"]) == "Pointer[Int]"
+
+
+class TestGoNil:
+    @covers(GoFeature.ZERO_VALUE)
+    def test_var_without_initializer_takes_zero_value(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { var n int\n var s string\n var b bool\n"
+            " var p *int\n var m map[string]int }"
+        )
+        consts = {inst.result_reg: inst.value for inst in _find_all(ir, Opcode.CONST)}
+        zeros = {
+            str(inst.name): consts[inst.value_reg]
+            for inst in _find_all(ir, Opcode.DECL_VAR)
+            if inst.value_reg in consts
+        }
+        expected = {"n": 0, "s": "", "b": False, "p": None, "m": None}
+        assert zeros.items() >= expected.items()
+
+    @covers(GoFeature.ZERO_VALUE)
+    def test_array_field_and_named_array_are_zero_filled(self):
+        ir = _parse_and_lower(
+            "package main\ntype Grid [3]int\ntype Board struct { cells [2]int }\n"
+            "func main() { var g Grid\n b := Board{} }"
+        )
+        assert len(_find_all(ir, Opcode.NEW_ARRAY)) == 2
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert sum("__go_array_fill__" in inst.operands for inst in calls) == 2
+
+    @covers(GoFeature.NIL)
+    def test_nil_map_write_panics(self):
+        ir = _parse_and_lower(
+            'package main\nfunc main() { var m map[string]int\n m["a"] = 1 }'
+        )
+        assert len(_find_all(ir, Opcode.THROW)) == 1
+        assert any(
+            inst.value == "assignment to entry in nil map"
+            for inst in _find_all(ir, Opcode.CONST)
+        )
+
+    @covers(GoFeature.NIL)
+    def test_interface_method_call_is_nil_checked(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { var err error\n msg := err.Error() }"
+        )
+        assert len(_find_all(ir, Opcode.THROW)) == 1
+        assert len(_find_all(ir, Opcode.CALL_METHOD)) == 1
+
+    @covers(GoFeature.NIL)
+    @pytest.mark.parametrize(
+        "body, message",
+        [
+            ("x := nil", "use of untyped nil in assignment"),
+            ("var x = nil", "use of untyped nil in variable declaration"),
+            (
+                "var n int = nil",
+                "cannot use nil as int value in variable declaration",
+            ),
+            ("s := \"a\"\n s = nil", "cannot use nil as string value in assignment"),
+            ("p := P{X: nil}", "cannot use nil as int value in struct literal"),
+            ("var p P = nil", "cannot use nil as P value in variable declaration"),
+        ],
+    )
+    def test_untyped_nil_is_rejected(self, body, message):
+        with pytest.raises(GoUntypedNilError, match=message):
+            _parse_and_lower(
+                f"package main\ntype P struct {{ X int }}\nfunc main() {{ {body} }}"
+            )
+
+    @covers(GoFeature.NIL)
+    @pytest.mark.parametrize(
+        "body, message",
+        [
+            ("n := 1\n _ = n == nil", r"mismatched types int and untyped nil"),
+            ("s := \"\"\n _ = nil != s", r"mismatched types untyped nil and string"),
+            ("var p P\n _ = p == nil", r"mismatched types P and untyped nil"),
+            ("_ = nil == nil", r"operator == not defined on untyped nil"),
+        ],
+    )
+    def test_nil_comparison_with_non_nillable_type_is_rejected(self, body, message):
+        with pytest.raises(GoMismatchedTypesError, match=message):
+            _parse_and_lower(
+                f"package main\ntype P struct {{ X int }}\nfunc main() {{ {body} }}"
+            )
+
+    @covers(GoFeature.NIL)
+    def test_nil_comparisons_with_reference_types_lower(self):
+        ir = _parse_and_lower(
+            "package main\ntype Shape interface { Area() int }\n"
+            "func main() { var p *int\n var xs []int\n var m map[int]int\n"
+            " var s Shape\n var err error\n"
+            " ok := p == nil && xs == nil && m == nil && s == nil && err == nil }"
+        )
+        assert any(inst.operands[0] == "==" for inst in _find_all(ir, Opcode.BINOP))
+
+
+class TestGoOrdering:
+    @covers(GoFeature.ORDERING)
+    def test_string_ordering_lowers_to_binop(self):
+        ir = _parse_and_lower(
+            'package main\nfunc main() { a, b := "apple", "banana"\n'
+            " lt := a < b\n ge := a >= \"a\" }"
+        )
+        ops = [inst.operator for inst in _find_all(ir, Opcode.BINOP)]
+        assert "<" in ops
+        assert ">=" in ops
+
+    @covers(GoFeature.ORDERING)
+    @pytest.mark.parametrize(
+        "body, message",
+        [
+            ("a, b := true, false\n _ = a < b", r"operator < not defined on bool"),
+            ("_ = true > false", r"operator > not defined on untyped bool"),
+            ("p, q := P{}, P{}\n _ = p <= q", r"operator <= not defined on struct"),
+            ("var xs []int\n _ = xs < xs", r"operator < not defined on slice"),
+            ("var m map[int]int\n _ = m >= m", r"operator >= not defined on map"),
+            ("var e error\n _ = e < e", r"operator < not defined on interface"),
+            ('s, n := "a", 1\n _ = s < n', r"mismatched types string and int"),
+            ("f, n := 1.5, 2\n _ = f > n", r"mismatched types float64 and int"),
+        ],
+    )
+    def test_unordered_operands_are_rejected(self, body, message):
+        with pytest.raises(GoMismatchedTypesError, match=message):
+            _parse_and_lower(
+                f"package main\ntype P struct {{ X int }}\nfunc main() {{ {body} }}"
+            )
+
+
+class TestGoDivision:
+    @covers(GoFeature.ARITHMETIC)
+    def test_integer_division_is_checked_and_truncated(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { a, b := 7, 2\n q := a / b\n r := a % b }"
+        )
+        calls = _find_all(ir, Opcode.CALL_FUNCTION)
+        assert sum("__go_divisor_ok__" in inst.operands for inst in calls) == 2
+        assert any("__go_quo__" in inst.operands for inst in calls)
+        assert any("__go_rem__" in inst.operands for inst in calls)
+        assert len(_find_all(ir, Opcode.THROW)) == 2
+        assert any(
+            inst.value == "runtime error: integer divide by zero"
+            for inst in _find_all(ir, Opcode.CONST)
+        )
+
+    @covers(GoFeature.ARITHMETIC)
+    def test_float_division_stays_a_binop(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { f := 7.0\n half := f / 2\n g := 1 / f }"
+        )
+        operators = [inst.operator for inst in _find_all(ir, Opcode.BINOP)]
+        assert operators.count("/") == 2
+        assert not _find_all(ir, Opcode.THROW)
+
+    @covers(GoFeature.ARITHMETIC)
+    @pytest.mark.parametrize("body", ["_ = n / 0", "_ = n % (1 - 1)", "n /= 0"])
+    def test_constant_zero_divisor_is_rejected(self, body):
+        with pytest.raises(GoDivisionByZeroError, match="division by zero"):
+            _parse_and_lower(f"package main\nfunc main() {{ n := 4\n {body} }}")
+
+
+class TestGoInitAndEntry:
+    @covers(GoFeature.INIT_FUNCTION)
+    def test_init_functions_called_in_order_before_main(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { x := 1 }\nfunc init() { a := 1 }\n"
+            "func init() { b := 2 }"
+        )
+        calls = [str(inst.func_name) for inst in _find_all(ir, Opcode.CALL_FUNCTION)]
+        assert [c for c in calls if c.startswith("init.")] == ["init.0", "init.1"]
+        decls = [str(inst.name) for inst in _find_all(ir, Opcode.DECL_VAR)]
+        assert {"init.0", "init.1"} <= set(decls)
+        assert decls.index("x") > decls.index("init.1")
+
+    @covers(GoFeature.ENTRY_FUNCTION)
+    def test_entry_function_is_hoisted_in_place_of_main(self):
+        frontend = GoFrontend(TreeSitterParserFactory(), "go", entry_func="Solve")
+        ir = frontend.lower(
+            b"package main\nfunc main() { m := 1 }\nfunc Solve() { s := 2 }"
+        )
+        decls = [str(inst.name) for inst in _find_all(ir, Opcode.DECL_VAR)]
+        assert "main" in decls
+        assert "Solve" not in decls
+        assert "s" in decls
+
+    @covers(GoFeature.INIT_FUNCTION)
+    @pytest.mark.parametrize(
+        "source, message",
+        [
+            ("func init(n int) {}\nfunc main() {}", "func init must have no"),
+            ("func init() int { return 1 }\nfunc main() {}", "func init must"),
+            ("func main() int { return 1 }", "func main must have no arguments"),
+        ],
+    )
+    def test_init_and_entry_signatures_are_checked(self, source, message):
+        with pytest.raises(GoEntryFunctionError, match=message):
+            _parse_and_lower(f"package main\n{source}")
+
+
+class TestGoVariadicParameter:
+    @covers(GoFeature.VARIADIC)
+    def test_variadic_param_slices_trailing_arguments(self):
+        ir = _parse_and_lower(
+            "package main\nfunc sum(scale int, nums ...int) int { return scale }"
+        )
+        loads = _find_all(ir, Opcode.LOAD_VAR)
+        assert any("arguments" in inst.operands for inst in loads)
+        slices = [
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "slice" in inst.operands
+        ]
+        assert len(slices) == 1
+        assert any(inst.value == 1 for inst in _find_all(ir, Opcode.CONST))
+        hints = [str(inst.hint) for inst in _find_all(ir, Opcode.SYMBOLIC)]
+        assert hints == ["param:scale"]
+
+    @covers(GoFeature.VARIADIC)
+    def test_variadic_param_is_typed_as_slice(self):
+        _, builder = _parse_go_with_types(
+            "package main\nfunc sum(nums ...int) int { return len(nums) }"
+        )
+        assert str(builder.var_types["nums"]) == "Array[int]"
+
+    @covers(GoFeature.VARIADIC)
+    def test_spread_argument_is_passed_as_spread(self):
+        ir = _parse_and_lower(
+            "package main\nfunc main() { xs := []int{1, 2}\n n := sum(xs...) }"
+        )
+        call = next(
+            inst
+            for inst in _find_all(ir, Opcode.CALL_FUNCTION)
+            if "sum" in inst.operands
+        )
+        assert any(isinstance(arg, SpreadArguments) for arg in call.args)
+
+
+class TestGoFunctionValue:
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_function_typed_param_is_seeded_as_function_type(self):
+        _, builder = _parse_go_with_types(
+            "package main\nfunc apply(f func(int, string) bool, x int) {}"
+        )
+        assert str(builder.var_types["f"]) == "Fn(Int, String) -> Bool"
+
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_function_returning_function_seeds_function_return_type(self):
+        _, builder = _parse_go_with_types(
+            "package main\nfunc maker() func(int) int { return nil }"
+        )
+        rt = {k: v for k, v in builder.func_return_types.items() if "maker" in k}
+        assert [str(v) for v in rt.values()] == ["Fn(Int) -> Int"]
+
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_call_through_function_param_gets_return_type(self):
+        ir, builder = _parse_go_with_types(
+            "package main\nfunc apply(f func(int) int, x int) int { return f(x) }"
+        )
+        call = next(
+            inst for inst in _find_all(ir, Opcode.CALL_FUNCTION) if "f" in inst.operands
+        )
+        assert builder.register_types[call.result_reg] == scalar(TypeName("Int"))
+
+    @covers(GoFeature.FUNCTION_VALUE)
+    def test_function_field_call_is_indirect(self):
+        ir = _parse_and_lower(
+            "package main\n"
+            "type Handler struct {\n    run func(int) int\n}\n"
+            "func main() {\n    h := Handler{}\n    r := h.run(5)\n}"
+        )
+        assert not any(
+            "run" in inst.operands for inst in _find_all(ir, Opcode.CALL_METHOD)
+        )
+        loads = [
+            inst for inst in _find_all(ir, Opcode.LOAD_FIELD) if "run" in inst.operands
+        ]
+        assert len(loads) == 1
+        calls = _find_all(ir, Opcode.CALL_UNKNOWN)
+        assert any(inst.target_reg == loads[0].result_reg for inst in calls)
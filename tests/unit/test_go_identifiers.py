@@ -0,0 +1,82 @@
+"""Tests for NFC normalization of Go identifiers."""
+
+from __future__ import annotations
+
+from interpreter.frontends.go import GoFrontend
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.go.identifiers import normalize_go_identifiers
+from interpreter.frontends.go.namespace import go_pre_scan
+from interpreter.ir import Opcode
+from interpreter.parser import TreeSitterParserFactory
+from tests.covers import covers
+
+_NFD = "cafe\u0301"  # with a combining acute accent
+_NFC = "caf\u00e9"
+
+
+class TestNormalizeGoIdentifiers:
+    @covers(GoFeature.UNICODE_IDENTIFIER)
+    def test_code_is_normalized(self):
+        source = f"{_NFD} := 1\n{_NFD}++\n".encode()
+        assert normalize_go_identifiers(source)[0] == f"{_NFC} := 1\n{_NFC}++\n".encode()
+
+    @covers(GoFeature.UNICODE_IDENTIFIER)
+    def test_literals_and_comments_are_untouched(self):
+        source = (
+            f'x := "{_NFD}" + `{_NFD}` // {_NFD}\n'
+            f"r := 'e' /* {_NFD} */\n"
+            f'y := "\\"{_NFD}'
+        ).encode()
+        assert normalize_go_identifiers(source)[0] == source
+
+    @covers(GoFeature.UNICODE_IDENTIFIER)
+    def test_invalid_utf8_passes_through(self):
+        source = f"{_NFD} := 1\n".encode() + b"\xff\xfe\n"
+        expected = f"{_NFC} := 1\n".encode() + b"\xff\xfe\n"
+        assert normalize_go_identifiers(source)[0] == expected
+
+    @covers(GoFeature.UNICODE_IDENTIFIER)
+    def test_offsets_map_back_to_the_file(self):
+        source = f"{_NFD} := 1\n{_NFD}++; y := 2\n".encode()
+        normalized, offsets = normalize_go_identifiers(source)
+        assert offsets.offset(normalized.index(b"y")) == source.index(b"y")
+        assert offsets.offset(normalized.index(b"\n")) == source.index(b"\n")
+        assert offsets.offset(0) == 0
+
+
+class TestGoUnicodeIdentifiers:
+    @covers(GoFeature.UNICODE_IDENTIFIER)
+    def test_spellings_lower_to_one_name(self):
+        source = f"package main\nfunc main() {{\n    {_NFD} := 1\n    {_NFC}++\n}}\n"
+        frontend = GoFrontend(TreeSitterParserFactory(), "go")
+        instructions = frontend.lower(source.encode())
+        assert frontend.diagnostics == ()
+        names = {
+            str(inst.name)
+            for inst in instructions
+            if inst.opcode in (Opcode.DECL_VAR, Opcode.STORE_VAR)
+        }
+        assert _NFC in names
+        assert _NFD not in names
+
+    @covers(GoFeature.UNICODE_IDENTIFIER)
+    def test_location_after_nfd_identifier_is_in_the_file(self):
+        source = f"package main\nfunc main() {{\n    {_NFD} := 1; y := {_NFD}\n}}\n"
+        instructions = GoFrontend(TreeSitterParserFactory(), "go").lower(
+            source.encode()
+        )
+        decl = next(
+            inst
+            for inst in instructions
+            if inst.opcode == Opcode.DECL_VAR and str(inst.name) == "y"
+        )
+        encoded = source.encode()
+        line = encoded.split(b"\n")[2]
+        assert decl.source_location.start_line == 3
+        assert decl.source_location.start_col == line.index(b"y")
+        assert decl.source_location.start_byte == encoded.index(b"y :=")
+
+    @covers(GoFeature.UNICODE_IDENTIFIER)
+    def test_pre_scan_sees_normalized_names(self):
+        scan = go_pre_scan(f"package geo\nvar {_NFD} = 1\n".encode())
+        assert scan.names == [_NFC]
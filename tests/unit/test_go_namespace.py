@@ -0,0 +1,219 @@
+# tests/unit/test_go_namespace.py
+"""Tests for Go packages: pre-scan, package table, qualified lowering."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from interpreter.class_name import ClassName
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.go.frontend import GoFrontend
+from interpreter.frontends.go.namespace import (
+    GoImportCycleError,
+    GoNamespaceResolver,
+    build_go_packages,
+    check_go_import_cycles,
+    go_pre_scan,
+)
+from interpreter.frontends.go.packages import GoPackageMemberError
+from interpreter.instructions import CallFunction, DeclVar, LoadVar, NewObject
+from interpreter.parser import TreeSitterParserFactory
+from tests.covers import covers
+
+_UTILS = b"""\
+package utils
+
+type Pair struct {
+    A int
+    B int
+}
+
+var calls = 0
+
+func Sum(p Pair) int {
+    calls++
+    return add(p.A, p.B)
+}
+
+func add(a, b int) int {
+    return a + b
+}
+"""
+
+
+def _lower(source: str, resolver: GoNamespaceResolver | None = None) -> list:
+    frontend = GoFrontend(TreeSitterParserFactory(), "go")
+    if resolver is None:
+        return frontend.lower(source.encode("utf-8"))
+    return frontend.lower(source.encode("utf-8"), namespace_resolver=resolver)
+
+
+def _utils_resolver() -> GoNamespaceResolver:
+    scans = {Path("utils/utils.go"): go_pre_scan(_UTILS)}
+    return GoNamespaceResolver(build_go_packages(scans))
+
+
+class TestGoPreScan:
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_package_names_and_imports(self):
+        result = go_pre_scan(
+            b'package main\nimport (\n    "fmt"\n    u "./utils"\n)\n'
+            b"const limit = 3\nvar a, _ = 1, 2\ntype T struct{}\n"
+            b"func (t T) M() {}\nfunc main() {}\n"
+        )
+        assert result.package == "main"
+        assert result.imports == ["fmt", "./utils"]
+        assert result.names == ["limit", "a", "main"]
+        assert result.types == ["T"]
+        assert result.declares_main
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_main_function_outside_package_main_is_not_an_entry(self):
+        result = go_pre_scan(b"package utils\nfunc main() {}\n")
+        assert result.package == "utils"
+        assert not result.declares_main
+
+
+class TestBuildGoPackages:
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_files_of_one_package_share_names_and_symbols(self):
+        packages = build_go_packages(
+            {
+                Path("shapes/square.go"): go_pre_scan(
+                    b"package shapes\ntype Square struct {\n    side int\n}\n"
+                ),
+                Path("shapes/area.go"): go_pre_scan(
+                    b"package shapes\nfunc (s Square) Area() int {\n"
+                    b"    return s.side * s.side\n}\nfunc Unit() Square {\n"
+                    b"    return Square{1}\n}\n"
+                ),
+            }
+        )
+        shapes = packages["shapes"]
+        assert shapes.names == frozenset({"Unit"})
+        assert shapes.types == frozenset({"Square"})
+        square = shapes.symbol_table.classes[ClassName("Square")]
+        assert [str(m) for m in square.methods] == ["Area"]
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_only_project_packages_are_imports(self):
+        packages = build_go_packages(
+            {
+                Path("main.go"): go_pre_scan(
+                    b'package main\nimport (\n    "fmt"\n    "./utils"\n)\n'
+                ),
+                Path("utils/utils.go"): go_pre_scan(_UTILS),
+            }
+        )
+        assert packages["main"].imports == frozenset({"utils"})
+        assert packages["utils"].imports == frozenset()
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_import_cycle_is_rejected(self):
+        packages = build_go_packages(
+            {
+                Path("a/a.go"): go_pre_scan(b'package a\nimport "../b"\n'),
+                Path("b/b.go"): go_pre_scan(b'package b\nimport "../a"\n'),
+            }
+        )
+        with pytest.raises(
+            GoImportCycleError, match="import cycle not allowed: a imports b imports a"
+        ) as info:
+            check_go_import_cycles(packages)
+        assert info.value.code == DiagnosticCode.IMPORT_CYCLE
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_acyclic_imports_pass(self):
+        packages = build_go_packages(
+            {
+                Path("main.go"): go_pre_scan(b'package main\nimport "./a"\n'),
+                Path("a/a.go"): go_pre_scan(b'package a\nimport "../b"\n'),
+                Path("b/b.go"): go_pre_scan(b"package b\n"),
+            }
+        )
+        check_go_import_cycles(packages)
+
+
+class TestGoPackageLevelNames:
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_package_names_outside_main_are_qualified(self):
+        ir = _lower(_UTILS.decode())
+        declared = {str(i.name) for i in ir if isinstance(i, DeclVar)}
+        assert {"utils.Sum", "utils.add", "utils.calls"} <= declared
+        assert "Sum" not in declared
+        calls = [str(i.func_name) for i in ir if isinstance(i, CallFunction)]
+        assert "utils.add" in calls
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_package_main_keeps_bare_names(self):
+        ir = _lower("package main\nfunc add(a, b int) int { return a + b }\n")
+        declared = {str(i.name) for i in ir if isinstance(i, DeclVar)}
+        assert "add" in declared
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_parameter_hides_package_variable(self):
+        ir = _lower("package utils\nvar n = 1\nfunc Get(n int) int { return n }\n")
+        loads = [str(i.name) for i in ir if isinstance(i, LoadVar)]
+        assert "n" in loads
+        assert "utils.n" not in loads
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_names_from_other_files_of_the_package_are_qualified(self):
+        ir = _lower(
+            "package utils\nfunc Double(x int) int { return add(x, x) }\n",
+            _utils_resolver(),
+        )
+        calls = [str(i.func_name) for i in ir if isinstance(i, CallFunction)]
+        assert "utils.add" in calls
+
+
+class TestGoImportedPackageMembers:
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_exported_members_lower_to_qualified_names(self):
+        ir = _lower(
+            'package main\nimport "./utils"\nfunc main() {\n'
+            "    p := utils.Pair{1, 2}\n    s := utils.Sum(p)\n}\n",
+            _utils_resolver(),
+        )
+        calls = [str(i.func_name) for i in ir if isinstance(i, CallFunction)]
+        assert "utils.Sum" in calls
+        new_types = [str(i.type_hint) for i in ir if isinstance(i, NewObject)]
+        assert "Pair" in new_types
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_unexported_member_is_rejected(self):
+        with pytest.raises(
+            GoPackageMemberError, match="name add not exported by package utils"
+        ):
+            _lower(
+                'package main\nimport "./utils"\n'
+                "func main() {\n    x := utils.add(1, 2)\n}\n",
+                _utils_resolver(),
+            )
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_undeclared_member_is_rejected(self):
+        with pytest.raises(
+            GoPackageMemberError, match="undefined: utils.Product"
+        ) as info:
+            _lower(
+                'package main\nimport "./utils"\n'
+                "func main() {\n    x := utils.Product(1, 2)\n}\n",
+                _utils_resolver(),
+            )
+        assert info.value.code == DiagnosticCode.UNDEFINED_MEMBER
+        assert info.value.location.start_line == 4
+
+    @covers(GoFeature.PROJECT_PACKAGES)
+    def test_unexported_package_variable_is_rejected(self):
+        with pytest.raises(
+            GoPackageMemberError, match="name calls not exported by package utils"
+        ):
+            _lower(
+                'package main\nimport "./utils"\n'
+                "func main() {\n    n := utils.calls\n}\n",
+                _utils_resolver(),
+            )
@@ -1,8 +1,11 @@
 # pyright: standard
-"""Unit tests for GoTypeAliasExtractor."""
+"""Unit tests for GoTypeAliasExtractor and GoNamedTypeExtractor."""
 
 from interpreter.frontends.go.features import GoFeature
-from interpreter.frontends.go.type_alias_extractor import GoTypeAliasExtractor
+from interpreter.frontends.go.type_alias_extractor import (
+    GoNamedTypeExtractor,
+    GoTypeAliasExtractor,
+)
 from interpreter.type_name import TypeName
 from interpreter.types.type_expr import ScalarType
 from tests.covers import covers
@@ -39,36 +42,77 @@ def _type_spec(
     )
 
 
+def _type_alias(alias_name: str, target_type: str) -> _FakeGoNode:
+    return _FakeGoNode(
+        "type_alias",
+        fields={
+            "name": _FakeGoNode("type_identifier", text=alias_name.encode()),
+            "type": _FakeGoNode("type_identifier", text=target_type.encode()),
+        },
+    )
+
+
 class TestGoTypeAliasExtractorMatches:
     @covers(GoFeature.TYPE_ALIAS)
-    def test_matches_type_spec_with_type_identifier(self) -> None:
+    def test_matches_type_alias(self) -> None:
         extractor = GoTypeAliasExtractor()
-        node = _type_spec("UserId", "int")
+        node = _type_alias("Celsius", "float64")
         assert extractor.matches(node)
 
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_does_not_match_named_type_spec(self) -> None:
+        extractor = GoTypeAliasExtractor()
+        node = _type_spec("UserId", "int")
+        assert not extractor.matches(node)
+
     @covers(GoFeature.TYPE_ALIAS)
     def test_does_not_match_non_type_spec(self) -> None:
         extractor = GoTypeAliasExtractor()
         node = _FakeGoNode("var_declaration")
         assert not extractor.matches(node)
 
+
+class TestGoTypeAliasExtractorExtract:
     @covers(GoFeature.TYPE_ALIAS)
-    def test_does_not_match_struct_type_spec(self) -> None:
+    def test_extract_maps_known_type(self) -> None:
         extractor = GoTypeAliasExtractor()
+        node = _type_alias("Celsius", "float64")
+        type_map = {"float64": "Float"}
+        name, expr = extractor.extract(node, type_map)
+        assert name == TypeName("Celsius")
+        assert expr == ScalarType(TypeName("Float"))
+
+
+class TestGoNamedTypeExtractorMatches:
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_matches_type_spec_with_type_identifier(self) -> None:
+        extractor = GoNamedTypeExtractor()
+        node = _type_spec("UserId", "int")
+        assert extractor.matches(node)
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_does_not_match_type_alias(self) -> None:
+        extractor = GoNamedTypeExtractor()
+        node = _type_alias("Celsius", "float64")
+        assert not extractor.matches(node)
+
+    @covers(GoFeature.TYPE_ALIAS)
+    def test_does_not_match_struct_type_spec(self) -> None:
+        extractor = GoNamedTypeExtractor()
         node = _type_spec("Point", "struct_body", type_node_kind="struct_type")
         assert not extractor.matches(node)
 
     @covers(GoFeature.TYPE_ALIAS)
     def test_does_not_match_interface_type_spec(self) -> None:
-        extractor = GoTypeAliasExtractor()
+        extractor = GoNamedTypeExtractor()
         node = _type_spec("Stringer", "interface_body", type_node_kind="interface_type")
         assert not extractor.matches(node)
 
 
-class TestGoTypeAliasExtractorExtract:
+class TestGoNamedTypeExtractorExtract:
     @covers(GoFeature.TYPE_ALIAS)
     def test_extract_maps_known_type(self) -> None:
-        extractor = GoTypeAliasExtractor()
+        extractor = GoNamedTypeExtractor()
         node = _type_spec("UserId", "int")
         type_map = {"int": "Int", "string": "String"}
         name, expr = extractor.extract(node, type_map)
@@ -77,9 +121,8 @@ class TestGoTypeAliasExtractorExtract:
 
     @covers(GoFeature.TYPE_ALIAS)
     def test_extract_passes_through_unknown_type(self) -> None:
-        extractor = GoTypeAliasExtractor()
-        node = _type_spec("MyFloat", "float64")
-        type_map = {"float64": "Float"}
-        name, expr = extractor.extract(node, type_map)
-        assert name == TypeName("MyFloat")
-        assert expr == ScalarType(TypeName("Float"))
+        extractor = GoNamedTypeExtractor()
+        node = _type_spec("Meters", "Distance")
+        name, expr = extractor.extract(node, {})
+        assert name == TypeName("Meters")
+        assert expr == ScalarType(TypeName("Distance"))
@@ -0,0 +1,208 @@
+"""Tests for exporting the implemented grammar as EBNF and railroad diagrams."""
+
+from __future__ import annotations
+
+import xml.etree.ElementTree as ET
+
+import pytest
+
+from interpreter.constants import Language
+from interpreter.frontends import get_deterministic_frontend
+from interpreter.grammar_export import (
+    implemented_grammar,
+    production_to_ebnf,
+    to_ebnf,
+    tree_sitter_productions,
+)
+from interpreter.grammar_types import (
+    Choice,
+    Grammar,
+    NonTerminal,
+    Option,
+    Production,
+    Repeat,
+    Sequence,
+    Terminal,
+)
+from interpreter.railroad import railroad_svg
+from tests.covers import NotLanguageFeature, covers
+
+_SVG = "{http://www.w3.org/2000/svg}"
+
+
+def _symbol(name: str) -> dict:
+    return {"type": "SYMBOL", "name": name}
+
+
+def _string(value: str) -> dict:
+    return {"type": "STRING", "value": value}
+
+
+def _field(name: str, content: dict) -> dict:
+    return {"type": "FIELD", "name": name, "content": content}
+
+
+# A cut-down tree-sitter-python grammar.json.
+_TREE_SITTER_GRAMMAR = {
+    "rules": {
+        "module": {"type": "REPEAT", "content": _symbol("_statement")},
+        "_statement": {
+            "type": "CHOICE",
+            "members": [_symbol("if_statement"), _symbol("expression_statement")],
+        },
+        "if_statement": {
+            "type": "SEQ",
+            "members": [
+                _string("if"),
+                _field("condition", _symbol("expression")),
+                _string(":"),
+                _field("consequence", _symbol("_suite")),
+                {"type": "REPEAT", "content": _symbol("elif_clause")},
+                {
+                    "type": "CHOICE",
+                    "members": [_symbol("else_clause"), {"type": "BLANK"}],
+                },
+            ],
+        },
+        "_suite": {
+            "type": "CHOICE",
+            "members": [
+                {
+                    "type": "ALIAS",
+                    "content": _symbol("_simple_statements"),
+                    "named": True,
+                    "value": "block",
+                },
+                {"type": "SEQ", "members": [_symbol("_indent"), _symbol("block")]},
+            ],
+        },
+        "block": {"type": "REPEAT1", "content": _symbol("_statement")},
+        "expression": {
+            "type": "CHOICE",
+            "members": [_symbol("identifier"), _symbol("call")],
+        },
+        "call": {
+            "type": "PREC",
+            "value": 1,
+            "content": {
+                "type": "SEQ",
+                "members": [
+                    _symbol("identifier"),
+                    {"type": "TOKEN", "content": _string("(")},
+                    _symbol("_arguments"),
+                    _string(")"),
+                ],
+            },
+        },
+        "_arguments": {
+            "type": "CHOICE",
+            "members": [_symbol("expression"), {"type": "BLANK"}],
+        },
+        "identifier": {"type": "PATTERN", "value": "[a-z]+"},
+    },
+    "supertypes": [_symbol("expression")],
+}
+
+
+def _terminals(grammar: Grammar, name: str) -> set[str]:
+    [body] = [p.body for p in grammar.productions if p.name == name]
+    assert isinstance(body, Choice)
+    return {alt.text for alt in body.alternatives if isinstance(alt, Terminal)}
+
+
+class TestImplementedGrammar:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize("language", [Language.PYTHON, Language.GO])
+    def test_terminals_are_the_dispatch_tables(self, language):
+        grammar = implemented_grammar(language)
+        frontend = get_deterministic_frontend(language)
+        assert _terminals(grammar, "expression") <= frontend.expression_node_types()
+        assert _terminals(grammar, "statement") <= frontend.statement_node_types()
+        assert "if_statement" in _terminals(grammar, "statement")
+        assert "comment" in _terminals(grammar, "skipped")
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_ebnf_lists_every_production(self):
+        ebnf = to_ebnf(implemented_grammar(Language.PYTHON))
+        assert "program = { statement } ;" in ebnf
+        assert '"binary_operator"' in ebnf
+        for name in ("statement", "expression", "skipped"):
+            assert f"\n{name} = " in ebnf
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_long_choice_has_one_alternative_per_line(self):
+        grammar = Grammar(
+            language=Language.GO,
+            productions=(
+                Production(
+                    "statement",
+                    Choice(tuple(Terminal(f"kind_{i}") for i in range(10))),
+                ),
+                Production("skipped", Choice((Terminal("comment"), Terminal("\n")))),
+            ),
+        )
+        lines = to_ebnf(grammar).splitlines()
+        assert lines[2:4] == ['statement = "kind_0"', '          | "kind_1"']
+        assert "          ;" in lines
+        assert 'skipped = "comment" | "\\n" ;' in lines
+
+
+class TestTreeSitterProductions:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_lowered_kinds_expand_to_their_rules(self):
+        productions = tree_sitter_productions(
+            _TREE_SITTER_GRAMMAR,
+            frozenset({"block", "call", "identifier", "if_statement"}),
+            frozenset({"expression"}),
+        )
+
+        assert [production_to_ebnf(p) for p in productions] == [
+            "block = _statement { _statement } ;",
+            'call = "identifier" "(" _arguments ")" ;',
+            'if_statement = "if" expression ":" _suite { "elif_clause" } '
+            '[ "else_clause" ] ;',
+            '_statement = if_statement | "expression_statement" ;',
+            "_arguments = [ expression ] ;",
+            '_suite = "block" | "_indent" block ;',
+        ]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_supertype_is_expanded_unless_already_defined(self):
+        productions = tree_sitter_productions(_TREE_SITTER_GRAMMAR, frozenset({"call"}))
+
+        assert [p.name for p in productions] == ["call", "_arguments", "expression"]
+        assert productions[2].body == Choice(
+            (Terminal("identifier"), NonTerminal("call"))
+        )
+
+
+class TestRailroadSvg:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_every_production_draws_as_svg(self):
+        for production in implemented_grammar(Language.GO).productions:
+            root = ET.fromstring(railroad_svg(production))
+            assert root.tag == f"{_SVG}svg"
+            assert float(root.get("width")) > 0
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_nonterminals_link_to_their_diagrams(self):
+        production = Production(
+            "program",
+            Repeat(Choice((NonTerminal("statement"), Terminal("comment")))),
+        )
+        root = ET.fromstring(railroad_svg(production))
+        links = [a.get("href") for a in root.iter(f"{_SVG}a")]
+        texts = [t.text for t in root.iter(f"{_SVG}text")]
+        assert links == ["statement.svg"]
+        assert texts == ["program", "statement", '"comment"']
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_sequence_and_option_draw_each_item_in_order(self):
+        production = Production(
+            "call",
+            Sequence((Terminal("("), Option(NonTerminal("expression")), Terminal(")"))),
+        )
+        root = ET.fromstring(railroad_svg(production))
+        texts = [t.text for t in root.iter(f"{_SVG}text")]
+        assert texts == ["call", '"("', "expression", '")"']
+        assert float(root.get("width")) > 0
@@ -0,0 +1,72 @@
+"""Tests for incremental re-parsing of edited source."""
+
+from __future__ import annotations
+
+import pytest
+import tree_sitter_language_pack as tslp
+
+from interpreter.constants import Language
+from interpreter.cst import build_cst
+from interpreter.cst_sexp import cst_to_sexp
+from interpreter.incremental_parse import IncrementalParser, TextEdit
+from tests.covers import NotLanguageFeature, covers
+
+_SOURCE = b"def f(x):\n    return x\n\ny = f(1)\n"
+
+
+def _sexp(tree, source: bytes) -> str:
+    return cst_to_sexp(build_cst(tree.root_node, source))
+
+
+def _fresh_sexp(source: bytes) -> str:
+    return _sexp(tslp.get_parser("python").parse(source), source)
+
+
+class TestTextEdit:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_new_end_byte(self):
+        assert TextEdit(4, 5, b"abc").new_end_byte == 7
+
+
+class TestIncrementalParser:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    @pytest.mark.parametrize(
+        "edit",
+        [
+            TextEdit(21, 22, b"x + 1"),  # replace
+            TextEdit(10, 10, b"    z = 2\n"),  # insert a line
+            TextEdit(0, 23, b""),  # delete the function
+            TextEdit(6, 7, b"\xc3\xa9t\xc3\xa9"),  # multi-byte name
+        ],
+    )
+    def test_tree_matches_a_fresh_parse(self, edit):
+        parser = IncrementalParser(_SOURCE, Language.PYTHON)
+        parser.apply(edit)
+        expected = (
+            _SOURCE[: edit.start_byte] + edit.new_text + _SOURCE[edit.old_end_byte :]
+        )
+        assert parser.source == expected
+        assert _sexp(parser.tree, parser.source) == _fresh_sexp(expected)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_a_run_of_keystrokes(self):
+        parser = IncrementalParser(b"", Language.PYTHON)
+        for offset, char in enumerate(b"x = [1, 2]\n"):
+            parser.apply(TextEdit(offset, offset, bytes([char])))
+        assert parser.source == b"x = [1, 2]\n"
+        assert _sexp(parser.tree, parser.source) == _fresh_sexp(parser.source)
+        assert not parser.tree.root_node.has_error
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_changed_ranges_cover_the_edit(self):
+        parser = IncrementalParser(_SOURCE, Language.PYTHON)
+        changed = parser.apply(TextEdit(30, 31, b"1, 2"))  # y = f(1, 2)
+        assert changed
+        assert all(location.start_line == 4 for location in changed)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_edit_outside_the_source_raises(self):
+        parser = IncrementalParser(_SOURCE, Language.PYTHON)
+        with pytest.raises(ValueError, match="outside"):
+            parser.apply(TextEdit(30, len(_SOURCE) + 1, b""))
+        assert parser.source == _SOURCE
@@ -0,0 +1,58 @@
+"""Unit tests for apply_integer_overflow — exact, wrapping and trapping int64."""
+
+from __future__ import annotations
+
+import pytest
+
+from interpreter.ir import SourceLocation
+from interpreter.run_types import IntegerOverflowMode
+from interpreter.vm.integer_overflow import (
+    INT64_MAX,
+    INT64_MIN,
+    IntegerOverflowError,
+    apply_integer_overflow,
+)
+from tests.covers import NotLanguageFeature, covers
+
+_LOCATION = SourceLocation(start_line=3, start_col=4, end_line=3, end_col=13)
+
+
+class TestApplyIntegerOverflow:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_big_keeps_exact_result(self):
+        result = apply_integer_overflow(
+            IntegerOverflowMode.BIG, 2**64 - 1, "x + 1", _LOCATION
+        )
+        assert result == 2**64 - 1
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_in_range_result_unchanged_in_every_mode(self):
+        for mode in IntegerOverflowMode:
+            assert apply_integer_overflow(mode, INT64_MAX, "x", _LOCATION) == INT64_MAX
+            assert apply_integer_overflow(mode, INT64_MIN, "x", _LOCATION) == INT64_MIN
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_wrap_uses_twos_complement(self):
+        mode = IntegerOverflowMode.WRAP
+        assert apply_integer_overflow(mode, INT64_MAX + 1, "x", _LOCATION) == INT64_MIN
+        assert apply_integer_overflow(mode, INT64_MIN - 1, "x", _LOCATION) == INT64_MAX
+        assert apply_integer_overflow(mode, 2**64, "x", _LOCATION) == 0
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_trap_raises_with_location(self):
+        with pytest.raises(IntegerOverflowError) as exc_info:
+            apply_integer_overflow(
+                IntegerOverflowMode.TRAP, INT64_MAX + 1, "x + 1", _LOCATION
+            )
+        err = exc_info.value
+        assert err.location == _LOCATION
+        assert err.result == INT64_MAX + 1
+        assert "3:4-3:13" in str(err)
+        assert "x + 1" in str(err)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_non_integers_pass_through(self):
+        mode = IntegerOverflowMode.TRAP
+        assert apply_integer_overflow(mode, 1e300, "x", _LOCATION) == 1e300
+        assert apply_integer_overflow(mode, True, "x", _LOCATION) is True
+        assert apply_integer_overflow(mode, "s", "x", _LOCATION) == "s"
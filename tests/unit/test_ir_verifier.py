@@ -0,0 +1,118 @@
+"""Tests for the IR verifier's structural invariants."""
+
+from __future__ import annotations
+
+import pytest
+
+from interpreter.instructions import (
+    Binop,
+    Branch,
+    BranchIf,
+    Const,
+    Label_,
+    Return_,
+    TryPush,
+)
+from interpreter.ir import CodeLabel
+from interpreter.ir_verifier import (
+    IRVerificationError,
+    IRViolationKind,
+    check_ir,
+    verify_ir,
+)
+from interpreter.register import Register
+from tests.covers import NotLanguageFeature, covers
+
+
+def _reg(name: str) -> Register:
+    return Register(name)
+
+
+def _label(name: str) -> CodeLabel:
+    return CodeLabel(name)
+
+
+class TestVerifyIR:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_well_formed_ir_has_no_violations(self):
+        ir = [
+            Label_(label=_label("entry")),
+            Const.int_(_reg("%0"), 1),
+            Const.int_(_reg("%1"), 2),
+            Binop(result_reg=_reg("%2"), left=_reg("%0"), right=_reg("%1")),
+            BranchIf(
+                cond_reg=_reg("%2"),
+                branch_targets=(_label("then_0"), _label("end_0")),
+            ),
+            Label_(label=_label("then_0")),
+            Branch(label=_label("end_0")),
+            Label_(label=_label("end_0")),
+            Return_(value_reg=_reg("%2")),
+        ]
+        assert verify_ir(ir) == ()
+        check_ir(ir)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_duplicate_label(self):
+        ir = [Label_(label=_label("entry")), Label_(label=_label("entry"))]
+        [violation] = verify_ir(ir)
+        assert violation.kind == IRViolationKind.DUPLICATE_LABEL
+        assert violation.index == 1
+        assert violation.subject == "entry"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_undefined_branch_target(self):
+        ir = [Label_(label=_label("entry")), Branch(label=_label("missing"))]
+        [violation] = verify_ir(ir)
+        assert violation.kind == IRViolationKind.UNDEFINED_LABEL
+        assert violation.subject == "missing"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_try_push_targets_must_be_defined(self):
+        ir = [
+            TryPush(catch_labels=(_label("catch_0"),), end_label=_label("end_0")),
+            Label_(label=_label("end_0")),
+        ]
+        [violation] = verify_ir(ir)
+        assert violation.kind == IRViolationKind.UNDEFINED_LABEL
+        assert violation.subject == "catch_0"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_undefined_register(self):
+        ir = [Const.int_(_reg("%0"), 1), Return_(value_reg=_reg("%7"))]
+        [violation] = verify_ir(ir)
+        assert violation.kind == IRViolationKind.UNDEFINED_REGISTER
+        assert violation.index == 1
+        assert violation.subject == "%7"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_register_written_later_counts_as_defined(self):
+        ir = [
+            Branch(label=_label("def_0")),
+            Label_(label=_label("use_0")),
+            Return_(value_reg=_reg("%0")),
+            Label_(label=_label("def_0")),
+            Const.int_(_reg("%0"), 1),
+            Branch(label=_label("use_0")),
+        ]
+        assert verify_ir(ir) == ()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_violations_are_in_instruction_order(self):
+        ir = [
+            Return_(value_reg=_reg("%9")),
+            Label_(label=_label("a")),
+            Label_(label=_label("a")),
+        ]
+        assert [v.index for v in verify_ir(ir)] == [0, 2]
+
+
+class TestCheckIR:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_raises_listing_every_violation(self):
+        ir = [Branch(label=_label("nowhere")), Return_(value_reg=_reg("%3"))]
+        with pytest.raises(IRVerificationError) as exc_info:
+            check_ir(ir)
+        assert len(exc_info.value.violations) == 2
+        assert "undefined label nowhere" in str(exc_info.value)
+        assert "undefined register %3" in str(exc_info.value)
@@ -86,7 +86,7 @@ class TestJavaVariables:
         stores = _find_all(instructions, Opcode.DECL_VAR)
         assert any("x" in inst.operands for inst in stores)
         consts = _find_all(instructions, Opcode.CONST)
-        assert any(None in inst.operands for inst in consts)
+        assert any(inst.operands == [] for inst in consts)
 
     @covers(JavaFeature.ASSIGNMENT)
     def test_assignment_expression(self):
@@ -0,0 +1,58 @@
+"""Tests for the liveness of variables."""
+
+from __future__ import annotations
+
+from interpreter.cfg import build_cfg
+from interpreter.instructions import (
+    Branch,
+    BranchIf,
+    Const,
+    DeclVar,
+    Label_,
+    LoadVar,
+    StoreVar,
+)
+from interpreter.ir import CodeLabel
+from interpreter.liveness import live_before, live_out
+from interpreter.register import Register
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+
+class TestLiveness:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_loop_variable_is_live_around_the_back_edge(self):
+        zero, cond, one = Register("%0"), Register("%1"), Register("%2")
+        head, body, done = CodeLabel("head"), CodeLabel("body"), CodeLabel("done")
+        cfg = build_cfg(
+            [
+                Label_(label=CodeLabel("entry")),
+                Const.int_(zero, 0),
+                DeclVar(name=VarName("i"), value_reg=zero),
+                Branch(label=head),
+                Label_(label=head),
+                LoadVar(result_reg=cond, name=VarName("i")),
+                BranchIf(cond_reg=cond, branch_targets=(body, done)),
+                Label_(label=body),
+                Const.int_(one, 1),
+                StoreVar(name=VarName("i"), value_reg=one),
+                Branch(label=head),
+                Label_(label=done),
+            ]
+        )
+
+        live = live_out(cfg)
+
+        assert live[CodeLabel("entry")] == {"i"}
+        assert live[body] == {"i"}
+        assert live[head] == frozenset()
+        assert live[done] == frozenset()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_assignment_ends_and_read_starts_liveness(self):
+        live = frozenset({"x", "y"})
+        store = StoreVar(name=VarName("x"), value_reg=Register("%0"))
+        load = LoadVar(result_reg=Register("%1"), name=VarName("z"))
+
+        assert live_before(store, live) == {"y"}
+        assert live_before(load, live) == {"x", "y", "z"}
@@ -0,0 +1,176 @@
+"""Tests for reporting functions that can end without returning a value."""
+
+from __future__ import annotations
+
+from interpreter.api import missing_returns
+from interpreter.constants import FoundationTypeName, Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.frontends.rust.features import RustFeature
+from interpreter.instructions import (
+    Branch,
+    BranchIf,
+    Const,
+    InstructionBase,
+    Label_,
+    LoadVar,
+    Return_,
+)
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.missing_returns import find_missing_returns
+from interpreter.register import Register
+from interpreter.types.type_expr import scalar
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+JAVA_SOURCE = """\
+class M {
+    static int sign(int n) {
+        if (n > 0) {
+            return 1;
+        } else if (n < 0) {
+            return -1;
+        }
+    }
+
+    static int abs(int n) {
+        if (n < 0) {
+            return -n;
+        } else {
+            return n;
+        }
+    }
+
+    static void log(int n) {
+    }
+
+    static int first(int n) {
+        while (true) {
+            if (n > 0) {
+                return n;
+            }
+            n = n + 1;
+        }
+    }
+}
+"""
+
+GO_SOURCE = """\
+package main
+
+func sign(n int) int {
+\tif n > 0 {
+\t\treturn 1
+\t}
+}
+
+func positive(n int) int {
+\tif n > 0 {
+\t\treturn n
+\t}
+\tpanic("not positive")
+}
+
+func main() {
+\tsign(1)
+\tpositive(1)
+}
+"""
+
+RUST_SOURCE = """\
+fn double(n: i32) -> i32 {
+    n * 2
+}
+
+fn check(n: i32) -> i32 {
+    if n > 0 {
+        return n;
+    }
+    panic!("not positive");
+}
+"""
+
+
+def _function(condition: InstructionBase) -> list[InstructionBase]:
+    """``f`` returns 1 when *condition* holds and falls off the end otherwise."""
+    one, default = Register("%1"), Register("%2")
+    then, end = CodeLabel("if_true_2"), CodeLabel("if_end_3")
+    return [
+        Branch(label=CodeLabel("end_f_1")),
+        Label_(label=CodeLabel("func_f_0")),
+        condition,
+        BranchIf(cond_reg=condition.result_reg, branch_targets=(then, end)),
+        Label_(label=then),
+        Const.int_(one, 1),
+        Return_(value_reg=one),
+        Label_(label=end),
+        Const.null_(default),
+        Return_(
+            value_reg=default,
+            implicit=True,
+            source_location=SourceLocation(
+                start_line=1, start_col=0, end_line=6, end_col=1
+            ),
+        ),
+        Label_(label=CodeLabel("end_f_1")),
+    ]
+
+
+_UNKNOWN_CONDITION = LoadVar(result_reg=Register("%0"), name=VarName("c"))
+_RETURNS_INT = {"func_f_0": scalar(FoundationTypeName.INT)}
+_RETURNS_NOTHING = {"func_f_0": scalar(FoundationTypeName.ANY)}
+
+
+class TestFindMissingReturns:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_reachable_implicit_return_is_reported_with_its_path(self):
+        missing = find_missing_returns(
+            _function(_UNKNOWN_CONDITION), _RETURNS_INT, Language.JAVA
+        )
+
+        assert [(m.function, m.path) for m in missing] == [
+            ("f", (CodeLabel("if_end_3"),))
+        ]
+        assert missing[0].message == "missing return statement in f (via if_end_3)"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_functions_without_a_result_are_not_checked(self):
+        instructions = _function(_UNKNOWN_CONDITION)
+
+        assert find_missing_returns(instructions, {}, Language.JAVA) == ()
+        assert find_missing_returns(instructions, _RETURNS_NOTHING, Language.JAVA) == ()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_branch_on_a_literal_follows_one_side(self):
+        instructions = _function(Const.bool_(Register("%0"), True))
+
+        assert find_missing_returns(instructions, _RETURNS_INT, Language.JAVA) == ()
+
+
+class TestMissingReturns:
+    @covers(JavaFeature.IF_ELSE)
+    def test_java_method_without_a_final_else(self):
+        missing = missing_returns(JAVA_SOURCE, Language.JAVA)
+
+        assert [m.function for m in missing] == ["sign"]
+        assert missing[0].location.start_line == 2
+        assert str(missing[0].path[-1]).startswith("if_end")
+
+    @covers(GoFeature.PANIC_RECOVER)
+    def test_go_panic_ends_a_path(self):
+        missing = missing_returns(GO_SOURCE, Language.GO)
+
+        assert [(m.function, m.location.start_line) for m in missing] == [
+            ("sign", 3)
+        ]
+
+    @covers(RustFeature.RETURN)
+    def test_rust_tail_expression_and_panic_return(self):
+        assert missing_returns(RUST_SOURCE, Language.RUST) == ()
+
+    @covers(PythonFeature.FUNCTION_DECLARATION)
+    def test_dynamically_typed_languages_are_not_checked(self):
+        source = "def f(n) -> int:\n    if n:\n        return 1\n"
+
+        assert missing_returns(source, Language.PYTHON) == ()
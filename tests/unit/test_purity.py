@@ -0,0 +1,210 @@
+"""Tests for classifying functions as pure or impure."""
+
+from __future__ import annotations
+
+from interpreter.api import function_purity
+from interpreter.cfg import build_cfg
+from interpreter.constants import Language
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.frontends.scopes import EMPTY_SCOPE
+from interpreter.func_name import FuncName
+from interpreter.instructions import (
+    Branch,
+    CallFunction,
+    Const,
+    InstructionBase,
+    Label_,
+    NewArray,
+    Return_,
+    StoreIndex,
+)
+from interpreter.interprocedural.call_graph import build_call_graph
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.purity import find_purity
+from interpreter.purity_types import Effect, Impurity
+from interpreter.register import Register
+from interpreter.registry import FunctionRegistry
+from tests.covers import NotLanguageFeature, covers
+
+PYTHON_SOURCE = """\
+scale = 2
+
+
+def area(w, h):
+    return w * h
+
+
+def scaled(w):
+    return w * scale
+
+
+def report(w, h):
+    print(area(w, h))
+
+
+def pad(xs):
+    xs.append(0)
+    return xs
+"""
+
+JAVA_SOURCE = """\
+class Counter {
+    int count;
+
+    void inc() {
+        count = count + 1;
+    }
+
+    static Counter started() {
+        Counter c = new Counter();
+        c.inc();
+        return c;
+    }
+
+    static void bump(Counter c) {
+        c.inc();
+    }
+}
+"""
+
+
+def _at(line: int) -> SourceLocation:
+    return SourceLocation(start_line=line, start_col=0, end_line=line, end_col=9)
+
+
+def _function(
+    name: str, index: int, line: int, body: list[InstructionBase]
+) -> list[InstructionBase]:
+    """*name*, declared on *line*, with *body*, and a reference to it."""
+    entry = CodeLabel(f"func_{name}_{index}")
+    end = CodeLabel(f"end_{name}_{index + 1}")
+    return [
+        Branch(label=end, source_location=_at(line)),
+        Label_(label=entry),
+        *body,
+        Return_(),
+        Label_(label=end),
+        Const.func_ref(Register(f"%f{index}"), str(entry)),
+    ]
+
+
+def _program() -> tuple[list[InstructionBase], FunctionRegistry]:
+    """tick prints, shout calls tick, fill fills a new array, keep calls fill."""
+    array = Register("%a")
+    instructions = [
+        *_function(
+            "tick",
+            0,
+            1,
+            [
+                CallFunction(
+                    result_reg=Register("%p"),
+                    func_name=FuncName("print"),
+                    source_location=_at(2),
+                )
+            ],
+        ),
+        *_function(
+            "shout",
+            2,
+            3,
+            [
+                CallFunction(
+                    result_reg=Register("%t"),
+                    func_name=FuncName("func_tick_0"),
+                    source_location=_at(4),
+                )
+            ],
+        ),
+        *_function(
+            "fill",
+            4,
+            5,
+            [
+                NewArray(result_reg=array, size_reg=Register("%n")),
+                StoreIndex(
+                    arr_reg=array,
+                    index_reg=Register("%i"),
+                    value_reg=Register("%v"),
+                    source_location=_at(6),
+                ),
+            ],
+        ),
+        *_function(
+            "keep",
+            6,
+            7,
+            [
+                CallFunction(
+                    result_reg=Register("%k"), func_name=FuncName("func_fill_4")
+                )
+            ],
+        ),
+    ]
+    registry = FunctionRegistry(
+        func_params={
+            CodeLabel(f"func_{name}_{index}"): []
+            for name, index in [("tick", 0), ("shout", 2), ("fill", 4), ("keep", 6)]
+        }
+    )
+    return instructions, registry
+
+
+class TestFindPurity:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_effects_and_calls_of_impure_functions_make_a_function_impure(self):
+        instructions, registry = _program()
+        graph = build_call_graph(build_cfg(instructions), registry)
+
+        purity = find_purity(instructions, graph, EMPTY_SCOPE)
+
+        assert [(p.function, p.effects) for p in purity] == [
+            ("tick", (Effect(_at(2), Impurity.IO, "print"),)),
+            ("shout", (Effect(_at(4), Impurity.IMPURE_CALL, "tick"),)),
+            ("fill", ()),
+            ("keep", ()),
+        ]
+        assert str(purity[1]) == (
+            "3:0-3:9: shout is impure: it calls an impure function (tick)"
+        )
+        assert str(purity[2]) == "5:0-5:9: fill is pure"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_required_purity_is_an_error_with_each_effect(self):
+        instructions, registry = _program()
+        graph = build_call_graph(build_cfg(instructions), registry)
+
+        diagnostic = find_purity(instructions, graph, EMPTY_SCOPE)[0].as_diagnostic()
+
+        assert diagnostic.code == DiagnosticCode.IMPURE_FUNCTION
+        assert str(diagnostic) == "1:0-1:9: error[RD3005]: tick must be pure"
+        assert [str(span) for span in diagnostic.secondary] == [
+            "2:0-2:9: note: tick performs I/O (print)"
+        ]
+
+
+class TestFunctionPurity:
+    @covers(PythonFeature.FUNCTION_DECLARATION, PythonFeature.METHOD_CALL)
+    def test_python_globals_io_and_mutated_arguments(self):
+        purity = function_purity(PYTHON_SOURCE, Language.PYTHON)
+
+        assert [
+            (p.function, [(e.impurity, e.detail) for e in p.effects]) for p in purity
+        ] == [
+            ("area", []),
+            ("scaled", [(Impurity.NONLOCAL, "scale")]),
+            ("report", [(Impurity.IO, "print")]),
+            ("pad", [(Impurity.MUTATION, "xs")]),
+        ]
+
+    @covers(JavaFeature.METHOD_CALL, JavaFeature.FIELD_ACCESS)
+    def test_java_receiver_mutation_is_pure_to_the_owner_of_the_object(self):
+        purity = {p.function: p for p in function_purity(JAVA_SOURCE, Language.JAVA)}
+
+        assert [e.impurity for e in purity["inc"].effects] == [Impurity.RECEIVER]
+        assert purity["started"].is_pure
+        assert [(e.impurity, e.detail) for e in purity["bump"].effects] == [
+            (Impurity.MUTATION, "inc")
+        ]
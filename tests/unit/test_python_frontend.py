@@ -10,6 +10,7 @@ from interpreter.ir import (
     CodeLabel,
     Opcode,
     SourceLocation,
+    SourcePosition,
     SpreadArguments,
 )
 from interpreter.parser import TreeSitterParserFactory
@@ -1249,6 +1250,15 @@ class TestSourceLocationModel:
         assert loc.end_line == 5
         assert loc.end_col == 12
 
+    @covers(PythonFeature.SOURCE_LOCATION)
+    def test_pos_and_end(self):
+        loc = SourceLocation(
+            start_line=2, start_col=4, end_line=3, end_col=1, start_byte=9, end_byte=20
+        )
+        assert loc.pos() == SourcePosition(line=2, col=4, byte=9)
+        assert loc.end() == SourcePosition(line=3, col=1, byte=20)
+        assert str(loc) == "2:4-3:1"
+
     @covers(PythonFeature.SOURCE_LOCATION)
     def test_no_source_location_is_unknown(self):
         assert NO_SOURCE_LOCATION.is_unknown()
@@ -1296,6 +1306,17 @@ class TestSourceLocationTraceability:
         y_store = next(s for s in stores if "y" in s.operands)
         assert y_store.source_location.start_line == 2
 
+    @covers(PythonFeature.SOURCE_LOCATION)
+    def test_source_location_carries_byte_span(self):
+        """pos()/end() give line, column and byte offset of the lowered node."""
+        source = "x = 10\ny = 20"
+        instructions = _parse_python(source)
+        stores = _find_all(instructions, Opcode.STORE_VAR)
+        y_loc = next(s for s in stores if "y" in s.operands).source_location
+        assert y_loc.pos() == SourcePosition(line=2, col=0, byte=7)
+        assert y_loc.end() == SourcePosition(line=2, col=6, byte=13)
+        assert source[y_loc.start_byte : y_loc.end_byte] == "y = 20"
+
     @covers(PythonFeature.SOURCE_LOCATION)
     def test_instruction_str_includes_source_location(self):
         """str(instruction) should include # line:col-line:col when source_location is set."""
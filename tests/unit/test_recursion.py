@@ -0,0 +1,139 @@
+"""Tests for finding directly and mutually recursive functions."""
+
+from __future__ import annotations
+
+from interpreter.api import recursive_functions
+from interpreter.cfg import build_cfg
+from interpreter.constants import Language
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.func_name import FuncName
+from interpreter.instructions import (
+    Branch,
+    CallFunction,
+    InstructionBase,
+    Label_,
+    Return_,
+)
+from interpreter.interprocedural.call_graph import build_call_graph
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.recursion import find_recursion
+from interpreter.recursion_types import Recursion
+from interpreter.register import Register
+from interpreter.registry import FunctionRegistry
+from tests.covers import NotLanguageFeature, covers
+
+PYTHON_SOURCE = """\
+def even(n):
+    return n == 0 or odd(n - 1)
+
+
+def odd(n):
+    return n != 0 and even(n - 1)
+
+
+def fact(n):
+    return 1 if n <= 1 else n * fact(n - 1)
+
+
+def twice(n):
+    return fact(n) * 2
+"""
+
+JAVA_SOURCE = """\
+class M {
+    static int fib(int n) {
+        return n < 2 ? n : fib(n - 1) + fib(n - 2);
+    }
+
+    static int sum(int n) {
+        int total = 0;
+        for (int i = 0; i < n; i++) {
+            total += i;
+        }
+        return total;
+    }
+}
+"""
+
+
+def _function(
+    name: str, index: int, line: int, calls: list[str]
+) -> list[InstructionBase]:
+    """*name*, declared on *line*, calling each function of *calls*."""
+    end = CodeLabel(f"end_{name}_{index + 1}")
+    location = SourceLocation(start_line=line, start_col=0, end_line=line, end_col=9)
+    return [
+        Branch(label=end, source_location=location),
+        Label_(label=CodeLabel(f"func_{name}_{index}")),
+        *(
+            CallFunction(result_reg=Register(f"%{line}{i}"), func_name=FuncName(c))
+            for i, c in enumerate(calls)
+        ),
+        Return_(),
+        Label_(label=end),
+    ]
+
+
+def _program() -> tuple[list[InstructionBase], FunctionRegistry]:
+    """f calls itself, g and h call each other, k calls f."""
+    instructions = [
+        *_function("h", 4, 3, ["func_g_2"]),
+        *_function("f", 0, 1, ["func_f_0"]),
+        *_function("g", 2, 2, ["func_h_4"]),
+        *_function("k", 6, 4, ["func_f_0"]),
+    ]
+    registry = FunctionRegistry(
+        func_params={
+            CodeLabel(f"func_{name}_{index}"): []
+            for name, index in [("f", 0), ("g", 2), ("h", 4), ("k", 6)]
+        }
+    )
+    return instructions, registry
+
+
+class TestFindRecursion:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_direct_and_mutual_recursion_in_source_order(self):
+        instructions, registry = _program()
+        graph = build_call_graph(build_cfg(instructions), registry)
+
+        recursions = find_recursion(instructions, graph)
+
+        assert [(r.function, r.others) for r in recursions] == [
+            ("f", ()),
+            ("g", ("h",)),
+            ("h", ("g",)),
+        ]
+        assert str(recursions[0]) == "1:0-1:9: f calls itself"
+        assert recursions[2].as_error() == (
+            "3:0-3:9: error: recursion not allowed in this dialect "
+            "(h is mutually recursive with g)"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_message_lists_every_other_function_of_the_cycle(self):
+        location = SourceLocation(start_line=1, start_col=0, end_line=1, end_col=1)
+
+        recursion = Recursion(location=location, function="a", others=("b", "c"))
+
+        assert recursion.is_mutual
+        assert recursion.message == "a is mutually recursive with b and c"
+
+
+class TestRecursiveFunctions:
+    @covers(PythonFeature.FUNCTION_DECLARATION)
+    def test_python_mutual_and_direct_recursion(self):
+        recursions = recursive_functions(PYTHON_SOURCE, Language.PYTHON)
+
+        assert [(r.location.start_line, r.function, r.others) for r in recursions] == [
+            (1, "even", ("odd",)),
+            (5, "odd", ("even",)),
+            (9, "fact", ()),
+        ]
+
+    @covers(JavaFeature.METHOD_DECLARATION)
+    def test_java_recursive_method(self):
+        recursions = recursive_functions(JAVA_SOURCE, Language.JAVA)
+
+        assert [(r.function, r.is_mutual) for r in recursions] == [("fib", False)]
@@ -0,0 +1,144 @@
+"""Tests for the public token stream."""
+
+from __future__ import annotations
+
+import pytest
+
+from interpreter.constants import Language
+from interpreter.scanner import Scanner, tokenize
+from interpreter.token_types import END_TOKEN, TokenCategory
+from tests.covers import NotLanguageFeature, covers
+
+K = TokenCategory
+
+
+def _pairs(source: str, language: Language) -> list[tuple[str, TokenCategory]]:
+    return [(t.text, t.category) for t in tokenize(source, language)]
+
+
+class TestTokenize:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_python(self):
+        source = 'def f(x):  # doc\n    return x + 1.5 if x else "a b"\n'
+        assert _pairs(source, Language.PYTHON) == [
+            ("def", K.KEYWORD),
+            ("f", K.IDENTIFIER),
+            ("(", K.PUNCTUATION),
+            ("x", K.IDENTIFIER),
+            (")", K.PUNCTUATION),
+            (":", K.PUNCTUATION),
+            ("# doc", K.COMMENT),
+            ("return", K.KEYWORD),
+            ("x", K.IDENTIFIER),
+            ("+", K.OPERATOR),
+            ("1.5", K.NUMBER),
+            ("if", K.KEYWORD),
+            ("x", K.IDENTIFIER),
+            ("else", K.KEYWORD),
+            ('"a b"', K.STRING),
+        ]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_c_primitive_type(self):
+        assert ("int", K.TYPE) in _pairs("int x = 1;", Language.C)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_go(self):
+        source = "package main\n\nfunc f() int { var c rune = 'x'; return nil }\n"
+        assert _pairs(source, Language.GO) == [
+            ("package", K.KEYWORD),
+            ("main", K.IDENTIFIER),
+            ("func", K.KEYWORD),
+            ("f", K.IDENTIFIER),
+            ("(", K.PUNCTUATION),
+            (")", K.PUNCTUATION),
+            ("int", K.IDENTIFIER),
+            ("{", K.PUNCTUATION),
+            ("var", K.KEYWORD),
+            ("c", K.IDENTIFIER),
+            ("rune", K.IDENTIFIER),
+            ("=", K.OPERATOR),
+            ("'x'", K.STRING),
+            (";", K.PUNCTUATION),
+            ("return", K.KEYWORD),
+            ("nil", K.KEYWORD),
+            ("}", K.PUNCTUATION),
+        ]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_type_keywords_and_literals(self):
+        pairs = _pairs("class A { char c = 'x'; int n = 0x1F; }", Language.JAVA)
+        assert ("char", K.KEYWORD) in pairs
+        assert ("'x'", K.STRING) in pairs
+        assert ("0x1F", K.NUMBER) in pairs
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_tokens_cover_source_without_gaps_but_whitespace(self):
+        source = "let s = `a ${b} c`; // end\nconst n = s.length >>> 1;\n"
+        tokens = tokenize(source, Language.JAVASCRIPT)
+        rebuilt = "".join(t.text for t in tokens)
+        assert "".join(rebuilt.split()) == "".join(source.split())
+        assert [t.location.start_byte for t in tokens] == sorted(
+            t.location.start_byte for t in tokens
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_malformed_source_still_tokenizes(self):
+        tokens = tokenize("x = = 1\n", Language.PYTHON)
+        assert [t.text for t in tokens] == ["x", "=", "=", "1"]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_illegal_character_is_an_error_token(self):
+        tokens = tokenize("x = 1 $ 2\ny = 3\n", Language.PYTHON)
+        errors = [t for t in tokens if t.category == K.ERROR]
+        assert any("$" in t.text for t in errors)
+        assert errors[0].location.start_line == 1
+        assert [t.text for t in tokens][-3:] == ["y", "=", "3"]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_unterminated_string_is_an_error_token(self):
+        source = 'package main\nvar s = "abc\nvar n = 1\n'
+        tokens = tokenize(source, Language.GO)
+        [first_error, *_] = [t for t in tokens if t.category == K.ERROR]
+        assert first_error.text.startswith('"')
+        location = first_error.location
+        assert (location.start_line, location.start_col) == (2, 8)
+        assert [t.text for t in tokens][-4:] == ["var", "n", "=", "1"]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_locations(self):
+        [_, second] = tokenize("a\n  bc\n", Language.PYTHON)[:2]
+        assert (second.location.start_line, second.location.start_col) == (2, 2)
+        assert (second.location.start_byte, second.location.end_byte) == (4, 6)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_tokens_are_spans_of_the_shared_source(self):
+        tokens = tokenize("total = total + 1\n", Language.PYTHON)
+        assert all(t.source is tokens[0].source for t in tokens)
+        assert "text" not in vars(tokens[0])  # built on first read
+        assert tokens[0].text is tokens[2].text  # interned
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_cobol_is_rejected(self):
+        with pytest.raises(ValueError, match="COBOL"):
+            tokenize("       IDENTIFICATION DIVISION.\n", Language.COBOL)
+
+
+class TestScanner:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_pulls_tokens_then_end(self):
+        scanner = Scanner("x = 1", Language.PYTHON)
+        assert scanner.next_token().text == "x"
+        assert scanner.next_token().text == "="
+        assert scanner.next_token().text == "1"
+        assert scanner.next_token() == END_TOKEN
+        assert scanner.next_token().is_end()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_is_an_iterator(self):
+        assert [t.kind for t in Scanner("f(a)", Language.PYTHON)] == [
+            "identifier",
+            "(",
+            "identifier",
+            ")",
+        ]
@@ -0,0 +1,118 @@
+"""Tests for the scopes and symbols lowering resolves names against."""
+
+from __future__ import annotations
+
+from interpreter.api import resolve_scopes, type_at
+from interpreter.constants import Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.frontends.scopes import (
+    NO_SYMBOL,
+    ScopeBuilder,
+    ScopeKind,
+    SymbolKind,
+)
+from interpreter.ir import SourceLocation, SourcePosition
+from interpreter.types.type_expr import UNKNOWN, scalar
+from tests.covers import NotLanguageFeature, covers
+
+PYTHON_SOURCE = """\
+x = 1
+def f(a):
+    x = a
+    return x
+y = f(x)
+"""
+
+GO_SOURCE = """\
+package main
+
+func pick() int {
+\tx := 1
+\tif true {
+\t\tx := 2
+\t\ty := x
+\t}
+\treturn x
+}
+
+func main() {
+\tpick()
+}
+"""
+
+
+GO_INFERRED_SOURCE = """\
+package main
+
+func area(r float64) float64 {
+\tscale := 2
+\ttotal := r * r * 3.14
+\treturn total * float64(scale)
+}
+"""
+
+
+def _at(line: int, col: int) -> SourcePosition:
+    return SourcePosition(line, col, 0)
+
+
+def _span(start_line: int, end_line: int) -> SourceLocation:
+    return SourceLocation(
+        start_line=start_line, start_col=0, end_line=end_line, end_col=0
+    )
+
+
+class TestScopeLookup:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_innermost_declaration_wins(self):
+        builder = ScopeBuilder(_span(1, 10))
+        builder.declare("n", SymbolKind.VARIABLE, _span(1, 1))
+        builder.enter(ScopeKind.FUNCTION, _span(2, 5), "f")
+        builder.declare("n", SymbolKind.VARIABLE, _span(3, 3))
+        builder.exit(ScopeKind.FUNCTION, "f")
+        scopes = builder.build()
+        assert scopes.lookup_at(_at(4, 0), "n").location.start_line == 3
+        assert scopes.lookup_at(_at(6, 0), "n").location.start_line == 1
+        assert scopes.scope_at(_at(4, 0)).kind == ScopeKind.FUNCTION
+        assert scopes.lookup_at(_at(4, 0), "m") is NO_SYMBOL
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_declaration_after_the_use_is_found(self):
+        builder = ScopeBuilder(_span(1, 10))
+        builder.declare("g", SymbolKind.FUNCTION, _span(8, 9))
+        scopes = builder.build()
+        assert scopes.lookup_at(_at(2, 0), "g").kind == SymbolKind.FUNCTION
+
+
+class TestResolvedScopes:
+    @covers(PythonFeature.FUNCTION_DECLARATION)
+    def test_python_assignment_declares_a_local(self):
+        scopes = resolve_scopes(PYTHON_SOURCE, Language.PYTHON)
+        assert scopes.lookup_at(_at(4, 11), "x").location.start_line == 3
+        assert scopes.lookup_at(_at(4, 11), "a").location.start_line == 2
+        assert scopes.lookup_at(_at(5, 6), "x").location.start_line == 1
+        f = scopes.lookup_at(_at(5, 4), "f")
+        assert (f.kind, f.location.start_line) == (SymbolKind.FUNCTION, 2)
+        assert not scopes.lookup_at(_at(5, 0), "a").is_present()
+
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_go_shadowing_declaration_in_a_block(self):
+        scopes = resolve_scopes(GO_SOURCE, Language.GO)
+        inner = scopes.lookup_at(_at(7, 7), "x")
+        assert inner.location.start_line == 6
+        assert inner.resolved_name != "x"
+        outer = scopes.lookup_at(_at(9, 8), "x")
+        assert (outer.location.start_line, outer.resolved_name) == (4, "x")
+        pick = scopes.lookup_at(_at(13, 1), "pick")
+        assert (pick.kind, pick.location.start_line) == (SymbolKind.FUNCTION, 3)
+
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_short_var_decl_reports_its_inferred_type(self):
+        total = type_at(GO_INFERRED_SOURCE, _at(6, 8), "total", Language.GO)
+        scale = type_at(GO_INFERRED_SOURCE, _at(6, 24), "scale", Language.GO)
+        assert (total, scale) == (scalar("Float"), scalar("Int"))
+        assert type_at(GO_INFERRED_SOURCE, _at(6, 8), "r", Language.GO) == scalar(
+            "Float"
+        )
+        assert type_at(GO_INFERRED_SOURCE, _at(6, 8), "y", Language.GO) is UNKNOWN
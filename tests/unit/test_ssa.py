@@ -0,0 +1,220 @@
+"""Tests for converting programs to SSA form and back."""
+
+from __future__ import annotations
+
+from interpreter.api import ssa_form
+from interpreter.constants import Language
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.frontends.scopes import Scope, ScopeBuilder, SymbolKind
+from interpreter.instructions import (
+    Binop,
+    Branch,
+    BranchIf,
+    Const,
+    DeclVar,
+    InstructionBase,
+    Label_,
+    LoadVar,
+    Return_,
+    StoreVar,
+    Symbolic,
+)
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.operator_kind import BinopKind
+from interpreter.register import Register
+from interpreter.ssa import from_ssa, to_ssa
+from interpreter.ssa_types import SSAMode
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+PYTHON_SOURCE = """\
+def count(n):
+    i = 0
+    while i < n:
+        i = i + 1
+    return i
+"""
+
+JAVA_SOURCE = """\
+class Counter {
+    static int count(int n) {
+        int i = 0;
+        while (i < n) {
+            i = i + 1;
+        }
+        return i;
+    }
+}
+"""
+
+
+def _scopes(*names: str) -> Scope:
+    builder = ScopeBuilder(
+        SourceLocation(start_line=1, start_col=0, end_line=20, end_col=0)
+    )
+    for name in names:
+        builder.declare(name, SymbolKind.VARIABLE, SourceLocation())
+    return builder.build()
+
+
+def _load(result: str, name: str) -> LoadVar:
+    return LoadVar(result_reg=Register(result), name=VarName(name))
+
+
+def _decl(name: str, value: str) -> DeclVar:
+    return DeclVar(name=VarName(name), value_reg=Register(value))
+
+
+def _loop() -> list[InstructionBase]:
+    """i counts up to 10 and is returned; t is read once, before the loop,
+    and k is never read; the loop assigns all three."""
+    cond, body, done = CodeLabel("cond"), CodeLabel("body"), CodeLabel("done")
+    return [
+        Label_(label=CodeLabel("entry")),
+        _load("%t", "t"),
+        Const.int_(Register("%0"), 0),
+        _decl("i", "%0"),
+        _decl("t", "%0"),
+        Label_(label=cond),
+        _load("%1", "i"),
+        Const.int_(Register("%2"), 10),
+        Binop(
+            result_reg=Register("%3"),
+            operator=BinopKind.LT,
+            left=Register("%1"),
+            right=Register("%2"),
+        ),
+        BranchIf(cond_reg=Register("%3"), branch_targets=(body, done)),
+        Label_(label=body),
+        _load("%4", "i"),
+        _decl("t", "%4"),
+        _decl("k", "%4"),
+        Const.int_(Register("%5"), 1),
+        Binop(
+            result_reg=Register("%6"),
+            operator=BinopKind.ADD,
+            left=Register("%4"),
+            right=Register("%5"),
+        ),
+        StoreVar(name=VarName("i"), value_reg=Register("%6")),
+        Branch(label=cond),
+        Label_(label=done),
+        _load("%7", "i"),
+        Return_(value_reg=Register("%7")),
+    ]
+
+
+def _diamond() -> list[InstructionBase]:
+    """x is 0, or 1 when c holds, then returned."""
+    then, join = CodeLabel("then"), CodeLabel("join")
+    return [
+        Label_(label=CodeLabel("entry")),
+        Symbolic(result_reg=Register("%c"), hint="param:c"),
+        Const.int_(Register("%0"), 0),
+        _decl("x", "%0"),
+        BranchIf(cond_reg=Register("%c"), branch_targets=(then, join)),
+        Label_(label=then),
+        Const.int_(Register("%1"), 1),
+        StoreVar(name=VarName("x"), value_reg=Register("%1")),
+        Label_(label=join),
+        _load("%2", "x"),
+        Return_(value_reg=Register("%2")),
+    ]
+
+
+class TestToSsa:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_each_mode_places_fewer_phis(self):
+        def phi_names(mode: SSAMode) -> list[str]:
+            ssa = to_ssa(_scopes("i", "t", "k"), _loop(), mode)
+            return [phi.name for phi in ssa.phis.get(CodeLabel("cond"), ())]
+
+        assert phi_names(SSAMode.MINIMAL) == ["i", "k", "t"]
+        assert phi_names(SSAMode.SEMI_PRUNED) == ["i", "t"]
+        assert phi_names(SSAMode.PRUNED) == ["i"]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_reads_name_the_version_reaching_them(self):
+        ssa = to_ssa(_scopes("i", "t", "k"), _loop())
+
+        assert [str(phi) for phi in ssa.phis[CodeLabel("cond")]] == [
+            "i#2 = phi(entry: i#1, body: i#3)"
+        ]
+        body = [str(inst) for inst in ssa.cfg.blocks[CodeLabel("body")].instructions]
+        assert body[0] == "%4 = load_var i#2"
+        assert "decl_var i#3 %6" in body
+        done = ssa.cfg.blocks[CodeLabel("done")].instructions
+        assert str(done[0]) == "%7 = load_var i#2"
+        assert str(ssa.cfg.blocks[CodeLabel("entry")].instructions[0]) == (
+            "%t = load_var t"
+        )
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_variables_the_scopes_do_not_declare_keep_their_names(self):
+        ssa = to_ssa(_scopes("t", "k"), _loop())
+
+        assert not ssa.phis
+        assert str(ssa.cfg.blocks[CodeLabel("done")].instructions[0]) == (
+            "%7 = load_var i"
+        )
+
+
+class TestFromSsa:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_phi_becomes_copies_with_the_branch_edge_split(self):
+        ssa = to_ssa(_scopes("x"), _diamond())
+
+        assert [str(inst) for inst in from_ssa(ssa)] == [
+            "entry:",
+            "%c = symbolic param:c",
+            "%0 = const 0",
+            "decl_var x#1 %0",
+            "branch_if %c then,ssa_edge_0",
+            "ssa_edge_0:",
+            "%ssa_0 = load_var x#1",
+            "decl_var x#3 %ssa_0",
+            "branch join",
+            "then:",
+            "%1 = const 1",
+            "decl_var x#2 %1",
+            "%ssa_1 = load_var x#2",
+            "decl_var x#3 %ssa_1",
+            "join:",
+            "%2 = load_var x#3",
+            "return %2",
+        ]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_copies_read_every_source_before_writing(self):
+        ssa = to_ssa(_scopes("i", "t", "k"), _loop(), SSAMode.MINIMAL)
+
+        instructions = [str(inst) for inst in from_ssa(ssa)]
+
+        end = instructions.index("branch cond")
+        assert instructions[end - 6 : end] == [
+            "%ssa_2 = load_var i#3",
+            "%ssa_3 = load_var k#2",
+            "%ssa_4 = load_var t#3",
+            "decl_var i#2 %ssa_2",
+            "decl_var k#1 %ssa_3",
+            "decl_var t#2 %ssa_4",
+        ]
+
+
+class TestSsaForm:
+    @covers(PythonFeature.WHILE_LOOP, PythonFeature.FUNCTION_DECLARATION)
+    def test_python_loop_counter_gets_a_phi_at_the_loop_head(self):
+        ssa = ssa_form(PYTHON_SOURCE, Language.PYTHON)
+
+        phis = [phi for phis in ssa.phis.values() for phi in phis]
+        assert [phi.name for phi in phis] == ["i"]
+        assert len(phis[0].sources) == 2
+
+    @covers(JavaFeature.WHILE_LOOP, JavaFeature.LOCAL_VARIABLE)
+    def test_java_loop_counter_gets_a_phi_at_the_loop_head(self):
+        ssa = ssa_form(JAVA_SOURCE, Language.JAVA, SSAMode.PRUNED)
+
+        phis = [phi for phis in ssa.phis.values() for phi in phis]
+        assert [phi.name for phi in phis] == ["i"]
+        assert {version for _, version in phis[0].sources} == {"i#1", "i#3"}
@@ -0,0 +1,203 @@
+"""Tests for parsing, scanning and lowering from a stream through a bounded window."""
+
+from __future__ import annotations
+
+import io
+
+import pytest
+
+from interpreter.constants import Language
+from interpreter.frontends.go import GoFrontend
+from interpreter.frontends.python import PythonFrontend
+from interpreter.instructions import StoreVar
+from interpreter.parser import Parser, TreeSitterParserFactory
+from interpreter.scanner import Scanner
+from interpreter.stream_source import StreamSource, StreamWindowError
+from interpreter.token_types import TokenCategory
+from tests.covers import NotLanguageFeature, covers
+
+_DIGITS = bytes(range(48, 58)) * 10  # 100 bytes
+
+
+class _Unseekable(io.BytesIO):
+    """A stream read once, front to back, like a pipe."""
+
+    def seekable(self) -> bool:
+        return False
+
+
+class _GeneratedLines(io.RawIOBase):
+    """The program ``x0000000 = 1`` ... one line per index, made on demand.
+
+    Each read builds only the lines it covers, so the program never exists
+    as one string, and the largest read is recorded.
+    """
+
+    _LINE = 13  # len(b"x0000000 = 1\n")
+
+    def __init__(self, count: int):
+        self._size = count * self._LINE
+        self._position = 0
+        self.largest_read = 0
+
+    def readable(self) -> bool:
+        return True
+
+    def seekable(self) -> bool:
+        return True
+
+    def tell(self) -> int:
+        return self._position
+
+    def seek(self, offset: int, whence: int = io.SEEK_SET) -> int:
+        assert whence == io.SEEK_SET
+        self._position = offset
+        return offset
+
+    def readinto(self, buffer) -> int:
+        size = min(len(buffer), self._size - self._position)
+        if size <= 0:
+            return 0
+        first = self._position // self._LINE
+        last = (self._position + size - 1) // self._LINE
+        lines = b"".join(b"x%07d = 1\n" % i for i in range(first, last + 1))
+        skip = self._position - first * self._LINE
+        buffer[:size] = lines[skip : skip + size]
+        self._position += size
+        self.largest_read = max(self.largest_read, size)
+        return size
+
+
+class TestStreamSource:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_reads_chunks_on_demand(self):
+        source = StreamSource(io.BytesIO(_DIGITS), chunk_size=8, window_size=32)
+        assert source.read(0) == _DIGITS[:8]
+        assert source.bytes_read == 8
+        assert source.read(5) == _DIGITS[5:13]
+        assert source.bytes_read == 16
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_end_of_stream_is_empty(self):
+        source = StreamSource(io.BytesIO(_DIGITS), chunk_size=8, window_size=32)
+        assert source.read(96) == _DIGITS[96:]
+        assert source.read(100) == b""
+        assert source.read(500) == b""
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_old_bytes_leave_the_window(self):
+        source = StreamSource(_Unseekable(_DIGITS), chunk_size=8, window_size=32)
+        assert source.read(60) == _DIGITS[60:68]
+        assert source.read(40) == _DIGITS[40:48]
+        with pytest.raises(StreamWindowError, match="Byte 10"):
+            source.read(10)
+        with pytest.raises(StreamWindowError, match="Byte 10"):
+            source[10:12]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_slices_read_through_the_window(self):
+        source = StreamSource(io.BytesIO(_DIGITS), chunk_size=8, window_size=32)
+        assert source[3:7] == _DIGITS[3:7]
+        assert source[20:30] == _DIGITS[20:30]
+        assert source.bytes_read == 32
+        assert source[95:120] == _DIGITS[95:]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_seekable_stream_rereads_old_bytes(self):
+        stream = io.BytesIO(b"header" + _DIGITS)
+        stream.seek(6)
+        source = StreamSource(stream, chunk_size=8, window_size=32)
+        assert source.read(80) == _DIGITS[80:88]
+        assert source[10:14] == _DIGITS[10:14]
+        assert source.read(60) == _DIGITS[60:68]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_window_must_hold_a_chunk(self):
+        with pytest.raises(ValueError, match="at least one chunk"):
+            StreamSource(io.BytesIO(b""), chunk_size=64, window_size=16)
+
+
+class TestParseStream:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_tree_matches_parsing_the_whole_source(self):
+        source = "def f(x):\n    return 'héllo' + x\n\nprint(f('!'))\n"
+        parser = Parser(TreeSitterParserFactory())
+        streamed = parser.parse_stream(
+            StreamSource(io.BytesIO(source.encode()), chunk_size=8, window_size=16),
+            Language.PYTHON,
+        )
+        whole = parser.parse(source, Language.PYTHON)
+        assert str(streamed.root_node) == str(whole.root_node)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_large_program_through_a_small_window(self):
+        program = b"".join(b"x%d = %d + 1\n" % (i, i) for i in range(20_000))
+        source = StreamSource(io.BytesIO(program), chunk_size=1024, window_size=4096)
+        tree = Parser(TreeSitterParserFactory()).parse_stream(source, Language.PYTHON)
+        assert tree.root_node.child_count == 20_000
+        assert tree.root_node.end_byte == len(program)
+        assert not tree.root_node.has_error
+
+
+class TestScanStream:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_tokens_match_scanning_the_string(self):
+        source = "x = 'héllo'  # hi\nif x:\n    y = x $ 2\n"
+        streamed = Scanner(
+            StreamSource(io.BytesIO(source.encode()), chunk_size=8, window_size=16),
+            Language.PYTHON,
+        )
+        whole = Scanner(source, Language.PYTHON)
+        assert [(t.text, t.category, t.location) for t in streamed] == [
+            (t.text, t.category, t.location) for t in whole
+        ]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_large_program_never_held_whole(self):
+        stream = _GeneratedLines(20_000)
+        source = StreamSource(stream, chunk_size=1024, window_size=4096)
+        tokens = list(Scanner(source, Language.PYTHON))
+        assert len(tokens) == 3 * 20_000
+        last = tokens[-3]
+        assert (last.text, last.category) == ("x0019999", TokenCategory.IDENTIFIER)
+        assert last.location.start_line == 20_000
+        assert tokens[0].text == "x0000000"
+        assert stream.largest_read <= 1024
+
+
+def _python() -> PythonFrontend:
+    return PythonFrontend(TreeSitterParserFactory(), Language.PYTHON)
+
+
+class TestLowerStream:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_ir_matches_lowering_the_bytes(self):
+        source = (
+            b"class Point:\n"
+            b"    def __init__(self, x):\n"
+            b"        self.x = x\n"
+            b"\n"
+            b"p = Point('h\xc3\xa9llo')\n"
+            b"print(p.x)\n"
+        )
+        streamed = _python().lower(
+            StreamSource(io.BytesIO(source), chunk_size=8, window_size=16)
+        )
+        whole = _python().lower(source)
+        assert [str(inst) for inst in streamed] == [str(inst) for inst in whole]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_large_program_never_held_whole(self):
+        stream = _GeneratedLines(5_000)
+        source = StreamSource(stream, chunk_size=1024, window_size=4096)
+        instructions = _python().lower(source)
+        stores = [str(inst.name) for inst in instructions if isinstance(inst, StoreVar)]
+        assert stores[0] == "x0000000"
+        assert stores[-1] == "x0004999"
+        assert stream.largest_read <= 1024
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_frontend_that_rewrites_its_source_refuses_a_stream(self):
+        frontend = GoFrontend(TreeSitterParserFactory(), Language.GO)
+        with pytest.raises(ValueError, match="cannot be lowered from a stream"):
+            frontend.lower(StreamSource(io.BytesIO(b"package main\n")))
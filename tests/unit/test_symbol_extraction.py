@@ -6,9 +6,11 @@ from interpreter.class_name import ClassName
 from interpreter.constants import Language
 from interpreter.field_name import FieldName
 from interpreter.frontend import get_frontend
+from interpreter.frontends.go.features import GoFeature
 from interpreter.frontends.symbol_table import SymbolTable
 from interpreter.func_name import FuncName
 from interpreter.parser import TreeSitterParserFactory
+from tests.covers import covers
 
 
 def _extract(language: Language, source: str) -> SymbolTable:
@@ -336,12 +338,73 @@ class TestGoSymbolExtraction:
         st = _extract(Language.GO, src)
         assert FuncName("Area") in st.classes[ClassName("C")].methods
 
+    @covers(GoFeature.METHOD_DECLARATION)
+    def test_extracts_pointer_receiver_method_declared_before_struct(self):
+        src = "package main\nfunc (c *C) Inc() { c.X++ }\ntype C struct { X int }"
+        st = _extract(Language.GO, src)
+        assert FuncName("Inc") in st.classes[ClassName("C")].methods
+
+    @covers(GoFeature.INTERFACE)
+    def test_interface_implementors_are_structural(self):
+        src = (
+            "package main\ntype Shape interface { Area() int }\n"
+            "type Sq struct { s int }\nfunc (q Sq) Area() int { return 0 }\n"
+            "type Pt struct { x int }"
+        )
+        st = _extract(Language.GO, src)
+        assert st.interfaces[ClassName("Shape")] == (FuncName("Area"),)
+        assert st.implementors(ClassName("Shape")) == (ClassName("Sq"),)
+
     def test_multiple_structs(self):
         src = "package main\ntype A struct { X int }\ntype B struct { Y int }"
         st = _extract(Language.GO, src)
         assert ClassName("A") in st.classes
         assert ClassName("B") in st.classes
 
+    @covers(GoFeature.DOC_COMMENT)
+    def test_doc_comments_attach_to_declarations(self):
+        src = (
+            "package main\n\n"
+            "// Shape is anything with an area.\n"
+            "// It has one method.\n"
+            "type Shape interface { Area() int }\n\n"
+            "/* Sq is a square. */\n"
+            "type Sq struct { s int }\n\n"
+            "// Area is s squared.\n"
+            "func (q Sq) Area() int { return q.s * q.s }\n\n"
+            "// Unit is the unit square.\n"
+            "var Unit = Sq{1}\n\n"
+            "const (\n"
+            "    // Small is small.\n"
+            "    Small = 1\n"
+            "    Large = 2\n"
+            ")\n\n"
+            "// Detached by a blank line.\n\n"
+            "func main() {}\n"
+        )
+        st = _extract(Language.GO, src)
+        assert st.docs == {
+            "Shape": "Shape is anything with an area.\nIt has one method.",
+            "Sq": "Sq is a square.",
+            "Sq.Area": "Area is s squared.",
+            "Unit": "Unit is the unit square.",
+            "Small": "Small is small.",
+            "Large": "",
+            "main": "",
+        }
+        assert st.classes[ClassName("Sq")].doc == "Sq is a square."
+        assert (
+            st.classes[ClassName("Sq")].methods[FuncName("Area")].doc
+            == "Area is s squared."
+        )
+        assert st.undocumented() == ("Large", "main")
+
+    @covers(GoFeature.DOC_COMMENT)
+    def test_trailing_comment_is_not_a_doc_comment(self):
+        src = "package main\nvar x = 1 // x is one\nfunc f() {}\n"
+        st = _extract(Language.GO, src)
+        assert st.functions[FuncName("f")].doc == ""
+
 
 class TestPHPSymbolExtraction:
     def test_extracts_class_with_fields(self):
@@ -0,0 +1,94 @@
+"""Tests for syntax-error recovery and the diagnostics it reports."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+import tree_sitter_language_pack as tslp
+
+from interpreter.api import syntax_diagnostics
+from interpreter.constants import Language
+from interpreter.frontends import get_deterministic_frontend
+from interpreter.frontends.syntax_diagnostics import collect_syntax_diagnostics
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+_TWO_ERRORS = b"a = 1\nb = = 2\nc = 3\nd = * 4\nz = 5\n"
+
+
+@dataclass
+class FakeNode:
+    type: str
+    start_byte: int
+    end_byte: int
+    start_point: tuple[int, int]
+    end_point: tuple[int, int]
+    is_error: bool = False
+    is_missing: bool = False
+    has_error: bool = False
+    children: tuple[FakeNode, ...] = ()
+
+
+def _node(type_: str, start: int, end: int, **flags) -> FakeNode:
+    """A node on the first line, spanning bytes (and columns) *start*..*end*."""
+    return FakeNode(type_, start, end, (0, start), (0, end), **flags)
+
+
+class TestCollectSyntaxDiagnostics:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_valid_source_has_none(self):
+        source = b"x = 1\nprint(x)\n"
+        tree = tslp.get_parser("python").parse(source)
+        assert collect_syntax_diagnostics(tree.root_node, source) == ()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_reports_every_error_in_one_pass(self):
+        tree = tslp.get_parser("python").parse(_TWO_ERRORS)
+        diagnostics = collect_syntax_diagnostics(tree.root_node, _TWO_ERRORS)
+        lines = [d.location.start_line for d in diagnostics]
+        assert {2, 4} <= set(lines)
+        assert lines == sorted(lines)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_missing_token_message(self):
+        missing = _node(")", 7, 7, is_missing=True)
+        root = _node("module", 0, 7, has_error=True, children=(missing,))
+        [diagnostic] = collect_syntax_diagnostics(root, b"print(1")
+        assert diagnostic.message == 'missing ")"'
+        assert str(diagnostic) == '1:7-1:7: missing ")"'
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_unexpected_excerpt_is_first_line_truncated(self):
+        source = b"x = " + b"y" * 50 + b"\nmore\n"
+        error = _node("ERROR", 4, len(source), is_error=True)
+        root = _node("module", 0, len(source), has_error=True, children=(error,))
+        [diagnostic] = collect_syntax_diagnostics(root, source)
+        assert diagnostic.message == f'unexpected "{"y" * 40}..."'
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_nested_errors_reported_once(self):
+        inner = _node(")", 3, 3, is_missing=True)
+        outer = _node("ERROR", 0, 3, is_error=True, has_error=True, children=(inner,))
+        root = _node("module", 0, 3, has_error=True, children=(outer,))
+        assert len(collect_syntax_diagnostics(root, b"f(x")) == 1
+
+
+class TestFrontendDiagnostics:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_lowering_continues_past_errors(self):
+        frontend = get_deterministic_frontend(Language.PYTHON)
+        ir = frontend.lower(_TWO_ERRORS)
+        assert len(frontend.diagnostics) >= 2
+        assert any(getattr(inst, "name", None) == VarName("z") for inst in ir)
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_valid_source_clears_previous_diagnostics(self):
+        frontend = get_deterministic_frontend(Language.PYTHON)
+        frontend.lower(_TWO_ERRORS)
+        frontend.lower(b"x = 1\n")
+        assert frontend.diagnostics == ()
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_api_reports_without_lowering(self):
+        diagnostics = syntax_diagnostics(_TWO_ERRORS.decode(), Language.PYTHON)
+        assert len(diagnostics) >= 2
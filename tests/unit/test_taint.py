@@ -0,0 +1,166 @@
+"""Tests for finding untrusted values that reach sinks unsanitized."""
+
+from __future__ import annotations
+
+from interpreter.api import taint_flows
+from interpreter.cfg import build_cfg
+from interpreter.constants import Language
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.func_name import FuncName
+from interpreter.instructions import (
+    Branch,
+    CallFunction,
+    Const,
+    DeclVar,
+    InstructionBase,
+    Label_,
+    LoadVar,
+    Return_,
+    StoreVar,
+    Symbolic,
+)
+from interpreter.interprocedural.call_graph import build_call_graph
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.register import Register
+from interpreter.registry import FunctionRegistry
+from interpreter.taint import find_taint_flows
+from interpreter.taint_types import DEFAULT_POLICY, TaintPolicy
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+PYTHON_SOURCE = """\
+import os
+
+
+def run(cmd):
+    os.system(cmd)
+
+
+name = input()
+count = int(input())
+eval("print(" + name + ")")
+eval(str(count))
+run(name)
+"""
+
+JAVA_SOURCE = """\
+class Page {
+    static String readName() {
+        return "guest";
+    }
+
+    static void render(String html) {
+    }
+
+    static String clean(String s) {
+        return s;
+    }
+
+    static void show() {
+        String name = readName();
+        render("<b>" + name + "</b>");
+        render(clean(name));
+    }
+}
+"""
+
+
+def _at(line: int) -> SourceLocation:
+    return SourceLocation(start_line=line, start_col=0, end_line=line, end_col=9)
+
+
+def _call(result: str, name: str, line: int, *args: str) -> CallFunction:
+    return CallFunction(
+        result_reg=Register(result),
+        func_name=FuncName(name),
+        args=tuple(Register(a) for a in args),
+        source_location=_at(line),
+    )
+
+
+def _program() -> tuple[list[InstructionBase], FunctionRegistry]:
+    """run(cmd) passes cmd to system; the top level reads a name, passes it to
+    eval and run, and passes the name parsed as an int to eval."""
+    entry = CodeLabel("func_run_0")
+    end = CodeLabel("end_run_1")
+    instructions = [
+        Branch(label=end, source_location=_at(1)),
+        Label_(label=entry),
+        Symbolic(result_reg=Register("%p"), hint="param:cmd"),
+        DeclVar(name=VarName("cmd"), value_reg=Register("%p")),
+        LoadVar(result_reg=Register("%c"), name=VarName("cmd")),
+        _call("%s", "os.system", 2, "%c"),
+        Return_(),
+        Label_(label=end),
+        Const.func_ref(Register("%f"), str(entry)),
+        _call("%1", "input", 4),
+        StoreVar(name=VarName("s"), value_reg=Register("%1")),
+        LoadVar(result_reg=Register("%2"), name=VarName("s")),
+        _call("%3", "eval", 5, "%2"),
+        _call("%4", "int", 6, "%2"),
+        StoreVar(name=VarName("s"), value_reg=Register("%4")),
+        LoadVar(result_reg=Register("%5"), name=VarName("s")),
+        _call("%6", "eval", 7, "%5"),
+        _call("%7", "func_run_0", 8, "%2"),
+    ]
+    return instructions, FunctionRegistry(func_params={entry: ["cmd"]})
+
+
+class TestFindTaintFlows:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_taint_reaches_sinks_through_variables_and_calls(self):
+        instructions, registry = _program()
+        graph = build_call_graph(build_cfg(instructions), registry)
+
+        flows = find_taint_flows(instructions, graph, DEFAULT_POLICY)
+
+        assert [(f.location, f.sink, f.source) for f in flows] == [
+            (_at(2), "os.system", "input"),
+            (_at(5), "eval", "input"),
+        ]
+        assert str(flows[1]) == (
+            "5:0-5:9: warning: untrusted value from input reaches eval"
+        )
+        diagnostic = flows[0].as_diagnostic()
+        assert diagnostic.code == DiagnosticCode.TAINTED_FLOW
+        assert [str(span) for span in diagnostic.secondary] == [
+            "4:0-4:9: note: input called here"
+        ]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_policy_names_match_a_call_by_its_whole_name_or_last_part(self):
+        policy = TaintPolicy().with_sinks("os.system", "render")
+
+        assert policy.is_sink("os.system")
+        assert policy.is_sink("view.render")
+        assert not policy.is_sink("system")
+        assert DEFAULT_POLICY.is_sink("os.system")
+        assert not DEFAULT_POLICY.is_source("render")
+
+
+class TestTaintFlows:
+    @covers(PythonFeature.FUNCTION_CALL, PythonFeature.FUNCTION_DECLARATION)
+    def test_python_input_reaches_eval_and_system_unless_parsed(self):
+        flows = taint_flows(PYTHON_SOURCE, Language.PYTHON)
+
+        assert [(f.location.start_line, f.source) for f in flows] == [
+            (5, "input"),
+            (10, "input"),
+        ]
+
+    @covers(JavaFeature.FUNCTION_CALL, JavaFeature.LOCAL_VARIABLE)
+    def test_java_policy_of_the_programs_own_methods(self):
+        policy = (
+            TaintPolicy()
+            .with_sources("readName")
+            .with_sinks("render")
+            .with_sanitizers("clean")
+        )
+
+        flows = taint_flows(JAVA_SOURCE, Language.JAVA, policy)
+
+        assert [(f.location.start_line, f.sink, f.source) for f in flows] == [
+            (15, "render", "readName")
+        ]
@@ -0,0 +1,172 @@
+"""Tests for the opt-in static type checking pass."""
+
+from __future__ import annotations
+
+import pytest
+
+from interpreter.api import check_types
+from interpreter.constants import Language
+from interpreter.diagnostic import DiagnosticCode
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.kotlin.features import KotlinFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.run import run
+from interpreter.types.type_check import IllTypedProgramError
+from interpreter.types.typed_value import unwrap
+from interpreter.var_name import VarName
+from tests.covers import covers
+
+KOTLIN_RNA = """\
+fun toRna(dna: String, n: Int): String {
+    var result = ""
+    var i = 0
+    while (i < n) {
+        if (dna[i] == "G") {
+            result = result + "C"
+        }
+        i = i + 1
+    }
+    return result
+}
+
+val answer = toRna("GG", 2)
+"""
+
+KOTLIN_CHARS = """\
+fun count(dna: String, n: Int): Int {
+    var seen = 0
+    var i = 0
+    while (i < n) {
+        if (dna[i] == 'G') {
+            seen = seen + 1
+        }
+        i = i + 1
+    }
+    return seen
+}
+
+val answer = count("GAG", 3)
+"""
+
+JAVA_INDEXED_STRING = """\
+class M {
+    static int first(String dna) {
+        if (dna[0] == 'G') {
+            return 1;
+        }
+        return 0;
+    }
+}
+"""
+
+KOTLIN_CALLS = """\
+fun scale(n: Int, factor: Int): Int {
+    return n * factor
+}
+
+fun greet(name: String = "you"): String {
+    return "hi " + name
+}
+
+val a = scale(2)
+val b = scale("2", 3)
+val c = greet()
+val d = greet("me")
+"""
+
+GO_CALLS = """\
+package main
+
+func add(a, b int) int {
+\treturn a + b
+}
+
+func sum(xs ...int) int {
+\treturn len(xs)
+}
+
+func main() {
+\tlimit := 3
+\tx := add(1, 2, 3)
+\ty := sum(1, 2, 3)
+\tz := limit(1)
+}
+"""
+
+
+class TestTypeErrors:
+    @covers(KotlinFeature.INDEXING)
+    def test_char_compared_with_string_is_rejected(self):
+        errors = check_types(KOTLIN_RNA, Language.KOTLIN).errors
+
+        assert [e.message for e in errors] == ["cannot compare Int with String"]
+        assert errors[0].location.start_line == 5
+
+    @covers(KotlinFeature.CHAR_LITERAL)
+    def test_char_compared_with_char_is_accepted(self):
+        assert check_types(KOTLIN_CHARS, Language.KOTLIN).ok
+
+    @covers(JavaFeature.ARRAY_ACCESS)
+    def test_java_string_cannot_be_indexed(self):
+        errors = check_types(JAVA_INDEXED_STRING, Language.JAVA).errors
+
+        assert [e.message for e in errors] == ["cannot index a String"]
+        assert errors[0].location.start_line == 3
+
+    @covers(PythonFeature.COMPARISON)
+    def test_dynamically_typed_languages_are_not_rejected(self):
+        assert check_types('x = 1 == "1"\n', Language.PYTHON).ok
+
+
+class TestCallChecks:
+    @covers(KotlinFeature.FUNCTION_CALL)
+    def test_wrong_argument_count_and_type_point_at_the_declaration(self):
+        errors = check_types(KOTLIN_CALLS, Language.KOTLIN).errors
+
+        assert [(e.location.start_line, e.message) for e in errors] == [
+            (9, "not enough arguments in call to scale (have 1, want 2)"),
+            (10, "cannot use String as Int in argument 1 to scale"),
+        ]
+        assert [str(note) for note in errors[0].notes] == [
+            "1:0-3:1: note: scale declared here"
+        ]
+
+    @covers(GoFeature.VARIADIC)
+    def test_go_arity_variadic_and_non_function_calls(self):
+        errors = check_types(GO_CALLS, Language.GO).errors
+
+        assert [(e.location.start_line, e.message) for e in errors] == [
+            (13, "too many arguments in call to add (have 3, want 2)"),
+            (15, "cannot call non-function limit of type Int"),
+        ]
+        assert [e.code for e in errors] == [
+            DiagnosticCode.WRONG_ARGUMENT_COUNT,
+            DiagnosticCode.NOT_CALLABLE,
+        ]
+        assert errors[0].notes[0].location.start_line == 3
+
+
+class TestExpressionTypes:
+    @covers(KotlinFeature.INDEXING)
+    def test_every_expression_is_typed(self):
+        result = check_types(KOTLIN_RNA, Language.KOTLIN)
+
+        assert result.expression_types
+        assert all(result.expression_types.values())
+        assert "Bool" in {str(t) for t in result.expression_types.values()}
+
+
+class TestRunTypeCheck:
+    @covers(KotlinFeature.INDEXING)
+    def test_ill_typed_program_does_not_run(self):
+        with pytest.raises(IllTypedProgramError, match=r"type error at 5:"):
+            run(KOTLIN_RNA, language=Language.KOTLIN, type_check=True)
+
+    @covers(KotlinFeature.CHAR_LITERAL)
+    def test_well_typed_program_runs(self):
+        vm = run(
+            KOTLIN_CHARS, language=Language.KOTLIN, max_steps=1000, type_check=True
+        )
+
+        assert unwrap(vm.current_frame.local_vars[VarName("answer")]) == 2
@@ -0,0 +1,91 @@
+"""Tests for TypeInfo, the per-span record of a checked program."""
+
+from __future__ import annotations
+
+from types import MappingProxyType
+
+from interpreter.api import type_info
+from interpreter.constants import FoundationTypeName, Language
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.scopes import NO_SYMBOL, ScopeBuilder, SymbolKind
+from interpreter.instructions import Binop, Const, DeclVar, LoadVar
+from interpreter.ir import SourceLocation, SourcePosition
+from interpreter.register import Register
+from interpreter.types.type_check import TypeCheckResult
+from interpreter.types.type_expr import UNKNOWN, scalar
+from interpreter.types.type_info import build_type_info
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+_INT = scalar(FoundationTypeName.INT)
+
+GO_SOURCE = """\
+package main
+
+const KB = 1 << 10
+
+func main() {
+\tsize := KB * 4
+\tprintln(size)
+}
+"""
+
+
+def _span(line: int, start_col: int, end_col: int) -> SourceLocation:
+    return SourceLocation(
+        start_line=line, start_col=start_col, end_line=line, end_col=end_col
+    )
+
+
+def _info():
+    """``x := 1 << 3`` on line 2, then ``x + 1`` on line 3."""
+    builder = ScopeBuilder(
+        SourceLocation(start_line=1, start_col=0, end_line=5, end_col=0)
+    )
+    builder.declare("x", SymbolKind.VARIABLE, _span(2, 0, 1))
+    scopes = builder.build()
+    r = [Register(f"%{n}") for n in range(4)]
+    instructions = [
+        Const.int_(r[0], 8, source_location=_span(2, 5, 11)),
+        DeclVar(name=VarName("x"), value_reg=r[0], source_location=_span(2, 0, 11)),
+        LoadVar(result_reg=r[1], name=VarName("x"), source_location=_span(3, 0, 1)),
+        Const.int_(r[2], 1, source_location=_span(3, 4, 5)),
+        Binop(result_reg=r[3], left=r[1], right=r[2], source_location=_span(3, 0, 5)),
+    ]
+    checked = TypeCheckResult(
+        expression_types=MappingProxyType({reg: _INT for reg in r}), errors=()
+    )
+    return build_type_info(instructions, checked, scopes), scopes.symbols[0]
+
+
+class TestBuildTypeInfo:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_expression_types_and_constant_values(self):
+        info, _ = _info()
+
+        assert info.type_of(_span(3, 0, 5)) == _INT
+        assert info.values[(2, 5, 2, 11)] == 8
+        assert (3, 0, 3, 5) not in info.values
+        assert info.type_at(SourcePosition(3, 4, 0)) == _INT
+        assert info.type_at(SourcePosition(9, 0, 0)) == UNKNOWN
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_names_resolve_to_their_declaration(self):
+        info, x = _info()
+
+        assert info.object_of(_span(2, 0, 1)) == x
+        assert info.uses == {(3, 0, 3, 1): x}
+        assert info.object_at(SourcePosition(3, 0, 0)) == x
+        assert info.object_at(SourcePosition(3, 4, 0)) == NO_SYMBOL
+
+
+class TestTypeInfo:
+    @covers(GoFeature.CONST_DECLARATION)
+    def test_go_folded_constants_and_uses(self):
+        info = type_info(GO_SOURCE, Language.GO)
+
+        assert info.values[(3, 11, 3, 18)] == 1024
+        assert info.values[(6, 9, 6, 15)] == 4096
+        assert info.type_at(SourcePosition(6, 10, 0)) == _INT
+        size = info.object_at(SourcePosition(7, 10, 0))
+        assert (size.name, size.location.start_line) == ("size", 6)
@@ -0,0 +1,102 @@
+"""Tests for reporting locals and parameters that are never read."""
+
+from __future__ import annotations
+
+from interpreter.api import unused_variables
+from interpreter.constants import Language
+from interpreter.diagnostic import Severity
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.python.features import PythonFeature
+from interpreter.frontends.scopes import ScopeBuilder, ScopeKind, SymbolKind
+from interpreter.instructions import LoadVar
+from interpreter.ir import SourceLocation
+from interpreter.unused_variables import find_unused_variables
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+GO_SOURCE = """\
+package main
+
+func area(w int, h int, _ int) int {
+\tscale := 2
+\treturn w * w
+}
+
+func main() {
+\tunused := 1
+\ttotal := area(3, 4, 5)
+\t_ = total
+}
+"""
+
+PYTHON_SOURCE = """\
+def f(a, b):
+    c = a
+    return a
+"""
+
+
+def _span(start_line: int, end_line: int) -> SourceLocation:
+    return SourceLocation(
+        start_line=start_line, start_col=0, end_line=end_line, end_col=0
+    )
+
+
+def _load(name: str, line: int) -> LoadVar:
+    return LoadVar(name=VarName(name), source_location=_span(line, line))
+
+
+class TestFindUnusedVariables:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_read_outside_the_declaring_scope_is_not_a_use(self):
+        builder = ScopeBuilder(_span(1, 10))
+        builder.enter(ScopeKind.FUNCTION, _span(2, 4), "f")
+        builder.declare("n", SymbolKind.VARIABLE, _span(3, 3))
+        builder.exit(ScopeKind.FUNCTION, "f")
+        builder.enter(ScopeKind.FUNCTION, _span(5, 7), "g")
+        builder.declare("n", SymbolKind.VARIABLE, _span(6, 6))
+        builder.exit(ScopeKind.FUNCTION, "g")
+
+        unused = find_unused_variables(builder.build(), [_load("n", 6)])
+
+        assert [u.location.start_line for u in unused] == [3]
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_globals_and_exempt_names_are_not_reported(self):
+        builder = ScopeBuilder(_span(1, 10))
+        builder.declare("g", SymbolKind.VARIABLE, _span(1, 1))
+        builder.enter(ScopeKind.FUNCTION, _span(2, 4), "f")
+        builder.declare("self", SymbolKind.PARAMETER, _span(2, 2))
+        builder.declare("_ignored", SymbolKind.PARAMETER, _span(2, 2))
+        builder.exit(ScopeKind.FUNCTION, "f")
+
+        assert find_unused_variables(builder.build(), []) == ()
+
+
+class TestUnusedVariables:
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_go_unused_locals_and_parameters_are_warnings(self):
+        unused = unused_variables(GO_SOURCE, Language.GO)
+
+        assert [(u.location.start_line, u.message) for u in unused] == [
+            (3, "parameter declared and not used: h"),
+            (4, "declared and not used: scale"),
+            (9, "declared and not used: unused"),
+        ]
+        assert {u.severity for u in unused} == {Severity.WARNING}
+
+    @covers(GoFeature.SHORT_VAR_DECL)
+    def test_strict_reports_errors(self):
+        unused = unused_variables(GO_SOURCE, Language.GO, strict=True)
+
+        assert {u.severity for u in unused} == {Severity.ERROR}
+        assert str(unused[1]).endswith(": error: declared and not used: scale")
+
+    @covers(PythonFeature.FUNCTION_DECLARATION)
+    def test_python_unused_parameter_and_local(self):
+        unused = unused_variables(PYTHON_SOURCE, Language.PYTHON)
+
+        assert [(u.name, u.is_parameter) for u in unused] == [
+            ("b", True),
+            ("c", False),
+        ]
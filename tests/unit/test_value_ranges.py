@@ -0,0 +1,242 @@
+"""Tests for proving indexes in bounds and divisors nonzero by value ranges."""
+
+from __future__ import annotations
+
+import math
+
+from interpreter.api import range_checks
+from interpreter.constants import Language
+from interpreter.diagnostic import DiagnosticCode, Severity
+from interpreter.frontends.go.features import GoFeature
+from interpreter.frontends.java.features import JavaFeature
+from interpreter.frontends.scopes import Scope, ScopeBuilder, SymbolKind
+from interpreter.func_name import FuncName
+from interpreter.instructions import (
+    Binop,
+    Branch,
+    BranchIf,
+    CallFunction,
+    Const,
+    DeclVar,
+    InstructionBase,
+    Label_,
+    LoadIndex,
+    LoadVar,
+    NewArray,
+    StoreVar,
+    Symbolic,
+)
+from interpreter.ir import CodeLabel, SourceLocation
+from interpreter.operator_kind import BinopKind
+from interpreter.register import Register
+from interpreter.value_range_types import Interval, Verdict
+from interpreter.value_ranges import find_range_checks
+from interpreter.var_name import VarName
+from tests.covers import NotLanguageFeature, covers
+
+GO_SOURCE = """\
+package main
+
+func hammingDistance(s1 string, s2 string) int {
+\tcount := 0
+\ti := 0
+\tfor i < len(s1) {
+\t\tif s1[i] != s2[i] {
+\t\t\tcount = count + 1
+\t\t}
+\t\ti = i + 1
+\t}
+\treturn count
+}
+"""
+
+JAVA_SOURCE = """\
+class Hamming {
+    static int distance(char[] s1, char[] s2, int n) {
+        int count = 0;
+        for (int i = 0; i < n; i++) {
+            if (s1[i] != s2[i]) {
+                count++;
+            }
+        }
+        int[] counts = new int[3];
+        counts[3] = count;
+        return count / 0;
+    }
+}
+"""
+
+
+def _at(line: int) -> SourceLocation:
+    return SourceLocation(start_line=line, start_col=0, end_line=line, end_col=9)
+
+
+def _scopes(*names: str) -> Scope:
+    builder = ScopeBuilder(
+        SourceLocation(start_line=1, start_col=0, end_line=20, end_col=0)
+    )
+    for name in names:
+        builder.declare(name, SymbolKind.VARIABLE, _at(1))
+    return builder.build()
+
+
+def _load(result: str, name: str) -> LoadVar:
+    return LoadVar(result_reg=Register(result), name=VarName(name))
+
+
+def _index(result: str, arr: str, index: str, line: int) -> LoadIndex:
+    return LoadIndex(
+        result_reg=Register(result),
+        arr_reg=Register(arr),
+        index_reg=Register(index),
+        source_location=_at(line),
+    )
+
+
+def _divide(result: str, left: str, right: str, line: int) -> Binop:
+    return Binop(
+        result_reg=Register(result),
+        operator=BinopKind.DIV,
+        left=Register(left),
+        right=Register(right),
+        source_location=_at(line),
+    )
+
+
+def _hamming() -> list[InstructionBase]:
+    """i counts up while below len(s1), indexing s1 on line 4 and s2 on
+    line 5; after the loop 10 is divided by i on line 7 and by 0 on line 8."""
+    cond, body, end = CodeLabel("cond"), CodeLabel("body"), CodeLabel("end")
+    return [
+        Label_(label=CodeLabel("entry")),
+        Symbolic(result_reg=Register("%a"), hint="param:s1"),
+        DeclVar(name=VarName("s1"), value_reg=Register("%a")),
+        Symbolic(result_reg=Register("%b"), hint="param:s2"),
+        DeclVar(name=VarName("s2"), value_reg=Register("%b")),
+        Const.int_(Register("%0"), 0),
+        DeclVar(name=VarName("i"), value_reg=Register("%0")),
+        Label_(label=cond),
+        _load("%1", "i"),
+        _load("%2", "s1"),
+        CallFunction(
+            result_reg=Register("%3"), func_name=FuncName("len"), args=(Register("%2"),)
+        ),
+        Binop(
+            result_reg=Register("%4"),
+            operator=BinopKind.LT,
+            left=Register("%1"),
+            right=Register("%3"),
+        ),
+        BranchIf(cond_reg=Register("%4"), branch_targets=(body, end)),
+        Label_(label=body),
+        _load("%5", "s1"),
+        _load("%6", "i"),
+        _index("%7", "%5", "%6", 4),
+        _load("%8", "s2"),
+        _load("%9", "i"),
+        _index("%10", "%8", "%9", 5),
+        _load("%11", "i"),
+        Const.int_(Register("%12"), 1),
+        Binop(
+            result_reg=Register("%13"),
+            operator=BinopKind.ADD,
+            left=Register("%11"),
+            right=Register("%12"),
+        ),
+        StoreVar(name=VarName("i"), value_reg=Register("%13")),
+        Branch(label=cond),
+        Label_(label=end),
+        Const.int_(Register("%14"), 10),
+        _load("%15", "i"),
+        _divide("%16", "%14", "%15", 7),
+        Const.int_(Register("%17"), 0),
+        _divide("%18", "%14", "%17", 8),
+    ]
+
+
+class TestFindRangeChecks:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_loop_guard_bounds_the_index_of_the_array_it_measures(self):
+        checks = find_range_checks(_scopes("s1", "s2", "i"), _hamming(), Language.GO)
+
+        assert [(c.location.start_line, c.verdict, c.message) for c in checks] == [
+            (4, Verdict.SAFE, "index i of s1 is in bounds"),
+            (
+                5,
+                Verdict.POSSIBLE,
+                "index i of s2 is bounded by the length of s1, "
+                "not by the length of s2",
+            ),
+            (7, Verdict.POSSIBLE, "divisor i may be zero (0..inf)"),
+            (8, Verdict.UNSAFE, "division by zero: divisor is 0"),
+        ]
+        assert not checks[0].is_problem
+        diagnostic = checks[3].as_diagnostic()
+        assert diagnostic.code == DiagnosticCode.DIVISION_BY_ZERO
+        assert diagnostic.severity == Severity.ERROR
+        assert str(checks[1]).startswith("5:0-5:9: warning: index i of s2")
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_constant_index_against_an_array_of_constant_size(self):
+        instructions = [
+            Label_(label=CodeLabel("entry")),
+            Const.int_(Register("%0"), 3),
+            NewArray(result_reg=Register("%1"), size_reg=Register("%0")),
+            DeclVar(name=VarName("a"), value_reg=Register("%1")),
+            _load("%2", "a"),
+            Const.int_(Register("%3"), 2),
+            _index("%4", "%2", "%3", 2),
+            Const.int_(Register("%5"), 3),
+            _index("%6", "%2", "%5", 3),
+        ]
+
+        checks = find_range_checks(_scopes("a"), instructions, Language.JAVA)
+
+        assert [(c.verdict, c.message) for c in checks] == [
+            (Verdict.SAFE, "index 2 of a is in bounds"),
+            (Verdict.UNSAFE, "index 3 is out of bounds of a, of length 3"),
+        ]
+        assert checks[1].as_diagnostic().code == DiagnosticCode.INDEX_OUT_OF_BOUNDS
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_interval_arithmetic_and_widening(self):
+        assert Interval(0, 3) + Interval.of(1) == Interval(1, 4)
+        assert Interval(0, 3) - Interval(1, 2) == Interval(-2, 2)
+        assert Interval(-2, 3) * Interval(0, math.inf) == Interval(-math.inf, math.inf)
+        assert Interval(0, 1).widen(Interval(0, 2)) == Interval(0, math.inf)
+        assert Interval(3, 2).is_empty()
+        assert str(Interval(0, math.inf)) == "0..inf"
+        assert str(Interval.of(5)) == "5"
+
+
+class TestRangeChecks:
+    @covers(GoFeature.FOR_LOOP, GoFeature.INDEXING)
+    def test_go_hamming_distance_trusts_s1_for_the_length_of_s2(self):
+        checks = range_checks(GO_SOURCE, Language.GO)
+
+        assert [(c.location.start_line, c.verdict) for c in checks] == [
+            (7, Verdict.SAFE),
+            (7, Verdict.POSSIBLE),
+        ]
+        assert checks[1].message == (
+            "index i of s2 is bounded by the length of s1, not by the length of s2"
+        )
+
+    @covers(
+        JavaFeature.FOR_LOOP,
+        JavaFeature.ARRAY_ACCESS,
+        JavaFeature.ARRAY_CREATION,
+        JavaFeature.ARITHMETIC,
+    )
+    def test_java_index_bounded_by_a_parameter_and_constant_faults(self):
+        checks = range_checks(JAVA_SOURCE, Language.JAVA)
+
+        assert [(c.location.start_line, c.verdict) for c in checks] == [
+            (5, Verdict.POSSIBLE),
+            (5, Verdict.POSSIBLE),
+            (10, Verdict.UNSAFE),
+            (11, Verdict.UNSAFE),
+        ]
+        assert checks[0].message == (
+            "index i of s1 is bounded by n, not by the length of s1"
+        )
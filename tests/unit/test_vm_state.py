@@ -1,6 +1,7 @@
 """Tests for VMState — data_layout field and serialization."""
 
 from interpreter.vm.vm_types import VMState
+from tests.covers import NotLanguageFeature, covers
 
 
 class TestVMStateDataLayout:
@@ -32,3 +33,27 @@ class TestVMStateDataLayout:
         vm = VMState()
         result = vm.to_dict()
         assert "data_layout" not in result
+
+
+class TestVMStateStdout:
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_stdout_default_empty(self):
+        """VMState starts with no captured output."""
+        vm = VMState()
+        assert vm.stdout == []
+        assert vm.stdout_text() == ""
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_stdout_text_joins_writes_in_order(self):
+        """stdout_text() concatenates every captured write."""
+        vm = VMState()
+        vm.stdout.extend(["hello ", "world\n"])
+        assert vm.stdout_text() == "hello world\n"
+
+    @covers(NotLanguageFeature.INFRASTRUCTURE)
+    def test_stdout_in_to_dict_only_when_written(self):
+        """to_dict() carries the captured output once anything is printed."""
+        vm = VMState()
+        assert "stdout" not in vm.to_dict()
+        vm.stdout.append("42\n")
+        assert vm.to_dict()["stdout"] == "42\n"